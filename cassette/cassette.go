@@ -0,0 +1,407 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cassette provides an http.RoundTripper that records the exchanges of a service client to a file and
+// replays them from it, so that tests of code using the SDK can run offline from fixtures captured once.
+//
+// A Recorder is installed on the core.BaseService of a client:
+//
+//	recorder, err := cassette.New("testdata/create_cluster.yaml", cassette.ModeReplay, nil)
+//	recorder.Install(kubernetesServiceApi.Service)
+//	defer recorder.Stop()
+//
+// In ModeRecord the requests are sent to the service and the exchanges are written to the file by Stop, with the
+// Authorization and X-Auth-Refresh-Token headers and the file_contents of certificates redacted. In ModeReplay the
+// requests are answered from the file, matching them on method, path, query and body. Each recorded exchange is
+// replayed once, in order, so that polling a resource replays its successive states. The request bodies compressed
+// by a client with gzip compression enabled are recorded decompressed, so that they can be redacted.
+//
+// The requests of the authenticator of the client, such as IAM token requests, do not go through the Recorder. Replay
+// with a core.NoAuthAuthenticator.
+package cassette
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// Redacted replaces the values of the redacted headers and fields in the recorded exchanges.
+const Redacted = common.Redacted
+
+// ErrNoInteraction is wrapped by the errors of the requests that match no unused recorded exchange in ModeReplay.
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// Mode : Whether a Recorder records or replays the exchanges.
+type Mode int
+
+const (
+	// ModeRecord sends the requests to the service and records the exchanges.
+	ModeRecord Mode = iota
+
+	// ModeReplay answers the requests from the recorded exchanges.
+	ModeReplay
+)
+
+// DefaultRedactedHeaders are the request and response headers redacted in the recorded exchanges.
+var DefaultRedactedHeaders = common.SecretHeaders
+
+// DefaultRedactedFields are the JSON fields and multipart form fields redacted in the recorded request and response
+// bodies. They hold secrets such as the contents of certificates and keys.
+var DefaultRedactedFields = common.SecretFields
+
+// Options : The options of New.
+type Options struct {
+	// The transport the requests are sent through in ModeRecord. Defaults to the transport of the client the Recorder
+	// is installed on, or http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// Headers to redact in addition to DefaultRedactedHeaders.
+	RedactHeaders []string
+
+	// JSON and multipart form fields to redact in addition to DefaultRedactedFields.
+	RedactFields []string
+}
+
+// Cassette : The recorded exchanges, as stored in the file.
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+// Interaction : A recorded exchange.
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+}
+
+// Request : A recorded request. The body of a multipart form request is recorded as its fields in Form.
+type Request struct {
+	Method string              `yaml:"method"`
+	URL    string              `yaml:"url"`
+	Header map[string][]string `yaml:"header,omitempty"`
+	Body   string              `yaml:"body,omitempty"`
+	Form   map[string]string   `yaml:"form,omitempty"`
+}
+
+// Response : A recorded response.
+type Response struct {
+	StatusCode int                 `yaml:"status_code"`
+	Header     map[string][]string `yaml:"header,omitempty"`
+	Body       string              `yaml:"body,omitempty"`
+}
+
+// Recorder : An http.RoundTripper recording or replaying exchanges.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	redactor  *common.Redactor
+
+	mutex    sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New returns a Recorder of the cassette file at path. In ModeReplay the file is read, and must exist.
+func New(path string, mode Mode, options *Options) (*Recorder, error) {
+	if options == nil {
+		options = &Options{}
+	}
+	recorder := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: options.Transport,
+		redactor: common.NewRedactor(
+			append(append([]string{}, DefaultRedactedHeaders...), options.RedactHeaders...),
+			append(append([]string{}, DefaultRedactedFields...), options.RedactFields...)),
+	}
+
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(contents, &recorder.cassette); err != nil {
+			return nil, fmt.Errorf("cassette: reading %s: %w", path, err)
+		}
+		recorder.used = make([]bool, len(recorder.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("cassette: unknown mode %d", mode)
+	}
+	return recorder, nil
+}
+
+// Install makes the client of service send its requests through the Recorder. The http.Client of service is copied
+// rather than modified, since it may be shared.
+func (recorder *Recorder) Install(service *core.BaseService) {
+	client := http.Client{}
+	if service.Client != nil {
+		client = *service.Client
+	}
+	if recorder.transport == nil {
+		recorder.transport = client.Transport
+	}
+	client.Transport = recorder
+	service.SetHTTPClient(&client)
+}
+
+// Interactions returns the exchanges recorded, or loaded for replay.
+func (recorder *Recorder) Interactions() []Interaction {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return append([]Interaction(nil), recorder.cassette.Interactions...)
+}
+
+// Stop writes the recorded exchanges to the cassette file in ModeRecord, creating its directory if needed. It does
+// nothing in ModeReplay.
+func (recorder *Recorder) Stop() error {
+	if recorder.mode != ModeRecord {
+		return nil
+	}
+	recorder.mutex.Lock()
+	contents, err := yaml.Marshal(&recorder.cassette)
+	recorder.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(recorder.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(recorder.path, contents, 0644)
+}
+
+// RoundTrip records or replays the exchange of req.
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	recorded, err := recorder.request(req, body)
+	if err != nil {
+		return nil, err
+	}
+	if recorder.mode == ModeReplay {
+		return recorder.replay(req, recorded)
+	}
+	return recorder.record(req, recorded)
+}
+
+// record sends req and records the exchange.
+func (recorder *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	transport := recorder.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	response := Response{
+		StatusCode: res.StatusCode,
+		Header:     recorder.redactHeader(res.Header),
+		Body:       string(body),
+	}
+	if isJSON(res.Header.Get("Content-Type")) {
+		response.Body = recorder.redactJSON(body)
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, Interaction{Request: recorded, Response: response})
+	return res, nil
+}
+
+// replay answers req with the first unused recorded exchange matching it.
+func (recorder *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	for i, interaction := range recorder.cassette.Interactions {
+		if recorder.used[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		recorder.used[i] = true
+		header := http.Header{}
+		for name, values := range interaction.Response.Header {
+			header[http.CanonicalHeaderKey(name)] = values
+		}
+		// The body may have changed length when it was redacted.
+		header.Del("Content-Length")
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("cassette: %w: %s %s", ErrNoInteraction, req.Method, req.URL.String())
+}
+
+// request returns req as recorded, with its headers and body redacted. A gzip-compressed body, as sent by a client
+// with gzip compression enabled, is recorded decompressed so that it can be redacted; other encodings are rejected.
+func (recorder *Recorder) request(req *http.Request, body []byte) (Request, error) {
+	recorded := Request{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: recorder.redactHeader(req.Header),
+	}
+	switch encoding := req.Header.Get("Content-Encoding"); {
+	case len(body) == 0 || encoding == "" || strings.EqualFold(encoding, "identity"):
+	case strings.EqualFold(encoding, "gzip"):
+		decompressed, err := gunzip(body)
+		if err != nil {
+			return Request{}, fmt.Errorf("cassette: decompressing the body of %s %s: %w", req.Method, req.URL.Path, err)
+		}
+		body = decompressed
+		delete(recorded.Header, "Content-Encoding")
+	default:
+		return Request{}, fmt.Errorf("cassette: cannot redact the body of %s %s, which has Content-Encoding %s", req.Method, req.URL.Path, encoding)
+	}
+	contentType := req.Header.Get("Content-Type")
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	switch {
+	case len(body) == 0:
+	case mediaType == "multipart/form-data":
+		form, err := recorder.redactForm(body, params["boundary"])
+		if err != nil {
+			return Request{}, fmt.Errorf("cassette: reading the multipart form of %s %s: %w", req.Method, req.URL.Path, err)
+		}
+		recorded.Form = form
+		// The boundary is random, so the header would not match on replay.
+		delete(recorded.Header, "Content-Type")
+	case isJSON(contentType):
+		recorded.Body = recorder.redactJSON(body)
+	default:
+		recorded.Body = string(body)
+	}
+	return recorded, nil
+}
+
+// matches reports whether the request a recorded exchange was made with has the method, path, query and body of req.
+func matches(recorded Request, req Request) bool {
+	if recorded.Method != req.Method || recorded.Body != req.Body || !equalForms(recorded.Form, req.Form) {
+		return false
+	}
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	reqURL, _ := url.Parse(req.URL)
+	return recordedURL.Path == reqURL.Path && recordedURL.Query().Encode() == reqURL.Query().Encode()
+}
+
+// equalForms reports whether two multipart forms have the same fields.
+func equalForms(a map[string]string, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if other, ok := b[name]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
+
+// redactHeader returns a copy of header with the redacted headers replaced.
+func (recorder *Recorder) redactHeader(header http.Header) map[string][]string {
+	return recorder.redactor.Header(header)
+}
+
+// redactJSON returns body with the values of the redacted fields replaced, with its keys sorted so that equal
+// documents compare equal. Bodies that are not valid JSON are returned unchanged.
+func (recorder *Recorder) redactJSON(body []byte) string {
+	redacted, _ := recorder.redactor.JSON(body)
+	return string(redacted)
+}
+
+// redactForm returns the fields of a multipart form body, with the values of the redacted fields replaced.
+func (recorder *Recorder) redactForm(body []byte, boundary string) (map[string]string, error) {
+	form := make(map[string]string)
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		contents, err := ioutil.ReadAll(part)
+		if err != nil {
+			return nil, err
+		}
+		value := string(contents)
+		if recorder.redactor.IsSecretField(part.FormName()) {
+			value = Redacted
+		} else if isJSON(part.Header.Get("Content-Type")) {
+			value = recorder.redactJSON(contents)
+		}
+		form[part.FormName()] = value
+	}
+	return form, nil
+}
+
+// gunzip returns the decompressed content of a gzip-compressed body.
+func gunzip(body []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// isJSON reports whether contentType is a JSON media type.
+func isJSON(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
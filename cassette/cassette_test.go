@@ -0,0 +1,267 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cassette_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/cassette"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	kubefake "github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	linkfake "github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1/fake"
+)
+
+// roundTripperFunc : An http.RoundTripper answering the requests with a function.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+const testCert = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+var _ = Describe(`Recorder`, func() {
+	var dir string
+	var path string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "cassette")
+		Expect(err).To(BeNil())
+		path = filepath.Join(dir, "fixtures", "cassette.yaml")
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe(`With the Kubernetes Service API client`, func() {
+		// newClient returns a client of the service at url, authenticated with a bearer token.
+		var newClient = func(url string) *kubernetesserviceapiv1.KubernetesServiceApiV1 {
+			authenticator, err := core.NewBearerTokenAuthenticator("secret-token")
+			Expect(err).To(BeNil())
+			service, err := kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+				URL:           url,
+				Authenticator: authenticator,
+			})
+			Expect(err).To(BeNil())
+			return service
+		}
+
+		// createAndPoll creates a cluster and reads it twice, returning the states read.
+		var createAndPoll = func(service *kubernetesserviceapiv1.KubernetesServiceApiV1) []string {
+			options := service.NewVpcCreateClusterOptions("rg1")
+			options.Name = core.StringPtr("c1")
+			options.XAuthRefreshToken = core.StringPtr("secret-refresh-token")
+			options.WorkerPool = &kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+				Flavor:      core.StringPtr("bx2.4x16"),
+				WorkerCount: core.Int64Ptr(1),
+				Zones: []kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{
+					{ID: core.StringPtr("us-south-1"), SubnetID: core.StringPtr("subnet-1")},
+				},
+			}
+			created, _, err := service.VpcCreateCluster(options)
+			Expect(err).To(BeNil())
+
+			var states []string
+			for i := 0; i < 2; i++ {
+				cluster, _, err := service.GetCluster(service.NewGetClusterOptions(*created.ClusterID))
+				Expect(err).To(BeNil())
+				states = append(states, *cluster.State)
+			}
+			return states
+		}
+
+		It(`Records redacted exchanges and replays them in order`, func() {
+			server := kubefake.NewServer(nil)
+			recorder, err := cassette.New(path, cassette.ModeRecord, nil)
+			Expect(err).To(BeNil())
+			service := newClient(server.URL)
+			recorder.Install(service.Service)
+			recorded := createAndPoll(service)
+			server.Close()
+			Expect(recorded).To(Equal([]string{"deploying", "pending"}))
+			Expect(recorder.Stop()).To(BeNil())
+
+			contents, err := ioutil.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(contents)).ToNot(ContainSubstring("secret"))
+			Expect(string(contents)).To(ContainSubstring(cassette.Redacted))
+			interactions := recorder.Interactions()
+			Expect(interactions).To(HaveLen(3))
+			Expect(interactions[0].Request.Header["Authorization"]).To(Equal([]string{cassette.Redacted}))
+			Expect(interactions[0].Request.Header["X-Auth-Refresh-Token"]).To(Equal([]string{cassette.Redacted}))
+
+			recorder, err = cassette.New(path, cassette.ModeReplay, nil)
+			Expect(err).To(BeNil())
+			service = newClient("https://replay.invalid")
+			recorder.Install(service.Service)
+			Expect(createAndPoll(service)).To(Equal(recorded))
+
+			_, _, err = service.GetCluster(service.NewGetClusterOptions("c1"))
+			Expect(errors.Is(err, cassette.ErrNoInteraction)).To(BeTrue())
+		})
+		It(`Matches the requests on their query and body`, func() {
+			server := kubefake.NewServer(nil)
+			defer server.Close()
+			recorder, err := cassette.New(path, cassette.ModeRecord, nil)
+			Expect(err).To(BeNil())
+			service := newClient(server.URL)
+			recorder.Install(service.Service)
+			_, _, err = service.VpcGetClusters(service.NewVpcGetClustersOptions().SetProvider("vpc-gen2"))
+			Expect(err).To(BeNil())
+			Expect(recorder.Stop()).To(BeNil())
+
+			recorder, err = cassette.New(path, cassette.ModeReplay, nil)
+			Expect(err).To(BeNil())
+			service = newClient("https://replay.invalid")
+			recorder.Install(service.Service)
+			_, _, err = service.VpcGetClusters(service.NewVpcGetClustersOptions().SetProvider("vpc-classic"))
+			Expect(errors.Is(err, cassette.ErrNoInteraction)).To(BeTrue())
+			_, _, err = service.VpcGetClusters(service.NewVpcGetClustersOptions().SetProvider("vpc-gen2"))
+			Expect(err).To(BeNil())
+		})
+		It(`Decompresses the gzip-compressed bodies to redact them`, func() {
+			var sent []byte
+			recorder, err := cassette.New(path, cassette.ModeRecord, &cassette.Options{
+				RedactFields: []string{"cosInstanceCRN"},
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					sent, _ = ioutil.ReadAll(req.Body)
+					return &http.Response{
+						StatusCode: http.StatusCreated,
+						Header:     http.Header{"Content-Type": []string{"application/json"}},
+						Body:       ioutil.NopCloser(strings.NewReader(`{"clusterID": "c1"}`)),
+						Request:    req,
+					}, nil
+				}),
+			})
+			Expect(err).To(BeNil())
+			service := newClient("https://record.invalid")
+			service.SetEnableGzipCompression(true)
+			recorder.Install(service.Service)
+			options := service.NewVpcCreateClusterOptions("rg1")
+			options.Name = core.StringPtr("c1")
+			options.CosInstanceCRN = core.StringPtr("secret-crn")
+			_, _, err = service.VpcCreateCluster(options)
+			Expect(err).To(BeNil())
+			Expect(recorder.Stop()).To(BeNil())
+			Expect(bytes.HasPrefix(sent, []byte{0x1f, 0x8b})).To(BeTrue())
+
+			contents, err := ioutil.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(contents)).ToNot(ContainSubstring("secret"))
+			request := recorder.Interactions()[0].Request
+			Expect(request.Body).To(ContainSubstring(`"cosInstanceCRN":"` + cassette.Redacted + `"`))
+			Expect(request.Header).ToNot(HaveKey("Content-Encoding"))
+
+			recorder, err = cassette.New(path, cassette.ModeReplay, nil)
+			Expect(err).To(BeNil())
+			service = newClient("https://replay.invalid")
+			service.SetEnableGzipCompression(true)
+			recorder.Install(service.Service)
+			options.CosInstanceCRN = core.StringPtr(cassette.Redacted)
+			created, _, err := service.VpcCreateCluster(options)
+			Expect(err).To(BeNil())
+			Expect(*created.ClusterID).To(Equal("c1"))
+
+			req, err := http.NewRequest("POST", "https://replay.invalid/v2/vpc/createCluster", strings.NewReader("..."))
+			Expect(err).To(BeNil())
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Content-Encoding", "br")
+			_, err = recorder.RoundTrip(req)
+			Expect(err).To(MatchError("cassette: cannot redact the body of POST /v2/vpc/createCluster, which has Content-Encoding br"))
+		})
+		It(`Requires the cassette file to replay`, func() {
+			_, err := cassette.New(path, cassette.ModeReplay, nil)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Describe(`With the Satellite Link client`, func() {
+		// newClient returns a client of the service at url.
+		var newClient = func(url string) *satellitelinkv1.SatelliteLinkV1 {
+			service, err := satellitelinkv1.NewSatelliteLinkV1(&satellitelinkv1.SatelliteLinkV1Options{
+				URL:           url,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+			return service
+		}
+
+		// createEndpoint creates a link and an endpoint with certificates, then uploads a client certificate.
+		var createEndpoint = func(service *satellitelinkv1.SatelliteLinkV1) *satellitelinkv1.Endpoint {
+			_, _, err := service.CreateLink(service.NewCreateLinkOptions().SetLocationID("loc1"))
+			Expect(err).To(BeNil())
+
+			options := service.NewCreateEndpointsOptions("loc1")
+			options.ConnType = core.StringPtr(satellitelinkv1.CreateEndpointsOptions_ConnType_Location)
+			options.DisplayName = core.StringPtr("endpoint1")
+			options.ServerHost = core.StringPtr("example.com")
+			options.ServerPort = core.Int64Ptr(443)
+			options.ClientProtocol = core.StringPtr(satellitelinkv1.CreateEndpointsOptions_ClientProtocol_Tls)
+			options.Certs = &satellitelinkv1.AdditionalNewEndpointRequestCerts{
+				Server: &satellitelinkv1.AdditionalNewEndpointRequestCertsServer{
+					Cert: &satellitelinkv1.AdditionalNewEndpointRequestCertsServerCert{FileContents: core.StringPtr(testCert)},
+				},
+			}
+			endpoint, _, err := service.CreateEndpoints(options)
+			Expect(err).To(BeNil())
+
+			uploadOptions := service.NewUploadEndpointCertsOptions("loc1", *endpoint.EndpointID)
+			uploadOptions.ClientCert = ioutil.NopCloser(bytes.NewReader([]byte(testCert)))
+			endpoint, _, err = service.UploadEndpointCerts(uploadOptions)
+			Expect(err).To(BeNil())
+			return endpoint
+		}
+
+		It(`Redacts certificates and matches multipart forms`, func() {
+			server := linkfake.NewServer(nil)
+			recorder, err := cassette.New(path, cassette.ModeRecord, nil)
+			Expect(err).To(BeNil())
+			service := newClient(server.URL)
+			recorder.Install(service.Service)
+			recorded := createEndpoint(service)
+			server.Close()
+			Expect(recorder.Stop()).To(BeNil())
+
+			contents, err := ioutil.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(contents)).ToNot(ContainSubstring("MIIB"))
+			interactions := recorder.Interactions()
+			Expect(interactions).To(HaveLen(3))
+			Expect(interactions[1].Request.Body).To(ContainSubstring(`"file_contents":"REDACTED"`))
+			Expect(interactions[2].Request.Form).To(Equal(map[string]string{"client_cert": cassette.Redacted}))
+
+			recorder, err = cassette.New(path, cassette.ModeReplay, nil)
+			Expect(err).To(BeNil())
+			service = newClient("https://replay.invalid")
+			recorder.Install(service.Service)
+			Expect(createEndpoint(service)).To(Equal(recorded))
+		})
+	})
+})
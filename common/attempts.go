@@ -0,0 +1,120 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// AttemptCounter counts the attempts to send a request, retries included, once CountAttempts is installed on the
+// service sending it.
+type AttemptCounter struct {
+	attempts int64
+}
+
+// Attempts returns the number of attempts to send the request so far.
+func (counter *AttemptCounter) Attempts() int64 {
+	return atomic.LoadInt64(&counter.attempts)
+}
+
+// Retries returns the number of attempts to send the request after the first one.
+func (counter *AttemptCounter) Retries() int64 {
+	if attempts := counter.Attempts(); attempts > 1 {
+		return attempts - 1
+	}
+	return 0
+}
+
+// attemptCounterKey is the key of the AttemptCounter in a request context.
+type attemptCounterKey struct{}
+
+// WithAttemptCounter returns a copy of ctx carrying an AttemptCounter, and the counter. The counter already carried
+// by ctx is returned if any, so that several interceptors share it.
+func WithAttemptCounter(ctx context.Context) (context.Context, *AttemptCounter) {
+	if counter, ok := ctx.Value(attemptCounterKey{}).(*AttemptCounter); ok {
+		return ctx, counter
+	}
+	counter := &AttemptCounter{}
+	return context.WithValue(ctx, attemptCounterKey{}, counter), counter
+}
+
+// CountAttempts makes service count the attempts to send the requests whose context carries an AttemptCounter.
+// Installing it again has no effect.
+func CountAttempts(service *core.BaseService) {
+	WrapTransport(service, func(transport http.RoundTripper) http.RoundTripper {
+		if _, ok := transport.(*countingTransport); ok {
+			return transport
+		}
+		return &countingTransport{transport: transport}
+	})
+}
+
+// WrapTransport replaces the transport of the client of service by wrap(transport), transport defaulting to
+// http.DefaultTransport. When retries are enabled, the transport below the retrying client is wrapped, so that it
+// sees every attempt to send a request; call it after EnableRetries, which replaces the client. The http.Client of
+// service is copied rather than modified, since it may be shared.
+func WrapTransport(service *core.BaseService, wrap func(transport http.RoundTripper) http.RoundTripper) {
+	service.SetHTTPClient(wrapClient(service.Client, wrap))
+}
+
+// wrapClient returns a copy of client with its transport wrapped by wrap.
+func wrapClient(client *http.Client, wrap func(transport http.RoundTripper) http.RoundTripper) *http.Client {
+	wrapped := http.Client{}
+	if client != nil {
+		wrapped = *client
+	}
+	if retrying, ok := wrapped.Transport.(*retryablehttp.RoundTripper); ok && retrying.Client != nil {
+		wrapped.Transport = &retryablehttp.RoundTripper{Client: &retryablehttp.Client{
+			HTTPClient:      wrapClient(retrying.Client.HTTPClient, wrap),
+			Logger:          retrying.Client.Logger,
+			RetryWaitMin:    retrying.Client.RetryWaitMin,
+			RetryWaitMax:    retrying.Client.RetryWaitMax,
+			RetryMax:        retrying.Client.RetryMax,
+			RequestLogHook:  retrying.Client.RequestLogHook,
+			ResponseLogHook: retrying.Client.ResponseLogHook,
+			CheckRetry:      retrying.Client.CheckRetry,
+			Backoff:         retrying.Client.Backoff,
+			ErrorHandler:    retrying.Client.ErrorHandler,
+		}}
+		return &wrapped
+	}
+	if wrapped.Transport == nil {
+		wrapped.Transport = http.DefaultTransport
+	}
+	wrapped.Transport = wrap(wrapped.Transport)
+	return &wrapped
+}
+
+// countingTransport is an http.RoundTripper counting the attempts to send the requests.
+type countingTransport struct {
+	transport http.RoundTripper
+}
+
+// RoundTrip counts an attempt to send req and sends it.
+func (transport *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if counter, ok := req.Context().Value(attemptCounterKey{}).(*AttemptCounter); ok {
+		atomic.AddInt64(&counter.attempts, 1)
+	}
+	return transport.transport.RoundTrip(req)
+}
+
+// RetryAfter returns the time given by the Retry-After header of a response received at now, either a number of
+// seconds or an HTTP date.
+func RetryAfter(header http.Header, now time.Time) (time.Time, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return time.Time{}, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return now.Add(time.Duration(seconds) * time.Second), seconds >= 0
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return date, true
+	}
+	return time.Time{}, false
+}
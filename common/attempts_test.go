@@ -0,0 +1,64 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAttemptCounterShared(t *testing.T) {
+	ctx, counter := WithAttemptCounter(context.Background())
+	same, shared := WithAttemptCounter(ctx)
+	assert.Equal(t, ctx, same)
+	assert.True(t, counter == shared)
+	assert.Equal(t, int64(0), counter.Retries())
+}
+
+func TestCountAttempts(t *testing.T) {
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		received++
+		if received < 3 {
+			res.Header().Set("Retry-After", "0")
+			res.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		res.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	service.EnableRetries(3, 10*time.Millisecond)
+	CountAttempts(service)
+	CountAttempts(service)
+
+	ctx, counter := WithAttemptCounter(context.Background())
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	response, err := service.Request(request, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+	assert.Equal(t, int64(3), counter.Attempts())
+	assert.Equal(t, int64(2), counter.Retries())
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	until, ok := RetryAfter(http.Header{"Retry-After": {"30"}}, now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(30*time.Second), until)
+
+	until, ok = RetryAfter(http.Header{"Retry-After": {"Wed, 01 May 2024 12:01:00 GMT"}}, now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(time.Minute), until)
+
+	_, ok = RetryAfter(http.Header{}, now)
+	assert.False(t, ok)
+	_, ok = RetryAfter(http.Header{"Retry-After": {"soon"}}, now)
+	assert.False(t, ok)
+}
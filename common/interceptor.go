@@ -0,0 +1,27 @@
+package common
+
+import (
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Invoker sends the request of an operation and returns the response of the service.
+type Invoker func(request *http.Request) (*core.DetailedResponse, error)
+
+// Interceptor wraps the sending of the request of the operation operationID, such as "GetCluster" or
+// "CreateEndpoints". It may observe or replace request before passing it to next, and observe or replace the
+// response and error returned by next. An interceptor that does not call next must return a response or an error.
+type Interceptor func(operationID string, request *http.Request, next Invoker) (*core.DetailedResponse, error)
+
+// Intercept sends request through interceptors in order, the last one calling invoker.
+func Intercept(interceptors []Interceptor, operationID string, request *http.Request, invoker Invoker) (*core.DetailedResponse, error) {
+	next := invoker
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := interceptors[i], next
+		next = func(request *http.Request) (*core.DetailedResponse, error) {
+			return interceptor(operationID, request, inner)
+		}
+	}
+	return next(request)
+}
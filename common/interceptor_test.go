@@ -0,0 +1,55 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterceptOrder(t *testing.T) {
+	var calls []string
+	trace := func(name string) Interceptor {
+		return func(operationID string, request *http.Request, next Invoker) (*core.DetailedResponse, error) {
+			calls = append(calls, name+" "+operationID)
+			request.Header.Set("X-"+name, "true")
+			response, err := next(request)
+			calls = append(calls, name+" done")
+			return response, err
+		}
+	}
+	request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	response, err := Intercept([]Interceptor{trace("First"), trace("Second")}, "GetCluster", request, func(request *http.Request) (*core.DetailedResponse, error) {
+		assert.Equal(t, "true", request.Header.Get("X-First"))
+		assert.Equal(t, "true", request.Header.Get("X-Second"))
+		calls = append(calls, "invoker")
+		return &core.DetailedResponse{StatusCode: 200}, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, []string{"First GetCluster", "Second GetCluster", "invoker", "Second done", "First done"}, calls)
+}
+
+func TestInterceptShortCircuit(t *testing.T) {
+	denied := errors.New("denied")
+	deny := func(operationID string, request *http.Request, next Invoker) (*core.DetailedResponse, error) {
+		return nil, denied
+	}
+	request, _ := http.NewRequest(http.MethodDelete, "https://example.com", nil)
+	_, err := Intercept([]Interceptor{deny}, "RemoveCluster", request, func(request *http.Request) (*core.DetailedResponse, error) {
+		t.Fatal("the invoker was called")
+		return nil, nil
+	})
+	assert.Equal(t, denied, err)
+}
+
+func TestInterceptWithoutInterceptors(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	response, err := Intercept(nil, "GetCluster", request, func(request *http.Request) (*core.DetailedResponse, error) {
+		return &core.DetailedResponse{StatusCode: 204}, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 204, response.StatusCode)
+}
@@ -0,0 +1,34 @@
+package common
+
+import "context"
+
+// Operation describes an operation of a service, such as the GetCluster operation of the kubernetes_service_api
+// service.
+type Operation struct {
+	// The name of the service, as passed to GetSdkHeaders.
+	ServiceName string
+
+	// The ID of the operation, as passed to GetSdkHeaders.
+	ID string
+
+	// The HTTP method of the request of the operation.
+	Method string
+
+	// The path of the request of the operation relative to the service URL, with its path parameters in braces,
+	// for example "/v2/vpc/getCluster" or "/v1/locations/{location_id}".
+	PathTemplate string
+}
+
+// operationKey is the key of the Operation in a request context.
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx carrying operation.
+func WithOperation(ctx context.Context, operation Operation) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// OperationFromContext returns the Operation carried by ctx, as the request context passed to an Interceptor does.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	operation, ok := ctx.Value(operationKey{}).(Operation)
+	return operation, ok
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Redacted replaces the values of the secrets removed by a Redactor.
+const Redacted = "REDACTED"
+
+// SecretHeaders are the request and response headers carrying secrets.
+var SecretHeaders = []string{"Authorization", "X-Auth-Refresh-Token", "X-Auth-Softlayer-APIKey"}
+
+// SecretFields are the JSON and form fields carrying secrets, such as the contents of the certificates and keys of
+// Satellite Link endpoints and the data of secrets.
+var SecretFields = []string{
+	"file_contents", "client_cert", "server_cert", "connector_cert", "connector_key",
+	"clientKey", "iam_client_secret", "secret_access_key", "ingestionKey", "agentKey",
+	"apikey", "api_key", "password", "access_token", "refresh_token", "data", "stringData",
+}
+
+// Redactor replaces the values of the secrets in headers and JSON documents with Redacted.
+type Redactor struct {
+	headers map[string]bool
+	fields  map[string]bool
+}
+
+// NewRedactor returns a Redactor of SecretHeaders and SecretFields, and of the additional headers and fields.
+// Header names are case-insensitive, and so are field names.
+func NewRedactor(headers []string, fields []string) *Redactor {
+	redactor := &Redactor{headers: make(map[string]bool), fields: make(map[string]bool)}
+	for _, header := range append(append([]string{}, SecretHeaders...), headers...) {
+		redactor.headers[http.CanonicalHeaderKey(header)] = true
+	}
+	for _, field := range append(append([]string{}, SecretFields...), fields...) {
+		redactor.fields[strings.ToLower(field)] = true
+	}
+	return redactor
+}
+
+// IsSecretHeader reports whether the header name carries a secret.
+func (redactor *Redactor) IsSecretHeader(name string) bool {
+	return redactor.headers[http.CanonicalHeaderKey(name)]
+}
+
+// IsSecretField reports whether the JSON or form field name carries a secret.
+func (redactor *Redactor) IsSecretField(name string) bool {
+	return redactor.fields[strings.ToLower(name)]
+}
+
+// Header returns a copy of header with the values of the secret headers replaced, or nil if header is empty.
+func (redactor *Redactor) Header(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	redacted := make(http.Header, len(header))
+	for name, values := range header {
+		if redactor.IsSecretHeader(name) {
+			values = []string{Redacted}
+		}
+		redacted[name] = append([]string(nil), values...)
+	}
+	return redacted
+}
+
+// JSON returns body with the values of the secret fields replaced and its keys sorted, so that equal documents
+// compare equal, and whether body was a valid JSON document. Other bodies are returned unchanged.
+func (redactor *Redactor) JSON(body []byte) ([]byte, bool) {
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return body, false
+	}
+	redacted, err := json.Marshal(redactor.Value(document))
+	if err != nil {
+		return body, false
+	}
+	return redacted, true
+}
+
+// Value replaces the values of the secret fields in a decoded JSON document, in place, and returns it.
+func (redactor *Redactor) Value(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if redactor.IsSecretField(key) {
+				v[key] = Redacted
+			} else {
+				v[key] = redactor.Value(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactor.Value(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,36 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactorHeader(t *testing.T) {
+	redactor := NewRedactor([]string{"x-custom-secret"}, nil)
+	header := http.Header{
+		"Authorization":           {"Bearer token"},
+		"X-Auth-Softlayer-APIKey": {"key"},
+		"X-Custom-Secret":         {"value"},
+		"X-Region":                {"us-south"},
+	}
+	redacted := redactor.Header(header)
+	assert.Equal(t, []string{Redacted}, redacted["Authorization"])
+	assert.Equal(t, []string{Redacted}, redacted["X-Auth-Softlayer-APIKey"])
+	assert.Equal(t, []string{Redacted}, redacted["X-Custom-Secret"])
+	assert.Equal(t, []string{"us-south"}, redacted["X-Region"])
+	assert.Equal(t, "Bearer token", header.Get("Authorization"))
+	assert.Nil(t, redactor.Header(nil))
+}
+
+func TestRedactorJSON(t *testing.T) {
+	redactor := NewRedactor(nil, []string{"token"})
+	redacted, ok := redactor.JSON([]byte(`{"name":"c1","certs":[{"file_contents":"PEM"}],"Token":"t","stringData":{"a":"b"}}`))
+	assert.True(t, ok)
+	assert.Equal(t, `{"Token":"REDACTED","certs":[{"file_contents":"REDACTED"}],"name":"c1","stringData":"REDACTED"}`, string(redacted))
+
+	redacted, ok = redactor.JSON([]byte("not json"))
+	assert.False(t, ok)
+	assert.Equal(t, "not json", string(redacted))
+}
@@ -0,0 +1,101 @@
+package common
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ValidationError is a violation of the constraints of a field of an options struct.
+type ValidationError struct {
+	// The name of the field, such as "ServerPort" or "Addresses[1]".
+	Field string
+
+	// What is wrong with the value of the field.
+	Message string
+}
+
+// Error returns the field and the message of the violation.
+func (err *ValidationError) Error() string {
+	return fmt.Sprintf("%s %s", err.Field, err.Message)
+}
+
+// ValidationErrors lists the violations found by validating an options struct.
+type ValidationErrors struct {
+	// The name of the options struct, such as "CreateEndpointsOptions".
+	Options string
+
+	// The violations, in the order of the fields.
+	Errors []*ValidationError
+}
+
+// Error lists the violations of the options struct.
+func (errs *ValidationErrors) Error() string {
+	messages := make([]string, len(errs.Errors))
+	for i, err := range errs.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%s is not valid: %s", errs.Options, strings.Join(messages, "; "))
+}
+
+// Validation collects the violations of the constraints of an options struct which core.ValidateStruct does not
+// check, such as enum membership, ranges and syntax.
+type Validation struct {
+	errs ValidationErrors
+}
+
+// NewValidation starts the validation of the options struct named options.
+func NewValidation(options string) *Validation {
+	return &Validation{errs: ValidationErrors{Options: options}}
+}
+
+// Errorf records a violation of the constraints of field.
+func (validation *Validation) Errorf(field string, format string, args ...interface{}) {
+	validation.errs.Errors = append(validation.errs.Errors, &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Enum records a violation if value is set to none of the allowed values.
+func (validation *Validation) Enum(field string, value *string, allowed ...string) {
+	if value == nil {
+		return
+	}
+	for _, a := range allowed {
+		if *value == a {
+			return
+		}
+	}
+	validation.Errorf(field, "must be one of '%s', not '%s'", strings.Join(allowed, "', '"), *value)
+}
+
+// Range records a violation if value is set outside of [min, max].
+func (validation *Validation) Range(field string, value *int64, min int64, max int64) {
+	if value != nil && (*value < min || *value > max) {
+		validation.Errorf(field, "must be between %d and %d, not %d", min, max, *value)
+	}
+}
+
+// MaxLength records a violation if value is set to more than max characters.
+func (validation *Validation) MaxLength(field string, value *string, max int) {
+	if value != nil && len([]rune(*value)) > max {
+		validation.Errorf(field, "must be %d characters or fewer", max)
+	}
+}
+
+// CIDR records a violation if value is set to something else than a CIDR block, such as "172.30.0.0/16".
+func (validation *Validation) CIDR(field string, value *string) {
+	if value == nil {
+		return
+	}
+	if _, _, err := net.ParseCIDR(*value); err != nil {
+		validation.Errorf(field, "must be a CIDR block, not '%s'", *value)
+	}
+}
+
+// Err returns the violations as a *ValidationErrors, or nil if there were none.
+func (validation *Validation) Err() error {
+	if len(validation.errs.Errors) == 0 {
+		return nil
+	}
+	errs := validation.errs
+	return &errs
+}
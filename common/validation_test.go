@@ -0,0 +1,34 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidation(t *testing.T) {
+	validation := NewValidation("MyOptions")
+	validation.Enum("Protocol", core.StringPtr("tcp"), "tcp", "udp")
+	validation.Enum("Protocol", nil, "tcp", "udp")
+	validation.Range("Port", core.Int64Ptr(443), 1, 65535)
+	validation.CIDR("Subnet", core.StringPtr("172.30.0.0/16"))
+	validation.MaxLength("Name", core.StringPtr("c1"), 63)
+	assert.Nil(t, validation.Err())
+
+	validation.Enum("Protocol", core.StringPtr("sctp"), "tcp", "udp")
+	validation.Range("Port", core.Int64Ptr(70000), 1, 65535)
+	validation.CIDR("Subnet", core.StringPtr("172.30.0.0"))
+	validation.Errorf("Sni", "is only used with the 'tls' server protocol")
+	err := validation.Err()
+
+	var errs *ValidationErrors
+	assert.True(t, errors.As(err, &errs))
+	assert.Equal(t, "MyOptions", errs.Options)
+	assert.Len(t, errs.Errors, 4)
+	assert.Equal(t, "Port", errs.Errors[1].Field)
+	assert.Equal(t, "MyOptions is not valid: Protocol must be one of 'tcp', 'udp', not 'sctp'; "+
+		"Port must be between 1 and 65535, not 70000; Subnet must be a CIDR block, not '172.30.0.0'; "+
+		"Sni is only used with the 'tls' server protocol", err.Error())
+}
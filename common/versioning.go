@@ -0,0 +1,41 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CompareVersions compares the numeric components of version, such as "1.29.3_1547" or "4.15.8_1541_openshift", to
+// those of wanted, such as "1.30" or "1.30.2", up to the precision of wanted. It returns -1 if version is older,
+// 0 if it is wanted or a more specific version of it, and 1 if it is newer.
+func CompareVersions(version string, wanted string) int {
+	v, w := VersionComponents(version), VersionComponents(wanted)
+	for i := range w {
+		var component int
+		if i < len(v) {
+			component = v[i]
+		}
+		switch {
+		case component < w[i]:
+			return -1
+		case component > w[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// VersionComponents returns the numeric components of version before its first "_", such as [1 29 3] for
+// "1.29.3_1547".
+func VersionComponents(version string) []int {
+	version = strings.SplitN(version, "_", 2)[0]
+	var components []int
+	for _, part := range strings.Split(version, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		components = append(components, n)
+	}
+	return components
+}
@@ -0,0 +1,20 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, CompareVersions("1.29.3_1547", "1.29"))
+	assert.Equal(t, 0, CompareVersions("1.29.3_1547", "1.29.3"))
+	assert.Equal(t, 0, CompareVersions("4.15.8_1541_openshift", "4.15.8_openshift"))
+	assert.Equal(t, -1, CompareVersions("1.29.3_1547", "1.30"))
+	assert.Equal(t, -1, CompareVersions("1.29.3_1547", "1.29.4"))
+	assert.Equal(t, -1, CompareVersions("1.29", "1.29.3"))
+	assert.Equal(t, 1, CompareVersions("1.30.2", "1.29.3"))
+	assert.Equal(t, 1, CompareVersions("1.9.1", "1.8"))
+	assert.Equal(t, []int{1, 29, 3}, VersionComponents("1.29.3_1547"))
+	assert.Empty(t, VersionComponents("latest"))
+}
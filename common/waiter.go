@@ -0,0 +1,85 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultWaitInterval is the time between two polls when WaitOptions.Interval is not set.
+	DefaultWaitInterval = 30 * time.Second
+
+	// DefaultWaitMaxInterval caps the time between two polls when WaitOptions.MaxInterval is not set.
+	DefaultWaitMaxInterval = 5 * time.Minute
+)
+
+// ErrWaitTimeout is returned by Poll when WaitOptions.Timeout expires before the condition is met.
+var ErrWaitTimeout = errors.New("timed out waiting for the condition")
+
+// WaitOptions configures how a waiter polls the service.
+type WaitOptions struct {
+	// The time between two polls. Defaults to DefaultWaitInterval.
+	Interval time.Duration
+
+	// The factor the interval is multiplied by after every poll. Values less than or equal to 1 keep the interval constant.
+	Backoff float64
+
+	// The upper bound of the interval when Backoff is used. Defaults to DefaultWaitMaxInterval.
+	MaxInterval time.Duration
+
+	// The maximum time to wait. Zero means the wait is only bounded by the context.
+	Timeout time.Duration
+}
+
+// Poll invokes condition until it reports done or returns an error, sleeping between invocations as
+// configured by options (which may be nil). If options.Timeout expires first, Poll returns ErrWaitTimeout;
+// if ctx is cancelled first, Poll returns the context's error.
+func Poll(ctx context.Context, options *WaitOptions, condition func(ctx context.Context) (done bool, err error)) error {
+	if options == nil {
+		options = &WaitOptions{}
+	}
+	interval := options.Interval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	maxInterval := options.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxInterval
+	}
+
+	pollCtx := ctx
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		pollCtx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	for {
+		done, err := condition(pollCtx)
+		if done || err != nil {
+			if err != nil && pollCtx.Err() != nil && ctx.Err() == nil {
+				return ErrWaitTimeout
+			}
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-pollCtx.Done():
+			timer.Stop()
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return ErrWaitTimeout
+		case <-timer.C:
+		}
+
+		if options.Backoff > 1 {
+			interval = time.Duration(float64(interval) * options.Backoff)
+			if interval > maxInterval {
+				interval = maxInterval
+			}
+		}
+	}
+}
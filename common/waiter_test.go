@@ -0,0 +1,65 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollUntilDone(t *testing.T) {
+	calls := 0
+	err := Poll(context.Background(), &WaitOptions{Interval: time.Millisecond}, func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPollConditionError(t *testing.T) {
+	conditionErr := errors.New("failed")
+	err := Poll(context.Background(), &WaitOptions{Interval: time.Millisecond}, func(ctx context.Context) (bool, error) {
+		return false, conditionErr
+	})
+	assert.Equal(t, conditionErr, err)
+}
+
+func TestPollTimeout(t *testing.T) {
+	err := Poll(context.Background(), &WaitOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.Equal(t, ErrWaitTimeout, err)
+}
+
+func TestPollContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := Poll(ctx, &WaitOptions{Interval: time.Millisecond, Timeout: time.Minute}, func(ctx context.Context) (bool, error) {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return false, nil
+	})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestPollBackoff(t *testing.T) {
+	var last time.Time
+	var gaps []time.Duration
+	err := Poll(context.Background(), &WaitOptions{Interval: 5 * time.Millisecond, Backoff: 2, MaxInterval: 20 * time.Millisecond}, func(ctx context.Context) (bool, error) {
+		now := time.Now()
+		if !last.IsZero() {
+			gaps = append(gaps, now.Sub(last))
+		}
+		last = now
+		return len(gaps) == 4, nil
+	})
+	assert.Nil(t, err)
+	assert.True(t, gaps[1] >= 10*time.Millisecond)
+	assert.True(t, gaps[3] >= 20*time.Millisecond)
+	assert.True(t, gaps[3] < 100*time.Millisecond)
+}
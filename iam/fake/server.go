@@ -0,0 +1,276 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fake provides a local IAM token server for testing code that authenticates with the iam package, or with
+// a core.IamAuthenticator, without access to IBM Cloud.
+//
+// The server issues access and refresh tokens for API keys at the /identity/token route of the token server, and
+// failures can be injected to test error handling:
+//
+//	server := fake.NewServer(&fake.Options{APIKeys: []string{"my-api-key"}})
+//	defer server.Close()
+//	authenticator, err := iam.NewAuthenticator(&iam.Options{
+//		ApiKey:       "my-api-key",
+//		URL:          server.URL,
+//		ClientID:     fake.DefaultClientID,
+//		ClientSecret: fake.DefaultClientSecret,
+//	})
+package fake
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+// DefaultExpiresIn is the lifetime of the tokens when Options.ExpiresIn is not set.
+const DefaultExpiresIn = time.Hour
+
+// DefaultClientID and DefaultClientSecret are the client the server issues refresh tokens to when Options.ClientID
+// is not set.
+const (
+	DefaultClientID     = "fake-client"
+	DefaultClientSecret = "fake-client-secret"
+)
+
+// APIKeyGrantType is the grant type of the requests for tokens for an API key.
+const APIKeyGrantType = "urn:ibm:params:oauth:grant-type:apikey"
+
+// Options : The options of NewServer.
+type Options struct {
+	// The API keys the server issues tokens for. Any API key if empty.
+	APIKeys []string
+
+	// The client the server issues refresh tokens to, and its secret. Default to DefaultClientID and
+	// DefaultClientSecret. The other clients are rejected, and the anonymous requests get no refresh token.
+	ClientID     string
+	ClientSecret string
+
+	// The lifetime of the tokens, rounded to the second. Defaults to DefaultExpiresIn; at least a second.
+	ExpiresIn time.Duration
+}
+
+// Fault : A failure injected into the responses of the server.
+type Fault struct {
+	// The status code to respond with. The request is served normally after Latency if 0.
+	StatusCode int
+
+	// How long to wait before responding.
+	Latency time.Duration
+
+	// How many requests to fail. All of them if 0.
+	Count int
+}
+
+// Request : A request received by the server.
+type Request struct {
+	GrantType string
+	APIKey    string
+
+	// The client of the basic authorization of the request, if any.
+	ClientID string
+}
+
+// Server : A local IAM token server.
+type Server struct {
+	// The URL of the server, to pass as the URL of the authenticators.
+	URL string
+
+	httpServer *httptest.Server
+	options    Options
+
+	mutex    sync.Mutex
+	issued   int
+	faults   []*Fault
+	requests []Request
+}
+
+// NewServer starts a server. Close it when done.
+func NewServer(options *Options) *Server {
+	server := &Server{}
+	if options != nil {
+		server.options = *options
+	}
+	if server.options.ClientID == "" && server.options.ClientSecret == "" {
+		server.options.ClientID = DefaultClientID
+		server.options.ClientSecret = DefaultClientSecret
+	}
+	if server.options.ExpiresIn == 0 {
+		server.options.ExpiresIn = DefaultExpiresIn
+	} else if server.options.ExpiresIn < time.Second {
+		server.options.ExpiresIn = time.Second
+	}
+	server.httpServer = httptest.NewServer(server)
+	server.URL = server.httpServer.URL
+	return server
+}
+
+// Close shuts the server down.
+func (server *Server) Close() {
+	server.httpServer.Close()
+}
+
+// InjectFault makes the server fail the requests matching fault.
+func (server *Server) InjectFault(fault Fault) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = append(server.faults, &fault)
+}
+
+// ClearFaults removes the injected faults.
+func (server *Server) ClearFaults() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = nil
+}
+
+// Requests returns the token requests received so far.
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]Request(nil), server.requests...)
+}
+
+// ServeHTTP serves a token request, applying the injected faults first.
+func (server *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost || req.URL.Path != "/identity/token" {
+		writeError(res, http.StatusNotFound, "BXNIM0404E", fmt.Sprintf("The route %s %s is not implemented by the fake server.", req.Method, req.URL.Path))
+		return
+	}
+	if err := req.ParseForm(); err != nil {
+		writeError(res, http.StatusBadRequest, "BXNIM0109E", "The request body is not a valid form.")
+		return
+	}
+	clientID, clientSecret, authorized := req.BasicAuth()
+	request := Request{
+		GrantType: req.PostForm.Get("grant_type"),
+		APIKey:    req.PostForm.Get("apikey"),
+		ClientID:  clientID,
+	}
+
+	server.mutex.Lock()
+	server.requests = append(server.requests, request)
+	fault := server.takeFault()
+	server.mutex.Unlock()
+
+	if fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-req.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			writeError(res, fault.StatusCode, "BXNIM0001E", "Injected fault.")
+			return
+		}
+	}
+
+	switch {
+	case request.GrantType != APIKeyGrantType:
+		writeError(res, http.StatusBadRequest, "BXNIM0103E", fmt.Sprintf("The grant type '%s' is not supported by the fake server.", request.GrantType))
+		return
+	case authorized && (clientID != server.options.ClientID || clientSecret != server.options.ClientSecret):
+		writeError(res, http.StatusUnauthorized, "BXNIM0308E", "The client credentials are not valid.")
+		return
+	case !server.knows(request.APIKey):
+		writeError(res, http.StatusBadRequest, "BXNIM0415E", "Provided API key could not be found.")
+		return
+	}
+
+	server.mutex.Lock()
+	server.issued++
+	issued := server.issued
+	server.mutex.Unlock()
+
+	now := time.Now()
+	expiresIn := int64(server.options.ExpiresIn / time.Second)
+	refreshToken := "not_supported"
+	if authorized {
+		refreshToken = fmt.Sprintf("fake-refresh-token-%d", issued)
+	}
+	res.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(res).Encode(map[string]interface{}{
+		"access_token":  accessToken(issued, now, expiresIn),
+		"refresh_token": refreshToken,
+		"token_type":    "Bearer",
+		"expires_in":    expiresIn,
+		"expiration":    now.Unix() + expiresIn,
+	})
+}
+
+// takeFault returns the first fault and consumes one of its requests.
+func (server *Server) takeFault() *Fault {
+	if len(server.faults) == 0 {
+		return nil
+	}
+	fault := server.faults[0]
+	if fault.Count > 0 {
+		fault.Count--
+		if fault.Count == 0 {
+			server.faults = server.faults[1:]
+		}
+	}
+	return fault
+}
+
+// knows reports whether the server issues tokens for apiKey.
+func (server *Server) knows(apiKey string) bool {
+	if apiKey == "" {
+		return false
+	}
+	if len(server.options.APIKeys) == 0 {
+		return true
+	}
+	for _, known := range server.options.APIKeys {
+		if apiKey == known {
+			return true
+		}
+	}
+	return false
+}
+
+// accessToken returns the issued-th access token, an unsigned JWT with the claims the core.IamAuthenticator reads.
+func accessToken(issued int, now time.Time, expiresIn int64) string {
+	header, _ := json.Marshal(map[string]string{"alg": "none", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"jti":    fmt.Sprintf("fake-%d", issued),
+		"iam_id": "iam-ServiceId-fake",
+		"sub":    "ServiceId-fake",
+		"iat":    now.Unix(),
+		"exp":    now.Unix() + expiresIn,
+	})
+	encoding := base64.RawURLEncoding
+	return encoding.EncodeToString(header) + "." + encoding.EncodeToString(claims) + "." + encoding.EncodeToString([]byte("fake"))
+}
+
+// writeError writes an error response in the format of the token server.
+func writeError(res http.ResponseWriter, status int, code string, message string) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	json.NewEncoder(res).Encode(map[string]interface{}{
+		"errorCode":    code,
+		"errorMessage": message,
+		"context": map[string]string{
+			"requestId": fmt.Sprintf("fake-%d", time.Now().UnixNano()),
+		},
+	})
+}
@@ -0,0 +1,259 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package iam authenticates the service clients with an API key, and provides the IAM refresh token that operations
+// such as GetClusterConfig, ApplyRBACAndGetKubeconfig or VpcCreateCluster require in their X-Auth-Refresh-Token
+// header.
+//
+// The core.IamAuthenticator only yields the access token of the token server response. An Authenticator obtains the
+// access token and the refresh token with a single request, caches them together, and renews them before they
+// expire, so that automation using a service ID can call these operations without a user login. It is used as the
+// authenticator of a client and as its refresh token provider:
+//
+//	authenticator, err := iam.NewAuthenticator(&iam.Options{
+//		ApiKey:       os.Getenv("IBMCLOUD_API_KEY"),
+//		ClientID:     os.Getenv("IAM_CLIENT_ID"),
+//		ClientSecret: os.Getenv("IAM_CLIENT_SECRET"),
+//	})
+//	kubernetesServiceApi, err := kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+//		Authenticator:        authenticator,
+//		RefreshTokenProvider: authenticator.RefreshToken,
+//	})
+package iam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrNoClient is returned by NewAuthenticator and Validate when the client ID or secret is not set.
+var ErrNoClient = errors.New("iam: the client ID and the client secret are required")
+
+// ErrNoRefreshToken is returned by RefreshToken when the token server did not issue a refresh token, typically
+// because the client the tokens are requested for is not allowed to receive them.
+var ErrNoRefreshToken = errors.New("iam: the token server did not issue a refresh token")
+
+// Options : The options of NewAuthenticator.
+type Options struct {
+	// The API key of the user or service ID to authenticate as.
+	ApiKey string
+
+	// The URL of the token server. Defaults to core.DEFAULT_IAM_URL.
+	URL string
+
+	// The client the tokens are requested for, and its secret, both required. The token server issues refresh tokens
+	// to registered clients only, not to anonymous requests. They have no default so that the tokens are not requested
+	// on behalf of another tool, such as the IBM Cloud CLI, without the caller choosing to.
+	ClientID     string
+	ClientSecret string
+
+	// The scope of the tokens, if any.
+	Scope string
+
+	// Headers to send to the token server.
+	Headers map[string]string
+
+	// The client sending the requests to the token server. Defaults to a client with a 30 second timeout.
+	Client *http.Client
+
+	// Whether to skip the verification of the certificate of the token server.
+	DisableSSLVerification bool
+}
+
+// Authenticator : A core.Authenticator sending an IAM access token, which also provides the refresh token issued
+// with it.
+type Authenticator struct {
+	requester *core.IamAuthenticator
+
+	mutex   sync.Mutex
+	tokens  *tokens
+	pending *request
+}
+
+// tokens : The tokens of a response of the token server.
+type tokens struct {
+	accessToken  string
+	refreshToken string
+
+	// When to renew the tokens, and when they expire.
+	refreshTime time.Time
+	expiration  time.Time
+}
+
+// request : A request to the token server in flight.
+type request struct {
+	done   chan struct{}
+	tokens *tokens
+	err    error
+}
+
+// NewAuthenticator returns an Authenticator configured by options, or an error if they are not valid.
+func NewAuthenticator(options *Options) (*Authenticator, error) {
+	if options == nil {
+		options = &Options{}
+	}
+	requester := &core.IamAuthenticator{
+		ApiKey:                 options.ApiKey,
+		URL:                    options.URL,
+		ClientId:               options.ClientID,
+		ClientSecret:           options.ClientSecret,
+		Scope:                  options.Scope,
+		Headers:                options.Headers,
+		Client:                 options.Client,
+		DisableSSLVerification: options.DisableSSLVerification,
+	}
+	authenticator := &Authenticator{requester: requester}
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	return authenticator, nil
+}
+
+// AuthenticationType returns the authentication type of the Authenticator, core.AUTHTYPE_IAM.
+func (*Authenticator) AuthenticationType() string {
+	return core.AUTHTYPE_IAM
+}
+
+// Validate returns an error if the API key is missing or not valid, or ErrNoClient if the client ID or secret is
+// missing.
+func (authenticator *Authenticator) Validate() error {
+	if err := authenticator.requester.Validate(); err != nil {
+		return err
+	}
+	if authenticator.requester.ClientId == "" || authenticator.requester.ClientSecret == "" {
+		return ErrNoClient
+	}
+	return nil
+}
+
+// Authenticate adds the access token to the Authorization header of request.
+func (authenticator *Authenticator) Authenticate(request *http.Request) error {
+	accessToken, err := authenticator.AccessToken(request.Context())
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+	return nil
+}
+
+// AccessToken returns the cached access token, requesting new tokens if there are none or they expired.
+func (authenticator *Authenticator) AccessToken(ctx context.Context) (string, error) {
+	tokens, err := authenticator.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return tokens.accessToken, nil
+}
+
+// RefreshToken returns the cached refresh token, requesting new tokens if there are none or they expired. It returns
+// ErrNoRefreshToken if the token server did not issue one. Its signature is the one of the refresh token provider
+// of the clients.
+func (authenticator *Authenticator) RefreshToken(ctx context.Context) (string, error) {
+	tokens, err := authenticator.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if tokens.refreshToken == "" {
+		return "", ErrNoRefreshToken
+	}
+	return tokens.refreshToken, nil
+}
+
+// Invalidate discards the cached tokens, for example when the service rejected them, so that new ones are requested
+// by the next call.
+func (authenticator *Authenticator) Invalidate() {
+	authenticator.mutex.Lock()
+	defer authenticator.mutex.Unlock()
+	authenticator.tokens = nil
+}
+
+// get returns the cached tokens if they have not expired, renewing them in the background once their refresh time
+// has passed. Otherwise it waits for new tokens, or until ctx is done. Concurrent calls share the same request.
+func (authenticator *Authenticator) get(ctx context.Context) (*tokens, error) {
+	authenticator.mutex.Lock()
+	now := time.Now()
+	if cached := authenticator.tokens; cached != nil && now.Before(cached.expiration) {
+		if !now.Before(cached.refreshTime) && authenticator.pending == nil {
+			authenticator.request()
+		}
+		authenticator.mutex.Unlock()
+		return cached, nil
+	}
+	pending := authenticator.pending
+	if pending == nil {
+		pending = authenticator.request()
+	}
+	authenticator.mutex.Unlock()
+
+	select {
+	case <-pending.done:
+		return pending.tokens, pending.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// request starts a request to the token server, caching the tokens it returns. It is called with the mutex held.
+func (authenticator *Authenticator) request() *request {
+	pending := &request{done: make(chan struct{})}
+	authenticator.pending = pending
+	go func() {
+		sent := time.Now()
+		response, err := authenticator.requester.RequestToken()
+		if err == nil {
+			pending.tokens, err = newTokens(response, sent)
+		}
+		pending.err = err
+
+		authenticator.mutex.Lock()
+		if err == nil {
+			authenticator.tokens = pending.tokens
+		}
+		authenticator.pending = nil
+		authenticator.mutex.Unlock()
+		close(pending.done)
+	}()
+	return pending
+}
+
+// newTokens returns the tokens of response to a request sent at sent. As the core.IamAuthenticator does, the tokens
+// are renewed once 80% of their lifetime has passed.
+func newTokens(response *core.IamTokenServerResponse, sent time.Time) (*tokens, error) {
+	if response.AccessToken == "" {
+		return nil, errors.New("iam: the token server did not issue an access token")
+	}
+	tokens := &tokens{accessToken: response.AccessToken}
+	// The token server sends "not_supported" to the clients it does not issue refresh tokens to.
+	if response.RefreshToken != "not_supported" {
+		tokens.refreshToken = response.RefreshToken
+	}
+
+	lifetime := time.Duration(response.ExpiresIn) * time.Second
+	if lifetime <= 0 && response.Expiration > 0 {
+		lifetime = time.Unix(response.Expiration, 0).Sub(sent)
+	}
+	if lifetime <= 0 {
+		return nil, errors.New("iam: the token server issued expired tokens")
+	}
+	tokens.expiration = sent.Add(lifetime)
+	tokens.refreshTime = tokens.expiration.Add(-lifetime / 5)
+	return tokens, nil
+}
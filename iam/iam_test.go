@@ -0,0 +1,172 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iam_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/iam"
+	iamfake "github.com/IBM-Cloud/container-services-go-sdk/iam/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+)
+
+var _ = Describe(`Authenticator`, func() {
+	var tokenServer *iamfake.Server
+
+	// newAuthenticator returns an authenticator of the token server.
+	var newAuthenticator = func(apiKey string) *iam.Authenticator {
+		authenticator, err := iam.NewAuthenticator(&iam.Options{
+			ApiKey:       apiKey,
+			URL:          tokenServer.URL,
+			ClientID:     iamfake.DefaultClientID,
+			ClientSecret: iamfake.DefaultClientSecret,
+		})
+		Expect(err).To(BeNil())
+		return authenticator
+	}
+
+	AfterEach(func() {
+		tokenServer.Close()
+	})
+
+	It(`Sends the access token and the refresh token of a single token request`, func() {
+		tokenServer = iamfake.NewServer(&iamfake.Options{APIKeys: []string{"service-id-key"}})
+		server := fake.NewServer(nil)
+		defer server.Close()
+
+		authenticator := newAuthenticator("service-id-key")
+		service, err := kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:                  server.URL,
+			Authenticator:        authenticator,
+			RefreshTokenProvider: authenticator.RefreshToken,
+		})
+		Expect(err).To(BeNil())
+
+		options := service.NewVpcCreateClusterOptions("rg1")
+		options.Name = core.StringPtr("c1")
+		options.WorkerPool = &kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+			Flavor:      core.StringPtr("bx2.4x16"),
+			WorkerCount: core.Int64Ptr(1),
+			Zones: []kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{
+				{ID: core.StringPtr("us-south-1"), SubnetID: core.StringPtr("subnet-1")},
+			},
+		}
+		_, _, err = service.VpcCreateCluster(options)
+		Expect(err).To(BeNil())
+		_, _, err = service.GetCluster(service.NewGetClusterOptions("c1"))
+		Expect(err).To(BeNil())
+
+		accessToken, err := authenticator.AccessToken(context.Background())
+		Expect(err).To(BeNil())
+		requests := server.Requests()
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].Header.Get("Authorization")).To(Equal("Bearer " + accessToken))
+		Expect(requests[0].Header.Get("X-Auth-Refresh-Token")).To(Equal("fake-refresh-token-1"))
+		Expect(requests[1].Header.Get("Authorization")).To(Equal("Bearer " + accessToken))
+
+		Expect(tokenServer.Requests()).To(Equal([]iamfake.Request{{
+			GrantType: iamfake.APIKeyGrantType,
+			APIKey:    "service-id-key",
+			ClientID:  iamfake.DefaultClientID,
+		}}))
+	})
+
+	It(`Renews the tokens once they expire`, func() {
+		tokenServer = iamfake.NewServer(&iamfake.Options{ExpiresIn: time.Second})
+		authenticator := newAuthenticator("service-id-key")
+
+		refreshToken, err := authenticator.RefreshToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(refreshToken).To(Equal("fake-refresh-token-1"))
+
+		time.Sleep(1100 * time.Millisecond)
+		refreshToken, err = authenticator.RefreshToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(refreshToken).To(Equal("fake-refresh-token-2"))
+
+		authenticator.Invalidate()
+		refreshToken, err = authenticator.RefreshToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(refreshToken).To(Equal("fake-refresh-token-3"))
+		Expect(tokenServer.Requests()).To(HaveLen(3))
+	})
+
+	It(`Shares a token request between concurrent calls`, func() {
+		tokenServer = iamfake.NewServer(nil)
+		tokenServer.InjectFault(iamfake.Fault{Latency: 100 * time.Millisecond, Count: 1})
+		authenticator := newAuthenticator("service-id-key")
+
+		var wg sync.WaitGroup
+		refreshTokens := make([]string, 5)
+		for i := range refreshTokens {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				var err error
+				refreshTokens[i], err = authenticator.RefreshToken(context.Background())
+				Expect(err).To(BeNil())
+			}(i)
+		}
+		wg.Wait()
+		for _, refreshToken := range refreshTokens {
+			Expect(refreshToken).To(Equal("fake-refresh-token-1"))
+		}
+		Expect(tokenServer.Requests()).To(HaveLen(1))
+	})
+
+	It(`Returns the errors of the token server`, func() {
+		tokenServer = iamfake.NewServer(&iamfake.Options{APIKeys: []string{"service-id-key"}})
+
+		_, err := iam.NewAuthenticator(&iam.Options{URL: tokenServer.URL, ClientID: iamfake.DefaultClientID, ClientSecret: iamfake.DefaultClientSecret})
+		Expect(err).ToNot(BeNil())
+		_, err = iam.NewAuthenticator(&iam.Options{ApiKey: "service-id-key", URL: tokenServer.URL})
+		Expect(err).To(Equal(iam.ErrNoClient))
+		Expect(tokenServer.Requests()).To(BeEmpty())
+
+		_, err = newAuthenticator("unknown-key").RefreshToken(context.Background())
+		var authenticationErr *core.AuthenticationError
+		Expect(errors.As(err, &authenticationErr)).To(BeTrue())
+		Expect(authenticationErr.Response.StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(err.Error()).To(ContainSubstring("BXNIM0415E"))
+
+		authenticator := newAuthenticator("service-id-key")
+		tokenServer.InjectFault(iamfake.Fault{StatusCode: http.StatusServiceUnavailable, Count: 1})
+		_, err = authenticator.AccessToken(context.Background())
+		Expect(err).ToNot(BeNil())
+
+		tokenServer.InjectFault(iamfake.Fault{Latency: 200 * time.Millisecond, Count: 1})
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = authenticator.AccessToken(ctx)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+
+		// The request in flight goes on, and its tokens are cached.
+		_, err = authenticator.AccessToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(tokenServer.Requests()).To(HaveLen(3))
+	})
+})
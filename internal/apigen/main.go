@@ -0,0 +1,644 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command apigen generates the API interface of a service client, a mock implementing it and the table of the
+// operations of the service.
+//
+// It is run by go generate from the package of the client:
+//
+//	go run ../internal/apigen -type KubernetesServiceApiV1
+//
+// The interface has the exported methods of the client returning an error, in source order, except the methods
+// configuring the client, and is written to api.go. The mock is written to mock/mock.go. The operations, with the
+// HTTP method and path template of their requests, are written to operations.go.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// clientMethods are the methods of every service client that configure it rather than call the service.
+var clientMethods = []string{
+	"Clone",
+	"DisableRetries",
+	"EnableRetries",
+	"GetEnableGzipCompression",
+	"GetServiceURL",
+	"SetDefaultHeaders",
+	"SetEnableGzipCompression",
+	"SetServiceURL",
+}
+
+const header = `/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+`
+
+// method : An exported method of the client.
+type method struct {
+	name    string
+	doc     string
+	params  []field
+	results []field
+}
+
+// field : A parameter or result of a method.
+type field struct {
+	name     string
+	typ      string // as written in the package of the client
+	mockType string // qualified with the package of the client
+	variadic bool
+}
+
+func main() {
+	typeName := flag.String("type", "", "the name of the client type")
+	flag.Parse()
+	if *typeName == "" {
+		log.Fatal("apigen: -type is required")
+	}
+
+	skipped := make(map[string]bool)
+	for _, name := range clientMethods {
+		skipped[name] = true
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != "api.go" && info.Name() != "operations.go"
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatalf("apigen: %s", err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("apigen: expected one package, found %d", len(pkgs))
+	}
+	var pkg *ast.Package
+	for _, p := range pkgs {
+		pkg = p
+	}
+
+	importPath, err := packageImportPath()
+	if err != nil {
+		log.Fatalf("apigen: %s", err)
+	}
+
+	g := &generator{
+		fset:    fset,
+		pkgName: pkg.Name,
+		types:   make(map[string]bool),
+		imports: make(map[string]string),
+	}
+	var fileNames []string
+	for name := range pkg.Files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+	for _, name := range fileNames {
+		g.collectDecls(pkg.Files[name])
+	}
+	for _, name := range fileNames {
+		g.collectMethods(pkg.Files[name], *typeName, skipped)
+	}
+
+	directive := "//go:generate go run ../internal/apigen " + strings.Join(os.Args[1:], " ")
+	write("api.go", g.api(*typeName, directive))
+	if err := os.MkdirAll("mock", 0755); err != nil {
+		log.Fatalf("apigen: %s", err)
+	}
+	write(filepath.Join("mock", "mock.go"), g.mock(importPath))
+	write("operations.go", g.operationTable(pkg, fileNames))
+}
+
+// generator : The declarations of the package of the client.
+type generator struct {
+	fset    *token.FileSet
+	pkgName string
+	types   map[string]bool   // the types declared by the package
+	imports map[string]string // the import paths of the packages the methods refer to, by name
+	methods []method
+	used    map[string]bool
+}
+
+// collectDecls records the types and imports of file.
+func (g *generator) collectDecls(file *ast.File) {
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+			for _, spec := range gen.Specs {
+				g.types[spec.(*ast.TypeSpec).Name.Name] = true
+			}
+		}
+	}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		} else if strings.HasPrefix(name, "v") && strings.Contains(path, "/") {
+			// Major version suffixes, as in github.com/IBM/go-sdk-core/v5/core, are not package names.
+			if _, err := strconv.Atoi(name[1:]); err == nil {
+				name = filepath.Base(filepath.Dir(path))
+			}
+		}
+		g.imports[name] = path
+	}
+}
+
+// collectMethods records the exported methods of the client declared in file that return an error.
+func (g *generator) collectMethods(file *ast.File, typeName string, skipped map[string]bool) {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || !fn.Name.IsExported() || skipped[fn.Name.Name] {
+			continue
+		}
+		star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		if ident, ok := star.X.(*ast.Ident); !ok || ident.Name != typeName {
+			continue
+		}
+		if !returnsError(fn.Type) {
+			// The options constructors and the like do not call the service.
+			continue
+		}
+		m := method{name: fn.Name.Name}
+		if fn.Doc != nil {
+			m.doc = summary(fn.Doc.Text())
+		}
+		m.params = g.fields(fn.Type.Params, "arg")
+		m.results = g.fields(fn.Type.Results, "result")
+		g.methods = append(g.methods, m)
+	}
+}
+
+// summary returns the summary line of an operation, as in "GetCluster : View details for a cluster", or else the
+// first sentence of doc.
+func summary(doc string) string {
+	paragraph := strings.SplitN(strings.TrimSpace(doc), "\n\n", 2)[0]
+	if first := strings.SplitN(paragraph, "\n", 2)[0]; strings.Contains(first, " : ") {
+		return first
+	}
+	sentence := strings.Join(strings.Fields(paragraph), " ")
+	if end := strings.Index(sentence, ". "); end >= 0 {
+		sentence = sentence[:end+1]
+	}
+	return sentence
+}
+
+// comment returns text as a comment indented by indent, wrapped at 120 columns.
+func comment(indent string, text string) string {
+	var lines []string
+	line := indent + "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 120 && line != indent+"//" {
+			lines = append(lines, line)
+			line = indent + "//"
+		}
+		line += " " + word
+	}
+	return strings.Join(append(lines, line), "\n") + "\n"
+}
+
+// returnsError reports whether the last result of fn is an error.
+func returnsError(fn *ast.FuncType) bool {
+	if fn.Results == nil || len(fn.Results.List) == 0 {
+		return false
+	}
+	ident, ok := fn.Results.List[len(fn.Results.List)-1].Type.(*ast.Ident)
+	return ok && ident.Name == "error"
+}
+
+// fields returns the fields of list, naming the unnamed ones after prefix.
+func (g *generator) fields(list *ast.FieldList, prefix string) []field {
+	var fields []field
+	if list == nil {
+		return fields
+	}
+	for _, f := range list.List {
+		typ := f.Type
+		variadic := false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = ellipsis.Elt, true
+		}
+		names := f.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{nil}
+		}
+		for _, name := range names {
+			fd := field{typ: g.print(typ), mockType: g.print(g.qualify(typ)), variadic: variadic}
+			if name != nil && name.Name != "_" {
+				fd.name = name.Name
+			} else {
+				fd.name = fmt.Sprintf("%s%d", prefix, len(fields))
+			}
+			fields = append(fields, fd)
+		}
+	}
+	return fields
+}
+
+// qualify returns a copy of typ referring to the types of the package through its name.
+func (g *generator) qualify(typ ast.Expr) ast.Expr {
+	expr, err := parser.ParseExpr(g.print(typ))
+	if err != nil {
+		log.Fatalf("apigen: %s", err)
+	}
+	var rewrite func(expr ast.Expr) ast.Expr
+	rewrite = func(expr ast.Expr) ast.Expr {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			if g.types[e.Name] {
+				return &ast.SelectorExpr{X: ast.NewIdent(g.pkgName), Sel: e}
+			}
+		case *ast.StarExpr:
+			e.X = rewrite(e.X)
+		case *ast.ArrayType:
+			e.Elt = rewrite(e.Elt)
+		case *ast.MapType:
+			e.Key, e.Value = rewrite(e.Key), rewrite(e.Value)
+		case *ast.ChanType:
+			e.Value = rewrite(e.Value)
+		case *ast.FuncType:
+			for _, list := range []*ast.FieldList{e.Params, e.Results} {
+				if list != nil {
+					for _, f := range list.List {
+						f.Type = rewrite(f.Type)
+					}
+				}
+			}
+		}
+		return expr
+	}
+	return rewrite(expr)
+}
+
+// print returns the source of expr, recording the packages it refers to.
+func (g *generator) print(expr ast.Expr) string {
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				if g.used == nil {
+					g.used = make(map[string]bool)
+				}
+				g.used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	printer.Fprint(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+// signature returns the parameters and results of m, with the types of the package qualified if mock is true.
+func (m method) signature(mock bool) string {
+	list := func(fields []field, named bool) string {
+		var parts []string
+		for _, f := range fields {
+			typ := f.typ
+			if mock {
+				typ = f.mockType
+			}
+			if f.variadic {
+				typ = "..." + typ
+			}
+			if named {
+				typ = f.name + " " + typ
+			}
+			parts = append(parts, typ)
+		}
+		return strings.Join(parts, ", ")
+	}
+	return fmt.Sprintf("(%s) (%s)", list(m.params, true), list(m.results, false))
+}
+
+// importBlock returns the import declaration of the packages in paths.
+func importBlock(paths []string) string {
+	var std, other []string
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, strconv.Quote(path))
+		} else {
+			std = append(std, strconv.Quote(path))
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	block := "import (\n\t" + strings.Join(std, "\n\t")
+	if len(std) > 0 && len(other) > 0 {
+		block += "\n"
+	}
+	if len(other) > 0 {
+		block += "\n\t" + strings.Join(other, "\n\t")
+	}
+	return block + "\n)\n\n"
+}
+
+// usedImports returns the import paths of the packages the methods refer to.
+func (g *generator) usedImports() []string {
+	var paths []string
+	for name := range g.used {
+		if path, ok := g.imports[name]; ok {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// api returns the source of the interface file.
+func (g *generator) api(typeName string, directive string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "package %s\n\n", g.pkgName)
+	buf.WriteString(importBlock(g.usedImports()))
+	buf.WriteString(directive + "\n\n")
+	fmt.Fprintf(&buf, "// API : The operations of %s, for substituting test doubles such as the one of the mock package.\n", typeName)
+	buf.WriteString("type API interface {\n")
+	for i, m := range g.methods {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		if m.doc != "" {
+			buf.WriteString(comment("\t", m.doc))
+		}
+		fmt.Fprintf(&buf, "\t%s%s\n", m.name, m.signature(false))
+	}
+	buf.WriteString("}\n\n")
+	fmt.Fprintf(&buf, "var _ API = (*%s)(nil)\n", typeName)
+	return buf.Bytes()
+}
+
+// mock returns the source of the mock file.
+func (g *generator) mock(importPath string) []byte {
+	byName := make(map[string]bool)
+	for _, m := range g.methods {
+		byName[m.name] = true
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "// Package mock provides a mock implementation of the %s.API interface.\n", g.pkgName)
+	buf.WriteString("//\n")
+	buf.WriteString("// Each method of API calls the function field of the same name with a Func suffix. A method without a\n")
+	buf.WriteString("// context falls back to the function of its WithContext form, called with context.Background(). Methods whose\n")
+	buf.WriteString("// function is not set return zero values and an error wrapping ErrNotStubbed.\n")
+	buf.WriteString("package mock\n\n")
+	buf.WriteString(importBlock(append(g.usedImports(), "context", "errors", "fmt", "sync", importPath)))
+
+	buf.WriteString("// ErrNotStubbed is wrapped by the errors of the methods whose function is not set.\n")
+	buf.WriteString("var ErrNotStubbed = errors.New(\"mock: method not stubbed\")\n\n")
+	buf.WriteString("// Call : A call of a method of the mock.\n")
+	buf.WriteString("type Call struct {\n\tMethod string\n\tArgs   []interface{}\n}\n\n")
+	fmt.Fprintf(&buf, "// API : A mock implementation of %s.API.\n", g.pkgName)
+	buf.WriteString("type API struct {\n")
+	for _, m := range g.methods {
+		fmt.Fprintf(&buf, "\t%sFunc func%s\n", m.name, m.signature(true))
+	}
+	buf.WriteString("\n\tmutex sync.Mutex\n\tcalls []Call\n}\n\n")
+	fmt.Fprintf(&buf, "var _ %s.API = (*API)(nil)\n\n", g.pkgName)
+
+	buf.WriteString(`// Calls returns the calls of the methods of the mock, in order.
+func (m *API) Calls() []Call {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the calls of the method called name, in order.
+func (m *API) CallsTo(name string) []Call {
+	var calls []Call
+	for _, call := range m.Calls() {
+		if call.Method == name {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls recorded so far.
+func (m *API) Reset() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.calls = nil
+}
+
+// record records a call of the method called name.
+func (m *API) record(name string, args ...interface{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.calls = append(m.calls, Call{Method: name, Args: args})
+}
+
+// notStubbed returns the error of the method called name when its function is not set.
+func notStubbed(name string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, name)
+}
+`)
+
+	for _, m := range g.methods {
+		var args, values []string
+		for _, p := range m.params {
+			args = append(args, p.name)
+			if p.variadic {
+				values = append(values, p.name+"...")
+			} else {
+				values = append(values, p.name)
+			}
+		}
+		recorded := strconv.Quote(m.name)
+		if len(args) > 0 {
+			recorded += ", " + strings.Join(args, ", ")
+		}
+		call := strings.Join(values, ", ")
+
+		fmt.Fprintf(&buf, "\n// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(&buf, "func (m *API) %s%s {\n", m.name, m.signature(true))
+		fmt.Fprintf(&buf, "\tm.record(%s)\n", recorded)
+		fmt.Fprintf(&buf, "\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", m.name, m.name, call)
+		withContext := m.name + "WithContext"
+		if byName[withContext] {
+			contextCall := "context.Background()"
+			if call != "" {
+				contextCall += ", " + call
+			}
+			fmt.Fprintf(&buf, "\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", withContext, withContext, contextCall)
+		}
+		var zeros []string
+		for _, r := range m.results[:len(m.results)-1] {
+			zeros = append(zeros, "r"+strconv.Itoa(len(zeros)))
+			fmt.Fprintf(&buf, "\tvar %s %s\n", zeros[len(zeros)-1], r.mockType)
+		}
+		zeros = append(zeros, fmt.Sprintf("notStubbed(%s)", strconv.Quote(m.name)))
+		fmt.Fprintf(&buf, "\treturn %s\n}\n", strings.Join(zeros, ", "))
+	}
+	return buf.Bytes()
+}
+
+// write formats source and writes it to the file called name.
+func write(name string, source []byte) {
+	formatted, err := format.Source(source)
+	if err != nil {
+		log.Fatalf("apigen: formatting %s: %s", name, err)
+	}
+	if err := ioutil.WriteFile(name, formatted, 0644); err != nil {
+		log.Fatalf("apigen: %s", err)
+	}
+}
+
+// packageImportPath returns the import path of the package in the working directory, from the go.mod file above it.
+func packageImportPath() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	var rel []string
+	for {
+		contents, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(contents), "\n") {
+				if strings.HasPrefix(line, "module ") {
+					module := strings.TrimSpace(strings.TrimPrefix(line, "module "))
+					return strings.Join(append([]string{module}, rel...), "/"), nil
+				}
+			}
+			return "", fmt.Errorf("no module declaration in %s", filepath.Join(dir, "go.mod"))
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("no go.mod found")
+		}
+		rel = append([]string{filepath.Base(dir)}, rel...)
+		dir = parent
+	}
+}
+
+// operation : An operation of the service, found in the method of the client building its request.
+type operation struct {
+	serviceName  string
+	id           string
+	method       string
+	pathTemplate string
+}
+
+// operationTable returns the source of the operations file, with the operations of the methods whose requests are
+// built with core.NewRequestBuilder, core.ResolveRequestURL and common.GetSdkHeaders.
+func (g *generator) operationTable(pkg *ast.Package, fileNames []string) []byte {
+	var operations []operation
+	seen := make(map[string]bool)
+	for _, name := range fileNames {
+		for _, decl := range pkg.Files[name].Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			var op operation
+			ast.Inspect(fn.Body, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				switch sel.Sel.Name {
+				case "NewRequestBuilder":
+					if len(call.Args) == 1 {
+						if method, ok := call.Args[0].(*ast.SelectorExpr); ok {
+							op.method = method.Sel.Name
+						}
+					}
+				case "ResolveRequestURL":
+					if len(call.Args) == 3 {
+						op.pathTemplate = stringLiteral(call.Args[1])
+					}
+				case "GetSdkHeaders":
+					if len(call.Args) == 3 {
+						op.serviceName, op.id = stringLiteral(call.Args[0]), stringLiteral(call.Args[2])
+					}
+				}
+				return true
+			})
+			if op.id == "" || op.method == "" || op.pathTemplate == "" {
+				continue
+			}
+			if seen[op.id] {
+				log.Fatalf("apigen: %s sends the operation ID %s of another method", fn.Name.Name, op.id)
+			}
+			seen[op.id] = true
+			operations = append(operations, op)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "package %s\n\n", g.pkgName)
+	buf.WriteString(importBlock([]string{g.imports["common"]}))
+	buf.WriteString("// operations are the operations of the service by ID, passed to the interceptors in the request context.\n")
+	buf.WriteString("var operations = map[string]common.Operation{\n")
+	for _, op := range operations {
+		fmt.Fprintf(&buf, "\t%q: {ServiceName: %q, ID: %q, Method: %q, PathTemplate: %q},\n", op.id, op.serviceName, op.id, op.method, op.pathTemplate)
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// stringLiteral returns the value of expr if it is a string literal, and "" otherwise.
+func stringLiteral(expr ast.Expr) string {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return ""
+	}
+	value, err := strconv.Unquote(literal.Value)
+	if err != nil {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,1642 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+package kubernetesserviceapiv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/apigen -type KubernetesServiceApiV1
+
+// API : The operations of KubernetesServiceApiV1, for substituting test doubles such as the one of the mock package.
+type API interface {
+	// GetClusterConfigToWriter is an alternate form of the GetClusterConfig method which copies the archive to writer as
+	// it is received and returns the number of bytes written.
+	GetClusterConfigToWriter(getClusterConfigOptions *GetClusterConfigOptions, writer io.Writer) (int64, *core.DetailedResponse, error)
+
+	// GetClusterConfigToWriterWithContext is an alternate form of the GetClusterConfigToWriter method which supports a
+	// Context parameter
+	GetClusterConfigToWriterWithContext(ctx context.Context, getClusterConfigOptions *GetClusterConfigOptions, writer io.Writer) (int64, *core.DetailedResponse, error)
+
+	// ExtractClusterConfig is an alternate form of the GetClusterConfig method which streams the archive to a temporary
+	// file and extracts it into dir with ExtractClusterConfigArchive.
+	ExtractClusterConfig(getClusterConfigOptions *GetClusterConfigOptions, dir string) (*ClusterConfigManifest, *core.DetailedResponse, error)
+
+	// ExtractClusterConfigWithContext is an alternate form of the ExtractClusterConfig method which supports a Context
+	// parameter
+	ExtractClusterConfigWithContext(ctx context.Context, getClusterConfigOptions *GetClusterConfigOptions, dir string) (*ClusterConfigManifest, *core.DetailedResponse, error)
+
+	// WaitForClusterState polls the cluster until predicate is satisfied and returns the last cluster read.
+	WaitForClusterState(ctx context.Context, cluster string, predicate ClusterPredicate, options *ClusterWaitOptions) (*GetClusterResponse, error)
+
+	// WaitForClusterNormal waits until the cluster state is normal and its master is deployed and healthy.
+	WaitForClusterNormal(ctx context.Context, cluster string, options *ClusterWaitOptions) (*GetClusterResponse, error)
+
+	// WaitForClusterDeleted waits until reading the cluster returns a 404 or its state is deleted.
+	WaitForClusterDeleted(ctx context.Context, cluster string, options *ClusterWaitOptions) error
+
+	// GetParsedKubeconfig is an alternate form of the GetKubeconfig method which returns the kubeconfig parsed.
+	GetParsedKubeconfig(getKubeconfigOptions *GetKubeconfigOptions) (*Kubeconfig, *core.DetailedResponse, error)
+
+	// GetParsedKubeconfigWithContext is an alternate form of the GetParsedKubeconfig method which supports a Context
+	// parameter
+	GetParsedKubeconfigWithContext(ctx context.Context, getKubeconfigOptions *GetKubeconfigOptions) (*Kubeconfig, *core.DetailedResponse, error)
+
+	// GetKubeconfigBundle is an alternate form of the GetKubeconfig method which requests the zip format and returns the
+	// archive.
+	GetKubeconfigBundle(getKubeconfigOptions *GetKubeconfigOptions) (*KubeconfigBundle, *core.DetailedResponse, error)
+
+	// GetKubeconfigBundleWithContext is an alternate form of the GetKubeconfigBundle method which supports a Context
+	// parameter
+	GetKubeconfigBundleWithContext(ctx context.Context, getKubeconfigOptions *GetKubeconfigOptions) (*KubeconfigBundle, *core.DetailedResponse, error)
+
+	// ApplyRBACAndGetParsedKubeconfig is an alternate form of the ApplyRBACAndGetKubeconfig method which returns the
+	// kubeconfig parsed.
+	ApplyRBACAndGetParsedKubeconfig(applyRBACAndGetKubeconfigOptions *ApplyRBACAndGetKubeconfigOptions) (*Kubeconfig, *core.DetailedResponse, error)
+
+	// ApplyRBACAndGetParsedKubeconfigWithContext is an alternate form of the ApplyRBACAndGetParsedKubeconfig method which
+	// supports a Context parameter
+	ApplyRBACAndGetParsedKubeconfigWithContext(ctx context.Context, applyRBACAndGetKubeconfigOptions *ApplyRBACAndGetKubeconfigOptions) (*Kubeconfig, *core.DetailedResponse, error)
+
+	// GetUserCredentials : View the IBM Cloud classic infrastructure account credentials that are set for your IBM Cloud
+	// Kubernetes Service account
+	GetUserCredentials(getUserCredentialsOptions *GetUserCredentialsOptions) (*Credentials, *core.DetailedResponse, error)
+
+	// GetUserCredentialsWithContext is an alternate form of the GetUserCredentials method which supports a Context
+	// parameter
+	GetUserCredentialsWithContext(ctx context.Context, getUserCredentialsOptions *GetUserCredentialsOptions) (*Credentials, *core.DetailedResponse, error)
+
+	// StoreUserCredentials : Set IBM Cloud classic infrastructure account credentials for your IBM Cloud Kubernetes
+	// Service account
+	StoreUserCredentials(storeUserCredentialsOptions *StoreUserCredentialsOptions) (*core.DetailedResponse, error)
+
+	// StoreUserCredentialsWithContext is an alternate form of the StoreUserCredentials method which supports a Context
+	// parameter
+	StoreUserCredentialsWithContext(ctx context.Context, storeUserCredentialsOptions *StoreUserCredentialsOptions) (*core.DetailedResponse, error)
+
+	// RemoveUserCredentials : Remove IBM Cloud classic infrastructure account credentials from your IBM Cloud Kubernetes
+	// Service account
+	RemoveUserCredentials(removeUserCredentialsOptions *RemoveUserCredentialsOptions) (*core.DetailedResponse, error)
+
+	// RemoveUserCredentialsWithContext is an alternate form of the RemoveUserCredentials method which supports a Context
+	// parameter
+	RemoveUserCredentialsWithContext(ctx context.Context, removeUserCredentialsOptions *RemoveUserCredentialsOptions) (*core.DetailedResponse, error)
+
+	// GetInfraPermissions : Get details on the permissions that the IBM Cloud infrastructure (SoftLayer) credentials have
+	GetInfraPermissions(getInfraPermissionsOptions *GetInfraPermissionsOptions) (*AccountStatus, *core.DetailedResponse, error)
+
+	// GetInfraPermissionsWithContext is an alternate form of the GetInfraPermissions method which supports a Context
+	// parameter
+	GetInfraPermissionsWithContext(ctx context.Context, getInfraPermissionsOptions *GetInfraPermissionsOptions) (*AccountStatus, *core.DetailedResponse, error)
+
+	// ResetUserAPIKey : Reset the IAM API key
+	ResetUserAPIKey(resetUserAPIKeyOptions *ResetUserAPIKeyOptions) (*core.DetailedResponse, error)
+
+	// ResetUserAPIKeyWithContext is an alternate form of the ResetUserAPIKey method which supports a Context parameter
+	ResetUserAPIKeyWithContext(ctx context.Context, resetUserAPIKeyOptions *ResetUserAPIKeyOptions) (*core.DetailedResponse, error)
+
+	// GetVlanSpanning : View the VLAN spanning status
+	GetVlanSpanning(getVlanSpanningOptions *GetVlanSpanningOptions) (*VlanSpan, *core.DetailedResponse, error)
+
+	// GetVlanSpanningWithContext is an alternate form of the GetVlanSpanning method which supports a Context parameter
+	GetVlanSpanningWithContext(ctx context.Context, getVlanSpanningOptions *GetVlanSpanningOptions) (*VlanSpan, *core.DetailedResponse, error)
+
+	// GetClusterACLs : Get a cluster's private service endpoint allowlist
+	GetClusterACLs(getClusterACLsOptions *GetClusterACLsOptions) (*ACLResponse, *core.DetailedResponse, error)
+
+	// GetClusterACLsWithContext is an alternate form of the GetClusterACLs method which supports a Context parameter
+	GetClusterACLsWithContext(ctx context.Context, getClusterACLsOptions *GetClusterACLsOptions) (*ACLResponse, *core.DetailedResponse, error)
+
+	// DisableClusterACLs : Disable the subnet allowlist for a cluster's private service endpoint
+	DisableClusterACLs(disableClusterACLsOptions *DisableClusterACLsOptions) (*core.DetailedResponse, error)
+
+	// DisableClusterACLsWithContext is an alternate form of the DisableClusterACLs method which supports a Context
+	// parameter
+	DisableClusterACLsWithContext(ctx context.Context, disableClusterACLsOptions *DisableClusterACLsOptions) (*core.DetailedResponse, error)
+
+	// AddClusterACLs : Add subnets to a cluster's private service endpoint allowlist
+	AddClusterACLs(addClusterACLsOptions *AddClusterACLsOptions) (*core.DetailedResponse, error)
+
+	// AddClusterACLsWithContext is an alternate form of the AddClusterACLs method which supports a Context parameter
+	AddClusterACLsWithContext(ctx context.Context, addClusterACLsOptions *AddClusterACLsOptions) (*core.DetailedResponse, error)
+
+	// EnableClusterACLs : Enable a subnet allowlist for a cluster's private service endpoint
+	EnableClusterACLs(enableClusterACLsOptions *EnableClusterACLsOptions) (*core.DetailedResponse, error)
+
+	// EnableClusterACLsWithContext is an alternate form of the EnableClusterACLs method which supports a Context parameter
+	EnableClusterACLsWithContext(ctx context.Context, enableClusterACLsOptions *EnableClusterACLsOptions) (*core.DetailedResponse, error)
+
+	// RemoveClusterACLs : Remove subnets from a cluster's private service endpoint allowlist
+	RemoveClusterACLs(removeClusterACLsOptions *RemoveClusterACLsOptions) (*core.DetailedResponse, error)
+
+	// RemoveClusterACLsWithContext is an alternate form of the RemoveClusterACLs method which supports a Context parameter
+	RemoveClusterACLsWithContext(ctx context.Context, removeClusterACLsOptions *RemoveClusterACLsOptions) (*core.DetailedResponse, error)
+
+	// EnableALB : Enable an existing ALB in a cluster
+	EnableALB(enableALBOptions *EnableALBOptions) (*core.DetailedResponse, error)
+
+	// EnableALBWithContext is an alternate form of the EnableALB method which supports a Context parameter
+	EnableALBWithContext(ctx context.Context, enableALBOptions *EnableALBOptions) (*core.DetailedResponse, error)
+
+	// GetClusterALB : View details of an ALB
+	GetClusterALB(getClusterALBOptions *GetClusterALBOptions) (*ALBConfig, *core.DetailedResponse, error)
+
+	// GetClusterALBWithContext is an alternate form of the GetClusterALB method which supports a Context parameter
+	GetClusterALBWithContext(ctx context.Context, getClusterALBOptions *GetClusterALBOptions) (*ALBConfig, *core.DetailedResponse, error)
+
+	// DisableALB : Disable an ALB in your cluster
+	DisableALB(disableALBOptions *DisableALBOptions) (*core.DetailedResponse, error)
+
+	// DisableALBWithContext is an alternate form of the DisableALB method which supports a Context parameter
+	DisableALBWithContext(ctx context.Context, disableALBOptions *DisableALBOptions) (*core.DetailedResponse, error)
+
+	// GetAvailableALBTypes : List the ALB types that are supported
+	GetAvailableALBTypes(getAvailableALBTypesOptions *GetAvailableALBTypesOptions) ([]string, *core.DetailedResponse, error)
+
+	// GetAvailableALBTypesWithContext is an alternate form of the GetAvailableALBTypes method which supports a Context
+	// parameter
+	GetAvailableALBTypesWithContext(ctx context.Context, getAvailableALBTypesOptions *GetAvailableALBTypesOptions) ([]string, *core.DetailedResponse, error)
+
+	// GetClusterALBs : List all ALBs in a cluster
+	GetClusterALBs(getClusterALBsOptions *GetClusterALBsOptions) ([]ClusterALB, *core.DetailedResponse, error)
+
+	// GetClusterALBsWithContext is an alternate form of the GetClusterALBs method which supports a Context parameter
+	GetClusterALBsWithContext(ctx context.Context, getClusterALBsOptions *GetClusterALBsOptions) ([]ClusterALB, *core.DetailedResponse, error)
+
+	// UpdateALBs : Force a one-time update of all ALB pods to the latest build
+	UpdateALBs(updateALBsOptions *UpdateALBsOptions) (*core.DetailedResponse, error)
+
+	// UpdateALBsWithContext is an alternate form of the UpdateALBs method which supports a Context parameter
+	UpdateALBsWithContext(ctx context.Context, updateALBsOptions *UpdateALBsOptions) (*core.DetailedResponse, error)
+
+	// GetUpdatePolicy : Check if automatic updates for Ingress ALBs are enabled in a cluster
+	GetUpdatePolicy(getUpdatePolicyOptions *GetUpdatePolicyOptions) (*UpdatePolicy, *core.DetailedResponse, error)
+
+	// GetUpdatePolicyWithContext is an alternate form of the GetUpdatePolicy method which supports a Context parameter
+	GetUpdatePolicyWithContext(ctx context.Context, getUpdatePolicyOptions *GetUpdatePolicyOptions) (*UpdatePolicy, *core.DetailedResponse, error)
+
+	// ChangeUpdatePolicy : Enable or disable automatic updates for the Ingress ALBs in a cluster
+	ChangeUpdatePolicy(changeUpdatePolicyOptions *ChangeUpdatePolicyOptions) (*core.DetailedResponse, error)
+
+	// ChangeUpdatePolicyWithContext is an alternate form of the ChangeUpdatePolicy method which supports a Context
+	// parameter
+	ChangeUpdatePolicyWithContext(ctx context.Context, changeUpdatePolicyOptions *ChangeUpdatePolicyOptions) (*core.DetailedResponse, error)
+
+	// RollbackUpdate : Roll back all ALB pods in a cluster to their previously running build
+	RollbackUpdate(rollbackUpdateOptions *RollbackUpdateOptions) (*core.DetailedResponse, error)
+
+	// RollbackUpdateWithContext is an alternate form of the RollbackUpdate method which supports a Context parameter
+	RollbackUpdateWithContext(ctx context.Context, rollbackUpdateOptions *RollbackUpdateOptions) (*core.DetailedResponse, error)
+
+	// CreateALB : Create a public or private ALB in a specified zone and VLAN
+	CreateALB(createALBOptions *CreateALBOptions) (*AlbCreateResp, *core.DetailedResponse, error)
+
+	// CreateALBWithContext is an alternate form of the CreateALB method which supports a Context parameter
+	CreateALBWithContext(ctx context.Context, createALBOptions *CreateALBOptions) (*AlbCreateResp, *core.DetailedResponse, error)
+
+	// UpdateALBSecret : Update an ALB secret that you imported from Certificate Manager
+	UpdateALBSecret(updateALBSecretOptions *UpdateALBSecretOptions) (*core.DetailedResponse, error)
+
+	// UpdateALBSecretWithContext is an alternate form of the UpdateALBSecret method which supports a Context parameter
+	UpdateALBSecretWithContext(ctx context.Context, updateALBSecretOptions *UpdateALBSecretOptions) (*core.DetailedResponse, error)
+
+	// CreateALBSecret : Import an ALB secret from Certificate Manager
+	CreateALBSecret(createALBSecretOptions *CreateALBSecretOptions) (*core.DetailedResponse, error)
+
+	// CreateALBSecretWithContext is an alternate form of the CreateALBSecret method which supports a Context parameter
+	CreateALBSecretWithContext(ctx context.Context, createALBSecretOptions *CreateALBSecretOptions) (*core.DetailedResponse, error)
+
+	// ViewClusterALBSecrets : View details of an ALB secret that you imported from Certificate Manager
+	ViewClusterALBSecrets(viewClusterALBSecretsOptions *ViewClusterALBSecretsOptions) (*ClusterALBSecret, *core.DetailedResponse, error)
+
+	// ViewClusterALBSecretsWithContext is an alternate form of the ViewClusterALBSecrets method which supports a Context
+	// parameter
+	ViewClusterALBSecretsWithContext(ctx context.Context, viewClusterALBSecretsOptions *ViewClusterALBSecretsOptions) (*ClusterALBSecret, *core.DetailedResponse, error)
+
+	// DeleteClusterALBSecrets : Delete an ALB secret that is imported from Certificate Manager from a cluster
+	DeleteClusterALBSecrets(deleteClusterALBSecretsOptions *DeleteClusterALBSecretsOptions) (*core.DetailedResponse, error)
+
+	// DeleteClusterALBSecretsWithContext is an alternate form of the DeleteClusterALBSecrets method which supports a
+	// Context parameter
+	DeleteClusterALBSecretsWithContext(ctx context.Context, deleteClusterALBSecretsOptions *DeleteClusterALBSecretsOptions) (*core.DetailedResponse, error)
+
+	// GetAuditWebhook : View details for an audit webhook configuration
+	GetAuditWebhook(getAuditWebhookOptions *GetAuditWebhookOptions) (*AuditWebhookConfig, *core.DetailedResponse, error)
+
+	// GetAuditWebhookWithContext is an alternate form of the GetAuditWebhook method which supports a Context parameter
+	GetAuditWebhookWithContext(ctx context.Context, getAuditWebhookOptions *GetAuditWebhookOptions) (*AuditWebhookConfig, *core.DetailedResponse, error)
+
+	// UpdateAuditWebhook : Create or update an audit webhook configuration for a cluster
+	UpdateAuditWebhook(updateAuditWebhookOptions *UpdateAuditWebhookOptions) (*core.DetailedResponse, error)
+
+	// UpdateAuditWebhookWithContext is an alternate form of the UpdateAuditWebhook method which supports a Context
+	// parameter
+	UpdateAuditWebhookWithContext(ctx context.Context, updateAuditWebhookOptions *UpdateAuditWebhookOptions) (*core.DetailedResponse, error)
+
+	// DeleteAuditWebhook : Delete an audit webhook configuration
+	DeleteAuditWebhook(deleteAuditWebhookOptions *DeleteAuditWebhookOptions) (*core.DetailedResponse, error)
+
+	// DeleteAuditWebhookWithContext is an alternate form of the DeleteAuditWebhook method which supports a Context
+	// parameter
+	DeleteAuditWebhookWithContext(ctx context.Context, deleteAuditWebhookOptions *DeleteAuditWebhookOptions) (*core.DetailedResponse, error)
+
+	// GetLBConfig : Get configuration of load balancers for Ingress ALBs
+	GetLBConfig(getLBConfigOptions *GetLBConfigOptions) (*LBConfig, *core.DetailedResponse, error)
+
+	// GetLBConfigWithContext is an alternate form of the GetLBConfig method which supports a Context parameter
+	GetLBConfigWithContext(ctx context.Context, getLBConfigOptions *GetLBConfigOptions) (*LBConfig, *core.DetailedResponse, error)
+
+	// PatchLBConfig : Update the configuration of load balancers for Ingress ALBs
+	PatchLBConfig(patchLBConfigOptions *PatchLBConfigOptions) (*core.DetailedResponse, error)
+
+	// PatchLBConfigWithContext is an alternate form of the PatchLBConfig method which supports a Context parameter
+	PatchLBConfigWithContext(ctx context.Context, patchLBConfigOptions *PatchLBConfigOptions) (*core.DetailedResponse, error)
+
+	// CreateSecret : Create an Ingress secret for a certificate
+	CreateSecret(createSecretOptions *CreateSecretOptions) (*Secret, *core.DetailedResponse, error)
+
+	// CreateSecretWithContext is an alternate form of the CreateSecret method which supports a Context parameter
+	CreateSecretWithContext(ctx context.Context, createSecretOptions *CreateSecretOptions) (*Secret, *core.DetailedResponse, error)
+
+	// DeleteIngressSecret : Delete an Ingress secret from the cluster
+	DeleteIngressSecret(deleteIngressSecretOptions *DeleteIngressSecretOptions) (*core.DetailedResponse, error)
+
+	// DeleteIngressSecretWithContext is an alternate form of the DeleteIngressSecret method which supports a Context
+	// parameter
+	DeleteIngressSecretWithContext(ctx context.Context, deleteIngressSecretOptions *DeleteIngressSecretOptions) (*core.DetailedResponse, error)
+
+	// GetSecret : View Ingress secret details
+	GetSecret(getSecretOptions *GetSecretOptions) (*Secret, *core.DetailedResponse, error)
+
+	// GetSecretWithContext is an alternate form of the GetSecret method which supports a Context parameter
+	GetSecretWithContext(ctx context.Context, getSecretOptions *GetSecretOptions) (*Secret, *core.DetailedResponse, error)
+
+	// GetSecrets : View Ingress secrets for a cluster
+	GetSecrets(getSecretsOptions *GetSecretsOptions) ([]Secret, *core.DetailedResponse, error)
+
+	// GetSecretsWithContext is an alternate form of the GetSecrets method which supports a Context parameter
+	GetSecretsWithContext(ctx context.Context, getSecretsOptions *GetSecretsOptions) ([]Secret, *core.DetailedResponse, error)
+
+	// UpdateSecret : Update an Ingress secret for a certificate
+	UpdateSecret(updateSecretOptions *UpdateSecretOptions) (*Secret, *core.DetailedResponse, error)
+
+	// UpdateSecretWithContext is an alternate form of the UpdateSecret method which supports a Context parameter
+	UpdateSecretWithContext(ctx context.Context, updateSecretOptions *UpdateSecretOptions) (*Secret, *core.DetailedResponse, error)
+
+	// CleanupMigration : Clean up any Ingress resources and ConfigMaps that are no longer needed after an Ingress
+	// migration
+	CleanupMigration(cleanupMigrationOptions *CleanupMigrationOptions) (*core.DetailedResponse, error)
+
+	// CleanupMigrationWithContext is an alternate form of the CleanupMigration method which supports a Context parameter
+	CleanupMigrationWithContext(ctx context.Context, cleanupMigrationOptions *CleanupMigrationOptions) (*core.DetailedResponse, error)
+
+	// V2GetClusterALB : View details of an ALB
+	V2GetClusterALB(v2GetClusterALBOptions *V2GetClusterALBOptions) (*VpcALBConfig, *core.DetailedResponse, error)
+
+	// V2GetClusterALBWithContext is an alternate form of the V2GetClusterALB method which supports a Context parameter
+	V2GetClusterALBWithContext(ctx context.Context, v2GetClusterALBOptions *V2GetClusterALBOptions) (*VpcALBConfig, *core.DetailedResponse, error)
+
+	// GetSupportedImages : List supported Ingress controller images
+	GetSupportedImages(getSupportedImagesOptions *GetSupportedImagesOptions) ([]string, *core.DetailedResponse, error)
+
+	// GetSupportedImagesWithContext is an alternate form of the GetSupportedImages method which supports a Context
+	// parameter
+	GetSupportedImagesWithContext(ctx context.Context, getSupportedImagesOptions *GetSupportedImagesOptions) ([]string, *core.DetailedResponse, error)
+
+	// V2GetClusterALBs : List all ALBs in a cluster
+	V2GetClusterALBs(v2GetClusterALBsOptions *V2GetClusterALBsOptions) (*VpcClusterALB, *core.DetailedResponse, error)
+
+	// V2GetClusterALBsWithContext is an alternate form of the V2GetClusterALBs method which supports a Context parameter
+	V2GetClusterALBsWithContext(ctx context.Context, v2GetClusterALBsOptions *V2GetClusterALBsOptions) (*VpcClusterALB, *core.DetailedResponse, error)
+
+	// GetMigrationStatus : Get the status of the migration process
+	GetMigrationStatus(getMigrationStatusOptions *GetMigrationStatusOptions) (*MigrationStatus, *core.DetailedResponse, error)
+
+	// GetMigrationStatusWithContext is an alternate form of the GetMigrationStatus method which supports a Context
+	// parameter
+	GetMigrationStatusWithContext(ctx context.Context, getMigrationStatusOptions *GetMigrationStatusOptions) (*MigrationStatus, *core.DetailedResponse, error)
+
+	// GetStatus : Get the status of the ingress resources in a cluster
+	GetStatus(getStatusOptions *GetStatusOptions) (*IngressStatus, *core.DetailedResponse, error)
+
+	// GetStatusWithContext is an alternate form of the GetStatus method which supports a Context parameter
+	GetStatusWithContext(ctx context.Context, getStatusOptions *GetStatusOptions) (*IngressStatus, *core.DetailedResponse, error)
+
+	// StartMigration : Start a migration of your IBM Cloud Ingress ConfigMap and Ingress resources to the Kubernetes
+	// Ingress format
+	StartMigration(startMigrationOptions *StartMigrationOptions) (*core.DetailedResponse, error)
+
+	// StartMigrationWithContext is an alternate form of the StartMigration method which supports a Context parameter
+	StartMigrationWithContext(ctx context.Context, startMigrationOptions *StartMigrationOptions) (*core.DetailedResponse, error)
+
+	// V2UpdateALB : Update ALBs in a cluster
+	V2UpdateALB(v2UpdateALBOptions *V2UpdateALBOptions) (*core.DetailedResponse, error)
+
+	// V2UpdateALBWithContext is an alternate form of the V2UpdateALB method which supports a Context parameter
+	V2UpdateALBWithContext(ctx context.Context, v2UpdateALBOptions *V2UpdateALBOptions) (*core.DetailedResponse, error)
+
+	// VpcCreateALB : Create a public or private ALB in a specified zone and VPC cluster
+	VpcCreateALB(vpcCreateALBOptions *VpcCreateALBOptions) (*AlbCreateResp, *core.DetailedResponse, error)
+
+	// VpcCreateALBWithContext is an alternate form of the VpcCreateALB method which supports a Context parameter
+	VpcCreateALBWithContext(ctx context.Context, vpcCreateALBOptions *VpcCreateALBOptions) (*AlbCreateResp, *core.DetailedResponse, error)
+
+	// VpcDisableALB : Disable an ALB in your VPC cluster
+	VpcDisableALB(vpcDisableALBOptions *VpcDisableALBOptions) (*core.DetailedResponse, error)
+
+	// VpcDisableALBWithContext is an alternate form of the VpcDisableALB method which supports a Context parameter
+	VpcDisableALBWithContext(ctx context.Context, vpcDisableALBOptions *VpcDisableALBOptions) (*core.DetailedResponse, error)
+
+	// VpcEnableALB : Enable an existing ALB in a VPC cluster
+	VpcEnableALB(vpcEnableALBOptions *VpcEnableALBOptions) (*core.DetailedResponse, error)
+
+	// VpcEnableALBWithContext is an alternate form of the VpcEnableALB method which supports a Context parameter
+	VpcEnableALBWithContext(ctx context.Context, vpcEnableALBOptions *VpcEnableALBOptions) (*core.DetailedResponse, error)
+
+	// V2DisablePrivateServiceEndpoint : Disables a private service endpoint for a cluster
+	V2DisablePrivateServiceEndpoint(v2DisablePrivateServiceEndpointOptions *V2DisablePrivateServiceEndpointOptions) (*core.DetailedResponse, error)
+
+	// V2DisablePrivateServiceEndpointWithContext is an alternate form of the V2DisablePrivateServiceEndpoint method which
+	// supports a Context parameter
+	V2DisablePrivateServiceEndpointWithContext(ctx context.Context, v2DisablePrivateServiceEndpointOptions *V2DisablePrivateServiceEndpointOptions) (*core.DetailedResponse, error)
+
+	// V2DisablePublicServiceEndpoint : Disables a public service endpoint for a cluster
+	V2DisablePublicServiceEndpoint(v2DisablePublicServiceEndpointOptions *V2DisablePublicServiceEndpointOptions) (*core.DetailedResponse, error)
+
+	// V2DisablePublicServiceEndpointWithContext is an alternate form of the V2DisablePublicServiceEndpoint method which
+	// supports a Context parameter
+	V2DisablePublicServiceEndpointWithContext(ctx context.Context, v2DisablePublicServiceEndpointOptions *V2DisablePublicServiceEndpointOptions) (*core.DetailedResponse, error)
+
+	// V2EnablePrivateServiceEndpoint : Enables the private service endpoint for a cluster
+	V2EnablePrivateServiceEndpoint(v2EnablePrivateServiceEndpointOptions *V2EnablePrivateServiceEndpointOptions) (*core.DetailedResponse, error)
+
+	// V2EnablePrivateServiceEndpointWithContext is an alternate form of the V2EnablePrivateServiceEndpoint method which
+	// supports a Context parameter
+	V2EnablePrivateServiceEndpointWithContext(ctx context.Context, v2EnablePrivateServiceEndpointOptions *V2EnablePrivateServiceEndpointOptions) (*core.DetailedResponse, error)
+
+	// V2EnablePublicServiceEndpoint : Enables the public service endpoint for a cluster
+	V2EnablePublicServiceEndpoint(v2EnablePublicServiceEndpointOptions *V2EnablePublicServiceEndpointOptions) (*core.DetailedResponse, error)
+
+	// V2EnablePublicServiceEndpointWithContext is an alternate form of the V2EnablePublicServiceEndpoint method which
+	// supports a Context parameter
+	V2EnablePublicServiceEndpointWithContext(ctx context.Context, v2EnablePublicServiceEndpointOptions *V2EnablePublicServiceEndpointOptions) (*core.DetailedResponse, error)
+
+	// V2EnablePullSecret : Create an image pull secret to IBM Cloud Container Registry in the `default` namespace of the
+	// cluster. Note that this API method also requires the Administrator platform role to IBM Cloud Container Registry
+	V2EnablePullSecret(v2EnablePullSecretOptions *V2EnablePullSecretOptions) (*core.DetailedResponse, error)
+
+	// V2EnablePullSecretWithContext is an alternate form of the V2EnablePullSecret method which supports a Context
+	// parameter
+	V2EnablePullSecretWithContext(ctx context.Context, v2EnablePullSecretOptions *V2EnablePullSecretOptions) (*core.DetailedResponse, error)
+
+	// V2GetVersions : List available cluster versions
+	V2GetVersions(v2GetVersionsOptions *V2GetVersionsOptions) ([]IKSVersion, *core.DetailedResponse, error)
+
+	// V2GetVersionsWithContext is an alternate form of the V2GetVersions method which supports a Context parameter
+	V2GetVersionsWithContext(ctx context.Context, v2GetVersionsOptions *V2GetVersionsOptions) ([]IKSVersion, *core.DetailedResponse, error)
+
+	// DeleteSecret : Remove a secret from an NLB subdomain
+	DeleteSecret(deleteSecretOptions *DeleteSecretOptions) (*core.DetailedResponse, error)
+
+	// DeleteSecretWithContext is an alternate form of the DeleteSecret method which supports a Context parameter
+	DeleteSecretWithContext(ctx context.Context, deleteSecretOptions *DeleteSecretOptions) (*core.DetailedResponse, error)
+
+	// GetNlbDNSList : List registered NLB subdomains in a cluster
+	GetNlbDNSList(getNlbDNSListOptions *GetNlbDNSListOptions) ([]NlbVPCListConfig, *core.DetailedResponse, error)
+
+	// GetNlbDNSListWithContext is an alternate form of the GetNlbDNSList method which supports a Context parameter
+	GetNlbDNSListWithContext(ctx context.Context, getNlbDNSListOptions *GetNlbDNSListOptions) ([]NlbVPCListConfig, *core.DetailedResponse, error)
+
+	// GetSatLocationNlbDNSList : List registered NLB subdomains in a Satellite location
+	GetSatLocationNlbDNSList(getSatLocationNlbDNSListOptions *GetSatLocationNlbDNSListOptions) ([]NlbVPCListConfig, *core.DetailedResponse, error)
+
+	// GetSatLocationNlbDNSListWithContext is an alternate form of the GetSatLocationNlbDNSList method which supports a
+	// Context parameter
+	GetSatLocationNlbDNSListWithContext(ctx context.Context, getSatLocationNlbDNSListOptions *GetSatLocationNlbDNSListOptions) ([]NlbVPCListConfig, *core.DetailedResponse, error)
+
+	// RegenerateCert : Regenerates cert for secret
+	RegenerateCert(regenerateCertOptions *RegenerateCertOptions) (*core.DetailedResponse, error)
+
+	// RegenerateCertWithContext is an alternate form of the RegenerateCert method which supports a Context parameter
+	RegenerateCertWithContext(ctx context.Context, regenerateCertOptions *RegenerateCertOptions) (*core.DetailedResponse, error)
+
+	// RegisterMultishiftCluster : Registers domains: c001, c002, c003, each tied to a corresponding IP and c000 tied to
+	// all of the IPs for the cluster. Also registers 1 cname, ce00, for the specified multishift cluster
+	RegisterMultishiftCluster(registerMultishiftClusterOptions *RegisterMultishiftClusterOptions) (*MSCRegisterResp, *core.DetailedResponse, error)
+
+	// RegisterMultishiftClusterWithContext is an alternate form of the RegisterMultishiftCluster method which supports a
+	// Context parameter
+	RegisterMultishiftClusterWithContext(ctx context.Context, registerMultishiftClusterOptions *RegisterMultishiftClusterOptions) (*MSCRegisterResp, *core.DetailedResponse, error)
+
+	// ReplaceLBHostname : Update the DNS record for an NLB subdomain by replacing the load balancer hostname
+	ReplaceLBHostname(replaceLBHostnameOptions *ReplaceLBHostnameOptions) (*NlbVPCConfig, *core.DetailedResponse, error)
+
+	// ReplaceLBHostnameWithContext is an alternate form of the ReplaceLBHostname method which supports a Context parameter
+	ReplaceLBHostnameWithContext(ctx context.Context, replaceLBHostnameOptions *ReplaceLBHostnameOptions) (*NlbVPCConfig, *core.DetailedResponse, error)
+
+	// CreateNlbDNS : Create a new NLB subdomain in a VPC cluster and associate a load balancer hostname with it
+	CreateNlbDNS(createNlbDNSOptions *CreateNlbDNSOptions) (*NlbVPCConfig, *core.DetailedResponse, error)
+
+	// CreateNlbDNSWithContext is an alternate form of the CreateNlbDNS method which supports a Context parameter
+	CreateNlbDNSWithContext(ctx context.Context, createNlbDNSOptions *CreateNlbDNSOptions) (*NlbVPCConfig, *core.DetailedResponse, error)
+
+	// RemoveLBHostname : Remove the load balancer hostname from the DNS record for an existing NLB subdomain
+	RemoveLBHostname(removeLBHostnameOptions *RemoveLBHostnameOptions) (*core.DetailedResponse, error)
+
+	// RemoveLBHostnameWithContext is an alternate form of the RemoveLBHostname method which supports a Context parameter
+	RemoveLBHostnameWithContext(ctx context.Context, removeLBHostnameOptions *RemoveLBHostnameOptions) (*core.DetailedResponse, error)
+
+	// ReplaceWorker : Replace a worker node with a new worker node
+	ReplaceWorker(replaceWorkerOptions *ReplaceWorkerOptions) (*core.DetailedResponse, error)
+
+	// ReplaceWorkerWithContext is an alternate form of the ReplaceWorker method which supports a Context parameter
+	ReplaceWorkerWithContext(ctx context.Context, replaceWorkerOptions *ReplaceWorkerOptions) (*core.DetailedResponse, error)
+
+	// V2UpdateMaster : Update the version of the Kubernetes cluster master node
+	V2UpdateMaster(v2UpdateMasterOptions *V2UpdateMasterOptions) (*core.DetailedResponse, error)
+
+	// V2UpdateMasterWithContext is an alternate form of the V2UpdateMaster method which supports a Context parameter
+	V2UpdateMasterWithContext(ctx context.Context, v2UpdateMasterOptions *V2UpdateMasterOptions) (*core.DetailedResponse, error)
+
+	// GetClusters : List the clusters that you have access to
+	GetClusters(getClustersOptions *GetClustersOptions) ([]Cluster, *core.DetailedResponse, error)
+
+	// GetClustersWithContext is an alternate form of the GetClusters method which supports a Context parameter
+	GetClustersWithContext(ctx context.Context, getClustersOptions *GetClustersOptions) ([]Cluster, *core.DetailedResponse, error)
+
+	// CreateCluster : Create a cluster
+	CreateCluster(createClusterOptions *CreateClusterOptions) (*ClusterCreateResponse, *core.DetailedResponse, error)
+
+	// CreateClusterWithContext is an alternate form of the CreateCluster method which supports a Context parameter
+	CreateClusterWithContext(ctx context.Context, createClusterOptions *CreateClusterOptions) (*ClusterCreateResponse, *core.DetailedResponse, error)
+
+	// GetCluster1 : View details for a cluster
+	GetCluster1(getCluster1Options *GetCluster1Options) (*Cluster, *core.DetailedResponse, error)
+
+	// GetCluster1WithContext is an alternate form of the GetCluster1 method which supports a Context parameter
+	GetCluster1WithContext(ctx context.Context, getCluster1Options *GetCluster1Options) (*Cluster, *core.DetailedResponse, error)
+
+	// UpdateCluster : Update the version of the Kubernetes cluster master node
+	UpdateCluster(updateClusterOptions *UpdateClusterOptions) (*core.DetailedResponse, error)
+
+	// UpdateClusterWithContext is an alternate form of the UpdateCluster method which supports a Context parameter
+	UpdateClusterWithContext(ctx context.Context, updateClusterOptions *UpdateClusterOptions) (*core.DetailedResponse, error)
+
+	// RemoveCluster : Delete a cluster
+	RemoveCluster(removeClusterOptions *RemoveClusterOptions) (*core.DetailedResponse, error)
+
+	// RemoveClusterWithContext is an alternate form of the RemoveCluster method which supports a Context parameter
+	RemoveClusterWithContext(ctx context.Context, removeClusterOptions *RemoveClusterOptions) (*core.DetailedResponse, error)
+
+	// GetClusterAddons : View details of the add-ons that are enabled in a cluster
+	GetClusterAddons(getClusterAddonsOptions *GetClusterAddonsOptions) ([]ClusterAddon, *core.DetailedResponse, error)
+
+	// GetClusterAddonsWithContext is an alternate form of the GetClusterAddons method which supports a Context parameter
+	GetClusterAddonsWithContext(ctx context.Context, getClusterAddonsOptions *GetClusterAddonsOptions) ([]ClusterAddon, *core.DetailedResponse, error)
+
+	// ManageClusterAddons : Enable, disable, or update add-ons for a cluster
+	ManageClusterAddons(manageClusterAddonsOptions *ManageClusterAddonsOptions) (*AddonResponse, *core.DetailedResponse, error)
+
+	// ManageClusterAddonsWithContext is an alternate form of the ManageClusterAddons method which supports a Context
+	// parameter
+	ManageClusterAddonsWithContext(ctx context.Context, manageClusterAddonsOptions *ManageClusterAddonsOptions) (*AddonResponse, *core.DetailedResponse, error)
+
+	// GetClusterConfig : Get the cluster-specific configuration and certificates
+	GetClusterConfig(getClusterConfigOptions *GetClusterConfigOptions) (*core.DetailedResponse, error)
+
+	// GetClusterConfigWithContext is an alternate form of the GetClusterConfig method which supports a Context parameter
+	GetClusterConfigWithContext(ctx context.Context, getClusterConfigOptions *GetClusterConfigOptions) (*core.DetailedResponse, error)
+
+	// CreateKMSConfig : Create a key management service (KMS) provider configuration for a cluster
+	CreateKMSConfig(createKMSConfigOptions *CreateKMSConfigOptions) (*core.DetailedResponse, error)
+
+	// CreateKMSConfigWithContext is an alternate form of the CreateKMSConfig method which supports a Context parameter
+	CreateKMSConfigWithContext(ctx context.Context, createKMSConfigOptions *CreateKMSConfigOptions) (*core.DetailedResponse, error)
+
+	// HandleMasterAPIServer : Refresh the Kubernetes master
+	HandleMasterAPIServer(handleMasterAPIServerOptions *HandleMasterAPIServerOptions) (*core.DetailedResponse, error)
+
+	// HandleMasterAPIServerWithContext is an alternate form of the HandleMasterAPIServer method which supports a Context
+	// parameter
+	HandleMasterAPIServerWithContext(ctx context.Context, handleMasterAPIServerOptions *HandleMasterAPIServerOptions) (*core.DetailedResponse, error)
+
+	// ListServicesForAllNamespaces : List the IBM Cloud services bound to a cluster across all namespaces
+	ListServicesForAllNamespaces(listServicesForAllNamespacesOptions *ListServicesForAllNamespacesOptions) ([]BoundService, *core.DetailedResponse, error)
+
+	// ListServicesForAllNamespacesWithContext is an alternate form of the ListServicesForAllNamespaces method which
+	// supports a Context parameter
+	ListServicesForAllNamespacesWithContext(ctx context.Context, listServicesForAllNamespacesOptions *ListServicesForAllNamespacesOptions) ([]BoundService, *core.DetailedResponse, error)
+
+	// BindServiceToNamespace : Bind an IBM Cloud service to a cluster
+	BindServiceToNamespace(bindServiceToNamespaceOptions *BindServiceToNamespaceOptions) (*BoundService, *core.DetailedResponse, error)
+
+	// BindServiceToNamespaceWithContext is an alternate form of the BindServiceToNamespace method which supports a Context
+	// parameter
+	BindServiceToNamespaceWithContext(ctx context.Context, bindServiceToNamespaceOptions *BindServiceToNamespaceOptions) (*BoundService, *core.DetailedResponse, error)
+
+	// ListServicesInNamespace : List the IBM Cloud services bound to a specific namespace in a cluster
+	ListServicesInNamespace(listServicesInNamespaceOptions *ListServicesInNamespaceOptions) ([]BoundService, *core.DetailedResponse, error)
+
+	// ListServicesInNamespaceWithContext is an alternate form of the ListServicesInNamespace method which supports a
+	// Context parameter
+	ListServicesInNamespaceWithContext(ctx context.Context, listServicesInNamespaceOptions *ListServicesInNamespaceOptions) ([]BoundService, *core.DetailedResponse, error)
+
+	// UnbindServiceFromNamespace : Unbind an IBM Cloud service from a cluster
+	UnbindServiceFromNamespace(unbindServiceFromNamespaceOptions *UnbindServiceFromNamespaceOptions) (*core.DetailedResponse, error)
+
+	// UnbindServiceFromNamespaceWithContext is an alternate form of the UnbindServiceFromNamespace method which supports a
+	// Context parameter
+	UnbindServiceFromNamespaceWithContext(ctx context.Context, unbindServiceFromNamespaceOptions *UnbindServiceFromNamespaceOptions) (*core.DetailedResponse, error)
+
+	// GetClusterSubnets : List classic subnets that are bound to a cluster
+	GetClusterSubnets(getClusterSubnetsOptions *GetClusterSubnetsOptions) ([]VlanConfigField, *core.DetailedResponse, error)
+
+	// GetClusterSubnetsWithContext is an alternate form of the GetClusterSubnets method which supports a Context parameter
+	GetClusterSubnetsWithContext(ctx context.Context, getClusterSubnetsOptions *GetClusterSubnetsOptions) ([]VlanConfigField, *core.DetailedResponse, error)
+
+	// AddClusterSubnet : Add an IBM Cloud classic infrastructure subnet to a cluster
+	AddClusterSubnet(addClusterSubnetOptions *AddClusterSubnetOptions) (*core.DetailedResponse, error)
+
+	// AddClusterSubnetWithContext is an alternate form of the AddClusterSubnet method which supports a Context parameter
+	AddClusterSubnetWithContext(ctx context.Context, addClusterSubnetOptions *AddClusterSubnetOptions) (*core.DetailedResponse, error)
+
+	// DetachClusterSubnet : Detach a public or private portable subnet from a cluster
+	DetachClusterSubnet(detachClusterSubnetOptions *DetachClusterSubnetOptions) (*core.DetailedResponse, error)
+
+	// DetachClusterSubnetWithContext is an alternate form of the DetachClusterSubnet method which supports a Context
+	// parameter
+	DetachClusterSubnetWithContext(ctx context.Context, detachClusterSubnetOptions *DetachClusterSubnetOptions) (*core.DetailedResponse, error)
+
+	// GetClusterUserSubnet : List user-managed subnets that are bound to a cluster
+	GetClusterUserSubnet(getClusterUserSubnetOptions *GetClusterUserSubnetOptions) ([]VlanConfigField, *core.DetailedResponse, error)
+
+	// GetClusterUserSubnetWithContext is an alternate form of the GetClusterUserSubnet method which supports a Context
+	// parameter
+	GetClusterUserSubnetWithContext(ctx context.Context, getClusterUserSubnetOptions *GetClusterUserSubnetOptions) ([]VlanConfigField, *core.DetailedResponse, error)
+
+	// AddClusterUserSubnet : Add an existing user-managed subnet to a cluster
+	AddClusterUserSubnet(addClusterUserSubnetOptions *AddClusterUserSubnetOptions) (*core.DetailedResponse, error)
+
+	// AddClusterUserSubnetWithContext is an alternate form of the AddClusterUserSubnet method which supports a Context
+	// parameter
+	AddClusterUserSubnetWithContext(ctx context.Context, addClusterUserSubnetOptions *AddClusterUserSubnetOptions) (*core.DetailedResponse, error)
+
+	// RemoveClusterUserSubnet : Remove a user-managed subnet from a cluster
+	RemoveClusterUserSubnet(removeClusterUserSubnetOptions *RemoveClusterUserSubnetOptions) (*core.DetailedResponse, error)
+
+	// RemoveClusterUserSubnetWithContext is an alternate form of the RemoveClusterUserSubnet method which supports a
+	// Context parameter
+	RemoveClusterUserSubnetWithContext(ctx context.Context, removeClusterUserSubnetOptions *RemoveClusterUserSubnetOptions) (*core.DetailedResponse, error)
+
+	// CreateClusterSubnet : Create an IBM Cloud classic infrastructure subnet and add it to an existing cluster
+	CreateClusterSubnet(createClusterSubnetOptions *CreateClusterSubnetOptions) (*core.DetailedResponse, error)
+
+	// CreateClusterSubnetWithContext is an alternate form of the CreateClusterSubnet method which supports a Context
+	// parameter
+	CreateClusterSubnetWithContext(ctx context.Context, createClusterSubnetOptions *CreateClusterSubnetOptions) (*core.DetailedResponse, error)
+
+	// GetClusterWebhooks : List all webhooks for a cluster
+	GetClusterWebhooks(getClusterWebhooksOptions *GetClusterWebhooksOptions) ([]ClusterWebhook, *core.DetailedResponse, error)
+
+	// GetClusterWebhooksWithContext is an alternate form of the GetClusterWebhooks method which supports a Context
+	// parameter
+	GetClusterWebhooksWithContext(ctx context.Context, getClusterWebhooksOptions *GetClusterWebhooksOptions) ([]ClusterWebhook, *core.DetailedResponse, error)
+
+	// AddClusterWebhooks : Add a webhook to a cluster
+	AddClusterWebhooks(addClusterWebhooksOptions *AddClusterWebhooksOptions) (*core.DetailedResponse, error)
+
+	// AddClusterWebhooksWithContext is an alternate form of the AddClusterWebhooks method which supports a Context
+	// parameter
+	AddClusterWebhooksWithContext(ctx context.Context, addClusterWebhooksOptions *AddClusterWebhooksOptions) (*core.DetailedResponse, error)
+
+	// GetWorkerPools : List the worker pools in a cluster
+	GetWorkerPools(getWorkerPoolsOptions *GetWorkerPoolsOptions) ([]WorkerPoolResponse, *core.DetailedResponse, error)
+
+	// GetWorkerPoolsWithContext is an alternate form of the GetWorkerPools method which supports a Context parameter
+	GetWorkerPoolsWithContext(ctx context.Context, getWorkerPoolsOptions *GetWorkerPoolsOptions) ([]WorkerPoolResponse, *core.DetailedResponse, error)
+
+	// CreateWorkerPool : Create a worker pool for a cluster
+	CreateWorkerPool(createWorkerPoolOptions *CreateWorkerPoolOptions) (*WorkerPoolResponse, *core.DetailedResponse, error)
+
+	// CreateWorkerPoolWithContext is an alternate form of the CreateWorkerPool method which supports a Context parameter
+	CreateWorkerPoolWithContext(ctx context.Context, createWorkerPoolOptions *CreateWorkerPoolOptions) (*WorkerPoolResponse, *core.DetailedResponse, error)
+
+	// GetWorkerPool1 : View details for a worker pool
+	GetWorkerPool1(getWorkerPool1Options *GetWorkerPool1Options) (*WorkerPoolResponse, *core.DetailedResponse, error)
+
+	// GetWorkerPool1WithContext is an alternate form of the GetWorkerPool1 method which supports a Context parameter
+	GetWorkerPool1WithContext(ctx context.Context, getWorkerPool1Options *GetWorkerPool1Options) (*WorkerPoolResponse, *core.DetailedResponse, error)
+
+	// RemoveWorkerPool : Remove a worker pool from a cluster
+	RemoveWorkerPool(removeWorkerPoolOptions *RemoveWorkerPoolOptions) (*core.DetailedResponse, error)
+
+	// RemoveWorkerPoolWithContext is an alternate form of the RemoveWorkerPool method which supports a Context parameter
+	RemoveWorkerPoolWithContext(ctx context.Context, removeWorkerPoolOptions *RemoveWorkerPoolOptions) (*core.DetailedResponse, error)
+
+	// PatchWorkerPool : Resize or rebalance a worker pool
+	PatchWorkerPool(patchWorkerPoolOptions *PatchWorkerPoolOptions) (*core.DetailedResponse, error)
+
+	// PatchWorkerPoolWithContext is an alternate form of the PatchWorkerPool method which supports a Context parameter
+	PatchWorkerPoolWithContext(ctx context.Context, patchWorkerPoolOptions *PatchWorkerPoolOptions) (*core.DetailedResponse, error)
+
+	// AddWorkerPoolZone : Add a zone to the specified worker pool for a cluster
+	AddWorkerPoolZone(addWorkerPoolZoneOptions *AddWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+
+	// AddWorkerPoolZoneWithContext is an alternate form of the AddWorkerPoolZone method which supports a Context parameter
+	AddWorkerPoolZoneWithContext(ctx context.Context, addWorkerPoolZoneOptions *AddWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+
+	// RemoveWorkerPoolZone : Remove a zone from a worker pool
+	RemoveWorkerPoolZone(removeWorkerPoolZoneOptions *RemoveWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+
+	// RemoveWorkerPoolZoneWithContext is an alternate form of the RemoveWorkerPoolZone method which supports a Context
+	// parameter
+	RemoveWorkerPoolZoneWithContext(ctx context.Context, removeWorkerPoolZoneOptions *RemoveWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+
+	// AddWorkerPoolZoneNetwork : Update the network configuration details, such as VLANs, for a zone in the worker pool
+	AddWorkerPoolZoneNetwork(addWorkerPoolZoneNetworkOptions *AddWorkerPoolZoneNetworkOptions) (*core.DetailedResponse, error)
+
+	// AddWorkerPoolZoneNetworkWithContext is an alternate form of the AddWorkerPoolZoneNetwork method which supports a
+	// Context parameter
+	AddWorkerPoolZoneNetworkWithContext(ctx context.Context, addWorkerPoolZoneNetworkOptions *AddWorkerPoolZoneNetworkOptions) (*core.DetailedResponse, error)
+
+	// GetClusterWorkers : List all worker nodes in a cluster
+	GetClusterWorkers(getClusterWorkersOptions *GetClusterWorkersOptions) ([]Worker, *core.DetailedResponse, error)
+
+	// GetClusterWorkersWithContext is an alternate form of the GetClusterWorkers method which supports a Context parameter
+	GetClusterWorkersWithContext(ctx context.Context, getClusterWorkersOptions *GetClusterWorkersOptions) ([]Worker, *core.DetailedResponse, error)
+
+	// AddClusterWorkers : Add worker nodes to a cluster
+	AddClusterWorkers(addClusterWorkersOptions *AddClusterWorkersOptions) (*core.DetailedResponse, error)
+
+	// AddClusterWorkersWithContext is an alternate form of the AddClusterWorkers method which supports a Context parameter
+	AddClusterWorkersWithContext(ctx context.Context, addClusterWorkersOptions *AddClusterWorkersOptions) (*core.DetailedResponse, error)
+
+	// GetWorkers : View details of a worker node
+	GetWorkers(getWorkersOptions *GetWorkersOptions) (*Worker, *core.DetailedResponse, error)
+
+	// GetWorkersWithContext is an alternate form of the GetWorkers method which supports a Context parameter
+	GetWorkersWithContext(ctx context.Context, getWorkersOptions *GetWorkersOptions) (*Worker, *core.DetailedResponse, error)
+
+	// UpdateClusterWorker : Reboot, reload, or update a worker node for a cluster
+	UpdateClusterWorker(updateClusterWorkerOptions *UpdateClusterWorkerOptions) (*core.DetailedResponse, error)
+
+	// UpdateClusterWorkerWithContext is an alternate form of the UpdateClusterWorker method which supports a Context
+	// parameter
+	UpdateClusterWorkerWithContext(ctx context.Context, updateClusterWorkerOptions *UpdateClusterWorkerOptions) (*core.DetailedResponse, error)
+
+	// RemoveClusterWorker : Delete a worker node from a cluster
+	RemoveClusterWorker(removeClusterWorkerOptions *RemoveClusterWorkerOptions) (*core.DetailedResponse, error)
+
+	// RemoveClusterWorkerWithContext is an alternate form of the RemoveClusterWorker method which supports a Context
+	// parameter
+	RemoveClusterWorkerWithContext(ctx context.Context, removeClusterWorkerOptions *RemoveClusterWorkerOptions) (*core.DetailedResponse, error)
+
+	// GetUserConfig : View a user's ability to create free and standard clusters in a region and resource group
+	GetUserConfig(getUserConfigOptions *GetUserConfigOptions) (*UserConfig, *core.DetailedResponse, error)
+
+	// GetUserConfigWithContext is an alternate form of the GetUserConfig method which supports a Context parameter
+	GetUserConfigWithContext(ctx context.Context, getUserConfigOptions *GetUserConfigOptions) (*UserConfig, *core.DetailedResponse, error)
+
+	// V2DisableImageSecurity : The V2DisableImageSecurity operation.
+	V2DisableImageSecurity(v2DisableImageSecurityOptions *V2DisableImageSecurityOptions) (*core.DetailedResponse, error)
+
+	// V2DisableImageSecurityWithContext is an alternate form of the V2DisableImageSecurity method which supports a Context
+	// parameter
+	V2DisableImageSecurityWithContext(ctx context.Context, v2DisableImageSecurityOptions *V2DisableImageSecurityOptions) (*core.DetailedResponse, error)
+
+	// V2EnableImageSecurity : The V2EnableImageSecurity operation.
+	V2EnableImageSecurity(v2EnableImageSecurityOptions *V2EnableImageSecurityOptions) (*core.DetailedResponse, error)
+
+	// V2EnableImageSecurityWithContext is an alternate form of the V2EnableImageSecurity method which supports a Context
+	// parameter
+	V2EnableImageSecurityWithContext(ctx context.Context, v2EnableImageSecurityOptions *V2EnableImageSecurityOptions) (*core.DetailedResponse, error)
+
+	// FetchFilterConfigs : List all logging filter configurations in the cluster
+	FetchFilterConfigs(fetchFilterConfigsOptions *FetchFilterConfigsOptions) ([]FilterConfigResponse, *core.DetailedResponse, error)
+
+	// FetchFilterConfigsWithContext is an alternate form of the FetchFilterConfigs method which supports a Context
+	// parameter
+	FetchFilterConfigsWithContext(ctx context.Context, fetchFilterConfigsOptions *FetchFilterConfigsOptions) ([]FilterConfigResponse, *core.DetailedResponse, error)
+
+	// CreateFilterConfig : Create a logging filter configuration
+	CreateFilterConfig(createFilterConfigOptions *CreateFilterConfigOptions) (*FilterConfigResponse, *core.DetailedResponse, error)
+
+	// CreateFilterConfigWithContext is an alternate form of the CreateFilterConfig method which supports a Context
+	// parameter
+	CreateFilterConfigWithContext(ctx context.Context, createFilterConfigOptions *CreateFilterConfigOptions) (*FilterConfigResponse, *core.DetailedResponse, error)
+
+	// DeleteFilterConfigs : Deletes all logging filter configurations for the cluster
+	DeleteFilterConfigs(deleteFilterConfigsOptions *DeleteFilterConfigsOptions) (*core.DetailedResponse, error)
+
+	// DeleteFilterConfigsWithContext is an alternate form of the DeleteFilterConfigs method which supports a Context
+	// parameter
+	DeleteFilterConfigsWithContext(ctx context.Context, deleteFilterConfigsOptions *DeleteFilterConfigsOptions) (*core.DetailedResponse, error)
+
+	// FetchFilterConfig : View a logging filter configuration
+	FetchFilterConfig(fetchFilterConfigOptions *FetchFilterConfigOptions) (*FilterConfigResponse, *core.DetailedResponse, error)
+
+	// FetchFilterConfigWithContext is an alternate form of the FetchFilterConfig method which supports a Context parameter
+	FetchFilterConfigWithContext(ctx context.Context, fetchFilterConfigOptions *FetchFilterConfigOptions) (*FilterConfigResponse, *core.DetailedResponse, error)
+
+	// UpdateFilterConfig : Update a logging filter configuration
+	UpdateFilterConfig(updateFilterConfigOptions *UpdateFilterConfigOptions) (*FilterConfigResponse, *core.DetailedResponse, error)
+
+	// UpdateFilterConfigWithContext is an alternate form of the UpdateFilterConfig method which supports a Context
+	// parameter
+	UpdateFilterConfigWithContext(ctx context.Context, updateFilterConfigOptions *UpdateFilterConfigOptions) (*FilterConfigResponse, *core.DetailedResponse, error)
+
+	// DeleteFilterConfig : Delete a logging filter configuration
+	DeleteFilterConfig(deleteFilterConfigOptions *DeleteFilterConfigOptions) (*core.DetailedResponse, error)
+
+	// DeleteFilterConfigWithContext is an alternate form of the DeleteFilterConfig method which supports a Context
+	// parameter
+	DeleteFilterConfigWithContext(ctx context.Context, deleteFilterConfigOptions *DeleteFilterConfigOptions) (*core.DetailedResponse, error)
+
+	// GetMasterLogCollectionStatus : Show the status for the most recent master log collection request
+	GetMasterLogCollectionStatus(getMasterLogCollectionStatusOptions *GetMasterLogCollectionStatusOptions) ([]MasterLogCollectionStatusResponseBody, *core.DetailedResponse, error)
+
+	// GetMasterLogCollectionStatusWithContext is an alternate form of the GetMasterLogCollectionStatus method which
+	// supports a Context parameter
+	GetMasterLogCollectionStatusWithContext(ctx context.Context, getMasterLogCollectionStatusOptions *GetMasterLogCollectionStatusOptions) ([]MasterLogCollectionStatusResponseBody, *core.DetailedResponse, error)
+
+	// CreateMasterLogCollection : Create a new master log collection request
+	CreateMasterLogCollection(createMasterLogCollectionOptions *CreateMasterLogCollectionOptions) (*core.DetailedResponse, error)
+
+	// CreateMasterLogCollectionWithContext is an alternate form of the CreateMasterLogCollection method which supports a
+	// Context parameter
+	CreateMasterLogCollectionWithContext(ctx context.Context, createMasterLogCollectionOptions *CreateMasterLogCollectionOptions) (*core.DetailedResponse, error)
+
+	// GetClusterKeyOwner : View information about the containers-kubernetes-key API key owner
+	GetClusterKeyOwner(getClusterKeyOwnerOptions *GetClusterKeyOwnerOptions) (*ClusterKeyOwnerInfo, *core.DetailedResponse, error)
+
+	// GetClusterKeyOwnerWithContext is an alternate form of the GetClusterKeyOwner method which supports a Context
+	// parameter
+	GetClusterKeyOwnerWithContext(ctx context.Context, getClusterKeyOwnerOptions *GetClusterKeyOwnerOptions) (*ClusterKeyOwnerInfo, *core.DetailedResponse, error)
+
+	// GetDefaultLoggingEndpoint : View the default logging endpoint for the target region
+	GetDefaultLoggingEndpoint(getDefaultLoggingEndpointOptions *GetDefaultLoggingEndpointOptions) (*DefaultLoggingEndpointResponse, *core.DetailedResponse, error)
+
+	// GetDefaultLoggingEndpointWithContext is an alternate form of the GetDefaultLoggingEndpoint method which supports a
+	// Context parameter
+	GetDefaultLoggingEndpointWithContext(ctx context.Context, getDefaultLoggingEndpointOptions *GetDefaultLoggingEndpointOptions) (*DefaultLoggingEndpointResponse, *core.DetailedResponse, error)
+
+	// FetchLoggingConfigs : List all log forwarding configurations in the cluster
+	FetchLoggingConfigs(fetchLoggingConfigsOptions *FetchLoggingConfigsOptions) ([]LogConfigResponse, *core.DetailedResponse, error)
+
+	// FetchLoggingConfigsWithContext is an alternate form of the FetchLoggingConfigs method which supports a Context
+	// parameter
+	FetchLoggingConfigsWithContext(ctx context.Context, fetchLoggingConfigsOptions *FetchLoggingConfigsOptions) ([]LogConfigResponse, *core.DetailedResponse, error)
+
+	// DeleteLoggingConfigs : Delete all log forwarding configurations for a cluster
+	DeleteLoggingConfigs(deleteLoggingConfigsOptions *DeleteLoggingConfigsOptions) (*core.DetailedResponse, error)
+
+	// DeleteLoggingConfigsWithContext is an alternate form of the DeleteLoggingConfigs method which supports a Context
+	// parameter
+	DeleteLoggingConfigsWithContext(ctx context.Context, deleteLoggingConfigsOptions *DeleteLoggingConfigsOptions) (*core.DetailedResponse, error)
+
+	// FetchLoggingConfigsForSource : List all log forwarding configurations for a log source in the cluster
+	FetchLoggingConfigsForSource(fetchLoggingConfigsForSourceOptions *FetchLoggingConfigsForSourceOptions) ([]LogConfigResponse, *core.DetailedResponse, error)
+
+	// FetchLoggingConfigsForSourceWithContext is an alternate form of the FetchLoggingConfigsForSource method which
+	// supports a Context parameter
+	FetchLoggingConfigsForSourceWithContext(ctx context.Context, fetchLoggingConfigsForSourceOptions *FetchLoggingConfigsForSourceOptions) ([]LogConfigResponse, *core.DetailedResponse, error)
+
+	// CreateLoggingConfig : Create a log forwarding configuration
+	CreateLoggingConfig(createLoggingConfigOptions *CreateLoggingConfigOptions) (*LogConfigResponse, *core.DetailedResponse, error)
+
+	// CreateLoggingConfigWithContext is an alternate form of the CreateLoggingConfig method which supports a Context
+	// parameter
+	CreateLoggingConfigWithContext(ctx context.Context, createLoggingConfigOptions *CreateLoggingConfigOptions) (*LogConfigResponse, *core.DetailedResponse, error)
+
+	// UpdateLoggingConfig : Update a log forwarding configuration
+	UpdateLoggingConfig(updateLoggingConfigOptions *UpdateLoggingConfigOptions) (*LogConfigResponse, *core.DetailedResponse, error)
+
+	// UpdateLoggingConfigWithContext is an alternate form of the UpdateLoggingConfig method which supports a Context
+	// parameter
+	UpdateLoggingConfigWithContext(ctx context.Context, updateLoggingConfigOptions *UpdateLoggingConfigOptions) (*LogConfigResponse, *core.DetailedResponse, error)
+
+	// DeleteLoggingConfig : Delete a log forwarding configuration
+	DeleteLoggingConfig(deleteLoggingConfigOptions *DeleteLoggingConfigOptions) (*core.DetailedResponse, error)
+
+	// DeleteLoggingConfigWithContext is an alternate form of the DeleteLoggingConfig method which supports a Context
+	// parameter
+	DeleteLoggingConfigWithContext(ctx context.Context, deleteLoggingConfigOptions *DeleteLoggingConfigOptions) (*core.DetailedResponse, error)
+
+	// RefreshLoggingConfig : Refresh the cluster's logging configuration
+	RefreshLoggingConfig(refreshLoggingConfigOptions *RefreshLoggingConfigOptions) (*core.DetailedResponse, error)
+
+	// RefreshLoggingConfigWithContext is an alternate form of the RefreshLoggingConfig method which supports a Context
+	// parameter
+	RefreshLoggingConfigWithContext(ctx context.Context, refreshLoggingConfigOptions *RefreshLoggingConfigOptions) (*core.DetailedResponse, error)
+
+	// GetFluentdUpdatePolicy : Check if automatic updates for the Fluentd logging add-on are enabled in the cluster
+	GetFluentdUpdatePolicy(getFluentdUpdatePolicyOptions *GetFluentdUpdatePolicyOptions) (*UpdatePolicy, *core.DetailedResponse, error)
+
+	// GetFluentdUpdatePolicyWithContext is an alternate form of the GetFluentdUpdatePolicy method which supports a Context
+	// parameter
+	GetFluentdUpdatePolicyWithContext(ctx context.Context, getFluentdUpdatePolicyOptions *GetFluentdUpdatePolicyOptions) (*UpdatePolicy, *core.DetailedResponse, error)
+
+	// ChangeFluentdUpdatePolicy : Enable or diable automatic updates for the Fluentd logging add-on in the cluster
+	ChangeFluentdUpdatePolicy(changeFluentdUpdatePolicyOptions *ChangeFluentdUpdatePolicyOptions) (*core.DetailedResponse, error)
+
+	// ChangeFluentdUpdatePolicyWithContext is an alternate form of the ChangeFluentdUpdatePolicy method which supports a
+	// Context parameter
+	ChangeFluentdUpdatePolicyWithContext(ctx context.Context, changeFluentdUpdatePolicyOptions *ChangeFluentdUpdatePolicyOptions) (*core.DetailedResponse, error)
+
+	// CreateLoggingInstance : Create a LogDNA logging configuration for a cluster
+	CreateLoggingInstance(createLoggingInstanceOptions *CreateLoggingInstanceOptions) (*ConfigResponse, *core.DetailedResponse, error)
+
+	// CreateLoggingInstanceWithContext is an alternate form of the CreateLoggingInstance method which supports a Context
+	// parameter
+	CreateLoggingInstanceWithContext(ctx context.Context, createLoggingInstanceOptions *CreateLoggingInstanceOptions) (*ConfigResponse, *core.DetailedResponse, error)
+
+	// DiscoverLoggingInstance : Discover a LogDNA agent previously deployed in the cluster
+	DiscoverLoggingInstance(discoverLoggingInstanceOptions *DiscoverLoggingInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// DiscoverLoggingInstanceWithContext is an alternate form of the DiscoverLoggingInstance method which supports a
+	// Context parameter
+	DiscoverLoggingInstanceWithContext(ctx context.Context, discoverLoggingInstanceOptions *DiscoverLoggingInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// GetLoggingInstance : Show the details of an existing LogDNA logging configuration
+	GetLoggingInstance(getLoggingInstanceOptions *GetLoggingInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// GetLoggingInstanceWithContext is an alternate form of the GetLoggingInstance method which supports a Context
+	// parameter
+	GetLoggingInstanceWithContext(ctx context.Context, getLoggingInstanceOptions *GetLoggingInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// GetLoggingInstances : List all LogDNA logging configurations for a cluster
+	GetLoggingInstances(getLoggingInstancesOptions *GetLoggingInstancesOptions) ([]ObsConfig, *core.DetailedResponse, error)
+
+	// GetLoggingInstancesWithContext is an alternate form of the GetLoggingInstances method which supports a Context
+	// parameter
+	GetLoggingInstancesWithContext(ctx context.Context, getLoggingInstancesOptions *GetLoggingInstancesOptions) ([]ObsConfig, *core.DetailedResponse, error)
+
+	// ModifyLoggingInstance : Update a LogDNA logging configuration in the cluster
+	ModifyLoggingInstance(modifyLoggingInstanceOptions *ModifyLoggingInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// ModifyLoggingInstanceWithContext is an alternate form of the ModifyLoggingInstance method which supports a Context
+	// parameter
+	ModifyLoggingInstanceWithContext(ctx context.Context, modifyLoggingInstanceOptions *ModifyLoggingInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// RemoveLoggingInstance : Remove a LogDNA logging configuration from a cluster
+	RemoveLoggingInstance(removeLoggingInstanceOptions *RemoveLoggingInstanceOptions) (*core.DetailedResponse, error)
+
+	// RemoveLoggingInstanceWithContext is an alternate form of the RemoveLoggingInstance method which supports a Context
+	// parameter
+	RemoveLoggingInstanceWithContext(ctx context.Context, removeLoggingInstanceOptions *RemoveLoggingInstanceOptions) (*core.DetailedResponse, error)
+
+	// CreateMonitoringInstance : Create a Sysdig monitoring configuration for a cluster
+	CreateMonitoringInstance(createMonitoringInstanceOptions *CreateMonitoringInstanceOptions) (*ConfigResponse, *core.DetailedResponse, error)
+
+	// CreateMonitoringInstanceWithContext is an alternate form of the CreateMonitoringInstance method which supports a
+	// Context parameter
+	CreateMonitoringInstanceWithContext(ctx context.Context, createMonitoringInstanceOptions *CreateMonitoringInstanceOptions) (*ConfigResponse, *core.DetailedResponse, error)
+
+	// DiscoverMonitoringInstance : Discover a Sysdig agent previously deployed in the cluster
+	DiscoverMonitoringInstance(discoverMonitoringInstanceOptions *DiscoverMonitoringInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// DiscoverMonitoringInstanceWithContext is an alternate form of the DiscoverMonitoringInstance method which supports a
+	// Context parameter
+	DiscoverMonitoringInstanceWithContext(ctx context.Context, discoverMonitoringInstanceOptions *DiscoverMonitoringInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// GetMonitoringInstance : Show the details of an existing Sysdig monitoring configuration
+	GetMonitoringInstance(getMonitoringInstanceOptions *GetMonitoringInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// GetMonitoringInstanceWithContext is an alternate form of the GetMonitoringInstance method which supports a Context
+	// parameter
+	GetMonitoringInstanceWithContext(ctx context.Context, getMonitoringInstanceOptions *GetMonitoringInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// GetMonitoringInstances : List all Sysdig monitoring configurations for a cluster
+	GetMonitoringInstances(getMonitoringInstancesOptions *GetMonitoringInstancesOptions) ([]ObsConfig, *core.DetailedResponse, error)
+
+	// GetMonitoringInstancesWithContext is an alternate form of the GetMonitoringInstances method which supports a Context
+	// parameter
+	GetMonitoringInstancesWithContext(ctx context.Context, getMonitoringInstancesOptions *GetMonitoringInstancesOptions) ([]ObsConfig, *core.DetailedResponse, error)
+
+	// ModifyMonitoringInstance : Update a Sysdig monitoring configuration in the cluster
+	ModifyMonitoringInstance(modifyMonitoringInstanceOptions *ModifyMonitoringInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// ModifyMonitoringInstanceWithContext is an alternate form of the ModifyMonitoringInstance method which supports a
+	// Context parameter
+	ModifyMonitoringInstanceWithContext(ctx context.Context, modifyMonitoringInstanceOptions *ModifyMonitoringInstanceOptions) (*ObsConfig, *core.DetailedResponse, error)
+
+	// RemoveMonitoringInstance : Remove a Sysdig monitoring configuration from a cluster
+	RemoveMonitoringInstance(removeMonitoringInstanceOptions *RemoveMonitoringInstanceOptions) (*core.DetailedResponse, error)
+
+	// RemoveMonitoringInstanceWithContext is an alternate form of the RemoveMonitoringInstance method which supports a
+	// Context parameter
+	RemoveMonitoringInstanceWithContext(ctx context.Context, removeMonitoringInstanceOptions *RemoveMonitoringInstanceOptions) (*core.DetailedResponse, error)
+
+	// UpdateDNSWithIP : Update a DNS record by adding an NLB IP address
+	UpdateDNSWithIP(updateDNSWithIPOptions *UpdateDNSWithIPOptions) (*core.DetailedResponse, error)
+
+	// UpdateDNSWithIPWithContext is an alternate form of the UpdateDNSWithIP method which supports a Context parameter
+	UpdateDNSWithIPWithContext(ctx context.Context, updateDNSWithIPOptions *UpdateDNSWithIPOptions) (*core.DetailedResponse, error)
+
+	// UnregisterDNSWithIP : Remove an IP address from an NLB subdomain
+	UnregisterDNSWithIP(unregisterDNSWithIPOptions *UnregisterDNSWithIPOptions) (*core.DetailedResponse, error)
+
+	// UnregisterDNSWithIPWithContext is an alternate form of the UnregisterDNSWithIP method which supports a Context
+	// parameter
+	UnregisterDNSWithIPWithContext(ctx context.Context, unregisterDNSWithIPOptions *UnregisterDNSWithIPOptions) (*core.DetailedResponse, error)
+
+	// ListNLBIPsForSubdomain : List registered NLB subdomains and NLB IP addresses
+	ListNLBIPsForSubdomain(listNLBIPsForSubdomainOptions *ListNLBIPsForSubdomainOptions) (*ClusterNlbHostsList, *core.DetailedResponse, error)
+
+	// ListNLBIPsForSubdomainWithContext is an alternate form of the ListNLBIPsForSubdomain method which supports a Context
+	// parameter
+	ListNLBIPsForSubdomainWithContext(ctx context.Context, listNLBIPsForSubdomainOptions *ListNLBIPsForSubdomainOptions) (*ClusterNlbHostsList, *core.DetailedResponse, error)
+
+	// RegisterDNSWithIP : Create a new NLB subdomain and associate one or more NLB IP addresses with it
+	RegisterDNSWithIP(registerDNSWithIPOptions *RegisterDNSWithIPOptions) (*NlbConfig, *core.DetailedResponse, error)
+
+	// RegisterDNSWithIPWithContext is an alternate form of the RegisterDNSWithIP method which supports a Context parameter
+	RegisterDNSWithIPWithContext(ctx context.Context, registerDNSWithIPOptions *RegisterDNSWithIPOptions) (*NlbConfig, *core.DetailedResponse, error)
+
+	// UpdateNlbDNSHealthMonitor : Enable or disable a health check monitor for an NLB subdomain
+	UpdateNlbDNSHealthMonitor(updateNlbDNSHealthMonitorOptions *UpdateNlbDNSHealthMonitorOptions) (*core.DetailedResponse, error)
+
+	// UpdateNlbDNSHealthMonitorWithContext is an alternate form of the UpdateNlbDNSHealthMonitor method which supports a
+	// Context parameter
+	UpdateNlbDNSHealthMonitorWithContext(ctx context.Context, updateNlbDNSHealthMonitorOptions *UpdateNlbDNSHealthMonitorOptions) (*core.DetailedResponse, error)
+
+	// AddNlbDNSHealthMonitor : Configure a health check monitor for an NLB subdomain
+	AddNlbDNSHealthMonitor(addNlbDNSHealthMonitorOptions *AddNlbDNSHealthMonitorOptions) (*NlbHealthConfig, *core.DetailedResponse, error)
+
+	// AddNlbDNSHealthMonitorWithContext is an alternate form of the AddNlbDNSHealthMonitor method which supports a Context
+	// parameter
+	AddNlbDNSHealthMonitorWithContext(ctx context.Context, addNlbDNSHealthMonitorOptions *AddNlbDNSHealthMonitorOptions) (*NlbHealthConfig, *core.DetailedResponse, error)
+
+	// GetNlbDNSHealthMonitor : View the health check monitor settings for an NLB subdomain
+	GetNlbDNSHealthMonitor(getNlbDNSHealthMonitorOptions *GetNlbDNSHealthMonitorOptions) (*NlbHealthConfig, *core.DetailedResponse, error)
+
+	// GetNlbDNSHealthMonitorWithContext is an alternate form of the GetNlbDNSHealthMonitor method which supports a Context
+	// parameter
+	GetNlbDNSHealthMonitorWithContext(ctx context.Context, getNlbDNSHealthMonitorOptions *GetNlbDNSHealthMonitorOptions) (*NlbHealthConfig, *core.DetailedResponse, error)
+
+	// ListNlbDNSHealthMonitors : List the health check monitor settings for all NLB subdomains
+	ListNlbDNSHealthMonitors(listNlbDNSHealthMonitorsOptions *ListNlbDNSHealthMonitorsOptions) (*ClusterNlbHostsList, *core.DetailedResponse, error)
+
+	// ListNlbDNSHealthMonitorsWithContext is an alternate form of the ListNlbDNSHealthMonitors method which supports a
+	// Context parameter
+	ListNlbDNSHealthMonitorsWithContext(ctx context.Context, listNlbDNSHealthMonitorsOptions *ListNlbDNSHealthMonitorsOptions) (*ClusterNlbHostsList, *core.DetailedResponse, error)
+
+	// ListNlbDNSHealthMonitorStatus : List the health check status for the IPs behind NLB subdomains in a cluster
+	ListNlbDNSHealthMonitorStatus(listNlbDNSHealthMonitorStatusOptions *ListNlbDNSHealthMonitorStatusOptions) (*ClusterNlbHostsList, *core.DetailedResponse, error)
+
+	// ListNlbDNSHealthMonitorStatusWithContext is an alternate form of the ListNlbDNSHealthMonitorStatus method which
+	// supports a Context parameter
+	ListNlbDNSHealthMonitorStatusWithContext(ctx context.Context, listNlbDNSHealthMonitorStatusOptions *ListNlbDNSHealthMonitorStatusOptions) (*ClusterNlbHostsList, *core.DetailedResponse, error)
+
+	// GetDatacenterVLANs : List available VLANs for a zone
+	GetDatacenterVLANs(getDatacenterVLANsOptions *GetDatacenterVLANsOptions) ([]VLAN, *core.DetailedResponse, error)
+
+	// GetDatacenterVLANsWithContext is an alternate form of the GetDatacenterVLANs method which supports a Context
+	// parameter
+	GetDatacenterVLANsWithContext(ctx context.Context, getDatacenterVLANsOptions *GetDatacenterVLANsOptions) ([]VLAN, *core.DetailedResponse, error)
+
+	// ListSubnets : List available IBM Cloud classic infrastructure subnets
+	ListSubnets(listSubnetsOptions *ListSubnetsOptions) ([]Subnet, *core.DetailedResponse, error)
+
+	// ListSubnetsWithContext is an alternate form of the ListSubnets method which supports a Context parameter
+	ListSubnetsWithContext(ctx context.Context, listSubnetsOptions *ListSubnetsOptions) ([]Subnet, *core.DetailedResponse, error)
+
+	// CreateSatelliteCluster : Create an IBM Cloud Satellite cluster
+	CreateSatelliteCluster(createSatelliteClusterOptions *CreateSatelliteClusterOptions) (*MultishiftCreateClusterResponse, *core.DetailedResponse, error)
+
+	// CreateSatelliteClusterWithContext is an alternate form of the CreateSatelliteCluster method which supports a Context
+	// parameter
+	CreateSatelliteClusterWithContext(ctx context.Context, createSatelliteClusterOptions *CreateSatelliteClusterOptions) (*MultishiftCreateClusterResponse, *core.DetailedResponse, error)
+
+	// CreateSatelliteWorkerPool : Create a worker pool for an IBM Cloud Satellite cluster
+	CreateSatelliteWorkerPool(createSatelliteWorkerPoolOptions *CreateSatelliteWorkerPoolOptions) (*CreateWorkerpoolResponse, *core.DetailedResponse, error)
+
+	// CreateSatelliteWorkerPoolWithContext is an alternate form of the CreateSatelliteWorkerPool method which supports a
+	// Context parameter
+	CreateSatelliteWorkerPoolWithContext(ctx context.Context, createSatelliteWorkerPoolOptions *CreateSatelliteWorkerPoolOptions) (*CreateWorkerpoolResponse, *core.DetailedResponse, error)
+
+	// CreateSatelliteWorkerPoolZone : Create a zone in the specified worker pool for an IBM Cloud Satellite cluster
+	CreateSatelliteWorkerPoolZone(createSatelliteWorkerPoolZoneOptions *CreateSatelliteWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+
+	// CreateSatelliteWorkerPoolZoneWithContext is an alternate form of the CreateSatelliteWorkerPoolZone method which
+	// supports a Context parameter
+	CreateSatelliteWorkerPoolZoneWithContext(ctx context.Context, createSatelliteWorkerPoolZoneOptions *CreateSatelliteWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+
+	// GetSatelliteClusters : List the IBM Cloud Satellite clusters that you have access to
+	GetSatelliteClusters(getSatelliteClustersOptions *GetSatelliteClustersOptions) ([]GetClustersResponse, *core.DetailedResponse, error)
+
+	// GetSatelliteClustersWithContext is an alternate form of the GetSatelliteClusters method which supports a Context
+	// parameter
+	GetSatelliteClustersWithContext(ctx context.Context, getSatelliteClustersOptions *GetSatelliteClustersOptions) ([]GetClustersResponse, *core.DetailedResponse, error)
+
+	// CreateSatelliteAssignment : Assign a host to an IBM Cloud Satellite location or cluster
+	CreateSatelliteAssignment(createSatelliteAssignmentOptions *CreateSatelliteAssignmentOptions) (*MultishiftCreateAssignmentResponse, *core.DetailedResponse, error)
+
+	// CreateSatelliteAssignmentWithContext is an alternate form of the CreateSatelliteAssignment method which supports a
+	// Context parameter
+	CreateSatelliteAssignmentWithContext(ctx context.Context, createSatelliteAssignmentOptions *CreateSatelliteAssignmentOptions) (*MultishiftCreateAssignmentResponse, *core.DetailedResponse, error)
+
+	// AttachSatelliteHost : Attach a host to an IBM Cloud Satellite location
+	AttachSatelliteHost(attachSatelliteHostOptions *AttachSatelliteHostOptions) ([]byte, error)
+
+	// AttachSatelliteHostWithContext is an alternate form of the AttachSatelliteHost method which supports a Context
+	// parameter
+	AttachSatelliteHostWithContext(ctx context.Context, attachSatelliteHostOptions *AttachSatelliteHostOptions) ([]byte, error)
+
+	// GetSatelliteHosts : List the hosts in your IBM Cloud Satellite location
+	GetSatelliteHosts(getSatelliteHostsOptions *GetSatelliteHostsOptions) ([]MultishiftQueueNode, *core.DetailedResponse, error)
+
+	// GetSatelliteHostsWithContext is an alternate form of the GetSatelliteHosts method which supports a Context parameter
+	GetSatelliteHostsWithContext(ctx context.Context, getSatelliteHostsOptions *GetSatelliteHostsOptions) ([]MultishiftQueueNode, *core.DetailedResponse, error)
+
+	// RemoveSatelliteHost : Remove a host from an IBM Cloud Satellite location or cluster
+	RemoveSatelliteHost(removeSatelliteHostOptions *RemoveSatelliteHostOptions) (*core.DetailedResponse, error)
+
+	// RemoveSatelliteHostWithContext is an alternate form of the RemoveSatelliteHost method which supports a Context
+	// parameter
+	RemoveSatelliteHostWithContext(ctx context.Context, removeSatelliteHostOptions *RemoveSatelliteHostOptions) (*core.DetailedResponse, error)
+
+	// UpdateSatelliteHost : Update a host in your IBM Cloud Satellite location
+	UpdateSatelliteHost(updateSatelliteHostOptions *UpdateSatelliteHostOptions) (*core.DetailedResponse, error)
+
+	// UpdateSatelliteHostWithContext is an alternate form of the UpdateSatelliteHost method which supports a Context
+	// parameter
+	UpdateSatelliteHostWithContext(ctx context.Context, updateSatelliteHostOptions *UpdateSatelliteHostOptions) (*core.DetailedResponse, error)
+
+	// CreateSatelliteLocation : Create an IBM Cloud Satellite location
+	CreateSatelliteLocation(createSatelliteLocationOptions *CreateSatelliteLocationOptions) (*MultishiftCreateControllerResponse, *core.DetailedResponse, error)
+
+	// CreateSatelliteLocationWithContext is an alternate form of the CreateSatelliteLocation method which supports a
+	// Context parameter
+	CreateSatelliteLocationWithContext(ctx context.Context, createSatelliteLocationOptions *CreateSatelliteLocationOptions) (*MultishiftCreateControllerResponse, *core.DetailedResponse, error)
+
+	// GetSatelliteLocation : Get the details for an IBM Cloud Satellite location
+	GetSatelliteLocation(getSatelliteLocationOptions *GetSatelliteLocationOptions) (*MultishiftGetController, *core.DetailedResponse, error)
+
+	// GetSatelliteLocationWithContext is an alternate form of the GetSatelliteLocation method which supports a Context
+	// parameter
+	GetSatelliteLocationWithContext(ctx context.Context, getSatelliteLocationOptions *GetSatelliteLocationOptions) (*MultishiftGetController, *core.DetailedResponse, error)
+
+	// GetSatelliteLocations : List the IBM Cloud Satellite locations that you have access to
+	GetSatelliteLocations(getSatelliteLocationsOptions *GetSatelliteLocationsOptions) ([]MultishiftController, *core.DetailedResponse, error)
+
+	// GetSatelliteLocationsWithContext is an alternate form of the GetSatelliteLocations method which supports a Context
+	// parameter
+	GetSatelliteLocationsWithContext(ctx context.Context, getSatelliteLocationsOptions *GetSatelliteLocationsOptions) ([]MultishiftController, *core.DetailedResponse, error)
+
+	// RemoveSatelliteLocation : Remove an IBM Cloud Satellite Location
+	RemoveSatelliteLocation(removeSatelliteLocationOptions *RemoveSatelliteLocationOptions) (*core.DetailedResponse, error)
+
+	// RemoveSatelliteLocationWithContext is an alternate form of the RemoveSatelliteLocation method which supports a
+	// Context parameter
+	RemoveSatelliteLocationWithContext(ctx context.Context, removeSatelliteLocationOptions *RemoveSatelliteLocationOptions) (*core.DetailedResponse, error)
+
+	// CreateSatelliteClusterRemote : Create an IBM Cloud Satellite cluster in a location that does not belong to your
+	// account
+	CreateSatelliteClusterRemote(createSatelliteClusterRemoteOptions *CreateSatelliteClusterRemoteOptions) (*MultishiftCreateClusterResponse, *core.DetailedResponse, error)
+
+	// CreateSatelliteClusterRemoteWithContext is an alternate form of the CreateSatelliteClusterRemote method which
+	// supports a Context parameter
+	CreateSatelliteClusterRemoteWithContext(ctx context.Context, createSatelliteClusterRemoteOptions *CreateSatelliteClusterRemoteOptions) (*MultishiftCreateClusterResponse, *core.DetailedResponse, error)
+
+	// GetSatelliteServiceClusters : List the clusters in an IBM Cloud Satellite location that are associated with a
+	// Satellite-enabled service instance
+	GetSatelliteServiceClusters(getSatelliteServiceClustersOptions *GetSatelliteServiceClustersOptions) ([]MultishiftClustersResponse, *core.DetailedResponse, error)
+
+	// GetSatelliteServiceClustersWithContext is an alternate form of the GetSatelliteServiceClusters method which supports
+	// a Context parameter
+	GetSatelliteServiceClustersWithContext(ctx context.Context, getSatelliteServiceClustersOptions *GetSatelliteServiceClustersOptions) ([]MultishiftClustersResponse, *core.DetailedResponse, error)
+
+	// CreateAttachment : The CreateAttachment operation.
+	CreateAttachment(createAttachmentOptions *CreateAttachmentOptions) (*VolumeAttachment, *core.DetailedResponse, error)
+
+	// CreateAttachmentWithContext is an alternate form of the CreateAttachment method which supports a Context parameter
+	CreateAttachmentWithContext(ctx context.Context, createAttachmentOptions *CreateAttachmentOptions) (*VolumeAttachment, *core.DetailedResponse, error)
+
+	// DeleteAttachment : Detach volume from given worker
+	DeleteAttachment(deleteAttachmentOptions *DeleteAttachmentOptions) (*core.DetailedResponse, error)
+
+	// DeleteAttachmentWithContext is an alternate form of the DeleteAttachment method which supports a Context parameter
+	DeleteAttachmentWithContext(ctx context.Context, deleteAttachmentOptions *DeleteAttachmentOptions) (*core.DetailedResponse, error)
+
+	// GetAttachment : The GetAttachment operation.
+	GetAttachment(getAttachmentOptions *GetAttachmentOptions) (*VolumeAttachment, *core.DetailedResponse, error)
+
+	// GetAttachmentWithContext is an alternate form of the GetAttachment method which supports a Context parameter
+	GetAttachmentWithContext(ctx context.Context, getAttachmentOptions *GetAttachmentOptions) (*VolumeAttachment, *core.DetailedResponse, error)
+
+	// GetAttachments : The GetAttachments operation.
+	GetAttachments(getAttachmentsOptions *GetAttachmentsOptions) (*VolumeAttachmentList, *core.DetailedResponse, error)
+
+	// GetAttachmentsWithContext is an alternate form of the GetAttachments method which supports a Context parameter
+	GetAttachmentsWithContext(ctx context.Context, getAttachmentsOptions *GetAttachmentsOptions) (*VolumeAttachmentList, *core.DetailedResponse, error)
+
+	// GetVolume : Get the details of a storage volume
+	GetVolume(getVolumeOptions *GetVolumeOptions) (*Volume, *core.DetailedResponse, error)
+
+	// GetVolumeWithContext is an alternate form of the GetVolume method which supports a Context parameter
+	GetVolumeWithContext(ctx context.Context, getVolumeOptions *GetVolumeOptions) (*Volume, *core.DetailedResponse, error)
+
+	// GetVolumes : List storage volumes for a cluster or for the account if no cluster is provided
+	GetVolumes(getVolumesOptions *GetVolumesOptions) (*VolumesList, *core.DetailedResponse, error)
+
+	// GetVolumesWithContext is an alternate form of the GetVolumes method which supports a Context parameter
+	GetVolumesWithContext(ctx context.Context, getVolumesOptions *GetVolumesOptions) (*VolumesList, *core.DetailedResponse, error)
+
+	// CreateAssignment : Create an assignment using a Satellite storage configuration for the cluster group(s).
+	CreateAssignment(createAssignmentOptions *CreateAssignmentOptions) (*CreateSubscriptionData, *core.DetailedResponse, error)
+
+	// CreateAssignmentWithContext is an alternate form of the CreateAssignment method which supports a Context parameter
+	CreateAssignmentWithContext(ctx context.Context, createAssignmentOptions *CreateAssignmentOptions) (*CreateSubscriptionData, *core.DetailedResponse, error)
+
+	// CreateAssignmentByCluster : Create an assignment using a Satellite storage configuration for a Satellite cluster or
+	// service cluster.
+	CreateAssignmentByCluster(createAssignmentOptions *CreateAssignmentOptions) (*CreateSubscriptionData, *core.DetailedResponse, error)
+
+	// CreateAssignmentWithContext is an alternate form of the CreateAssignment method which supports a Context parameter
+	CreateAssignmentByClusterWithContext(ctx context.Context, createAssignmentOptions *CreateAssignmentOptions) (*CreateSubscriptionData, *core.DetailedResponse, error)
+
+	// CreateStorageConfiguration : Create a Satellite storage configuration
+	CreateStorageConfiguration(createStorageConfigurationOptions *CreateStorageConfigurationOptions) (*CreateConfigurationData, *core.DetailedResponse, error)
+
+	// CreateStorageConfigurationWithContext is an alternate form of the CreateStorageConfiguration method which supports a
+	// Context parameter
+	CreateStorageConfigurationWithContext(ctx context.Context, createStorageConfigurationOptions *CreateStorageConfigurationOptions) (*CreateConfigurationData, *core.DetailedResponse, error)
+
+	// GetAssignedStorageConfigs : List Satellite storage configurations by cluster
+	GetAssignedStorageConfigs(getAssignedStorageConfigsOptions *GetAssignedStorageConfigsOptions) ([]AppliedStorageConfig, *core.DetailedResponse, error)
+
+	// GetAssignedStorageConfigsWithContext is an alternate form of the GetAssignedStorageConfigs method which supports a
+	// Context parameter
+	GetAssignedStorageConfigsWithContext(ctx context.Context, getAssignedStorageConfigsOptions *GetAssignedStorageConfigsOptions) ([]AppliedStorageConfig, *core.DetailedResponse, error)
+
+	// GetAssignment : View details for an assignment to a Satellite storage configuration
+	GetAssignment(getAssignmentOptions *GetAssignmentOptions) (*Subscription, *core.DetailedResponse, error)
+
+	// GetAssignmentWithContext is an alternate form of the GetAssignment method which supports a Context parameter
+	GetAssignmentWithContext(ctx context.Context, getAssignmentOptions *GetAssignmentOptions) (*Subscription, *core.DetailedResponse, error)
+
+	// GetAssignmentByName : View details for an assignment to a Satellite storage configuration
+	GetAssignmentByName(getAssignmentByNameOptions *GetAssignmentByNameOptions) (*Subscription, *core.DetailedResponse, error)
+
+	// GetAssignmentByNameWithContext is an alternate form of the GetAssignmentByName method which supports a Context
+	// parameter
+	GetAssignmentByNameWithContext(ctx context.Context, getAssignmentByNameOptions *GetAssignmentByNameOptions) (*Subscription, *core.DetailedResponse, error)
+
+	// GetAssignments : List assignments to Satellite storage configurations in the IBM Cloud account
+	GetAssignments(getAssignmentsOptions *GetAssignmentsOptions) ([]Subscription, *core.DetailedResponse, error)
+
+	// GetAssignmentsWithContext is an alternate form of the GetAssignments method which supports a Context parameter
+	GetAssignmentsWithContext(ctx context.Context, getAssignmentsOptions *GetAssignmentsOptions) ([]Subscription, *core.DetailedResponse, error)
+
+	// GetAssignmentsByConfig : List storage assignments by configuration name or UUID
+	GetAssignmentsByConfig(getAssignmentsByConfigOptions *GetAssignmentsByConfigOptions) ([]Subscription, *core.DetailedResponse, error)
+
+	// GetAssignmentsByConfigWithContext is an alternate form of the GetAssignmentsByConfig method which supports a Context
+	// parameter
+	GetAssignmentsByConfigWithContext(ctx context.Context, getAssignmentsByConfigOptions *GetAssignmentsByConfigOptions) ([]Subscription, *core.DetailedResponse, error)
+
+	// GetAvailableStorageClasses : List available storage classes from Satellite storage configurations on a cluster
+	GetAvailableStorageClasses(getAvailableStorageClassesOptions *GetAvailableStorageClassesOptions) (*AvailableStorageClasses, *core.DetailedResponse, error)
+
+	// GetAvailableStorageClassesWithContext is an alternate form of the GetAvailableStorageClasses method which supports a
+	// Context parameter
+	GetAvailableStorageClassesWithContext(ctx context.Context, getAvailableStorageClassesOptions *GetAvailableStorageClassesOptions) (*AvailableStorageClasses, *core.DetailedResponse, error)
+
+	// GetStorageConfiguration : View details of a Satellite storage configuration
+	GetStorageConfiguration(getStorageConfigurationOptions *GetStorageConfigurationOptions) (*StorageConfig, *core.DetailedResponse, error)
+
+	// GetStorageConfigurationWithContext is an alternate form of the GetStorageConfiguration method which supports a
+	// Context parameter
+	GetStorageConfigurationWithContext(ctx context.Context, getStorageConfigurationOptions *GetStorageConfigurationOptions) (*StorageConfig, *core.DetailedResponse, error)
+
+	// GetStorageConfigurations : List Satellite storage configurations
+	GetStorageConfigurations(getStorageConfigurationsOptions *GetStorageConfigurationsOptions) ([]StorageConfig, *core.DetailedResponse, error)
+
+	// GetStorageConfigurationsWithContext is an alternate form of the GetStorageConfigurations method which supports a
+	// Context parameter
+	GetStorageConfigurationsWithContext(ctx context.Context, getStorageConfigurationsOptions *GetStorageConfigurationsOptions) ([]StorageConfig, *core.DetailedResponse, error)
+
+	// GetStorageTemplate : View details of a Satellite storage template
+	GetStorageTemplate(getStorageTemplateOptions *GetStorageTemplateOptions) (*RegisteredStorageVersion, *core.DetailedResponse, error)
+
+	// GetStorageTemplateWithContext is an alternate form of the GetStorageTemplate method which supports a Context
+	// parameter
+	GetStorageTemplateWithContext(ctx context.Context, getStorageTemplateOptions *GetStorageTemplateOptions) (*RegisteredStorageVersion, *core.DetailedResponse, error)
+
+	// GetStorageTemplates : List Satellite storage templates
+	GetStorageTemplates(getStorageTemplatesOptions *GetStorageTemplatesOptions) ([]RegisteredStorage, *core.DetailedResponse, error)
+
+	// GetStorageTemplatesWithContext is an alternate form of the GetStorageTemplates method which supports a Context
+	// parameter
+	GetStorageTemplatesWithContext(ctx context.Context, getStorageTemplatesOptions *GetStorageTemplatesOptions) ([]RegisteredStorage, *core.DetailedResponse, error)
+
+	// RemoveAssignment : Remove an assignment to a Satellite storage configuration
+	RemoveAssignment(removeAssignmentOptions *RemoveAssignmentOptions) (*RemoveSubscriptionData, *core.DetailedResponse, error)
+
+	// RemoveAssignmentWithContext is an alternate form of the RemoveAssignment method which supports a Context parameter
+	RemoveAssignmentWithContext(ctx context.Context, removeAssignmentOptions *RemoveAssignmentOptions) (*RemoveSubscriptionData, *core.DetailedResponse, error)
+
+	// RemoveStorageConfiguration : Remove a Satellite storage configuration
+	RemoveStorageConfiguration(removeStorageConfigurationOptions *RemoveStorageConfigurationOptions) (*ErrorResponse, *core.DetailedResponse, error)
+
+	// RemoveStorageConfigurationWithContext is an alternate form of the RemoveStorageConfiguration method which supports a
+	// Context parameter
+	RemoveStorageConfigurationWithContext(ctx context.Context, removeStorageConfigurationOptions *RemoveStorageConfigurationOptions) (*ErrorResponse, *core.DetailedResponse, error)
+
+	// UpdateAssignment : Update an assignment to a Satellite storage configuration
+	UpdateAssignment(updateAssignmentOptions *UpdateAssignmentOptions) (*UpdateSubscriptionData, *core.DetailedResponse, error)
+
+	// UpdateAssignmentWithContext is an alternate form of the UpdateAssignment method which supports a Context parameter
+	UpdateAssignmentWithContext(ctx context.Context, updateAssignmentOptions *UpdateAssignmentOptions) (*UpdateSubscriptionData, *core.DetailedResponse, error)
+
+	// UpdateAssignmentVersion : Update the version of the Satellite storage configuration that an assignment uses
+	UpdateAssignmentVersion(updateAssignmentVersionOptions *UpdateAssignmentVersionOptions) (*UpdateSubscriptionVersionData, *core.DetailedResponse, error)
+
+	// UpdateAssignmentVersionWithContext is an alternate form of the UpdateAssignmentVersion method which supports a
+	// Context parameter
+	UpdateAssignmentVersionWithContext(ctx context.Context, updateAssignmentVersionOptions *UpdateAssignmentVersionOptions) (*UpdateSubscriptionVersionData, *core.DetailedResponse, error)
+
+	// UpdateStorageConfiguration : Update a Satellite storage configuration
+	UpdateStorageConfiguration(updateStorageConfigurationOptions *UpdateStorageConfigurationOptions) (*ErrorResponse, *core.DetailedResponse, error)
+
+	// UpdateStorageConfigurationWithContext is an alternate form of the UpdateStorageConfiguration method which supports a
+	// Context parameter
+	UpdateStorageConfigurationWithContext(ctx context.Context, updateStorageConfigurationOptions *UpdateStorageConfigurationOptions) (*ErrorResponse, *core.DetailedResponse, error)
+
+	// GetAddons : List available add-ons that you can enable in a cluster
+	GetAddons(getAddonsOptions *GetAddonsOptions) ([]AddonCommon, *core.DetailedResponse, error)
+
+	// GetAddonsWithContext is an alternate form of the GetAddons method which supports a Context parameter
+	GetAddonsWithContext(ctx context.Context, getAddonsOptions *GetAddonsOptions) ([]AddonCommon, *core.DetailedResponse, error)
+
+	// GetBluemixConfig : List configuration values for your IBM Cloud account
+	GetBluemixConfig(getBluemixConfigOptions *GetBluemixConfigOptions) (*BluemixConfig, *core.DetailedResponse, error)
+
+	// GetBluemixConfigWithContext is an alternate form of the GetBluemixConfig method which supports a Context parameter
+	GetBluemixConfigWithContext(ctx context.Context, getBluemixConfigOptions *GetBluemixConfigOptions) (*BluemixConfig, *core.DetailedResponse, error)
+
+	// GetDatacenterMachineTypes : List available machine types for a zone (data center)
+	GetDatacenterMachineTypes(getDatacenterMachineTypesOptions *GetDatacenterMachineTypesOptions) ([]MachineType, *core.DetailedResponse, error)
+
+	// GetDatacenterMachineTypesWithContext is an alternate form of the GetDatacenterMachineTypes method which supports a
+	// Context parameter
+	GetDatacenterMachineTypesWithContext(ctx context.Context, getDatacenterMachineTypesOptions *GetDatacenterMachineTypesOptions) ([]MachineType, *core.DetailedResponse, error)
+
+	// GetKubeVersions : Deprecated: List available Kubernetes versions
+	GetKubeVersions(getKubeVersionsOptions *GetKubeVersionsOptions) ([]KubeVersion, *core.DetailedResponse, error)
+
+	// GetKubeVersionsWithContext is an alternate form of the GetKubeVersions method which supports a Context parameter
+	GetKubeVersionsWithContext(ctx context.Context, getKubeVersionsOptions *GetKubeVersionsOptions) ([]KubeVersion, *core.DetailedResponse, error)
+
+	// ListLocations : List available locations
+	ListLocations(listLocationsOptions *ListLocationsOptions) ([]IKSLocation, *core.DetailedResponse, error)
+
+	// ListLocationsWithContext is an alternate form of the ListLocations method which supports a Context parameter
+	ListLocationsWithContext(ctx context.Context, listLocationsOptions *ListLocationsOptions) ([]IKSLocation, *core.DetailedResponse, error)
+
+	// GetMessages : View the current user messages
+	GetMessages(getMessagesOptions *GetMessagesOptions) ([]MessageResponse, *core.DetailedResponse, error)
+
+	// GetMessagesWithContext is an alternate form of the GetMessages method which supports a Context parameter
+	GetMessagesWithContext(ctx context.Context, getMessagesOptions *GetMessagesOptions) ([]MessageResponse, *core.DetailedResponse, error)
+
+	// GetProductConfig : List product-specific values to substitute for variables in other files
+	GetProductConfig(getProductConfigOptions *GetProductConfigOptions) (*ProductConfig, *core.DetailedResponse, error)
+
+	// GetProductConfigWithContext is an alternate form of the GetProductConfig method which supports a Context parameter
+	GetProductConfigWithContext(ctx context.Context, getProductConfigOptions *GetProductConfigOptions) (*ProductConfig, *core.DetailedResponse, error)
+
+	// GetRegions : Deprecated: List available Kubernetes Service regions
+	GetRegions(getRegionsOptions *GetRegionsOptions) (*Regions, *core.DetailedResponse, error)
+
+	// GetRegionsWithContext is an alternate form of the GetRegions method which supports a Context parameter
+	GetRegionsWithContext(ctx context.Context, getRegionsOptions *GetRegionsOptions) (*Regions, *core.DetailedResponse, error)
+
+	// GetVersions : List available IBM Cloud Kubernetes Service versions
+	GetVersions(getVersionsOptions *GetVersionsOptions) (map[string][]KubeVersion, *core.DetailedResponse, error)
+
+	// GetVersionsWithContext is an alternate form of the GetVersions method which supports a Context parameter
+	GetVersionsWithContext(ctx context.Context, getVersionsOptions *GetVersionsOptions) (map[string][]KubeVersion, *core.DetailedResponse, error)
+
+	// GetZones : List available zones (data centers)
+	GetZones(getZonesOptions *GetZonesOptions) ([]ZoneResponse, *core.DetailedResponse, error)
+
+	// GetZonesWithContext is an alternate form of the GetZones method which supports a Context parameter
+	GetZonesWithContext(ctx context.Context, getZonesOptions *GetZonesOptions) ([]ZoneResponse, *core.DetailedResponse, error)
+
+	// V2GetMessages : View the current user messages
+	V2GetMessages(v2GetMessagesOptions *V2GetMessagesOptions) ([]MessageResponse, *core.DetailedResponse, error)
+
+	// V2GetMessagesWithContext is an alternate form of the V2GetMessages method which supports a Context parameter
+	V2GetMessagesWithContext(ctx context.Context, v2GetMessagesOptions *V2GetMessagesOptions) ([]MessageResponse, *core.DetailedResponse, error)
+
+	// ApplyRBACAndGetKubeconfig : Apply IAM roles to the cluster, then retrieve the cluster's kubeconfig file
+	ApplyRBACAndGetKubeconfig(applyRBACAndGetKubeconfigOptions *ApplyRBACAndGetKubeconfigOptions) (*core.DetailedResponse, error)
+
+	// ApplyRBACAndGetKubeconfigWithContext is an alternate form of the ApplyRBACAndGetKubeconfig method which supports a
+	// Context parameter
+	ApplyRBACAndGetKubeconfigWithContext(ctx context.Context, applyRBACAndGetKubeconfigOptions *ApplyRBACAndGetKubeconfigOptions) (*core.DetailedResponse, error)
+
+	// AutoUpdateMaster : Set the autoupdate status of the cluster master
+	AutoUpdateMaster(autoUpdateMasterOptions *AutoUpdateMasterOptions) (*core.DetailedResponse, error)
+
+	// AutoUpdateMasterWithContext is an alternate form of the AutoUpdateMaster method which supports a Context parameter
+	AutoUpdateMasterWithContext(ctx context.Context, autoUpdateMasterOptions *AutoUpdateMasterOptions) (*core.DetailedResponse, error)
+
+	// ClassicGetCluster : Detailed cluster information
+	ClassicGetCluster(classicGetClusterOptions *ClassicGetClusterOptions) ([]GetClusterResponse, *core.DetailedResponse, error)
+
+	// ClassicGetClusterWithContext is an alternate form of the ClassicGetCluster method which supports a Context parameter
+	ClassicGetClusterWithContext(ctx context.Context, classicGetClusterOptions *ClassicGetClusterOptions) ([]GetClusterResponse, *core.DetailedResponse, error)
+
+	// ClassicGetClusters : List the classic clusters that you have access to
+	ClassicGetClusters(classicGetClustersOptions *ClassicGetClustersOptions) ([]GetClustersResponse, *core.DetailedResponse, error)
+
+	// ClassicGetClustersWithContext is an alternate form of the ClassicGetClusters method which supports a Context
+	// parameter
+	ClassicGetClustersWithContext(ctx context.Context, classicGetClustersOptions *ClassicGetClustersOptions) ([]GetClustersResponse, *core.DetailedResponse, error)
+
+	// GetVLANs : List available classic infrastructure VLANs for a zone
+	GetVLANs(getVLANsOptions *GetVLANsOptions) (*VLAN, *core.DetailedResponse, error)
+
+	// GetVLANsWithContext is an alternate form of the GetVLANs method which supports a Context parameter
+	GetVLANsWithContext(ctx context.Context, getVLANsOptions *GetVLANsOptions) (*VLAN, *core.DetailedResponse, error)
+
+	// ClassicGetWorker : View details of a worker node for classic cluster
+	ClassicGetWorker(classicGetWorkerOptions *ClassicGetWorkerOptions) (*GetWorkerResponse, *core.DetailedResponse, error)
+
+	// ClassicGetWorkerWithContext is an alternate form of the ClassicGetWorker method which supports a Context parameter
+	ClassicGetWorkerWithContext(ctx context.Context, classicGetWorkerOptions *ClassicGetWorkerOptions) (*GetWorkerResponse, *core.DetailedResponse, error)
+
+	// ClassicGetWorkerPool : View details of a worker pool for a classic cluster
+	ClassicGetWorkerPool(classicGetWorkerPoolOptions *ClassicGetWorkerPoolOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// ClassicGetWorkerPoolWithContext is an alternate form of the ClassicGetWorkerPool method which supports a Context
+	// parameter
+	ClassicGetWorkerPoolWithContext(ctx context.Context, classicGetWorkerPoolOptions *ClassicGetWorkerPoolOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// ClassicGetWorkerPools : View details of a worker pool for a classic cluster
+	ClassicGetWorkerPools(classicGetWorkerPoolsOptions *ClassicGetWorkerPoolsOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// ClassicGetWorkerPoolsWithContext is an alternate form of the ClassicGetWorkerPools method which supports a Context
+	// parameter
+	ClassicGetWorkerPoolsWithContext(ctx context.Context, classicGetWorkerPoolsOptions *ClassicGetWorkerPoolsOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// ClassicGetWorkers : View all workers for a classic cluster
+	ClassicGetWorkers(classicGetWorkersOptions *ClassicGetWorkersOptions) ([]GetWorkerResponse, *core.DetailedResponse, error)
+
+	// ClassicGetWorkersWithContext is an alternate form of the ClassicGetWorkers method which supports a Context parameter
+	ClassicGetWorkersWithContext(ctx context.Context, classicGetWorkersOptions *ClassicGetWorkersOptions) ([]GetWorkerResponse, *core.DetailedResponse, error)
+
+	// KmsEnableCluster : Enables KMS in a cluster
+	KmsEnableCluster(kmsEnableClusterOptions *KmsEnableClusterOptions) (*core.DetailedResponse, error)
+
+	// KmsEnableClusterWithContext is an alternate form of the KmsEnableCluster method which supports a Context parameter
+	KmsEnableClusterWithContext(ctx context.Context, kmsEnableClusterOptions *KmsEnableClusterOptions) (*core.DetailedResponse, error)
+
+	// KmsGetCRKs : List the root keys for a Key Management Service instance
+	KmsGetCRKs(kmsGetCRKsOptions *KmsGetCRKsOptions) ([]GetCRKResponse, *core.DetailedResponse, error)
+
+	// KmsGetCRKsWithContext is an alternate form of the KmsGetCRKs method which supports a Context parameter
+	KmsGetCRKsWithContext(ctx context.Context, kmsGetCRKsOptions *KmsGetCRKsOptions) ([]GetCRKResponse, *core.DetailedResponse, error)
+
+	// GetCluster : View details for a cluster
+	GetCluster(getClusterOptions *GetClusterOptions) (*GetClusterResponse, *core.DetailedResponse, error)
+
+	// GetClusterWithContext is an alternate form of the GetCluster method which supports a Context parameter
+	GetClusterWithContext(ctx context.Context, getClusterOptions *GetClusterOptions) (*GetClusterResponse, *core.DetailedResponse, error)
+
+	// V2GetClusterAddons : View details of the add-ons that are enabled in a cluster
+	V2GetClusterAddons(v2GetClusterAddonsOptions *V2GetClusterAddonsOptions) ([]ClusterAddon, *core.DetailedResponse, error)
+
+	// V2GetClusterAddonsWithContext is an alternate form of the V2GetClusterAddons method which supports a Context
+	// parameter
+	V2GetClusterAddonsWithContext(ctx context.Context, v2GetClusterAddonsOptions *V2GetClusterAddonsOptions) ([]ClusterAddon, *core.DetailedResponse, error)
+
+	// V2GetFlavors : List available flavors types for a VPC zone (data center)
+	V2GetFlavors(v2GetFlavorsOptions *V2GetFlavorsOptions) ([]Flavor, *core.DetailedResponse, error)
+
+	// V2GetFlavorsWithContext is an alternate form of the V2GetFlavors method which supports a Context parameter
+	V2GetFlavorsWithContext(ctx context.Context, v2GetFlavorsOptions *V2GetFlavorsOptions) ([]Flavor, *core.DetailedResponse, error)
+
+	// KmsGetInstances : Gets KMS instances tied to an account
+	KmsGetInstances(kmsGetInstancesOptions *KmsGetInstancesOptions) ([]GetKMSInstanceResponse, *core.DetailedResponse, error)
+
+	// KmsGetInstancesWithContext is an alternate form of the KmsGetInstances method which supports a Context parameter
+	KmsGetInstancesWithContext(ctx context.Context, kmsGetInstancesOptions *KmsGetInstancesOptions) ([]GetKMSInstanceResponse, *core.DetailedResponse, error)
+
+	// GetKubeconfig : Get the cluster's kubeconfig file
+	GetKubeconfig(getKubeconfigOptions *GetKubeconfigOptions) (*core.DetailedResponse, error)
+
+	// GetKubeconfigWithContext is an alternate form of the GetKubeconfig method which supports a Context parameter
+	GetKubeconfigWithContext(ctx context.Context, getKubeconfigOptions *GetKubeconfigOptions) (*core.DetailedResponse, error)
+
+	// GetQuota : View the quota for resources per region in the account
+	GetQuota(getQuotaOptions *GetQuotaOptions) ([]GetQuotaResource, *core.DetailedResponse, error)
+
+	// GetQuotaWithContext is an alternate form of the GetQuota method which supports a Context parameter
+	GetQuotaWithContext(ctx context.Context, getQuotaOptions *GetQuotaOptions) ([]GetQuotaResource, *core.DetailedResponse, error)
+
+	// GetWorker : View details of a worker node for cluster
+	GetWorker(getWorkerOptions *GetWorkerOptions) (*GetWorkerResponse, *core.DetailedResponse, error)
+
+	// GetWorkerWithContext is an alternate form of the GetWorker method which supports a Context parameter
+	GetWorkerWithContext(ctx context.Context, getWorkerOptions *GetWorkerOptions) (*GetWorkerResponse, *core.DetailedResponse, error)
+
+	// GetWorkerPool : View details of a worker pool for a cluster
+	GetWorkerPool(getWorkerPoolOptions *GetWorkerPoolOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// GetWorkerPoolWithContext is an alternate form of the GetWorkerPool method which supports a Context parameter
+	GetWorkerPoolWithContext(ctx context.Context, getWorkerPoolOptions *GetWorkerPoolOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// GetWorkerPools1 : List the worker pools in a cluster
+	GetWorkerPools1(getWorkerPools1Options *GetWorkerPools1Options) ([]GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// GetWorkerPools1WithContext is an alternate form of the GetWorkerPools1 method which supports a Context parameter
+	GetWorkerPools1WithContext(ctx context.Context, getWorkerPools1Options *GetWorkerPools1Options) ([]GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// GetWorkers1 : View all workers for cluster
+	GetWorkers1(getWorkers1Options *GetWorkers1Options) ([]GetWorkerResponse, *core.DetailedResponse, error)
+
+	// GetWorkers1WithContext is an alternate form of the GetWorkers1 method which supports a Context parameter
+	GetWorkers1WithContext(ctx context.Context, getWorkers1Options *GetWorkers1Options) ([]GetWorkerResponse, *core.DetailedResponse, error)
+
+	// RebalanceWorkerPool : Rebalance workers in a worker pool
+	RebalanceWorkerPool(rebalanceWorkerPoolOptions *RebalanceWorkerPoolOptions) (*core.DetailedResponse, error)
+
+	// RebalanceWorkerPoolWithContext is an alternate form of the RebalanceWorkerPool method which supports a Context
+	// parameter
+	RebalanceWorkerPoolWithContext(ctx context.Context, rebalanceWorkerPoolOptions *RebalanceWorkerPoolOptions) (*core.DetailedResponse, error)
+
+	// VpcRefreshMaster : Refresh the Kubernetes master
+	VpcRefreshMaster(vpcRefreshMasterOptions *VpcRefreshMasterOptions) (*core.DetailedResponse, error)
+
+	// VpcRefreshMasterWithContext is an alternate form of the VpcRefreshMaster method which supports a Context parameter
+	VpcRefreshMasterWithContext(ctx context.Context, vpcRefreshMasterOptions *VpcRefreshMasterOptions) (*core.DetailedResponse, error)
+
+	// V2RemoveWorker : Delete a worker node from a cluster
+	V2RemoveWorker(v2RemoveWorkerOptions *V2RemoveWorkerOptions) (*core.DetailedResponse, error)
+
+	// V2RemoveWorkerWithContext is an alternate form of the V2RemoveWorker method which supports a Context parameter
+	V2RemoveWorkerWithContext(ctx context.Context, v2RemoveWorkerOptions *V2RemoveWorkerOptions) (*core.DetailedResponse, error)
+
+	// RemoveWorkerPool1 : Removes a worker pool
+	RemoveWorkerPool1(removeWorkerPool1Options *RemoveWorkerPool1Options) (*core.DetailedResponse, error)
+
+	// RemoveWorkerPool1WithContext is an alternate form of the RemoveWorkerPool1 method which supports a Context parameter
+	RemoveWorkerPool1WithContext(ctx context.Context, removeWorkerPool1Options *RemoveWorkerPool1Options) (*core.DetailedResponse, error)
+
+	// RemoveWorkerPoolZone1 : Remove a zone from a worker pool
+	RemoveWorkerPoolZone1(removeWorkerPoolZone1Options *RemoveWorkerPoolZone1Options) (*core.DetailedResponse, error)
+
+	// RemoveWorkerPoolZone1WithContext is an alternate form of the RemoveWorkerPoolZone1 method which supports a Context
+	// parameter
+	RemoveWorkerPoolZone1WithContext(ctx context.Context, removeWorkerPoolZone1Options *RemoveWorkerPoolZone1Options) (*core.DetailedResponse, error)
+
+	// V2ResizeWorkerPool : Resize an existing worker pool
+	V2ResizeWorkerPool(v2ResizeWorkerPoolOptions *V2ResizeWorkerPoolOptions) (*core.DetailedResponse, error)
+
+	// V2ResizeWorkerPoolWithContext is an alternate form of the V2ResizeWorkerPool method which supports a Context
+	// parameter
+	V2ResizeWorkerPoolWithContext(ctx context.Context, v2ResizeWorkerPoolOptions *V2ResizeWorkerPoolOptions) (*core.DetailedResponse, error)
+
+	// V2SetWorkerPoolLabels : Set custom labels for a worker pool
+	V2SetWorkerPoolLabels(v2SetWorkerPoolLabelsOptions *V2SetWorkerPoolLabelsOptions) (*core.DetailedResponse, error)
+
+	// V2SetWorkerPoolLabelsWithContext is an alternate form of the V2SetWorkerPoolLabels method which supports a Context
+	// parameter
+	V2SetWorkerPoolLabelsWithContext(ctx context.Context, v2SetWorkerPoolLabelsOptions *V2SetWorkerPoolLabelsOptions) (*core.DetailedResponse, error)
+
+	// V2SetWorkerPoolTaints : Set custom taints for a worker pool
+	V2SetWorkerPoolTaints(v2SetWorkerPoolTaintsOptions *V2SetWorkerPoolTaintsOptions) (*core.DetailedResponse, error)
+
+	// V2SetWorkerPoolTaintsWithContext is an alternate form of the V2SetWorkerPoolTaints method which supports a Context
+	// parameter
+	V2SetWorkerPoolTaintsWithContext(ctx context.Context, v2SetWorkerPoolTaintsOptions *V2SetWorkerPoolTaintsOptions) (*core.DetailedResponse, error)
+
+	// VpcCreateCluster : Create a VPC cluster
+	VpcCreateCluster(vpcCreateClusterOptions *VpcCreateClusterOptions) (*CreateClusterResponse, *core.DetailedResponse, error)
+
+	// VpcCreateClusterWithContext is an alternate form of the VpcCreateCluster method which supports a Context parameter
+	VpcCreateClusterWithContext(ctx context.Context, vpcCreateClusterOptions *VpcCreateClusterOptions) (*CreateClusterResponse, *core.DetailedResponse, error)
+
+	// VpcCreateWorkerPool : Create a worker pool for a VPC cluster
+	VpcCreateWorkerPool(vpcCreateWorkerPoolOptions *VpcCreateWorkerPoolOptions) (*CreateWorkerpoolResponse, *core.DetailedResponse, error)
+
+	// VpcCreateWorkerPoolWithContext is an alternate form of the VpcCreateWorkerPool method which supports a Context
+	// parameter
+	VpcCreateWorkerPoolWithContext(ctx context.Context, vpcCreateWorkerPoolOptions *VpcCreateWorkerPoolOptions) (*CreateWorkerpoolResponse, *core.DetailedResponse, error)
+
+	// VpcCreateWorkerPoolZone : Create a zone in the specified worker pool for a VPC cluster
+	VpcCreateWorkerPoolZone(vpcCreateWorkerPoolZoneOptions *VpcCreateWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+
+	// VpcCreateWorkerPoolZoneWithContext is an alternate form of the VpcCreateWorkerPoolZone method which supports a
+	// Context parameter
+	VpcCreateWorkerPoolZoneWithContext(ctx context.Context, vpcCreateWorkerPoolZoneOptions *VpcCreateWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+
+	// VpcGetCluster : Get details of a VPC cluster
+	VpcGetCluster(vpcGetClusterOptions *VpcGetClusterOptions) (*GetClusterResponse, *core.DetailedResponse, error)
+
+	// VpcGetClusterWithContext is an alternate form of the VpcGetCluster method which supports a Context parameter
+	VpcGetClusterWithContext(ctx context.Context, vpcGetClusterOptions *VpcGetClusterOptions) (*GetClusterResponse, *core.DetailedResponse, error)
+
+	// VpcGetClusters : List the VPC clusters that you have access to
+	VpcGetClusters(vpcGetClustersOptions *VpcGetClustersOptions) ([]GetClustersResponse, *core.DetailedResponse, error)
+
+	// VpcGetClustersWithContext is an alternate form of the VpcGetClusters method which supports a Context parameter
+	VpcGetClustersWithContext(ctx context.Context, vpcGetClustersOptions *VpcGetClustersOptions) ([]GetClustersResponse, *core.DetailedResponse, error)
+
+	// GetSubnets : View subnets for a given VPC
+	GetSubnets(getSubnetsOptions *GetSubnetsOptions) ([]Subnet, *core.DetailedResponse, error)
+
+	// GetSubnetsWithContext is an alternate form of the GetSubnets method which supports a Context parameter
+	GetSubnetsWithContext(ctx context.Context, getSubnetsOptions *GetSubnetsOptions) ([]Subnet, *core.DetailedResponse, error)
+
+	// GetVPC : View details of a VPC
+	GetVPC(getVPCOptions *GetVPCOptions) (*GetVPCResponse, *core.DetailedResponse, error)
+
+	// GetVPCWithContext is an alternate form of the GetVPC method which supports a Context parameter
+	GetVPCWithContext(ctx context.Context, getVPCOptions *GetVPCOptions) (*GetVPCResponse, *core.DetailedResponse, error)
+
+	// GetVPCs : View VPCs for the given provider
+	GetVPCs(getVPCsOptions *GetVPCsOptions) ([]VirtualPrivateCloudResponse, *core.DetailedResponse, error)
+
+	// GetVPCsWithContext is an alternate form of the GetVPCs method which supports a Context parameter
+	GetVPCsWithContext(ctx context.Context, getVPCsOptions *GetVPCsOptions) ([]VirtualPrivateCloudResponse, *core.DetailedResponse, error)
+
+	// VpcGetWorker : View details of a worker node for VPC cluster
+	VpcGetWorker(vpcGetWorkerOptions *VpcGetWorkerOptions) (*GetWorkerResponse, *core.DetailedResponse, error)
+
+	// VpcGetWorkerWithContext is an alternate form of the VpcGetWorker method which supports a Context parameter
+	VpcGetWorkerWithContext(ctx context.Context, vpcGetWorkerOptions *VpcGetWorkerOptions) (*GetWorkerResponse, *core.DetailedResponse, error)
+
+	// VpcGetWorkerPool : View details of a worker pool for a VPC cluster
+	VpcGetWorkerPool(vpcGetWorkerPoolOptions *VpcGetWorkerPoolOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// VpcGetWorkerPoolWithContext is an alternate form of the VpcGetWorkerPool method which supports a Context parameter
+	VpcGetWorkerPoolWithContext(ctx context.Context, vpcGetWorkerPoolOptions *VpcGetWorkerPoolOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// VpcGetWorkerPools : List the worker pools in a VPC cluster
+	VpcGetWorkerPools(vpcGetWorkerPoolsOptions *VpcGetWorkerPoolsOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// VpcGetWorkerPoolsWithContext is an alternate form of the VpcGetWorkerPools method which supports a Context parameter
+	VpcGetWorkerPoolsWithContext(ctx context.Context, vpcGetWorkerPoolsOptions *VpcGetWorkerPoolsOptions) (*GetWorkerPoolResponse, *core.DetailedResponse, error)
+
+	// VpcGetWorkers : View all workers for VPC cluster
+	VpcGetWorkers(vpcGetWorkersOptions *VpcGetWorkersOptions) ([]GetWorkerResponse, *core.DetailedResponse, error)
+
+	// VpcGetWorkersWithContext is an alternate form of the VpcGetWorkers method which supports a Context parameter
+	VpcGetWorkersWithContext(ctx context.Context, vpcGetWorkersOptions *VpcGetWorkersOptions) ([]GetWorkerResponse, *core.DetailedResponse, error)
+
+	// VpcGetZones : List available zones in a region
+	VpcGetZones(vpcGetZonesOptions *VpcGetZonesOptions) ([][]ZoneResponse, *core.DetailedResponse, error)
+
+	// VpcGetZonesWithContext is an alternate form of the VpcGetZones method which supports a Context parameter
+	VpcGetZonesWithContext(ctx context.Context, vpcGetZonesOptions *VpcGetZonesOptions) ([][]ZoneResponse, *core.DetailedResponse, error)
+
+	// VpcReplaceWorker : Replace a worker node with a new worker node
+	VpcReplaceWorker(vpcReplaceWorkerOptions *VpcReplaceWorkerOptions) (*core.DetailedResponse, error)
+
+	// VpcReplaceWorkerWithContext is an alternate form of the VpcReplaceWorker method which supports a Context parameter
+	VpcReplaceWorkerWithContext(ctx context.Context, vpcReplaceWorkerOptions *VpcReplaceWorkerOptions) (*core.DetailedResponse, error)
+
+	// NewClusterAddon : Instantiate ClusterAddon (Generic Model Constructor)
+	NewClusterAddon(name string) (*ClusterAddon, error)
+
+	// WaitForWorkerState polls the worker until predicate is satisfied and returns the last worker read.
+	WaitForWorkerState(ctx context.Context, cluster string, worker string, predicate WorkerPredicate, options *WorkerWaitOptions) (*GetWorkerResponse, error)
+
+	// WaitForWorkerReady waits until the worker is deployed, healthy and has no pending operation, for example after a
+	// reload or reboot with UpdateClusterWorker.
+	WaitForWorkerReady(ctx context.Context, cluster string, worker string, options *WorkerWaitOptions) (*GetWorkerResponse, error)
+
+	// WaitForWorkers polls the workers of the cluster, or of options.Pool, until every one of them satisfies predicate,
+	// and at least options.MinWorkers are listed, and returns the workers of the last poll.
+	WaitForWorkers(ctx context.Context, cluster string, predicate WorkerPredicate, options *WorkersWaitOptions) ([]GetWorkerResponse, error)
+
+	// WaitForWorkerPoolReady waits until every worker of the pool is ready and, if version is not empty, runs that
+	// Kubernetes version.
+	WaitForWorkerPoolReady(ctx context.Context, cluster string, pool string, version string, options *WorkersWaitOptions) ([]GetWorkerResponse, error)
+}
+
+var _ API = (*KubernetesServiceApiV1)(nil)
@@ -0,0 +1,151 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// APIError is returned by the KubernetesServiceApiV1 operations when the service responds with a non-2xx status code.
+// The error body, either an ErrorResponse or a ResponseErrors, is decoded into Items and IncidentID.
+type APIError struct {
+	// The operation that failed, e.g. "GetCluster".
+	OperationID string
+
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The incident ID to provide to IBM Cloud support.
+	IncidentID string
+
+	// The decoded error items. An ErrorResponse body is decoded as a single item.
+	Items []UserError
+
+	// The detailed response that carried the error.
+	Response *core.DetailedResponse
+
+	// The error reported by the core library.
+	err error
+}
+
+// Error returns the description of the first error item, falling back to the message reported by the core library.
+func (apiError *APIError) Error() string {
+	var details []string
+	details = append(details, fmt.Sprintf("status: %d", apiError.StatusCode))
+	message := apiError.err.Error()
+	if len(apiError.Items) > 0 {
+		item := apiError.Items[0]
+		if item.Description != nil && *item.Description != "" {
+			message = *item.Description
+		}
+		if item.Code != nil && *item.Code != "" {
+			details = append(details, fmt.Sprintf("code: %s", *item.Code))
+		}
+	}
+	if apiError.IncidentID != "" {
+		details = append(details, fmt.Sprintf("incidentID: %s", apiError.IncidentID))
+	}
+	return fmt.Sprintf("%s: %s (%s)", apiError.OperationID, message, strings.Join(details, ", "))
+}
+
+// Unwrap returns the error reported by the core library, so that errors.Is and errors.As see through the APIError.
+func (apiError *APIError) Unwrap() error {
+	return apiError.err
+}
+
+// Code returns the code of the first error item, or an empty string if the body carried none.
+func (apiError *APIError) Code() string {
+	if len(apiError.Items) > 0 && apiError.Items[0].Code != nil {
+		return *apiError.Items[0].Code
+	}
+	return ""
+}
+
+// Type returns the type of the first error item, or an empty string if the body carried none.
+func (apiError *APIError) Type() string {
+	if len(apiError.Items) > 0 && apiError.Items[0].Type != nil {
+		return *apiError.Items[0].Type
+	}
+	return ""
+}
+
+// RecoveryCLI returns the recovery command of the first error item, or an empty string if the body carried none.
+func (apiError *APIError) RecoveryCLI() string {
+	if len(apiError.Items) > 0 && apiError.Items[0].RecoveryCLI != nil {
+		return *apiError.Items[0].RecoveryCLI
+	}
+	return ""
+}
+
+// newAPIError builds an APIError for operationID from a non-2xx response and the error returned by the core library.
+func newAPIError(operationID string, response *core.DetailedResponse, err error) *APIError {
+	apiError := &APIError{
+		OperationID: operationID,
+		StatusCode:  response.StatusCode,
+		Response:    response,
+		err:         err,
+	}
+
+	var body []byte
+	if resultMap, ok := response.GetResultAsMap(); ok {
+		body, _ = json.Marshal(resultMap)
+	} else {
+		body = response.RawResult
+	}
+	var rawError map[string]json.RawMessage
+	if len(body) == 0 || json.Unmarshal(body, &rawError) != nil {
+		return apiError
+	}
+
+	if _, ok := rawError["items"]; ok {
+		var responseErrors *ResponseErrors
+		if core.UnmarshalModel(rawError, "", &responseErrors, UnmarshalResponseErrors) == nil && responseErrors != nil {
+			apiError.Items = responseErrors.Items
+			if responseErrors.IncidentID != nil {
+				apiError.IncidentID = *responseErrors.IncidentID
+			}
+		}
+		return apiError
+	}
+
+	var errorResponse *ErrorResponse
+	if core.UnmarshalModel(rawError, "", &errorResponse, UnmarshalErrorResponse) == nil && errorResponse != nil {
+		if errorResponse.IncidentID != nil {
+			apiError.IncidentID = *errorResponse.IncidentID
+		}
+		if errorResponse.Code != nil || errorResponse.Description != nil {
+			apiError.Items = []UserError{{
+				Code:             errorResponse.Code,
+				Description:      errorResponse.Description,
+				RecoveryCLI:      errorResponse.RecoveryCLI,
+				TerseDescription: errorResponse.TerseDescription,
+				Type:             errorResponse.Type,
+			}}
+		}
+	}
+	return apiError
+}
+
+// isAuthenticationError reports whether err was raised by the authenticator rather than by the service.
+func isAuthenticationError(err error) bool {
+	prefix := strings.SplitN(core.ERRORMSG_AUTHENTICATE_ERROR, "%s", 2)[0]
+	return strings.HasPrefix(err.Error(), prefix)
+}
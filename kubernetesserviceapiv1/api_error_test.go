@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+var _ = Describe(`APIError`, func() {
+	var testServer *httptest.Server
+	var kubernetesServiceApiService *kubernetesserviceapiv1.KubernetesServiceApiV1
+
+	var startServer = func(statusCode int, contentType string, body string) {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", contentType)
+			res.WriteHeader(statusCode)
+			fmt.Fprintf(res, "%s", body)
+		}))
+		var serviceErr error
+		kubernetesServiceApiService, serviceErr = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Decodes an ErrorResponse body`, func() {
+		startServer(404, "application/json", `{"code": "E0040", "description": "The specified cluster could not be found.", "incidentID": "abc-123", "recoveryCLI": "ibmcloud ks cluster ls", "terseDescription": "Cluster not found", "type": "NotFound"}`)
+
+		result, response, operationErr := kubernetesServiceApiService.GetCluster(kubernetesServiceApiService.NewGetClusterOptions("myCluster"))
+		Expect(result).To(BeNil())
+		Expect(response).ToNot(BeNil())
+
+		var apiError *kubernetesserviceapiv1.APIError
+		Expect(errors.As(operationErr, &apiError)).To(BeTrue())
+		Expect(apiError.OperationID).To(Equal("GetCluster"))
+		Expect(apiError.StatusCode).To(Equal(404))
+		Expect(apiError.IncidentID).To(Equal("abc-123"))
+		Expect(apiError.Items).To(HaveLen(1))
+		Expect(apiError.Code()).To(Equal("E0040"))
+		Expect(apiError.Type()).To(Equal("NotFound"))
+		Expect(apiError.RecoveryCLI()).To(Equal("ibmcloud ks cluster ls"))
+		Expect(apiError.Response).To(Equal(response))
+		Expect(apiError.Error()).To(Equal("GetCluster: The specified cluster could not be found. (status: 404, code: E0040, incidentID: abc-123)"))
+	})
+	It(`Decodes a ResponseErrors body`, func() {
+		startServer(400, "application/json", `{"incidentID": "def-456", "items": [{"code": "E1", "description": "first"}, {"code": "E2", "description": "second"}]}`)
+
+		_, operationErr := kubernetesServiceApiService.RemoveClusterWorker(kubernetesServiceApiService.NewRemoveClusterWorkerOptions("myCluster", "myWorker"))
+
+		var apiError *kubernetesserviceapiv1.APIError
+		Expect(errors.As(operationErr, &apiError)).To(BeTrue())
+		Expect(apiError.OperationID).To(Equal("RemoveClusterWorker"))
+		Expect(apiError.IncidentID).To(Equal("def-456"))
+		Expect(apiError.Items).To(HaveLen(2))
+		Expect(*apiError.Items[1].Code).To(Equal("E2"))
+		Expect(apiError.Code()).To(Equal("E1"))
+	})
+	It(`Falls back to the status text for a non-JSON body`, func() {
+		startServer(503, "text/plain", `service unavailable`)
+
+		_, operationErr := kubernetesServiceApiService.RemoveUserCredentials(kubernetesServiceApiService.NewRemoveUserCredentialsOptions("us-south"))
+
+		var apiError *kubernetesserviceapiv1.APIError
+		Expect(errors.As(operationErr, &apiError)).To(BeTrue())
+		Expect(apiError.StatusCode).To(Equal(503))
+		Expect(apiError.Items).To(BeEmpty())
+		Expect(apiError.Code()).To(BeEmpty())
+		Expect(apiError.Error()).To(Equal("RemoveUserCredentials: Service Unavailable (status: 503)"))
+		Expect(apiError.Unwrap()).To(MatchError("Service Unavailable"))
+		Expect(errors.Unwrap(operationErr)).To(Equal(apiError.Unwrap()))
+	})
+	It(`Does not wrap successful responses`, func() {
+		startServer(200, "application/json", `} this is not valid json {`)
+
+		_, _, operationErr := kubernetesServiceApiService.GetCluster(kubernetesServiceApiService.NewGetClusterOptions("myCluster"))
+		Expect(operationErr).ToNot(BeNil())
+
+		var apiError *kubernetesserviceapiv1.APIError
+		Expect(errors.As(operationErr, &apiError)).To(BeFalse())
+	})
+})
@@ -0,0 +1,286 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MaxClusterConfigFileSize is the largest file that ExtractClusterConfigArchive extracts from an archive.
+const MaxClusterConfigFileSize = 16 << 20
+
+// ClusterConfigManifest : The files extracted from a cluster configuration archive. The paths of the files that were
+// not in the archive are empty.
+type ClusterConfigManifest struct {
+	// The directory the archive was extracted into.
+	Dir string
+
+	// The paths of all the extracted files, sorted.
+	Files []string
+
+	// The path of the kubeconfig file.
+	KubeconfigPath string
+
+	// The path of the CA certificate of the cluster.
+	CACertificatePath string
+
+	// The path of the Calico network configuration, extracted when CreateNetworkConfig is set.
+	CalicoConfigPath string
+
+	// The paths of the admin certificate and key, extracted with the admin configuration.
+	AdminCertificatePath string
+	AdminKeyPath         string
+}
+
+// GetClusterConfigToWriter is an alternate form of the GetClusterConfig method which copies the archive to writer
+// as it is received and returns the number of bytes written.
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterConfigToWriter(getClusterConfigOptions *GetClusterConfigOptions, writer io.Writer) (int64, *core.DetailedResponse, error) {
+	return kubernetesServiceApi.GetClusterConfigToWriterWithContext(context.Background(), getClusterConfigOptions, writer)
+}
+
+// GetClusterConfigToWriterWithContext is an alternate form of the GetClusterConfigToWriter method which supports a
+// Context parameter
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterConfigToWriterWithContext(ctx context.Context, getClusterConfigOptions *GetClusterConfigOptions, writer io.Writer) (written int64, response *core.DetailedResponse, err error) {
+	response, err = kubernetesServiceApi.GetClusterConfigWithContext(ctx, getClusterConfigOptions)
+	if err != nil {
+		return
+	}
+	body, ok := response.Result.(io.ReadCloser)
+	if !ok {
+		err = fmt.Errorf("the response has no body")
+		return
+	}
+	defer body.Close()
+	written, err = io.Copy(writer, body)
+	return
+}
+
+// ExtractClusterConfig is an alternate form of the GetClusterConfig method which streams the archive to a temporary
+// file and extracts it into dir with ExtractClusterConfigArchive. Format must not be `yaml`, which returns a single
+// file rather than an archive.
+func (kubernetesServiceApi *KubernetesServiceApiV1) ExtractClusterConfig(getClusterConfigOptions *GetClusterConfigOptions, dir string) (*ClusterConfigManifest, *core.DetailedResponse, error) {
+	return kubernetesServiceApi.ExtractClusterConfigWithContext(context.Background(), getClusterConfigOptions, dir)
+}
+
+// ExtractClusterConfigWithContext is an alternate form of the ExtractClusterConfig method which supports a Context
+// parameter
+func (kubernetesServiceApi *KubernetesServiceApiV1) ExtractClusterConfigWithContext(ctx context.Context, getClusterConfigOptions *GetClusterConfigOptions, dir string) (result *ClusterConfigManifest, response *core.DetailedResponse, err error) {
+	archive, err := ioutil.TempFile("", "cluster-config")
+	if err != nil {
+		return
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	_, response, err = kubernetesServiceApi.GetClusterConfigToWriterWithContext(ctx, getClusterConfigOptions, archive)
+	if err != nil {
+		return
+	}
+	if err = archive.Close(); err != nil {
+		return
+	}
+	result, err = ExtractClusterConfigArchive(archive.Name(), dir)
+	return
+}
+
+// ExtractClusterConfigArchive extracts the zip, tar or gzipped tar archive returned by GetClusterConfig into dir and
+// returns a manifest of the extracted files. Directories are created with 0700 permissions and files with 0600.
+// Entries that would be written outside of dir, links and files larger than MaxClusterConfigFileSize are rejected.
+func ExtractClusterConfigArchive(archive string, dir string) (*ClusterConfigManifest, error) {
+	file, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	manifest := &ClusterConfigManifest{Dir: dir}
+
+	reader := bufio.NewReader(file)
+	header, _ := reader.Peek(512)
+	switch {
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")):
+		err = extractZip(file, manifest)
+	case bytes.HasPrefix(header, []byte{0x1f, 0x8b}):
+		var gzipReader *gzip.Reader
+		gzipReader, err = gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("error reading cluster config archive: %s", err.Error())
+		}
+		defer gzipReader.Close()
+		err = extractTar(gzipReader, manifest)
+	case len(header) > 262 && string(header[257:262]) == "ustar":
+		err = extractTar(reader, manifest)
+	default:
+		return nil, fmt.Errorf("error reading cluster config archive: unsupported archive format")
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(manifest.Files)
+	return manifest, nil
+}
+
+// extractZip extracts the zip archive file into manifest.Dir.
+func extractZip(file *os.File, manifest *ClusterConfigManifest) error {
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	reader, err := zip.NewReader(file, info.Size())
+	if err != nil {
+		return fmt.Errorf("error reading cluster config archive: %s", err.Error())
+	}
+	for _, entry := range reader.File {
+		mode := entry.Mode()
+		if mode&os.ModeSymlink != 0 {
+			return fmt.Errorf("cluster config archive entry '%s' is a link", entry.Name)
+		}
+		if mode.IsDir() {
+			if err := extractDir(manifest.Dir, entry.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		content, err := entry.Open()
+		if err != nil {
+			return fmt.Errorf("error reading cluster config archive: %s", err.Error())
+		}
+		err = extractFile(manifest, entry.Name, content)
+		content.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractTar extracts the tar archive read from reader into manifest.Dir.
+func extractTar(reader io.Reader, manifest *ClusterConfigManifest) error {
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error reading cluster config archive: %s", err.Error())
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = extractDir(manifest.Dir, header.Name)
+		case tar.TypeReg:
+			err = extractFile(manifest, header.Name, tarReader)
+		case tar.TypeSymlink, tar.TypeLink:
+			err = fmt.Errorf("cluster config archive entry '%s' is a link", header.Name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// extractDir creates the directory of the archive entry name under dir.
+func extractDir(dir string, name string) error {
+	target, err := archiveEntryPath(dir, name)
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(target, 0700)
+}
+
+// extractFile writes the archive entry name under manifest.Dir and records it in manifest.
+func extractFile(manifest *ClusterConfigManifest, name string, content io.Reader) error {
+	target, err := archiveEntryPath(manifest.Dir, name)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+		return err
+	}
+	if info, err := os.Lstat(target); err == nil && !info.Mode().IsRegular() {
+		return fmt.Errorf("cluster config archive entry '%s' would replace a file that is not regular", name)
+	}
+
+	file, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	written, err := io.Copy(file, io.LimitReader(content, MaxClusterConfigFileSize+1))
+	if err == nil && written > MaxClusterConfigFileSize {
+		err = fmt.Errorf("cluster config archive entry '%s' is larger than %d bytes", name, MaxClusterConfigFileSize)
+	}
+	if err == nil {
+		err = file.Chmod(0600)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(target)
+		return err
+	}
+
+	manifest.Files = append(manifest.Files, target)
+	base := strings.ToLower(path.Base(filepath.ToSlash(target)))
+	extension := path.Ext(base)
+	switch {
+	case base == "admin-key.pem":
+		manifest.AdminKeyPath = target
+	case base == "admin.pem":
+		manifest.AdminCertificatePath = target
+	case strings.HasPrefix(base, "ca") && extension == ".pem":
+		manifest.CACertificatePath = target
+	case strings.Contains(base, "calico"):
+		manifest.CalicoConfigPath = target
+	case strings.HasPrefix(base, "kube-config") && (extension == ".yml" || extension == ".yaml"):
+		manifest.KubeconfigPath = target
+	}
+	return nil
+}
+
+// archiveEntryPath returns the path that the archive entry name is extracted to, or an error if it is absolute or
+// would be outside of dir.
+func archiveEntryPath(dir string, name string) (string, error) {
+	slashed := strings.ReplaceAll(name, "\\", "/")
+	cleaned := path.Clean(slashed)
+	if slashed == "" || path.IsAbs(slashed) || filepath.VolumeName(name) != "" || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("cluster config archive entry '%s' is outside of the target directory", name)
+	}
+	return filepath.Join(dir, filepath.FromSlash(cleaned)), nil
+}
@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+var _ = Describe(`Cluster config`, func() {
+	var testServer *httptest.Server
+	var kubernetesServiceApiService *kubernetesserviceapiv1.KubernetesServiceApiV1
+	var dir string
+
+	var startServer = func(body []byte) {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/v1/clusters/c1/config"))
+			res.Header().Set("Content-type", "application/zip")
+			res.WriteHeader(200)
+			res.Write(body)
+		}))
+		var serviceErr error
+		kubernetesServiceApiService, serviceErr = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	var zipArchive = func(files map[string]string) []byte {
+		var buffer bytes.Buffer
+		writer := zip.NewWriter(&buffer)
+		for name, content := range files {
+			file, err := writer.Create(name)
+			Expect(err).To(BeNil())
+			file.Write([]byte(content))
+		}
+		Expect(writer.Close()).To(BeNil())
+		return buffer.Bytes()
+	}
+
+	var tarGzArchive = func(headers ...*tar.Header) []byte {
+		var buffer bytes.Buffer
+		gzipWriter := gzip.NewWriter(&buffer)
+		writer := tar.NewWriter(gzipWriter)
+		for _, header := range headers {
+			header.Mode = 0644
+			header.Size = int64(len(header.Name))
+			if header.Typeflag != tar.TypeReg {
+				header.Size = 0
+			}
+			Expect(writer.WriteHeader(header)).To(BeNil())
+			writer.Write([]byte(header.Name)[:header.Size])
+		}
+		Expect(writer.Close()).To(BeNil())
+		Expect(gzipWriter.Close()).To(BeNil())
+		return buffer.Bytes()
+	}
+
+	var writeArchive = func(content []byte) string {
+		archive := filepath.Join(dir, "archive")
+		Expect(ioutil.WriteFile(archive, content, 0600)).To(BeNil())
+		return archive
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "cluster-config")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+		if testServer != nil {
+			testServer.Close()
+			testServer = nil
+		}
+	})
+
+	It(`Streams the archive to a writer`, func() {
+		archive := zipArchive(map[string]string{"kube-config.yml": "config"})
+		startServer(archive)
+
+		var buffer bytes.Buffer
+		written, response, err := kubernetesServiceApiService.GetClusterConfigToWriter(kubernetesServiceApiService.NewGetClusterConfigOptions("token", "c1"), &buffer)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(written).To(Equal(int64(len(archive))))
+		Expect(buffer.Bytes()).To(Equal(archive))
+	})
+	It(`Extracts the archive and returns its manifest`, func() {
+		startServer(zipArchive(map[string]string{
+			"kubeConfig123/kube-config-dal10-c1.yml": "config",
+			"kubeConfig123/ca-dal10-c1.pem":          "ca",
+			"kubeConfig123/admin.pem":                "cert",
+			"kubeConfig123/admin-key.pem":            "key",
+			"kubeConfig123/calicoctl.cfg":            "calico",
+		}))
+		target := filepath.Join(dir, "out")
+
+		manifest, _, err := kubernetesServiceApiService.ExtractClusterConfig(kubernetesServiceApiService.NewGetClusterConfigOptions("token", "c1"), target)
+		Expect(err).To(BeNil())
+		Expect(manifest.Files).To(HaveLen(5))
+		Expect(manifest.KubeconfigPath).To(Equal(filepath.Join(target, "kubeConfig123", "kube-config-dal10-c1.yml")))
+		Expect(manifest.CACertificatePath).To(Equal(filepath.Join(target, "kubeConfig123", "ca-dal10-c1.pem")))
+		Expect(manifest.CalicoConfigPath).To(Equal(filepath.Join(target, "kubeConfig123", "calicoctl.cfg")))
+		Expect(manifest.AdminCertificatePath).ToNot(BeEmpty())
+		Expect(manifest.AdminKeyPath).ToNot(BeEmpty())
+
+		info, err := os.Stat(manifest.AdminKeyPath)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		info, err = os.Stat(filepath.Join(target, "kubeConfig123"))
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0700)))
+		content, _ := ioutil.ReadFile(manifest.CalicoConfigPath)
+		Expect(string(content)).To(Equal("calico"))
+	})
+	It(`Extracts a gzipped tar archive`, func() {
+		archive := writeArchive(tarGzArchive(
+			&tar.Header{Name: "config/", Typeflag: tar.TypeDir},
+			&tar.Header{Name: "config/kube-config.yaml", Typeflag: tar.TypeReg},
+		))
+
+		manifest, err := kubernetesserviceapiv1.ExtractClusterConfigArchive(archive, filepath.Join(dir, "out"))
+		Expect(err).To(BeNil())
+		Expect(manifest.KubeconfigPath).To(Equal(filepath.Join(dir, "out", "config", "kube-config.yaml")))
+	})
+	It(`Rejects path traversal`, func() {
+		for _, name := range []string{"../evil.pem", "config/../../evil.pem", "/etc/evil.pem"} {
+			archive := writeArchive(zipArchive(map[string]string{name: "evil"}))
+
+			_, err := kubernetesserviceapiv1.ExtractClusterConfigArchive(archive, filepath.Join(dir, "out"))
+			Expect(err).ToNot(BeNil(), name)
+			Expect(err.Error()).To(ContainSubstring("outside of the target directory"))
+		}
+		_, err := os.Stat(filepath.Join(dir, "evil.pem"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+	It(`Rejects links`, func() {
+		archive := writeArchive(tarGzArchive(&tar.Header{Name: "ca.pem", Linkname: "/etc/passwd", Typeflag: tar.TypeSymlink}))
+
+		_, err := kubernetesserviceapiv1.ExtractClusterConfigArchive(archive, filepath.Join(dir, "out"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("is a link"))
+	})
+	It(`Rejects a body that is not an archive`, func() {
+		archive := writeArchive([]byte("apiVersion: v1\nkind: Config\n"))
+
+		_, err := kubernetesserviceapiv1.ExtractClusterConfigArchive(archive, filepath.Join(dir, "out"))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,268 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// Cluster states reported in GetClusterResponse.State.
+const (
+	ClusterStateAborted      = "aborted"
+	ClusterStateCritical     = "critical"
+	ClusterStateDeleteFailed = "delete_failed"
+	ClusterStateDeleted      = "deleted"
+	ClusterStateDeleting     = "deleting"
+	ClusterStateDeployFailed = "deploy_failed"
+	ClusterStateDeploying    = "deploying"
+	ClusterStateNormal       = "normal"
+	ClusterStatePending      = "pending"
+	ClusterStateRequested    = "requested"
+	ClusterStateWarning      = "warning"
+)
+
+// Master states reported in CommonClusterLifecycle.MasterState.
+const (
+	MasterStateDeleteFailed    = "delete_failed"
+	MasterStateDeleting        = "deleting"
+	MasterStateDeployFailed    = "deploy_failed"
+	MasterStateDeployed        = "deployed"
+	MasterStateDeploying       = "deploying"
+	MasterStateUpdateCancelled = "update_cancelled"
+	MasterStateUpdateFailed    = "update_failed"
+	MasterStateUpdating        = "updating"
+)
+
+// Master health values reported in CommonClusterLifecycle.MasterHealth.
+const (
+	MasterHealthError       = "error"
+	MasterHealthNormal      = "normal"
+	MasterHealthUnavailable = "unavailable"
+	MasterHealthUnsupported = "unsupported"
+)
+
+// DefaultNotFoundGracePeriod is how long a waiter tolerates a 404 for a resource that was just created.
+const DefaultNotFoundGracePeriod = 5 * time.Minute
+
+// TerminalStateError is returned by a waiter when the resource reaches a failure state it does not recover from
+// without intervention.
+type TerminalStateError struct {
+	// The kind of resource, e.g. "cluster" or "worker".
+	Resource string
+
+	// The name or ID of the resource.
+	ID string
+
+	// The state field that failed, e.g. "state" or "masterState".
+	Field string
+
+	// The failure state.
+	State string
+}
+
+// Error returns a description of the failure state.
+func (terminalStateError *TerminalStateError) Error() string {
+	return fmt.Sprintf("%s '%s' reached terminal %s '%s'", terminalStateError.Resource, terminalStateError.ID,
+		terminalStateError.Field, terminalStateError.State)
+}
+
+// ClusterPredicate reports whether a cluster has reached the awaited state.
+// A non-nil error stops the wait and is returned to the caller.
+type ClusterPredicate func(cluster *GetClusterResponse) (done bool, err error)
+
+// ClusterWaitOptions : The options of the cluster waiters.
+type ClusterWaitOptions struct {
+	common.WaitOptions
+
+	// Poll with VpcGetCluster instead of GetCluster.
+	VPC bool
+
+	// The ID of the resource group that the cluster is in.
+	XAuthResourceGroup *string
+
+	// How long a 404 is tolerated after the wait starts, to cover the delay before a newly created cluster can be
+	// read. Defaults to DefaultNotFoundGracePeriod.
+	NotFoundGracePeriod time.Duration
+
+	// Called with the cluster after every successful poll.
+	OnProgress func(cluster *GetClusterResponse)
+}
+
+// ClusterStateIs returns a predicate that is satisfied when the cluster state is one of states.
+func ClusterStateIs(states ...string) ClusterPredicate {
+	return func(cluster *GetClusterResponse) (bool, error) {
+		return cluster.State != nil && containsString(states, *cluster.State), nil
+	}
+}
+
+// ClusterMasterReady is satisfied when the cluster master is deployed and healthy.
+func ClusterMasterReady(cluster *GetClusterResponse) (bool, error) {
+	lifecycle := cluster.Lifecycle
+	if lifecycle == nil || lifecycle.MasterState == nil || lifecycle.MasterHealth == nil {
+		return false, nil
+	}
+	return *lifecycle.MasterState == MasterStateDeployed && *lifecycle.MasterHealth == MasterHealthNormal, nil
+}
+
+// ClusterMasterVersion returns a predicate that is satisfied when the master runs version, which may be a full
+// version such as "1.29.3_1547" or a prefix such as "1.29".
+func ClusterMasterVersion(version string) ClusterPredicate {
+	return func(cluster *GetClusterResponse) (bool, error) {
+		return cluster.MasterKubeVersion != nil && versionMatches(*cluster.MasterKubeVersion, version), nil
+	}
+}
+
+// AllClusterPredicates returns a predicate that is satisfied when every one of predicates is satisfied.
+func AllClusterPredicates(predicates ...ClusterPredicate) ClusterPredicate {
+	return func(cluster *GetClusterResponse) (bool, error) {
+		for _, predicate := range predicates {
+			done, err := predicate(cluster)
+			if !done || err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+}
+
+// WaitForClusterState polls the cluster until predicate is satisfied and returns the last cluster read.
+// The wait stops early with a *TerminalStateError when the cluster or its master reaches a failure state.
+func (kubernetesServiceApi *KubernetesServiceApiV1) WaitForClusterState(ctx context.Context, cluster string, predicate ClusterPredicate, options *ClusterWaitOptions) (result *GetClusterResponse, err error) {
+	if options == nil {
+		options = &ClusterWaitOptions{}
+	}
+	notFoundDeadline := time.Now().Add(notFoundGracePeriod(options.NotFoundGracePeriod))
+
+	err = common.Poll(ctx, &options.WaitOptions, func(ctx context.Context) (bool, error) {
+		clusterResult, getErr := kubernetesServiceApi.getClusterForWait(ctx, cluster, options)
+		if getErr != nil {
+			if isNotFound(getErr) && time.Now().Before(notFoundDeadline) {
+				return false, nil
+			}
+			return false, getErr
+		}
+		result = clusterResult
+		if options.OnProgress != nil {
+			options.OnProgress(clusterResult)
+		}
+
+		done, predicateErr := predicate(clusterResult)
+		if done || predicateErr != nil {
+			return done, predicateErr
+		}
+		return false, clusterTerminalStateError(cluster, clusterResult)
+	})
+	return
+}
+
+// WaitForClusterNormal waits until the cluster state is normal and its master is deployed and healthy.
+func (kubernetesServiceApi *KubernetesServiceApiV1) WaitForClusterNormal(ctx context.Context, cluster string, options *ClusterWaitOptions) (*GetClusterResponse, error) {
+	return kubernetesServiceApi.WaitForClusterState(ctx, cluster, AllClusterPredicates(ClusterStateIs(ClusterStateNormal), ClusterMasterReady), options)
+}
+
+// WaitForClusterDeleted waits until reading the cluster returns a 404 or its state is deleted.
+// The wait stops early with a *TerminalStateError when the deletion fails.
+func (kubernetesServiceApi *KubernetesServiceApiV1) WaitForClusterDeleted(ctx context.Context, cluster string, options *ClusterWaitOptions) error {
+	if options == nil {
+		options = &ClusterWaitOptions{}
+	}
+	return common.Poll(ctx, &options.WaitOptions, func(ctx context.Context) (bool, error) {
+		clusterResult, getErr := kubernetesServiceApi.getClusterForWait(ctx, cluster, options)
+		if getErr != nil {
+			if isNotFound(getErr) {
+				return true, nil
+			}
+			return false, getErr
+		}
+		if options.OnProgress != nil {
+			options.OnProgress(clusterResult)
+		}
+		if clusterResult.State != nil && *clusterResult.State == ClusterStateDeleted {
+			return true, nil
+		}
+		if clusterResult.State != nil && *clusterResult.State == ClusterStateDeleteFailed {
+			return false, &TerminalStateError{Resource: "cluster", ID: cluster, Field: "state", State: *clusterResult.State}
+		}
+		return false, nil
+	})
+}
+
+// getClusterForWait reads the cluster with GetCluster or VpcGetCluster, as selected by options.
+func (kubernetesServiceApi *KubernetesServiceApiV1) getClusterForWait(ctx context.Context, cluster string, options *ClusterWaitOptions) (result *GetClusterResponse, err error) {
+	if options.VPC {
+		vpcGetClusterOptions := kubernetesServiceApi.NewVpcGetClusterOptions(cluster)
+		vpcGetClusterOptions.XAuthResourceGroup = options.XAuthResourceGroup
+		result, _, err = kubernetesServiceApi.VpcGetClusterWithContext(ctx, vpcGetClusterOptions)
+		return
+	}
+	getClusterOptions := kubernetesServiceApi.NewGetClusterOptions(cluster)
+	getClusterOptions.XAuthResourceGroup = options.XAuthResourceGroup
+	result, _, err = kubernetesServiceApi.GetClusterWithContext(ctx, getClusterOptions)
+	return
+}
+
+// clusterTerminalStateError returns a *TerminalStateError if the cluster or its master is in a failure state.
+func clusterTerminalStateError(cluster string, clusterResult *GetClusterResponse) error {
+	if clusterResult.State != nil {
+		switch *clusterResult.State {
+		case ClusterStateAborted, ClusterStateDeployFailed, ClusterStateDeleteFailed, ClusterStateDeleted:
+			return &TerminalStateError{Resource: "cluster", ID: cluster, Field: "state", State: *clusterResult.State}
+		}
+	}
+	if clusterResult.Lifecycle != nil && clusterResult.Lifecycle.MasterState != nil {
+		switch *clusterResult.Lifecycle.MasterState {
+		case MasterStateDeployFailed, MasterStateDeleteFailed, MasterStateUpdateFailed, MasterStateUpdateCancelled:
+			return &TerminalStateError{Resource: "cluster", ID: cluster, Field: "masterState", State: *clusterResult.Lifecycle.MasterState}
+		}
+	}
+	return nil
+}
+
+// notFoundGracePeriod returns gracePeriod, or DefaultNotFoundGracePeriod if it is not set.
+func notFoundGracePeriod(gracePeriod time.Duration) time.Duration {
+	if gracePeriod <= 0 {
+		return DefaultNotFoundGracePeriod
+	}
+	return gracePeriod
+}
+
+// isNotFound reports whether err is an *APIError with a 404 status code.
+func isNotFound(err error) bool {
+	var apiError *APIError
+	return errors.As(err, &apiError) && apiError.StatusCode == http.StatusNotFound
+}
+
+// versionMatches reports whether actual is the version wanted, or a more specific version of it.
+func versionMatches(actual string, wanted string) bool {
+	return actual == wanted || strings.HasPrefix(actual, wanted+".") || strings.HasPrefix(actual, wanted+"_")
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+var _ = Describe(`Cluster waiters`, func() {
+	var testServer *httptest.Server
+	var kubernetesServiceApiService *kubernetesserviceapiv1.KubernetesServiceApiV1
+	var requestedPaths []string
+	var mutex sync.Mutex
+
+	// startServer serves the bodies in order, repeating the last one; an empty body is served as a 404.
+	var startServer = func(bodies ...string) {
+		requestedPaths = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			requestedPaths = append(requestedPaths, req.URL.Path)
+			body := bodies[0]
+			if len(bodies) > 1 {
+				bodies = bodies[1:]
+			}
+			res.Header().Set("Content-type", "application/json")
+			if body == "" {
+				res.WriteHeader(404)
+				fmt.Fprintf(res, `{"code": "E0040", "description": "The specified cluster could not be found."}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", body)
+		}))
+		var serviceErr error
+		kubernetesServiceApiService, serviceErr = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	var waitOptions = func() *kubernetesserviceapiv1.ClusterWaitOptions {
+		return &kubernetesserviceapiv1.ClusterWaitOptions{
+			WaitOptions: common.WaitOptions{Interval: time.Millisecond, Timeout: 5 * time.Second},
+		}
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Waits through transient 404s until the cluster is normal`, func() {
+		startServer(
+			"",
+			`{"id": "c1", "state": "deploying", "lifecycle": {"masterState": "deploying", "masterHealth": "unavailable"}}`,
+			`{"id": "c1", "state": "normal", "lifecycle": {"masterState": "deployed", "masterHealth": "normal"}, "masterKubeVersion": "1.29.3_1547"}`,
+		)
+		var progress []string
+		options := waitOptions()
+		options.OnProgress = func(cluster *kubernetesserviceapiv1.GetClusterResponse) {
+			progress = append(progress, *cluster.State)
+		}
+
+		cluster, err := kubernetesServiceApiService.WaitForClusterNormal(context.Background(), "c1", options)
+		Expect(err).To(BeNil())
+		Expect(*cluster.State).To(Equal("normal"))
+		Expect(progress).To(Equal([]string{"deploying", "normal"}))
+		Expect(requestedPaths[0]).To(Equal("/v2/getCluster"))
+
+		done, _ := kubernetesserviceapiv1.ClusterMasterVersion("1.29")(cluster)
+		Expect(done).To(BeTrue())
+		done, _ = kubernetesserviceapiv1.ClusterMasterVersion("1.2")(cluster)
+		Expect(done).To(BeFalse())
+	})
+	It(`Stops early on a terminal master state`, func() {
+		startServer(`{"id": "c1", "state": "warning", "lifecycle": {"masterState": "update_failed", "masterHealth": "error"}}`)
+
+		_, err := kubernetesServiceApiService.WaitForClusterState(context.Background(), "c1", kubernetesserviceapiv1.ClusterMasterReady, waitOptions())
+		var terminalStateError *kubernetesserviceapiv1.TerminalStateError
+		Expect(errors.As(err, &terminalStateError)).To(BeTrue())
+		Expect(terminalStateError.Field).To(Equal("masterState"))
+		Expect(terminalStateError.State).To(Equal("update_failed"))
+	})
+	It(`Returns the 404 once the grace period has passed`, func() {
+		startServer("")
+		options := waitOptions()
+		options.NotFoundGracePeriod = 5 * time.Millisecond
+
+		_, err := kubernetesServiceApiService.WaitForClusterNormal(context.Background(), "c1", options)
+		var apiError *kubernetesserviceapiv1.APIError
+		Expect(errors.As(err, &apiError)).To(BeTrue())
+		Expect(apiError.StatusCode).To(Equal(404))
+	})
+	It(`Times out`, func() {
+		startServer(`{"id": "c1", "state": "deploying"}`)
+		options := waitOptions()
+		options.Timeout = 20 * time.Millisecond
+
+		cluster, err := kubernetesServiceApiService.WaitForClusterNormal(context.Background(), "c1", options)
+		Expect(err).To(Equal(common.ErrWaitTimeout))
+		Expect(*cluster.State).To(Equal("deploying"))
+	})
+	It(`Polls VpcGetCluster and waits for deletion`, func() {
+		startServer(`{"id": "c1", "state": "deleting"}`, "")
+		options := waitOptions()
+		options.VPC = true
+
+		err := kubernetesServiceApiService.WaitForClusterDeleted(context.Background(), "c1", options)
+		Expect(err).To(BeNil())
+		Expect(requestedPaths).To(Equal([]string{"/v2/vpc/getCluster", "/v2/vpc/getCluster"}))
+	})
+})
@@ -0,0 +1,269 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clusterspec_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/clusterspec"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+)
+
+const specYAML = `
+name: c1
+kubeVersion: "1.29"
+resourceGroup: rg1
+vpcID: vpc-1
+workerPools:
+  - name: default
+    flavor: bx2.4x16
+    workersPerZone: 2
+    zones:
+      - {id: us-south-1, subnetID: subnet-1}
+  - name: edge
+    flavor: cx2.2x4
+    workersPerZone: 1
+    labels: {dedicated: edge}
+    zones:
+      - {id: us-south-1, subnetID: subnet-1}
+      - {id: us-south-2, subnetID: subnet-2}
+acl:
+  entries: [10.0.0.0/8]
+addons:
+  - name: vpc-block-csi-driver
+    version: "5.2"
+updatePolicy:
+  autoUpdate: false
+`
+
+var _ = Describe(`Cluster spec`, func() {
+	var (
+		server     *fake.Server
+		service    *kubernetesserviceapiv1.KubernetesServiceApiV1
+		reconciler *clusterspec.Reconciler
+		ctx        = context.Background()
+	)
+
+	// actionTypes returns the types of actions.
+	var actionTypes = func(actions []clusterspec.Action) []clusterspec.ActionType {
+		types := []clusterspec.ActionType{}
+		for _, action := range actions {
+			types = append(types, action.Type)
+		}
+		return types
+	}
+
+	// loadSpec loads specYAML.
+	var loadSpec = func() *clusterspec.Spec {
+		spec, err := clusterspec.Load([]byte(specYAML))
+		Expect(err).To(BeNil())
+		return spec
+	}
+
+	// applySpec creates the cluster of spec.
+	var applySpec = func(spec *clusterspec.Spec) {
+		plan, err := reconciler.Plan(ctx, spec)
+		Expect(err).To(BeNil())
+		Expect(reconciler.Apply(ctx, plan)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		var err error
+		service, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		reconciler = clusterspec.NewReconciler(service, &clusterspec.Options{
+			Wait: &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: common.WaitOptions{Interval: 10 * time.Millisecond}},
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Plans and applies the creation of a cluster`, func() {
+		spec := loadSpec()
+		plan, err := reconciler.Plan(ctx, spec)
+		Expect(err).To(BeNil())
+		Expect(plan.ClusterID).To(BeEmpty())
+		Expect(actionTypes(plan.Actions)).To(Equal([]clusterspec.ActionType{
+			clusterspec.ActionCreateCluster,
+			clusterspec.ActionCreateWorkerPool,
+			clusterspec.ActionAddWorkerPoolZone,
+			clusterspec.ActionAddWorkerPoolZone,
+			clusterspec.ActionEnableAddon,
+			clusterspec.ActionAddACLEntries,
+			clusterspec.ActionSetAutoUpdate,
+		}))
+		Expect(plan.String()).To(ContainSubstring("+ create cluster c1 running 1.29 with worker pool default (bx2.4x16, 2 per zone in us-south-1)"))
+
+		Expect(reconciler.Apply(ctx, plan)).To(Succeed())
+
+		plan, err = reconciler.Plan(ctx, spec)
+		Expect(err).To(BeNil())
+		Expect(plan.ClusterID).ToNot(BeEmpty())
+		Expect(plan.Empty()).To(BeTrue(), plan.String())
+		Expect(plan.Skipped).To(BeEmpty())
+		Expect(plan.Notes).To(BeEmpty())
+		Expect(plan.String()).To(Equal("No changes to cluster c1.\n"))
+	})
+
+	It(`Plans the changes that make a cluster match its spec`, func() {
+		applySpec(loadSpec())
+
+		spec := loadSpec()
+		spec.KubeVersion = "1.30"
+		spec.WorkerPools[0].WorkersPerZone = 3
+		spec.WorkerPools[0].Zones = append(spec.WorkerPools[0].Zones, clusterspec.Zone{ID: "us-south-3", SubnetID: "subnet-3"})
+		spec.WorkerPools[1].Flavor = "cx2.4x8"
+		spec.WorkerPools[1].Labels = map[string]string{"dedicated": "gateway"}
+		spec.WorkerPools[1].Zones = spec.WorkerPools[1].Zones[:1]
+		spec.ACL.Entries = []string{"192.168.0.0/16"}
+		spec.Addons[0].Version = "5.3"
+		spec.Addons = append(spec.Addons, clusterspec.Addon{Name: "cluster-autoscaler"})
+		spec.PodSubnet = "172.17.0.0/18"
+		spec.UpdatePolicy.AutoUpdate = nil
+
+		plan, err := reconciler.Plan(ctx, spec)
+		Expect(err).To(BeNil())
+		Expect(actionTypes(plan.Actions)).To(Equal([]clusterspec.ActionType{
+			clusterspec.ActionAddWorkerPoolZone,
+			clusterspec.ActionResizeWorkerPool,
+			clusterspec.ActionSetWorkerPoolLabels,
+			clusterspec.ActionUpdateAddon,
+			clusterspec.ActionEnableAddon,
+			clusterspec.ActionAddACLEntries,
+			clusterspec.ActionRemoveACLEntries,
+		}))
+		Expect(actionTypes(plan.Skipped)).To(Equal([]clusterspec.ActionType{
+			clusterspec.ActionUpdateMaster,
+			clusterspec.ActionRemoveWorkerPoolZone,
+		}))
+		Expect(plan.Notes).To(ConsistOf(
+			"the pod subnet is 172.30.0.0/16, not 172.17.0.0/18, and cannot be changed",
+			"worker pool edge has flavor cx2.2x4, not cx2.4x8, and must be replaced to change it",
+		))
+		Expect(plan.String()).To(ContainSubstring("~ resize worker pool default from 2 to 3 workers per zone\n"))
+		Expect(plan.String()).To(ContainSubstring("~ set the labels of worker pool edge from {dedicated=edge} to {dedicated=gateway}\n"))
+		Expect(plan.String()).To(ContainSubstring("Skipped by the update policy:\n  ~ update master from 1.29 to 1.30\n  - remove zone us-south-2 from worker pool edge\n"))
+
+		Expect(reconciler.Apply(ctx, plan)).To(Succeed())
+
+		spec.UpdatePolicy = clusterspec.UpdatePolicy{UpdateMaster: true, Prune: true}
+		plan, err = reconciler.Plan(ctx, spec)
+		Expect(err).To(BeNil())
+		Expect(actionTypes(plan.Actions)).To(Equal([]clusterspec.ActionType{
+			clusterspec.ActionUpdateMaster,
+			clusterspec.ActionRemoveWorkerPoolZone,
+		}))
+		Expect(plan.Skipped).To(BeEmpty())
+		Expect(reconciler.Apply(ctx, plan)).To(Succeed())
+
+		spec.WorkerPools = spec.WorkerPools[:1]
+		plan, err = reconciler.Plan(ctx, spec)
+		Expect(err).To(BeNil())
+		Expect(actionTypes(plan.Actions)).To(Equal([]clusterspec.ActionType{clusterspec.ActionRemoveWorkerPool}))
+		Expect(reconciler.Apply(ctx, plan)).To(Succeed())
+
+		plan, err = reconciler.Plan(ctx, spec)
+		Expect(err).To(BeNil())
+		Expect(plan.Empty()).To(BeTrue(), plan.String())
+		Expect(plan.Notes).To(ConsistOf("the pod subnet is 172.30.0.0/16, not 172.17.0.0/18, and cannot be changed"))
+	})
+
+	It(`Serialises plans and reports the action that failed`, func() {
+		plan, err := reconciler.Plan(ctx, loadSpec())
+		Expect(err).To(BeNil())
+		data, err := json.Marshal(plan)
+		Expect(err).To(BeNil())
+		decoded := &clusterspec.Plan{}
+		Expect(json.Unmarshal(data, decoded)).To(Succeed())
+		Expect(decoded).To(Equal(plan))
+		Expect(decoded.String()).To(Equal(plan.String()))
+
+		server.InjectFault(fake.Fault{Method: "POST", Path: "/v2/vpc/createWorkerPool", StatusCode: 500})
+		err = reconciler.Apply(ctx, decoded)
+		Expect(err).To(MatchError(ContainSubstring("clusterspec: create worker pool edge (cx2.2x4, 1 per zone in us-south-1, us-south-2): ")))
+		var apiError *kubernetesserviceapiv1.APIError
+		Expect(errors.As(err, &apiError)).To(BeTrue())
+		Expect(apiError.StatusCode).To(Equal(500))
+	})
+
+	It(`Rejects invalid specs`, func() {
+		_, err := clusterspec.Load([]byte("name: c1\nworkerPool: []\n"))
+		Expect(err).To(MatchError(ContainSubstring("field workerPool not found")))
+
+		_, err = clusterspec.Load([]byte(`{"name": "c1", "podSubnet": "172.30.0.0", "workerPools": [{"name": "default", "flavor": "bx2.4x16", "workersPerZone": 0, "zones": [{"id": "us-south-1"}]}]}`))
+		var validationErrors *common.ValidationErrors
+		Expect(errors.As(err, &validationErrors)).To(BeTrue())
+		Expect(err).To(MatchError("Spec is not valid: PodSubnet must be a CIDR block, not '172.30.0.0'; " +
+			"WorkerPools[0].WorkersPerZone must be at least 1, not 0; WorkerPools[0].Zones[0].SubnetID is required"))
+
+		_, err = reconciler.Plan(ctx, &clusterspec.Spec{Name: "c1"})
+		Expect(err).To(MatchError(ContainSubstring("WorkerPools must list at least the default worker pool")))
+		Expect(server.Requests()).To(BeEmpty())
+	})
+
+	It(`Rejects invalid plans before applying them`, func() {
+		plan, err := reconciler.Plan(ctx, loadSpec())
+		Expect(err).To(BeNil())
+		data, err := json.Marshal(plan)
+		Expect(err).To(BeNil())
+		requests := len(server.Requests())
+
+		decoded := &clusterspec.Plan{}
+		Expect(json.Unmarshal([]byte(`{"clusterID": "c1", "actions": [{"type": "RemoveWorkerPool", "workerPool": "edge"}]}`), decoded)).To(Succeed())
+		Expect(decoded.String()).To(Equal("Changes to cluster c1:\n  - remove worker pool edge\n"))
+		Expect(reconciler.Apply(ctx, decoded)).To(MatchError("Plan is not valid: Spec is required"))
+
+		decoded = &clusterspec.Plan{}
+		Expect(json.Unmarshal(data, decoded)).To(Succeed())
+		decoded.Actions[2].Zone = nil
+		decoded.Actions[4].Addon = nil
+		decoded.Actions = append(decoded.Actions,
+			clusterspec.Action{Type: clusterspec.ActionRemoveWorkerPoolZone, WorkerPool: "edge"},
+			clusterspec.Action{Type: clusterspec.ActionUpdateAddon, To: "2.0"},
+			clusterspec.Action{Type: clusterspec.ActionResizeWorkerPool, WorkerPool: "edge", To: "many"},
+		)
+		Expect(decoded.String()).To(ContainSubstring("  + add zone ? (subnet ?) to worker pool edge\n  + add zone us-south-2"))
+		Expect(decoded.String()).To(ContainSubstring("  + enable addon ?\n"))
+		Expect(decoded.String()).To(ContainSubstring("  - remove zone ? from worker pool edge\n  ~ update addon ? from  to 2.0\n"))
+		err = reconciler.Apply(ctx, decoded)
+		var validationErrors *common.ValidationErrors
+		Expect(errors.As(err, &validationErrors)).To(BeTrue())
+		Expect(err).To(MatchError("Plan is not valid: Actions[2].Zone is required; Actions[4].Addon is required; " +
+			"Actions[7].Zone is required; Actions[8].Addon is required; Actions[9].To must be a number of workers per zone, not 'many'"))
+
+		decoded = &clusterspec.Plan{}
+		Expect(json.Unmarshal(data, decoded)).To(Succeed())
+		decoded.Actions = decoded.Actions[1:]
+		Expect(reconciler.Apply(ctx, decoded)).To(MatchError("Plan is not valid: ClusterID is required unless the first action creates the cluster"))
+		Expect(server.Requests()).To(HaveLen(requests))
+	})
+})
@@ -0,0 +1,286 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clusterspec
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// ActionType : The change an Action makes.
+type ActionType string
+
+// Types of the actions of a plan.
+const (
+	ActionCreateCluster        ActionType = "CreateCluster"
+	ActionSetAutoUpdate        ActionType = "SetAutoUpdate"
+	ActionUpdateMaster         ActionType = "UpdateMaster"
+	ActionCreateWorkerPool     ActionType = "CreateWorkerPool"
+	ActionAddWorkerPoolZone    ActionType = "AddWorkerPoolZone"
+	ActionResizeWorkerPool     ActionType = "ResizeWorkerPool"
+	ActionSetWorkerPoolLabels  ActionType = "SetWorkerPoolLabels"
+	ActionEnableAddon          ActionType = "EnableAddon"
+	ActionUpdateAddon          ActionType = "UpdateAddon"
+	ActionAddACLEntries        ActionType = "AddACLEntries"
+	ActionRemoveACLEntries     ActionType = "RemoveACLEntries"
+	ActionRemoveWorkerPoolZone ActionType = "RemoveWorkerPoolZone"
+	ActionRemoveWorkerPool     ActionType = "RemoveWorkerPool"
+)
+
+// Action : A change of a plan. Only the fields relevant to its type are set.
+type Action struct {
+	Type ActionType `yaml:"type" json:"type"`
+
+	// The worker pool created, changed or removed.
+	WorkerPool string `yaml:"workerPool,omitempty" json:"workerPool,omitempty"`
+
+	// The zone added to or removed from the worker pool.
+	Zone *Zone `yaml:"zone,omitempty" json:"zone,omitempty"`
+
+	// The addon enabled or updated.
+	Addon *Addon `yaml:"addon,omitempty" json:"addon,omitempty"`
+
+	// The ACL entries added or removed.
+	Entries []string `yaml:"entries,omitempty" json:"entries,omitempty"`
+
+	// The current value of what the action changes, for review, and the value it sets.
+	From string `yaml:"from,omitempty" json:"from,omitempty"`
+	To   string `yaml:"to,omitempty" json:"to,omitempty"`
+}
+
+// Plan : The actions that make a cluster match its spec, in the order they are applied.
+type Plan struct {
+	// The spec the plan was computed for.
+	Spec *Spec `yaml:"spec" json:"spec"`
+
+	// The ID of the cluster, or empty if the plan creates it.
+	ClusterID string `yaml:"clusterID,omitempty" json:"clusterID,omitempty"`
+
+	// The actions to apply.
+	Actions []Action `yaml:"actions" json:"actions"`
+
+	// The actions that the update policy of the spec does not allow, which are not applied.
+	Skipped []Action `yaml:"skipped,omitempty" json:"skipped,omitempty"`
+
+	// The differences between the cluster and its spec that cannot be reconciled, such as the flavor of a worker
+	// pool, which would require replacing it.
+	Notes []string `yaml:"notes,omitempty" json:"notes,omitempty"`
+}
+
+// Empty reports whether the plan has no actions to apply.
+func (plan *Plan) Empty() bool {
+	return len(plan.Actions) == 0
+}
+
+// Validate returns the violations of the constraints of the spec of the plan, or of its actions, which may have been
+// decoded or edited since the plan was computed, as a *common.ValidationErrors, or nil.
+func (plan *Plan) Validate() error {
+	if plan.Spec == nil {
+		validation := common.NewValidation("Plan")
+		validation.Errorf("Spec", "is required")
+		return validation.Err()
+	}
+	if err := plan.Spec.Validate(); err != nil {
+		return err
+	}
+
+	validation := common.NewValidation("Plan")
+	if len(plan.Actions) > 0 && plan.ClusterID == "" && plan.Actions[0].Type != ActionCreateCluster {
+		validation.Errorf("ClusterID", "is required unless the first action creates the cluster")
+	}
+	for i, action := range plan.Actions {
+		field := fmt.Sprintf("Actions[%d]", i)
+		switch action.Type {
+		case ActionCreateCluster:
+			if i > 0 || plan.ClusterID != "" {
+				validation.Errorf(field+".Type", "'%s' must be the first action of a plan without a cluster ID", action.Type)
+			}
+		case ActionSetAutoUpdate:
+			validation.Enum(field+".To", &action.To, onOff(true), onOff(false))
+		case ActionUpdateMaster:
+			if action.To == "" {
+				validation.Errorf(field+".To", "is required")
+			}
+		case ActionCreateWorkerPool, ActionSetWorkerPoolLabels:
+			if _, ok := plan.Spec.workerPool(action.WorkerPool); !ok {
+				validation.Errorf(field+".WorkerPool", "'%s' is not a worker pool of the spec", action.WorkerPool)
+			}
+		case ActionAddWorkerPoolZone, ActionRemoveWorkerPoolZone:
+			if action.WorkerPool == "" {
+				validation.Errorf(field+".WorkerPool", "is required")
+			}
+			if action.Zone == nil {
+				validation.Errorf(field+".Zone", "is required")
+				break
+			}
+			if action.Zone.ID == "" {
+				validation.Errorf(field+".Zone.ID", "is required")
+			}
+			if action.Type == ActionAddWorkerPoolZone && action.Zone.SubnetID == "" {
+				validation.Errorf(field+".Zone.SubnetID", "is required")
+			}
+		case ActionResizeWorkerPool:
+			if action.WorkerPool == "" {
+				validation.Errorf(field+".WorkerPool", "is required")
+			}
+			if size, err := strconv.ParseInt(action.To, 10, 64); err != nil || size < 1 {
+				validation.Errorf(field+".To", "must be a number of workers per zone, not '%s'", action.To)
+			}
+		case ActionEnableAddon, ActionUpdateAddon:
+			if action.Addon == nil || action.Addon.Name == "" {
+				validation.Errorf(field+".Addon", "is required")
+			}
+		case ActionAddACLEntries, ActionRemoveACLEntries:
+			if len(action.Entries) == 0 {
+				validation.Errorf(field+".Entries", "must list at least one entry")
+			}
+		case ActionRemoveWorkerPool:
+			if action.WorkerPool == "" {
+				validation.Errorf(field+".WorkerPool", "is required")
+			}
+		default:
+			validation.Errorf(field+".Type", "'%s' is not a type of action", action.Type)
+		}
+	}
+	return validation.Err()
+}
+
+// String returns the plan as printed for review, one line per action.
+func (plan *Plan) String() string {
+	var b strings.Builder
+	if plan.Empty() {
+		fmt.Fprintf(&b, "No changes to cluster %s.\n", plan.clusterName())
+	} else {
+		fmt.Fprintf(&b, "Changes to cluster %s:\n", plan.clusterName())
+		for _, action := range plan.Actions {
+			fmt.Fprintf(&b, "  %s\n", plan.describe(action))
+		}
+	}
+	if len(plan.Skipped) > 0 {
+		fmt.Fprintf(&b, "Skipped by the update policy:\n")
+		for _, action := range plan.Skipped {
+			fmt.Fprintf(&b, "  %s\n", plan.describe(action))
+		}
+	}
+	if len(plan.Notes) > 0 {
+		fmt.Fprintf(&b, "Notes:\n")
+		for _, note := range plan.Notes {
+			fmt.Fprintf(&b, "  ! %s\n", note)
+		}
+	}
+	return b.String()
+}
+
+// describe returns the line of action, prefixed with "+" for additions, "~" for changes and "-" for removals. It
+// prints "?" for what a plan that is not valid lacks, so that such a plan can still be reviewed.
+func (plan *Plan) describe(action Action) string {
+	zone := Zone{ID: "?", SubnetID: "?"}
+	if action.Zone != nil {
+		zone = *action.Zone
+	}
+	addon := Addon{Name: "?"}
+	if action.Addon != nil {
+		addon = *action.Addon
+	}
+	switch action.Type {
+	case ActionCreateCluster:
+		if plan.Spec == nil || len(plan.Spec.WorkerPools) == 0 {
+			return fmt.Sprintf("+ create cluster %s", plan.clusterName())
+		}
+		version := plan.Spec.KubeVersion
+		if version == "" {
+			version = "the default version"
+		}
+		return fmt.Sprintf("+ create cluster %s running %s with worker pool %s", plan.Spec.Name, version, describePool(plan.pool(plan.Spec.WorkerPools[0].Name)))
+	case ActionSetAutoUpdate:
+		return fmt.Sprintf("~ turn automatic master updates %s", action.To)
+	case ActionUpdateMaster:
+		return fmt.Sprintf("~ update master from %s to %s", action.From, action.To)
+	case ActionCreateWorkerPool:
+		return fmt.Sprintf("+ create worker pool %s", describePool(plan.pool(action.WorkerPool)))
+	case ActionAddWorkerPoolZone:
+		return fmt.Sprintf("+ add zone %s (subnet %s) to worker pool %s", zone.ID, zone.SubnetID, action.WorkerPool)
+	case ActionResizeWorkerPool:
+		return fmt.Sprintf("~ resize worker pool %s from %s to %s workers per zone", action.WorkerPool, action.From, action.To)
+	case ActionSetWorkerPoolLabels:
+		return fmt.Sprintf("~ set the labels of worker pool %s from {%s} to {%s}", action.WorkerPool, action.From, action.To)
+	case ActionEnableAddon:
+		return fmt.Sprintf("+ enable addon %s", describeAddon(addon))
+	case ActionUpdateAddon:
+		return fmt.Sprintf("~ update addon %s from %s to %s", addon.Name, action.From, action.To)
+	case ActionAddACLEntries:
+		return fmt.Sprintf("+ allow %s on the service endpoint", strings.Join(action.Entries, ", "))
+	case ActionRemoveACLEntries:
+		return fmt.Sprintf("- disallow %s on the service endpoint", strings.Join(action.Entries, ", "))
+	case ActionRemoveWorkerPoolZone:
+		return fmt.Sprintf("- remove zone %s from worker pool %s", zone.ID, action.WorkerPool)
+	case ActionRemoveWorkerPool:
+		return fmt.Sprintf("- remove worker pool %s", action.WorkerPool)
+	}
+	return fmt.Sprintf("? %s", action.Type)
+}
+
+// pool returns the worker pool of the spec called name.
+func (plan *Plan) pool(name string) WorkerPool {
+	if plan.Spec == nil {
+		return WorkerPool{Name: name}
+	}
+	pool, _ := plan.Spec.workerPool(name)
+	return pool
+}
+
+// clusterName returns the name of the cluster of the plan, or its ID if the plan has no spec.
+func (plan *Plan) clusterName() string {
+	if plan.Spec != nil && plan.Spec.Name != "" {
+		return plan.Spec.Name
+	}
+	if plan.ClusterID != "" {
+		return plan.ClusterID
+	}
+	return "?"
+}
+
+// describePool returns the name, flavor, size and zones of pool.
+func describePool(pool WorkerPool) string {
+	zones := make([]string, len(pool.Zones))
+	for i, zone := range pool.Zones {
+		zones[i] = zone.ID
+	}
+	return fmt.Sprintf("%s (%s, %d per zone in %s)", pool.Name, pool.Flavor, pool.WorkersPerZone, strings.Join(zones, ", "))
+}
+
+// describeAddon returns the name and version of addon.
+func describeAddon(addon Addon) string {
+	if addon.Version == "" {
+		return addon.Name
+	}
+	return fmt.Sprintf("%s %s", addon.Name, addon.Version)
+}
+
+// describeLabels returns labels as sorted key=value pairs.
+func describeLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,548 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clusterspec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// Options : The options of NewReconciler.
+type Options struct {
+	// How to wait for a new cluster to be ready, and for an updated master to run its new version, before applying
+	// the actions that follow. VPC defaults to true, and XAuthResourceGroup to the resource group of the spec.
+	Wait *kubernetesserviceapiv1.ClusterWaitOptions
+}
+
+// Reconciler : Plans and applies the changes that make clusters match their specs.
+type Reconciler struct {
+	service *kubernetesserviceapiv1.KubernetesServiceApiV1
+	options Options
+}
+
+// NewReconciler returns a Reconciler of the clusters of service, configured by options, which may be nil.
+func NewReconciler(service *kubernetesserviceapiv1.KubernetesServiceApiV1, options *Options) *Reconciler {
+	reconciler := &Reconciler{service: service}
+	if options != nil {
+		reconciler.options = *options
+	}
+	return reconciler
+}
+
+// Plan reads the cluster of spec and returns the plan that makes it match spec. It creates the cluster if there is
+// no cluster named spec.Name. The plan does not change anything until it is applied.
+func (reconciler *Reconciler) Plan(ctx context.Context, spec *Spec) (*Plan, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	plan := &Plan{Spec: spec, Actions: []Action{}}
+
+	getClusterOptions := reconciler.service.NewVpcGetClusterOptions(spec.Name)
+	getClusterOptions.XAuthResourceGroup = resourceGroup(spec)
+	cluster, _, err := reconciler.service.VpcGetClusterWithContext(ctx, getClusterOptions)
+	if err != nil {
+		var apiError *kubernetesserviceapiv1.APIError
+		if errors.As(err, &apiError) && apiError.StatusCode == http.StatusNotFound {
+			reconciler.planCreate(plan)
+			return plan, nil
+		}
+		return nil, fmt.Errorf("clusterspec: reading cluster %s: %w", spec.Name, err)
+	}
+	plan.ClusterID = core.StringNilMapper(cluster.ID)
+
+	pools, _, err := reconciler.service.GetWorkerPoolsWithContext(ctx, &kubernetesserviceapiv1.GetWorkerPoolsOptions{
+		IdOrName:           &plan.ClusterID,
+		XAuthResourceGroup: resourceGroup(spec),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("clusterspec: reading the worker pools of cluster %s: %w", spec.Name, err)
+	}
+	var addons []kubernetesserviceapiv1.ClusterAddon
+	if len(spec.Addons) > 0 {
+		addons, _, err = reconciler.service.GetClusterAddonsWithContext(ctx, &kubernetesserviceapiv1.GetClusterAddonsOptions{
+			IdOrName:           &plan.ClusterID,
+			XAuthResourceGroup: resourceGroup(spec),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("clusterspec: reading the addons of cluster %s: %w", spec.Name, err)
+		}
+	}
+	var acls *kubernetesserviceapiv1.ACLResponse
+	if spec.ACL != nil {
+		acls, _, err = reconciler.service.GetClusterACLsWithContext(ctx, &kubernetesserviceapiv1.GetClusterACLsOptions{
+			IdOrName:           &plan.ClusterID,
+			XAuthResourceGroup: resourceGroup(spec),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("clusterspec: reading the access control list of cluster %s: %w", spec.Name, err)
+		}
+	}
+
+	planCluster(plan, cluster)
+	planWorkerPools(plan, pools)
+	planAddons(plan, addons)
+	planACL(plan, acls)
+	planPrune(plan, pools)
+	return plan, nil
+}
+
+// planCreate adds the actions creating the cluster of the plan.
+func (reconciler *Reconciler) planCreate(plan *Plan) {
+	spec := plan.Spec
+	plan.add(Action{Type: ActionCreateCluster, WorkerPool: spec.WorkerPools[0].Name})
+	for _, pool := range spec.WorkerPools[1:] {
+		plan.add(Action{Type: ActionCreateWorkerPool, WorkerPool: pool.Name})
+		for i := range pool.Zones {
+			plan.add(Action{Type: ActionAddWorkerPoolZone, WorkerPool: pool.Name, Zone: &pool.Zones[i]})
+		}
+	}
+	for i := range spec.Addons {
+		plan.add(Action{Type: ActionEnableAddon, Addon: &spec.Addons[i]})
+	}
+	if spec.ACL != nil && len(spec.ACL.Entries) > 0 {
+		plan.add(Action{Type: ActionAddACLEntries, Entries: spec.ACL.Entries})
+	}
+	if autoUpdate := spec.UpdatePolicy.AutoUpdate; autoUpdate != nil && !*autoUpdate {
+		plan.add(Action{Type: ActionSetAutoUpdate, From: onOff(true), To: onOff(false)})
+	}
+}
+
+// planCluster adds the actions changing the master of the cluster, and notes the settings that cannot be changed.
+func planCluster(plan *Plan, cluster *kubernetesserviceapiv1.GetClusterResponse) {
+	spec := plan.Spec
+	if spec.PodSubnet != "" && cluster.PodSubnet != nil && *cluster.PodSubnet != spec.PodSubnet {
+		plan.note("the pod subnet is %s, not %s, and cannot be changed", *cluster.PodSubnet, spec.PodSubnet)
+	}
+	if spec.ServiceSubnet != "" && cluster.ServiceSubnet != nil && *cluster.ServiceSubnet != spec.ServiceSubnet {
+		plan.note("the service subnet is %s, not %s, and cannot be changed", *cluster.ServiceSubnet, spec.ServiceSubnet)
+	}
+
+	if autoUpdate := spec.UpdatePolicy.AutoUpdate; autoUpdate != nil {
+		current := cluster.DisableAutoUpdate == nil || !*cluster.DisableAutoUpdate
+		if current != *autoUpdate {
+			plan.add(Action{Type: ActionSetAutoUpdate, From: onOff(current), To: onOff(*autoUpdate)})
+		}
+	}
+
+	version := core.StringNilMapper(cluster.MasterKubeVersion)
+	if target := core.StringNilMapper(cluster.TargetVersion); target != "" {
+		version = target
+	}
+	if spec.KubeVersion == "" || version == "" || common.CompareVersions(version, spec.KubeVersion) == 0 {
+		return
+	}
+	if common.CompareVersions(version, spec.KubeVersion) > 0 {
+		plan.note("the master runs %s, which is newer than %s", version, spec.KubeVersion)
+		return
+	}
+	action := Action{Type: ActionUpdateMaster, From: version, To: spec.KubeVersion}
+	if spec.UpdatePolicy.UpdateMaster {
+		plan.add(action)
+	} else {
+		plan.skip(action)
+	}
+}
+
+// planWorkerPools adds the actions creating, extending, resizing and labelling the worker pools, and notes the
+// settings of the worker pools that cannot be changed.
+func planWorkerPools(plan *Plan, pools []kubernetesserviceapiv1.WorkerPoolResponse) {
+	for _, pool := range plan.Spec.WorkerPools {
+		current := findPool(pools, pool.Name)
+		if current == nil {
+			plan.add(Action{Type: ActionCreateWorkerPool, WorkerPool: pool.Name})
+			for i := range pool.Zones {
+				plan.add(Action{Type: ActionAddWorkerPoolZone, WorkerPool: pool.Name, Zone: &pool.Zones[i]})
+			}
+			continue
+		}
+
+		if flavor := core.StringNilMapper(current.MachineType); flavor != pool.Flavor {
+			plan.note("worker pool %s has flavor %s, not %s, and must be replaced to change it", pool.Name, flavor, pool.Flavor)
+		}
+		if isolation := core.StringNilMapper(current.Isolation); pool.Isolation != "" && isolation != pool.Isolation {
+			plan.note("worker pool %s has isolation %s, not %s, and must be replaced to change it", pool.Name, isolation, pool.Isolation)
+		}
+		for i, zone := range pool.Zones {
+			if !hasZone(current, zone.ID) {
+				plan.add(Action{Type: ActionAddWorkerPoolZone, WorkerPool: pool.Name, Zone: &pool.Zones[i]})
+			}
+		}
+		var size int64
+		if current.SizePerZone != nil {
+			size = *current.SizePerZone
+		}
+		if size != pool.WorkersPerZone {
+			plan.add(Action{
+				Type:       ActionResizeWorkerPool,
+				WorkerPool: pool.Name,
+				From:       strconv.FormatInt(size, 10),
+				To:         strconv.FormatInt(pool.WorkersPerZone, 10),
+			})
+		}
+		if pool.Labels != nil && !equalLabels(current.Labels, pool.Labels) {
+			plan.add(Action{
+				Type:       ActionSetWorkerPoolLabels,
+				WorkerPool: pool.Name,
+				From:       describeLabels(current.Labels),
+				To:         describeLabels(pool.Labels),
+			})
+		}
+	}
+}
+
+// planAddons adds the actions enabling and updating the addons.
+func planAddons(plan *Plan, addons []kubernetesserviceapiv1.ClusterAddon) {
+	for i, addon := range plan.Spec.Addons {
+		current := findAddon(addons, addon.Name)
+		switch {
+		case current == nil:
+			plan.add(Action{Type: ActionEnableAddon, Addon: &plan.Spec.Addons[i]})
+		case addon.Version != "" && core.StringNilMapper(current.Version) != addon.Version:
+			plan.add(Action{Type: ActionUpdateAddon, Addon: &plan.Spec.Addons[i], From: core.StringNilMapper(current.Version), To: addon.Version})
+		}
+	}
+}
+
+// planACL adds the actions making the access control list of the cluster, acls, list exactly the entries of the
+// spec.
+func planACL(plan *Plan, acls *kubernetesserviceapiv1.ACLResponse) {
+	if plan.Spec.ACL == nil || acls == nil {
+		return
+	}
+	list := acls.DesiredCSEACLList
+	if list == nil {
+		list = acls.ActualCSEACLList
+	}
+	var current []string
+	if list != nil {
+		current = list.CustomAclEntries
+	}
+	if added := difference(plan.Spec.ACL.Entries, current); len(added) > 0 {
+		plan.add(Action{Type: ActionAddACLEntries, Entries: added})
+	}
+	if removed := difference(current, plan.Spec.ACL.Entries); len(removed) > 0 {
+		plan.add(Action{Type: ActionRemoveACLEntries, Entries: removed})
+	}
+}
+
+// planPrune adds the actions removing the zones and the worker pools that the spec does not list, or skips them if
+// the update policy does not allow pruning.
+func planPrune(plan *Plan, pools []kubernetesserviceapiv1.WorkerPoolResponse) {
+	prune := plan.add
+	if !plan.Spec.UpdatePolicy.Prune {
+		prune = plan.skip
+	}
+	for _, current := range pools {
+		name := core.StringNilMapper(current.Name)
+		pool, ok := plan.Spec.workerPool(name)
+		if !ok {
+			prune(Action{Type: ActionRemoveWorkerPool, WorkerPool: name})
+			continue
+		}
+		for _, zone := range current.Zones {
+			id := core.StringNilMapper(zone.ID)
+			if !specHasZone(pool, id) {
+				prune(Action{Type: ActionRemoveWorkerPoolZone, WorkerPool: name, Zone: &Zone{ID: id}})
+			}
+		}
+	}
+}
+
+// add appends action to the actions of the plan.
+func (plan *Plan) add(action Action) {
+	plan.Actions = append(plan.Actions, action)
+}
+
+// skip appends action to the actions of the plan that the update policy does not allow.
+func (plan *Plan) skip(action Action) {
+	plan.Skipped = append(plan.Skipped, action)
+}
+
+// note appends a difference that cannot be reconciled to the notes of the plan.
+func (plan *Plan) note(format string, args ...interface{}) {
+	plan.Notes = append(plan.Notes, fmt.Sprintf(format, args...))
+}
+
+// Apply validates plan, then applies its actions in order and stops at the first one that fails. The plans computed
+// before a change to the cluster may be out of date, so a failed plan is best computed again before it is retried.
+func (reconciler *Reconciler) Apply(ctx context.Context, plan *Plan) error {
+	if err := plan.Validate(); err != nil {
+		return err
+	}
+	cluster := plan.ClusterID
+	for _, action := range plan.Actions {
+		var err error
+		if action.Type == ActionCreateCluster {
+			cluster, err = reconciler.createCluster(ctx, plan)
+		} else {
+			err = reconciler.apply(ctx, plan, cluster, action)
+		}
+		if err != nil {
+			return fmt.Errorf("clusterspec: %s: %w", strings.TrimLeft(plan.describe(action), "+~- "), err)
+		}
+	}
+	return nil
+}
+
+// createCluster creates the cluster of plan with its default worker pool, waits for its master to be ready and
+// returns its ID.
+func (reconciler *Reconciler) createCluster(ctx context.Context, plan *Plan) (string, error) {
+	spec := plan.Spec
+	pool := spec.WorkerPools[0]
+	zones := make([]kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone, len(pool.Zones))
+	for i := range pool.Zones {
+		zones[i] = kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{ID: &pool.Zones[i].ID, SubnetID: &pool.Zones[i].SubnetID}
+	}
+	options := &kubernetesserviceapiv1.VpcCreateClusterOptions{
+		XAuthResourceGroup: resourceGroup(spec),
+		CosInstanceCRN:     stringPtr(spec.CosInstanceCRN),
+		KubeVersion:        stringPtr(spec.KubeVersion),
+		Name:               &spec.Name,
+		PodSubnet:          stringPtr(spec.PodSubnet),
+		Provider:           core.StringPtr(ProviderVPC),
+		ServiceSubnet:      stringPtr(spec.ServiceSubnet),
+		WorkerPool: &kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+			Flavor:      &pool.Flavor,
+			Isolation:   stringPtr(pool.Isolation),
+			Labels:      pool.Labels,
+			Name:        &pool.Name,
+			VpcID:       stringPtr(spec.VpcID),
+			WorkerCount: &pool.WorkersPerZone,
+			Zones:       zones,
+		},
+	}
+	if spec.DisablePublicServiceEndpoint {
+		options.DisablePublicServiceEndpoint = core.BoolPtr(true)
+	}
+	if spec.ACL != nil {
+		options.CseACLEnabled = core.BoolPtr(true)
+	}
+	created, _, err := reconciler.service.VpcCreateClusterWithContext(ctx, options)
+	if err != nil {
+		return "", err
+	}
+	cluster := core.StringNilMapper(created.ClusterID)
+	_, err = reconciler.service.WaitForClusterState(ctx, cluster, kubernetesserviceapiv1.ClusterMasterReady, reconciler.waitOptions(spec))
+	return cluster, err
+}
+
+// apply applies action, other than ActionCreateCluster, to cluster.
+func (reconciler *Reconciler) apply(ctx context.Context, plan *Plan, cluster string, action Action) (err error) {
+	service := reconciler.service
+	group := resourceGroup(plan.Spec)
+	switch action.Type {
+	case ActionSetAutoUpdate:
+		_, err = service.AutoUpdateMasterWithContext(ctx, &kubernetesserviceapiv1.AutoUpdateMasterOptions{
+			AutoUpdate:         core.BoolPtr(action.To == onOff(true)),
+			Cluster:            &cluster,
+			XAuthResourceGroup: group,
+		})
+	case ActionUpdateMaster:
+		_, err = service.UpdateClusterWithContext(ctx, &kubernetesserviceapiv1.UpdateClusterOptions{
+			IdOrName:           &cluster,
+			Action:             core.StringPtr(kubernetesserviceapiv1.UpdateClusterOptions_Action_Update),
+			Version:            &action.To,
+			XAuthResourceGroup: group,
+		})
+		if err == nil {
+			predicate := kubernetesserviceapiv1.AllClusterPredicates(
+				kubernetesserviceapiv1.ClusterMasterReady, kubernetesserviceapiv1.ClusterMasterVersion(action.To))
+			_, err = service.WaitForClusterState(ctx, cluster, predicate, reconciler.waitOptions(plan.Spec))
+		}
+	case ActionCreateWorkerPool:
+		pool := plan.pool(action.WorkerPool)
+		_, _, err = service.VpcCreateWorkerPoolWithContext(ctx, &kubernetesserviceapiv1.VpcCreateWorkerPoolOptions{
+			Cluster:            &cluster,
+			Flavor:             &pool.Flavor,
+			Isolation:          stringPtr(pool.Isolation),
+			Labels:             pool.Labels,
+			Name:               &pool.Name,
+			VpcID:              stringPtr(plan.Spec.VpcID),
+			WorkerCount:        &pool.WorkersPerZone,
+			XAuthResourceGroup: group,
+		})
+	case ActionAddWorkerPoolZone:
+		_, err = service.VpcCreateWorkerPoolZoneWithContext(ctx, &kubernetesserviceapiv1.VpcCreateWorkerPoolZoneOptions{
+			Cluster:            &cluster,
+			ID:                 &action.Zone.ID,
+			SubnetID:           &action.Zone.SubnetID,
+			Workerpool:         &action.WorkerPool,
+			XAuthResourceGroup: group,
+		})
+	case ActionResizeWorkerPool:
+		size, _ := strconv.ParseInt(action.To, 10, 64) // checked by plan.Validate
+		_, err = service.PatchWorkerPoolWithContext(ctx, &kubernetesserviceapiv1.PatchWorkerPoolOptions{
+			IdOrName:           &cluster,
+			PoolidOrName:       &action.WorkerPool,
+			SizePerZone:        &size,
+			State:              core.StringPtr("resizing"),
+			XAuthResourceGroup: group,
+		})
+	case ActionSetWorkerPoolLabels:
+		_, err = service.PatchWorkerPoolWithContext(ctx, &kubernetesserviceapiv1.PatchWorkerPoolOptions{
+			IdOrName:           &cluster,
+			PoolidOrName:       &action.WorkerPool,
+			Labels:             plan.pool(action.WorkerPool).Labels,
+			State:              core.StringPtr("labels"),
+			XAuthResourceGroup: group,
+		})
+	case ActionEnableAddon, ActionUpdateAddon:
+		options := &kubernetesserviceapiv1.ManageClusterAddonsOptions{
+			IdOrName:           &cluster,
+			Addons:             []kubernetesserviceapiv1.ClusterAddon{{Name: &action.Addon.Name, Version: stringPtr(action.Addon.Version)}},
+			XAuthResourceGroup: group,
+		}
+		if action.Type == ActionEnableAddon {
+			options.Enable = core.BoolPtr(true)
+		} else {
+			options.Update = core.BoolPtr(true)
+		}
+		_, _, err = service.ManageClusterAddonsWithContext(ctx, options)
+	case ActionAddACLEntries:
+		_, err = service.AddClusterACLsWithContext(ctx, &kubernetesserviceapiv1.AddClusterACLsOptions{
+			IdOrName:           &cluster,
+			AclList:            action.Entries,
+			XAuthResourceGroup: group,
+		})
+	case ActionRemoveACLEntries:
+		_, err = service.RemoveClusterACLsWithContext(ctx, &kubernetesserviceapiv1.RemoveClusterACLsOptions{
+			IdOrName:           &cluster,
+			AclList:            action.Entries,
+			XAuthResourceGroup: group,
+		})
+	case ActionRemoveWorkerPoolZone:
+		_, err = service.RemoveWorkerPoolZoneWithContext(ctx, &kubernetesserviceapiv1.RemoveWorkerPoolZoneOptions{
+			IdOrName:           &cluster,
+			PoolidOrName:       &action.WorkerPool,
+			Zoneid:             &action.Zone.ID,
+			XAuthResourceGroup: group,
+		})
+	case ActionRemoveWorkerPool:
+		_, err = service.RemoveWorkerPoolWithContext(ctx, &kubernetesserviceapiv1.RemoveWorkerPoolOptions{
+			IdOrName:           &cluster,
+			PoolidOrName:       &action.WorkerPool,
+			XAuthResourceGroup: group,
+		})
+	default:
+		err = fmt.Errorf("unknown action type '%s'", action.Type)
+	}
+	return
+}
+
+// waitOptions returns the options of the waits for the cluster of spec.
+func (reconciler *Reconciler) waitOptions(spec *Spec) *kubernetesserviceapiv1.ClusterWaitOptions {
+	options := kubernetesserviceapiv1.ClusterWaitOptions{}
+	if reconciler.options.Wait != nil {
+		options = *reconciler.options.Wait
+	}
+	options.VPC = true
+	if options.XAuthResourceGroup == nil {
+		options.XAuthResourceGroup = resourceGroup(spec)
+	}
+	return &options
+}
+
+// resourceGroup returns the resource group of spec, or nil for the default resource group of the client.
+func resourceGroup(spec *Spec) *string {
+	return stringPtr(spec.ResourceGroup)
+}
+
+// stringPtr returns a pointer to s, or nil if s is empty.
+func stringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// onOff returns how a plan prints the automatic updates being enabled or not.
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
+
+// findPool returns the worker pool called name, or nil.
+func findPool(pools []kubernetesserviceapiv1.WorkerPoolResponse, name string) *kubernetesserviceapiv1.WorkerPoolResponse {
+	for i := range pools {
+		if core.StringNilMapper(pools[i].Name) == name {
+			return &pools[i]
+		}
+	}
+	return nil
+}
+
+// hasZone reports whether the worker pool spans the zone id.
+func hasZone(pool *kubernetesserviceapiv1.WorkerPoolResponse, id string) bool {
+	for _, zone := range pool.Zones {
+		if core.StringNilMapper(zone.ID) == id {
+			return true
+		}
+	}
+	return false
+}
+
+// specHasZone reports whether the worker pool of the spec lists the zone id.
+func specHasZone(pool WorkerPool, id string) bool {
+	for _, zone := range pool.Zones {
+		if zone.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// findAddon returns the addon called name, or nil.
+func findAddon(addons []kubernetesserviceapiv1.ClusterAddon, name string) *kubernetesserviceapiv1.ClusterAddon {
+	for i := range addons {
+		if core.StringNilMapper(addons[i].Name) == name {
+			return &addons[i]
+		}
+	}
+	return nil
+}
+
+// equalLabels reports whether two sets of labels are equal, an empty set being equal to nil.
+func equalLabels(a map[string]string, b map[string]string) bool {
+	return len(a) == 0 && len(b) == 0 || reflect.DeepEqual(a, b)
+}
+
+// difference returns the values of a that are not in b, in the order of a.
+func difference(a []string, b []string) []string {
+	var values []string
+	for _, value := range a {
+		found := false
+		for _, other := range b {
+			found = found || other == value
+		}
+		if !found {
+			values = append(values, value)
+		}
+	}
+	return values
+}
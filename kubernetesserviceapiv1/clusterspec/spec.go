@@ -0,0 +1,227 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package clusterspec describes VPC clusters declaratively and reconciles them with their description.
+//
+// A Spec describes a cluster: its version, subnets, worker pools with their flavors and zones, access control list,
+// addons and update policy. A Reconciler reads the live state of the cluster and computes a Plan of the actions that
+// make it match the spec, which can be printed or serialised for review, then applies it:
+//
+//	spec, err := clusterspec.Load(data)
+//	reconciler := clusterspec.NewReconciler(kubernetesServiceApi, nil)
+//	plan, err := reconciler.Plan(ctx, spec)
+//	fmt.Print(plan)
+//	err = reconciler.Apply(ctx, plan)
+package clusterspec
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// ProviderVPC is the infrastructure provider of the clusters a Spec describes.
+const ProviderVPC = "vpc-gen2"
+
+// Spec : The desired state of a VPC cluster.
+type Spec struct {
+	// The name of the cluster, which identifies it.
+	Name string `yaml:"name" json:"name"`
+
+	// The infrastructure provider. Defaults to ProviderVPC, the only one supported.
+	Provider string `yaml:"provider,omitempty" json:"provider,omitempty"`
+
+	// The Kubernetes version of the master, either a full version or a prefix such as "1.29". The default version
+	// of the service when the cluster is created if empty.
+	KubeVersion string `yaml:"kubeVersion,omitempty" json:"kubeVersion,omitempty"`
+
+	// The ID of the resource group of the cluster. The default resource group of the client if empty.
+	ResourceGroup string `yaml:"resourceGroup,omitempty" json:"resourceGroup,omitempty"`
+
+	// The ID of the VPC of the worker pools.
+	VpcID string `yaml:"vpcID,omitempty" json:"vpcID,omitempty"`
+
+	// The CIDR blocks of the pods and services, set when the cluster is created.
+	PodSubnet     string `yaml:"podSubnet,omitempty" json:"podSubnet,omitempty"`
+	ServiceSubnet string `yaml:"serviceSubnet,omitempty" json:"serviceSubnet,omitempty"`
+
+	// Whether the master is only reachable on the private service endpoint, set when the cluster is created.
+	DisablePublicServiceEndpoint bool `yaml:"disablePublicServiceEndpoint,omitempty" json:"disablePublicServiceEndpoint,omitempty"`
+
+	// The CRN of the Cloud Object Storage instance of the OpenShift internal registry, set when the cluster is
+	// created.
+	CosInstanceCRN string `yaml:"cosInstanceCRN,omitempty" json:"cosInstanceCRN,omitempty"`
+
+	// The worker pools. The first one is the default worker pool of the cluster.
+	WorkerPools []WorkerPool `yaml:"workerPools" json:"workerPools"`
+
+	// The access control list of the service endpoint. Not managed if nil.
+	ACL *ACL `yaml:"acl,omitempty" json:"acl,omitempty"`
+
+	// The addons to enable. The addons enabled on the cluster but not listed are left alone.
+	Addons []Addon `yaml:"addons,omitempty" json:"addons,omitempty"`
+
+	// Which changes the plans make.
+	UpdatePolicy UpdatePolicy `yaml:"updatePolicy,omitempty" json:"updatePolicy,omitempty"`
+}
+
+// WorkerPool : The desired state of a worker pool.
+type WorkerPool struct {
+	// The name of the worker pool, which identifies it.
+	Name string `yaml:"name" json:"name"`
+
+	// The flavor of the workers, such as "bx2.4x16". It cannot be changed once the worker pool is created.
+	Flavor string `yaml:"flavor" json:"flavor"`
+
+	// The isolation of the workers, "public" or "dedicated". It cannot be changed once the worker pool is created.
+	Isolation string `yaml:"isolation,omitempty" json:"isolation,omitempty"`
+
+	// The number of workers in each zone.
+	WorkersPerZone int64 `yaml:"workersPerZone" json:"workersPerZone"`
+
+	// The Kubernetes labels of the workers. Not managed if nil.
+	Labels map[string]string `yaml:"labels,omitempty" json:"labels,omitempty"`
+
+	// The zones of the worker pool.
+	Zones []Zone `yaml:"zones" json:"zones"`
+}
+
+// Zone : A zone of a worker pool.
+type Zone struct {
+	// The ID of the zone, such as "us-south-1".
+	ID string `yaml:"id" json:"id"`
+
+	// The ID of the VPC subnet of the workers in the zone.
+	SubnetID string `yaml:"subnetID" json:"subnetID"`
+}
+
+// ACL : The desired access control list of the service endpoint of a cluster.
+type ACL struct {
+	// The custom entries, IP addresses or CIDR blocks allowed to reach the service endpoint.
+	Entries []string `yaml:"entries" json:"entries"`
+}
+
+// Addon : An addon to enable.
+type Addon struct {
+	// The name of the addon, such as "vpc-block-csi-driver".
+	Name string `yaml:"name" json:"name"`
+
+	// The version of the addon. The default version of the service if empty, in which case any version enabled is
+	// kept.
+	Version string `yaml:"version,omitempty" json:"version,omitempty"`
+}
+
+// UpdatePolicy : Which changes the plans make. The changes that are not allowed are listed as skipped by the plans.
+type UpdatePolicy struct {
+	// Whether to update the master when it runs a version older than KubeVersion.
+	UpdateMaster bool `yaml:"updateMaster,omitempty" json:"updateMaster,omitempty"`
+
+	// Whether to remove the worker pools and the zones of worker pools that the spec does not list.
+	Prune bool `yaml:"prune,omitempty" json:"prune,omitempty"`
+
+	// Whether the master applies patch updates automatically. Not managed if nil.
+	AutoUpdate *bool `yaml:"autoUpdate,omitempty" json:"autoUpdate,omitempty"`
+}
+
+// Load decodes a YAML or JSON spec and validates it. Unknown fields are rejected.
+func Load(data []byte) (*Spec, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	spec := &Spec{}
+	if err := decoder.Decode(spec); err != nil {
+		return nil, fmt.Errorf("clusterspec: %w", err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Validate returns the violations of the constraints of the spec as a *common.ValidationErrors, or nil.
+func (spec *Spec) Validate() error {
+	validation := common.NewValidation("Spec")
+	if spec.Name == "" {
+		validation.Errorf("Name", "is required")
+	}
+	if spec.Provider != "" && spec.Provider != ProviderVPC {
+		validation.Errorf("Provider", "must be '%s', not '%s'", ProviderVPC, spec.Provider)
+	}
+	if spec.PodSubnet != "" {
+		validation.CIDR("PodSubnet", &spec.PodSubnet)
+	}
+	if spec.ServiceSubnet != "" {
+		validation.CIDR("ServiceSubnet", &spec.ServiceSubnet)
+	}
+
+	if len(spec.WorkerPools) == 0 {
+		validation.Errorf("WorkerPools", "must list at least the default worker pool")
+	}
+	pools := map[string]bool{}
+	for i, pool := range spec.WorkerPools {
+		field := fmt.Sprintf("WorkerPools[%d]", i)
+		if pool.Name == "" {
+			validation.Errorf(field+".Name", "is required")
+		} else if pools[pool.Name] {
+			validation.Errorf(field+".Name", "'%s' is listed more than once", pool.Name)
+		}
+		pools[pool.Name] = true
+		if pool.Flavor == "" {
+			validation.Errorf(field+".Flavor", "is required")
+		}
+		if pool.WorkersPerZone < 1 {
+			validation.Errorf(field+".WorkersPerZone", "must be at least 1, not %d", pool.WorkersPerZone)
+		}
+		if len(pool.Zones) == 0 {
+			validation.Errorf(field+".Zones", "must list at least one zone")
+		}
+		zones := map[string]bool{}
+		for j, zone := range pool.Zones {
+			if zone.ID == "" {
+				validation.Errorf(fmt.Sprintf("%s.Zones[%d].ID", field, j), "is required")
+			} else if zones[zone.ID] {
+				validation.Errorf(fmt.Sprintf("%s.Zones[%d].ID", field, j), "'%s' is listed more than once", zone.ID)
+			}
+			zones[zone.ID] = true
+			if zone.SubnetID == "" {
+				validation.Errorf(fmt.Sprintf("%s.Zones[%d].SubnetID", field, j), "is required")
+			}
+		}
+	}
+
+	addons := map[string]bool{}
+	for i, addon := range spec.Addons {
+		if addon.Name == "" {
+			validation.Errorf(fmt.Sprintf("Addons[%d].Name", i), "is required")
+		} else if addons[addon.Name] {
+			validation.Errorf(fmt.Sprintf("Addons[%d].Name", i), "'%s' is listed more than once", addon.Name)
+		}
+		addons[addon.Name] = true
+	}
+	return validation.Err()
+}
+
+// workerPool returns the worker pool of the spec called name, and whether the spec lists it.
+func (spec *Spec) workerPool(name string) (WorkerPool, bool) {
+	for _, pool := range spec.WorkerPools {
+		if pool.Name == name {
+			return pool, true
+		}
+	}
+	return WorkerPool{Name: name}, false
+}
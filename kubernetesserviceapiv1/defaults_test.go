@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+var _ = Describe(`Default headers`, func() {
+	var testServer *httptest.Server
+	var header http.Header
+
+	BeforeEach(func() {
+		header = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			header = req.Header
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			if strings.Contains(req.URL.Path, "discover") {
+				fmt.Fprintf(res, "%s", `{}`)
+			} else {
+				fmt.Fprintf(res, "%s", `[]`)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Sends the client resource group and resource group ID when the options do not set them`, func() {
+		kubernetesServiceApiService, serviceErr := kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:             testServer.URL,
+			Authenticator:   &core.NoAuthAuthenticator{},
+			ResourceGroup:   "rg1",
+			ResourceGroupID: "rg-id-1",
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(kubernetesServiceApiService.GetResourceGroup()).To(Equal("rg1"))
+		Expect(kubernetesServiceApiService.GetResourceGroupID()).To(Equal("rg-id-1"))
+
+		vpcGetClustersOptionsModel := kubernetesServiceApiService.NewVpcGetClustersOptions()
+		_, _, operationErr := kubernetesServiceApiService.VpcGetClusters(vpcGetClustersOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(header.Get("X-Auth-Resource-Group")).To(Equal("rg1"))
+		Expect(vpcGetClustersOptionsModel.XAuthResourceGroup).To(BeNil())
+
+		_, _, operationErr = kubernetesServiceApiService.VpcGetClusters(vpcGetClustersOptionsModel.SetXAuthResourceGroup("rg2"))
+		Expect(operationErr).To(BeNil())
+		Expect(header.Get("X-Auth-Resource-Group")).To(Equal("rg2"))
+
+		_, _, operationErr = kubernetesServiceApiService.FetchLoggingConfigs(kubernetesServiceApiService.NewFetchLoggingConfigsOptions("c1"))
+		Expect(operationErr).To(BeNil())
+		Expect(header.Get("X-Auth-Resource-Group-ID")).To(Equal("rg-id-1"))
+
+		kubernetesServiceApiService.SetResourceGroup("")
+		_, _, operationErr = kubernetesServiceApiService.VpcGetClusters(kubernetesServiceApiService.NewVpcGetClustersOptions())
+		Expect(operationErr).To(BeNil())
+		Expect(header.Values("X-Auth-Resource-Group")).To(BeEmpty())
+	})
+
+	It(`Satisfies a required refresh token with the client refresh token`, func() {
+		kubernetesServiceApiService, serviceErr := kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		_, _, operationErr := kubernetesServiceApiService.DiscoverLoggingInstance(new(kubernetesserviceapiv1.DiscoverLoggingInstanceOptions))
+		Expect(operationErr).ToNot(BeNil())
+
+		kubernetesServiceApiService.SetRefreshToken("refresh-token")
+		_, _, operationErr = kubernetesServiceApiService.DiscoverLoggingInstance(new(kubernetesserviceapiv1.DiscoverLoggingInstanceOptions))
+		Expect(operationErr).To(BeNil())
+		Expect(header.Get("X-Auth-Refresh-Token")).To(Equal("refresh-token"))
+	})
+
+	It(`Calls the refresh token provider for every operation`, func() {
+		calls := 0
+		kubernetesServiceApiService, serviceErr := kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			RefreshToken:  "static-token",
+			RefreshTokenProvider: func(ctx context.Context) (string, error) {
+				calls++
+				return fmt.Sprintf("fresh-token-%d", calls), nil
+			},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		for i := 1; i <= 2; i++ {
+			_, _, operationErr := kubernetesServiceApiService.DiscoverLoggingInstance(new(kubernetesserviceapiv1.DiscoverLoggingInstanceOptions))
+			Expect(operationErr).To(BeNil())
+			Expect(header.Get("X-Auth-Refresh-Token")).To(Equal(fmt.Sprintf("fresh-token-%d", i)))
+		}
+
+		_, _, operationErr := kubernetesServiceApiService.DiscoverLoggingInstance(kubernetesServiceApiService.NewDiscoverLoggingInstanceOptions("explicit-token"))
+		Expect(operationErr).To(BeNil())
+		Expect(header.Get("X-Auth-Refresh-Token")).To(Equal("explicit-token"))
+		Expect(calls).To(Equal(2))
+
+		providerErr := errors.New("no refresh token")
+		kubernetesServiceApiService.SetRefreshTokenProvider(func(ctx context.Context) (string, error) {
+			return "", providerErr
+		})
+		header = nil
+		_, _, operationErr = kubernetesServiceApiService.DiscoverLoggingInstance(new(kubernetesserviceapiv1.DiscoverLoggingInstanceOptions))
+		Expect(operationErr).To(Equal(providerErr))
+		Expect(header).To(BeNil())
+	})
+})
@@ -0,0 +1,193 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// addAddonRoutes adds the routes of the addons, the access control lists and the automatic updates of the clusters.
+func (server *Server) addAddonRoutes() {
+	server.handle("GET", "/v1/clusters/{idOrName}/addons", server.getClusterAddons)
+	server.handle("PATCH", "/v1/clusters/{idOrName}/addons", server.manageClusterAddons)
+
+	server.handle("GET", "/v1/acl/{idOrName}", server.getClusterACLs)
+	server.handle("POST", "/v1/acl/{idOrName}/enable", server.enableClusterACLs(true))
+	server.handle("DELETE", "/v1/acl/{idOrName}", server.enableClusterACLs(false))
+	server.handle("PATCH", "/v1/acl/{idOrName}/add", server.addClusterACLs)
+	server.handle("PATCH", "/v1/acl/{idOrName}/rm", server.removeClusterACLs)
+
+	server.handle("POST", "/v2/autoUpdateMaster", server.autoUpdateMaster)
+}
+
+// getClusterAddons serves GetClusterAddons.
+func (server *Server) getClusterAddons(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	addons := []kubernetesserviceapiv1.ClusterAddon{}
+	for _, addon := range cluster.addons {
+		addons = append(addons, kubernetesserviceapiv1.ClusterAddon{
+			Name:        stringPtr(addon.name),
+			Version:     stringPtr(addon.version),
+			HealthState: stringPtr("normal"),
+		})
+	}
+	c.ok(addons)
+}
+
+// manageClusterAddons serves ManageClusterAddons, which enables, disables or updates addons.
+func (server *Server) manageClusterAddons(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	var body struct {
+		Addons []struct {
+			Name    string
+			Version string
+		}
+		Enable bool
+		Update bool
+	}
+	if !c.decode(&body) {
+		return
+	}
+	for _, requested := range body.Addons {
+		i := cluster.findAddon(requested.Name)
+		switch {
+		case body.Update:
+			if i < 0 {
+				c.notFound("addon", requested.Name)
+				return
+			}
+			cluster.addons[i].version = requested.Version
+		case body.Enable:
+			if i >= 0 {
+				c.error(http.StatusConflict, "E0007", fmt.Sprintf("The addon '%s' is already enabled.", requested.Name))
+				return
+			}
+			cluster.addons = append(cluster.addons, addon{name: requested.Name, version: requested.Version})
+		default:
+			if i < 0 {
+				c.notFound("addon", requested.Name)
+				return
+			}
+			cluster.addons = append(cluster.addons[:i], cluster.addons[i+1:]...)
+		}
+	}
+	c.ok(kubernetesserviceapiv1.AddonResponse{})
+}
+
+// findAddon returns the index of the addon called name, or -1.
+func (cluster *cluster) findAddon(name string) int {
+	for i, addon := range cluster.addons {
+		if addon.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// getClusterACLs serves GetClusterACLs.
+func (server *Server) getClusterACLs(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	list := &kubernetesserviceapiv1.CSEACLList{CustomAclEntries: append([]string{}, cluster.acls...)}
+	c.ok(kubernetesserviceapiv1.ACLResponse{ActualCSEACLList: list, DesiredCSEACLList: list})
+}
+
+// enableClusterACLs serves EnableClusterACLs if enable, DisableClusterACLs otherwise.
+func (server *Server) enableClusterACLs(enable bool) func(c *call) {
+	return func(c *call) {
+		if cluster := server.pathCluster(c); cluster != nil {
+			cluster.aclEnabled = enable
+			c.noContent()
+		}
+	}
+}
+
+// addClusterACLs serves AddClusterACLs.
+func (server *Server) addClusterACLs(c *call) {
+	cluster, entries := server.aclRequest(c)
+	if cluster == nil {
+		return
+	}
+	for _, entry := range entries {
+		if !contains(cluster.acls, entry) {
+			cluster.acls = append(cluster.acls, entry)
+		}
+	}
+	c.noContent()
+}
+
+// removeClusterACLs serves RemoveClusterACLs.
+func (server *Server) removeClusterACLs(c *call) {
+	cluster, entries := server.aclRequest(c)
+	if cluster == nil {
+		return
+	}
+	var acls []string
+	for _, entry := range cluster.acls {
+		if !contains(entries, entry) {
+			acls = append(acls, entry)
+		}
+	}
+	cluster.acls = acls
+	c.noContent()
+}
+
+// aclRequest returns the cluster and the entries of a request changing the access control list, or reports a 404
+// or a 409 if the access control list of the cluster is not enabled.
+func (server *Server) aclRequest(c *call) (*cluster, []string) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return nil, nil
+	}
+	var body struct {
+		AclList []string
+	}
+	if !c.decode(&body) {
+		return nil, nil
+	}
+	if !cluster.aclEnabled {
+		c.error(http.StatusConflict, "E0130", "The access control list of the cluster is not enabled.")
+		return nil, nil
+	}
+	return cluster, body.AclList
+}
+
+// autoUpdateMaster serves AutoUpdateMaster.
+func (server *Server) autoUpdateMaster(c *call) {
+	var body struct {
+		Cluster    string
+		AutoUpdate bool
+	}
+	if !c.decode(&body) {
+		return
+	}
+	if cluster := server.requireCluster(c, body.Cluster); cluster != nil {
+		cluster.disableAutoUpdate = !body.AutoUpdate
+		c.noContent()
+	}
+}
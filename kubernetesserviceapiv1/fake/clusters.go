@@ -0,0 +1,408 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// Infrastructure providers of the clusters.
+const (
+	ProviderClassic   = "classic"
+	ProviderSatellite = "satellite"
+	ProviderVPC       = "vpc-gen2"
+)
+
+// DefaultRegion is the region of the resources created without an X-Region header.
+const DefaultRegion = "us-south"
+
+// addClusterRoutes adds the cluster routes.
+func (server *Server) addClusterRoutes() {
+	server.handle("POST", "/v2/vpc/createCluster", server.vpcCreateCluster)
+	server.handle("POST", "/v1/clusters", server.createClusterV1)
+	server.handle("POST", "/v2/satellite/createCluster", server.createSatelliteCluster)
+
+	server.handle("GET", "/v2/getCluster", server.getCluster)
+	server.handle("GET", "/v2/vpc/getCluster", server.getCluster)
+	server.handle("GET", "/v2/classic/getCluster", server.classicGetCluster)
+	server.handle("GET", "/v1/clusters/{idOrName}", server.getClusterV1)
+
+	server.handle("GET", "/v2/vpc/getClusters", server.getClusters(ProviderVPC))
+	server.handle("GET", "/v2/classic/getClusters", server.getClusters(ProviderClassic))
+	server.handle("GET", "/v2/satellite/getClusters", server.getClusters(ProviderSatellite))
+	server.handle("GET", "/v1/clusters", server.getClustersV1)
+
+	server.handle("PUT", "/v1/clusters/{idOrName}", server.updateCluster)
+	server.handle("DELETE", "/v1/clusters/{idOrName}", server.removeClusterV1)
+}
+
+// SetClusterStates scripts the states the cluster goes through from now on.
+func (server *Server) SetClusterStates(idOrName string, states ...string) error {
+	return server.withCluster(idOrName, func(cluster *cluster) {
+		cluster.state = newScript(states...)
+	})
+}
+
+// SetMasterStates scripts the states the master of the cluster goes through from now on.
+func (server *Server) SetMasterStates(idOrName string, states ...string) error {
+	return server.withCluster(idOrName, func(cluster *cluster) {
+		cluster.masterState = newScript(states...)
+	})
+}
+
+// withCluster calls update with the cluster locked.
+func (server *Server) withCluster(idOrName string, update func(cluster *cluster)) error {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	cluster := server.findCluster(idOrName)
+	if cluster == nil {
+		return fmt.Errorf("cluster '%s' not found", idOrName)
+	}
+	update(cluster)
+	return nil
+}
+
+// newCluster adds a cluster created by c, or reports a 409 if the name is taken.
+func (server *Server) newCluster(c *call, name string, provider string, version string) *cluster {
+	if name == "" {
+		c.badRequest("The cluster name is required.")
+		return nil
+	}
+	if server.findCluster(name) != nil {
+		c.error(http.StatusConflict, "E0007", fmt.Sprintf("A cluster with the name '%s' already exists.", name))
+		return nil
+	}
+	if version == "" {
+		version = server.options.KubeVersion
+	}
+	cluster := &cluster{
+		id:            server.newID("c"),
+		name:          name,
+		provider:      provider,
+		region:        requestRegion(c),
+		resourceGroup: requestResourceGroup(c),
+		createdDate:   now(),
+		masterVersion: version,
+		podSubnet:     "172.30.0.0/16",
+		serviceSubnet: "172.21.0.0/16",
+		state:         clusterStates(),
+		masterState:   masterStates(),
+	}
+	cluster.location = cluster.region
+	server.clusters = append(server.clusters, cluster)
+	return cluster
+}
+
+// vpcCreateCluster serves VpcCreateCluster.
+func (server *Server) vpcCreateCluster(c *call) {
+	var body struct {
+		Name          string
+		KubeVersion   string
+		Provider      string
+		PodSubnet     string
+		ServiceSubnet string
+		CseACLEnabled bool
+		WorkerPool    struct {
+			Name        string
+			Flavor      string
+			Isolation   string
+			WorkerCount int
+			Labels      map[string]string
+			Zones       []struct {
+				ID       string
+				SubnetID string
+			}
+		}
+	}
+	if !c.decode(&body) {
+		return
+	}
+	if body.Provider == "" {
+		body.Provider = ProviderVPC
+	}
+	cluster := server.newCluster(c, body.Name, body.Provider, body.KubeVersion)
+	if cluster == nil {
+		return
+	}
+	if body.PodSubnet != "" {
+		cluster.podSubnet = body.PodSubnet
+	}
+	if body.ServiceSubnet != "" {
+		cluster.serviceSubnet = body.ServiceSubnet
+	}
+	cluster.aclEnabled = body.CseACLEnabled
+	pool := &workerPool{
+		name:      body.WorkerPool.Name,
+		flavor:    body.WorkerPool.Flavor,
+		isolation: body.WorkerPool.Isolation,
+		perZone:   body.WorkerPool.WorkerCount,
+		labels:    body.WorkerPool.Labels,
+	}
+	if pool.name == "" {
+		pool.name = "default"
+	}
+	for _, zone := range body.WorkerPool.Zones {
+		pool.zones = append(pool.zones, poolZone{id: zone.ID, subnetID: zone.SubnetID})
+	}
+	if len(pool.zones) > 0 && c.req.Header.Get("X-Region") == "" {
+		cluster.region = zoneRegion(pool.zones[0].id)
+		cluster.location = cluster.region
+	}
+	server.addPool(cluster, pool)
+	c.json(http.StatusCreated, kubernetesserviceapiv1.CreateClusterResponse{ClusterID: stringPtr(cluster.id)})
+}
+
+// createClusterV1 serves CreateCluster.
+func (server *Server) createClusterV1(c *call) {
+	var body struct {
+		Name                  string
+		DataCenter            string
+		MachineType           string
+		MasterVersion         string
+		WorkerNum             int
+		Isolation             string
+		DefaultWorkerPoolName string
+		PodSubnet             string
+		ServiceSubnet         string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	cluster := server.newCluster(c, body.Name, ProviderClassic, body.MasterVersion)
+	if cluster == nil {
+		return
+	}
+	if body.DataCenter != "" {
+		cluster.location = body.DataCenter
+	}
+	if body.PodSubnet != "" {
+		cluster.podSubnet = body.PodSubnet
+	}
+	if body.ServiceSubnet != "" {
+		cluster.serviceSubnet = body.ServiceSubnet
+	}
+	pool := &workerPool{
+		name:      body.DefaultWorkerPoolName,
+		flavor:    body.MachineType,
+		isolation: body.Isolation,
+		perZone:   body.WorkerNum,
+		zones:     []poolZone{{id: cluster.location}},
+	}
+	if pool.name == "" {
+		pool.name = "default"
+	}
+	server.addPool(cluster, pool)
+	c.json(http.StatusCreated, kubernetesserviceapiv1.ClusterCreateResponse{ID: stringPtr(cluster.id)})
+}
+
+// createSatelliteCluster serves CreateSatelliteCluster.
+func (server *Server) createSatelliteCluster(c *call) {
+	var body struct {
+		Name          string
+		Controller    string
+		KubeVersion   string
+		Zone          string
+		PodSubnet     string
+		ServiceSubnet string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	location := server.findLocation(body.Controller)
+	if location == nil {
+		c.notFound("location", body.Controller)
+		return
+	}
+	cluster := server.newCluster(c, body.Name, ProviderSatellite, body.KubeVersion)
+	if cluster == nil {
+		return
+	}
+	cluster.satelliteLocation = location.id
+	cluster.location = location.location
+	if body.PodSubnet != "" {
+		cluster.podSubnet = body.PodSubnet
+	}
+	if body.ServiceSubnet != "" {
+		cluster.serviceSubnet = body.ServiceSubnet
+	}
+	pool := &workerPool{name: "default"}
+	if body.Zone != "" {
+		pool.zones = []poolZone{{id: body.Zone}}
+	}
+	server.addPool(cluster, pool)
+	c.json(http.StatusCreated, kubernetesserviceapiv1.MultishiftCreateClusterResponse{ID: stringPtr(cluster.id)})
+}
+
+// getCluster serves GetCluster and VpcGetCluster.
+func (server *Server) getCluster(c *call) {
+	cluster := server.queryCluster(c)
+	if cluster == nil {
+		return
+	}
+	c.ok(server.getClusterResponse(cluster))
+}
+
+// classicGetCluster serves ClassicGetCluster.
+func (server *Server) classicGetCluster(c *call) {
+	cluster := server.queryCluster(c)
+	if cluster == nil {
+		return
+	}
+	c.ok([]kubernetesserviceapiv1.GetClusterResponse{server.getClusterResponse(cluster)})
+}
+
+// getClusterV1 serves GetCluster1.
+func (server *Server) getClusterV1(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	c.ok(server.clusterV1(cluster))
+}
+
+// getClusters returns a handler listing the clusters of provider.
+func (server *Server) getClusters(provider string) func(c *call) {
+	return func(c *call) {
+		clusters := []kubernetesserviceapiv1.GetClustersResponse{}
+		for _, cluster := range server.listClusters(c) {
+			if cluster.provider == provider || (provider == ProviderVPC && strings.HasPrefix(cluster.provider, "vpc")) {
+				clusters = append(clusters, server.getClustersResponse(cluster))
+			}
+		}
+		c.ok(clusters)
+	}
+}
+
+// getClustersV1 serves GetClusters.
+func (server *Server) getClustersV1(c *call) {
+	clusters := []kubernetesserviceapiv1.Cluster{}
+	for _, cluster := range server.listClusters(c) {
+		clusters = append(clusters, server.clusterV1(cluster))
+	}
+	c.ok(clusters)
+}
+
+// listClusters returns the clusters in the location query parameter, or all of them. The slice is copied, since
+// reading a cluster that finished deleting removes it.
+func (server *Server) listClusters(c *call) []*cluster {
+	var clusters []*cluster
+	location := c.query("location")
+	for _, cluster := range server.clusters {
+		if location == "" || cluster.location == location || cluster.region == location {
+			clusters = append(clusters, cluster)
+		}
+	}
+	return clusters
+}
+
+// updateCluster serves UpdateCluster. The update action starts a master update to the version in the body.
+func (server *Server) updateCluster(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	var body struct {
+		Action  string
+		Version string
+		Force   bool
+	}
+	if !c.decode(&body) {
+		return
+	}
+	switch body.Action {
+	case "update":
+		if body.Version == "" {
+			c.badRequest("The version is required to update the master.")
+			return
+		}
+		if !cluster.masterState.settled() {
+			c.error(http.StatusConflict, "E0023", "The master is already being updated.")
+			return
+		}
+		cluster.targetVersion = body.Version
+		cluster.masterState = newScript(kubernetesserviceapiv1.MasterStateUpdating, kubernetesserviceapiv1.MasterStateDeployed)
+	case "refresh":
+		cluster.masterState = newScript(kubernetesserviceapiv1.MasterStateDeploying, kubernetesserviceapiv1.MasterStateDeployed)
+	default:
+		c.badRequest(fmt.Sprintf("The action '%s' is not supported.", body.Action))
+		return
+	}
+	c.noContent()
+}
+
+// removeClusterV1 serves RemoveCluster. The cluster goes through deleting and deleted, and is then removed.
+func (server *Server) removeClusterV1(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	cluster.state = newScript(kubernetesserviceapiv1.ClusterStateDeleting, kubernetesserviceapiv1.ClusterStateDeleted)
+	cluster.masterState = newScript(kubernetesserviceapiv1.MasterStateDeleting)
+	c.noContent()
+}
+
+// queryCluster returns the cluster of the cluster query parameter, or reports a 404.
+func (server *Server) queryCluster(c *call) *cluster {
+	return server.requireCluster(c, c.query("cluster"))
+}
+
+// pathCluster returns the cluster of the idOrName path parameter, or reports a 404.
+func (server *Server) pathCluster(c *call) *cluster {
+	return server.requireCluster(c, c.params["idOrName"])
+}
+
+// requireCluster returns the cluster with the ID or name idOrName, or reports a 404. A cluster that has been read
+// in the deleted state is removed first.
+func (server *Server) requireCluster(c *call, idOrName string) *cluster {
+	cluster := server.findCluster(idOrName)
+	if cluster != nil && cluster.state.current() == kubernetesserviceapiv1.ClusterStateDeleted && cluster.state.reads > 0 {
+		server.removeCluster(cluster.id)
+		cluster = nil
+	}
+	if cluster == nil {
+		c.notFound("cluster", idOrName)
+	}
+	return cluster
+}
+
+// requestRegion returns the region of the request.
+func requestRegion(c *call) string {
+	if region := c.req.Header.Get("X-Region"); region != "" {
+		return region
+	}
+	return DefaultRegion
+}
+
+// requestResourceGroup returns the resource group of the request.
+func requestResourceGroup(c *call) string {
+	if resourceGroup := c.req.Header.Get("X-Auth-Resource-Group"); resourceGroup != "" {
+		return resourceGroup
+	}
+	return "default"
+}
+
+// zoneRegion returns the region of a VPC zone such as us-south-1.
+func zoneRegion(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
@@ -0,0 +1,267 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// ALB types.
+const (
+	ALBTypePrivate = "private"
+	ALBTypePublic  = "public"
+)
+
+// alb : An Ingress application load balancer of a cluster.
+type alb struct {
+	id          string
+	clusterID   string
+	albType     string
+	zone        string
+	enabled     bool
+	createdDate string
+}
+
+// addNetworkRoutes adds the ALB and subnet routes.
+func (server *Server) addNetworkRoutes() {
+	server.handle("GET", "/v2/alb/getClusterAlbs", server.getClusterALBs)
+	server.handle("GET", "/v2/alb/getAlb", server.getALB)
+	server.handle("GET", "/v1/alb/clusters/{idOrName}", server.getClusterALBsV1)
+	server.handle("POST", "/v2/alb/vpc/createAlb", server.createALB)
+	server.handle("POST", "/v2/alb/vpc/enableAlb", server.enableALB(true))
+	server.handle("POST", "/v2/alb/vpc/disableAlb", server.enableALB(false))
+
+	server.handle("GET", "/v2/vpc/getSubnets", server.getSubnets)
+	server.handle("GET", "/v1/subnets", server.listSubnets)
+}
+
+// AddSubnet adds a subnet served by GetSubnets, if its VpcID is set, or ListSubnets otherwise.
+func (server *Server) AddSubnet(subnet kubernetesserviceapiv1.Subnet) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.subnets = append(server.subnets, subnet)
+}
+
+// ensureALBs adds a public and a private ALB, enabled, in every zone of the cluster that has none.
+func (server *Server) ensureALBs(cluster *cluster) {
+	for _, zone := range cluster.zones() {
+		found := false
+		for _, alb := range server.albs {
+			found = found || (alb.clusterID == cluster.id && alb.zone == zone)
+		}
+		if !found {
+			server.addALB(cluster, ALBTypePublic, zone, true)
+			server.addALB(cluster, ALBTypePrivate, zone, true)
+		}
+	}
+}
+
+// addALB adds an ALB to the cluster.
+func (server *Server) addALB(cluster *cluster, albType string, zone string, enabled bool) *alb {
+	alb := &alb{
+		id:          fmt.Sprintf("%s-cr%s-alb%d", albType, cluster.id, len(server.clusterALBs(cluster))+1),
+		clusterID:   cluster.id,
+		albType:     albType,
+		zone:        zone,
+		enabled:     enabled,
+		createdDate: now(),
+	}
+	server.albs = append(server.albs, alb)
+	return alb
+}
+
+// clusterALBs returns the ALBs of the cluster.
+func (server *Server) clusterALBs(cluster *cluster) []*alb {
+	var albs []*alb
+	for _, alb := range server.albs {
+		if alb.clusterID == cluster.id {
+			albs = append(albs, alb)
+		}
+	}
+	return albs
+}
+
+// findALB returns the ALB with the ID id.
+func (server *Server) findALB(id string) *alb {
+	for _, alb := range server.albs {
+		if alb.id == id {
+			return alb
+		}
+	}
+	return nil
+}
+
+// vpcALBConfig returns the ALB as a VpcALBConfig.
+func (alb *alb) vpcALBConfig() kubernetesserviceapiv1.VpcALBConfig {
+	state, status := "disabled", "-"
+	if alb.enabled {
+		state, status = "enabled", "healthy"
+	}
+	return kubernetesserviceapiv1.VpcALBConfig{
+		AlbID:                stringPtr(alb.id),
+		AlbType:              stringPtr(alb.albType),
+		Cluster:              stringPtr(alb.clusterID),
+		CreatedDate:          stringPtr(alb.createdDate),
+		Enable:               boolPtr(alb.enabled),
+		LoadBalancerHostname: stringPtr(fmt.Sprintf("%s.lb.appdomain.cloud", alb.id)),
+		NumOfInstances:       stringPtr("2"),
+		State:                stringPtr(state),
+		Status:               stringPtr(status),
+		Zone:                 stringPtr(alb.zone),
+	}
+}
+
+// getClusterALBs serves V2GetClusterALBs.
+func (server *Server) getClusterALBs(c *call) {
+	cluster := server.queryCluster(c)
+	if cluster == nil {
+		return
+	}
+	server.ensureALBs(cluster)
+	response := kubernetesserviceapiv1.VpcClusterALB{
+		ID:         stringPtr(cluster.id),
+		DataCenter: stringPtr(cluster.location),
+		Region:     stringPtr(cluster.region),
+		Alb:        []kubernetesserviceapiv1.VpcALBConfig{},
+	}
+	for _, alb := range server.clusterALBs(cluster) {
+		response.Alb = append(response.Alb, alb.vpcALBConfig())
+	}
+	c.ok(response)
+}
+
+// getClusterALBsV1 serves GetClusterALBs.
+func (server *Server) getClusterALBsV1(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	server.ensureALBs(cluster)
+	response := kubernetesserviceapiv1.ClusterALB{
+		ID:         stringPtr(cluster.id),
+		DataCenter: stringPtr(cluster.location),
+		Region:     stringPtr(cluster.region),
+		Alb:        []kubernetesserviceapiv1.ALBConfig{},
+	}
+	for _, alb := range server.clusterALBs(cluster) {
+		config := alb.vpcALBConfig()
+		response.Alb = append(response.Alb, kubernetesserviceapiv1.ALBConfig{
+			AlbID:       config.AlbID,
+			AlbType:     config.AlbType,
+			ClusterID:   config.Cluster,
+			CreatedDate: config.CreatedDate,
+			Enable:      config.Enable,
+			State:       config.State,
+			Status:      config.Status,
+			Zone:        config.Zone,
+		})
+	}
+	c.ok([]kubernetesserviceapiv1.ClusterALB{response})
+}
+
+// getALB serves V2GetClusterALB.
+func (server *Server) getALB(c *call) {
+	alb := server.findALB(c.query("albID"))
+	if alb == nil {
+		c.notFound("ALB", c.query("albID"))
+		return
+	}
+	c.ok(alb.vpcALBConfig())
+}
+
+// createALB serves VpcCreateALB.
+func (server *Server) createALB(c *call) {
+	var body struct {
+		Cluster         string
+		Type            string
+		Zone            string
+		EnableByDefault bool
+	}
+	if !c.decode(&body) {
+		return
+	}
+	cluster := server.requireCluster(c, body.Cluster)
+	if cluster == nil {
+		return
+	}
+	if body.Type != ALBTypePublic && body.Type != ALBTypePrivate {
+		c.badRequest(fmt.Sprintf("The ALB type '%s' is not valid.", body.Type))
+		return
+	}
+	if !contains(cluster.zones(), body.Zone) {
+		c.badRequest(fmt.Sprintf("The cluster has no workers in zone '%s'.", body.Zone))
+		return
+	}
+	server.ensureALBs(cluster)
+	alb := server.addALB(cluster, body.Type, body.Zone, body.EnableByDefault)
+	c.json(http.StatusCreated, kubernetesserviceapiv1.AlbCreateResp{Alb: stringPtr(alb.id), Cluster: stringPtr(cluster.id)})
+}
+
+// enableALB returns a handler serving VpcEnableALB or VpcDisableALB.
+func (server *Server) enableALB(enabled bool) func(c *call) {
+	return func(c *call) {
+		var body struct {
+			AlbID   string
+			Cluster string
+		}
+		if !c.decode(&body) {
+			return
+		}
+		if server.requireCluster(c, body.Cluster) == nil {
+			return
+		}
+		alb := server.findALB(body.AlbID)
+		if alb == nil {
+			c.notFound("ALB", body.AlbID)
+			return
+		}
+		alb.enabled = enabled
+		c.noContent()
+	}
+}
+
+// getSubnets serves GetSubnets, filtering the VPC subnets by the zone and vpc query parameters.
+func (server *Server) getSubnets(c *call) {
+	subnets := []kubernetesserviceapiv1.Subnet{}
+	for _, subnet := range server.subnets {
+		if subnet.VpcID == nil || !matches(subnet.Zone, c.query("zone")) || !matches(subnet.VpcID, c.query("vpc")) {
+			continue
+		}
+		subnets = append(subnets, subnet)
+	}
+	c.ok(subnets)
+}
+
+// listSubnets serves ListSubnets, filtering the classic subnets by the datacenters query parameter.
+func (server *Server) listSubnets(c *call) {
+	subnets := []kubernetesserviceapiv1.Subnet{}
+	for _, subnet := range server.subnets {
+		if subnet.VpcID != nil || !matches(subnet.Zone, c.query("datacenters")) {
+			continue
+		}
+		subnets = append(subnets, subnet)
+	}
+	c.ok(subnets)
+}
+
+// matches reports whether filter is empty or equal to value.
+func matches(value *string, filter string) bool {
+	return filter == "" || (value != nil && *value == filter)
+}
@@ -0,0 +1,32 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// addQuotaRoutes adds the routes of the quotas of the account.
+func (server *Server) addQuotaRoutes() {
+	server.handle("GET", "/v2/getQuota", server.getQuota)
+}
+
+// getQuota serves GetQuota.
+func (server *Server) getQuota(c *call) {
+	quotas := append([]kubernetesserviceapiv1.GetQuotaResource{}, server.options.Quotas...)
+	c.ok(quotas)
+}
@@ -0,0 +1,367 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// Satellite location states.
+const (
+	LocationStateActionRequired = "action required"
+	LocationStateDeploying      = "deploying"
+	LocationStateNormal         = "normal"
+)
+
+// Satellite host states.
+const (
+	HostStateAssigned   = "assigned"
+	HostStateUnassigned = "unassigned"
+)
+
+// LocationControlPlaneHosts is the number of hosts to assign to the control plane of a Satellite location before it
+// is deployed.
+const LocationControlPlaneHosts = 3
+
+// location : A Satellite location and its hosts.
+type location struct {
+	id          string
+	name        string
+	location    string
+	region      string
+	zones       []string
+	createdDate string
+	state       *script
+	hosts       []*host
+}
+
+// host : A host attached to a Satellite location.
+type host struct {
+	id         string
+	name       string
+	labels     map[string]string
+	state      string
+	clusterID  string
+	workerPool string
+	workerID   string
+	zone       string
+}
+
+// addSatelliteRoutes adds the Satellite location and host routes.
+func (server *Server) addSatelliteRoutes() {
+	server.handle("POST", "/v2/satellite/createController", server.createLocation)
+	server.handle("GET", "/v2/satellite/getController", server.getLocation)
+	server.handle("GET", "/v2/satellite/getControllers", server.getLocations)
+	server.handle("POST", "/v2/satellite/removeController", server.removeLocation)
+
+	server.handle("GET", "/v2/satellite/hostqueue/getHosts", server.getHosts)
+	server.handle("POST", "/v2/satellite/hostqueue/createAssignment", server.createAssignment)
+	server.handle("POST", "/v2/satellite/hostqueue/removeHost", server.removeHost)
+}
+
+// AddSatelliteHost attaches an unassigned host to the Satellite location, as running its registration script does,
+// and returns the ID of the host.
+func (server *Server) AddSatelliteHost(locationIDOrName string, name string, labels map[string]string) (string, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	location := server.findLocation(locationIDOrName)
+	if location == nil {
+		return "", fmt.Errorf("location '%s' not found", locationIDOrName)
+	}
+	host := &host{id: server.newID("h"), name: name, labels: labels, state: HostStateUnassigned}
+	location.hosts = append(location.hosts, host)
+	return host.id, nil
+}
+
+// SetLocationStates scripts the states the Satellite location goes through from now on.
+func (server *Server) SetLocationStates(locationIDOrName string, states ...string) error {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	location := server.findLocation(locationIDOrName)
+	if location == nil {
+		return fmt.Errorf("location '%s' not found", locationIDOrName)
+	}
+	location.state = newScript(states...)
+	return nil
+}
+
+// findLocation returns the Satellite location with the ID or name idOrName.
+func (server *Server) findLocation(idOrName string) *location {
+	for _, location := range server.locations {
+		if location.id == idOrName || location.name == idOrName {
+			return location
+		}
+	}
+	return nil
+}
+
+// findHost returns the host with the ID or name idOrName.
+func (location *location) findHost(idOrName string) *host {
+	for _, host := range location.hosts {
+		if host.id == idOrName || host.name == idOrName {
+			return host
+		}
+	}
+	return nil
+}
+
+// controlPlaneHosts returns the number of hosts assigned to the control plane of the location.
+func (location *location) controlPlaneHosts() int {
+	count := 0
+	for _, host := range location.hosts {
+		if host.state == HostStateAssigned && host.clusterID == "" {
+			count++
+		}
+	}
+	return count
+}
+
+// createLocation serves CreateSatelliteLocation.
+func (server *Server) createLocation(c *call) {
+	var body struct {
+		Name     string
+		Location string
+		Zones    []string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	if body.Name == "" || body.Location == "" {
+		c.badRequest("The location name and the managed from location are required.")
+		return
+	}
+	if server.findLocation(body.Name) != nil {
+		c.error(http.StatusConflict, "E0007", fmt.Sprintf("A location with the name '%s' already exists.", body.Name))
+		return
+	}
+	location := &location{
+		id:          server.newID("l"),
+		name:        body.Name,
+		location:    body.Location,
+		region:      requestRegion(c),
+		zones:       body.Zones,
+		createdDate: now(),
+		state:       newScript(LocationStateActionRequired),
+	}
+	if len(location.zones) == 0 {
+		location.zones = []string{"zone-1", "zone-2", "zone-3"}
+	}
+	server.locations = append(server.locations, location)
+	c.json(http.StatusCreated, kubernetesserviceapiv1.MultishiftCreateControllerResponse{
+		ID:  stringPtr(location.id),
+		Crn: stringPtr(fmt.Sprintf("crn:v1:bluemix:public:satellite:%s:a/fake::location:%s", location.region, location.id)),
+	})
+}
+
+// getLocation serves GetSatelliteLocation.
+func (server *Server) getLocation(c *call) {
+	location := server.requireLocation(c, c.query("controller"))
+	if location == nil {
+		return
+	}
+	state := location.state.read(server.options.ReadsPerTransition)
+	available := int64(0)
+	for _, host := range location.hosts {
+		if host.state == HostStateUnassigned {
+			available++
+		}
+	}
+	c.ok(kubernetesserviceapiv1.MultishiftGetController{
+		CreatedDate: stringPtr(location.createdDate),
+		ID:          stringPtr(location.id),
+		Hosts:       &kubernetesserviceapiv1.Hosts{Available: int64Ptr(available), Total: int64Ptr(int64(len(location.hosts)))},
+		Location:    stringPtr(location.location),
+		Name:        stringPtr(location.name),
+		Provider:    stringPtr(ProviderSatellite),
+		Region:      stringPtr(location.region),
+		State:       stringPtr(state),
+		Status:      stringPtr(state),
+		WorkerZones: location.zones,
+	})
+}
+
+// getLocations serves GetSatelliteLocations.
+func (server *Server) getLocations(c *call) {
+	locations := []kubernetesserviceapiv1.MultishiftController{}
+	for _, location := range server.locations {
+		locations = append(locations, kubernetesserviceapiv1.MultishiftController{
+			CreatedDate: stringPtr(location.createdDate),
+			ID:          stringPtr(location.id),
+			Location:    stringPtr(location.location),
+			Name:        stringPtr(location.name),
+			Region:      stringPtr(location.region),
+			State:       stringPtr(location.state.read(server.options.ReadsPerTransition)),
+			WorkerZones: location.zones,
+		})
+	}
+	c.ok(locations)
+}
+
+// removeLocation serves RemoveSatelliteLocation. A location that still has clusters is not removed.
+func (server *Server) removeLocation(c *call) {
+	var body struct {
+		Controller string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	location := server.requireLocation(c, body.Controller)
+	if location == nil {
+		return
+	}
+	for _, cluster := range server.clusters {
+		if cluster.satelliteLocation == location.id {
+			c.error(http.StatusConflict, "E0008", fmt.Sprintf("The location '%s' still has clusters.", body.Controller))
+			return
+		}
+	}
+	for i := range server.locations {
+		if server.locations[i] == location {
+			server.locations = append(server.locations[:i], server.locations[i+1:]...)
+			break
+		}
+	}
+	c.noContent()
+}
+
+// getHosts serves GetSatelliteHosts.
+func (server *Server) getHosts(c *call) {
+	location := server.requireLocation(c, c.query("controller"))
+	if location == nil {
+		return
+	}
+	hosts := []kubernetesserviceapiv1.MultishiftQueueNode{}
+	for _, host := range location.hosts {
+		node := kubernetesserviceapiv1.MultishiftQueueNode{
+			ID:     stringPtr(host.id),
+			Name:   stringPtr(host.name),
+			Labels: host.labels,
+			State:  stringPtr(host.state),
+			Health: &kubernetesserviceapiv1.Health{Status: stringPtr("ready")},
+		}
+		if host.state == HostStateAssigned {
+			node.Assignment = &kubernetesserviceapiv1.Assignment{
+				ClusterID:      stringPtr(host.clusterID),
+				WorkerID:       stringPtr(host.workerID),
+				WorkerPoolName: stringPtr(host.workerPool),
+				Zone:           stringPtr(host.zone),
+			}
+		}
+		hosts = append(hosts, node)
+	}
+	c.ok(hosts)
+}
+
+// createAssignment serves CreateSatelliteAssignment. A host assigned without a cluster joins the control plane of
+// the location, which deploys once LocationControlPlaneHosts hosts are assigned; a host assigned to a cluster
+// becomes a worker of its worker pool.
+func (server *Server) createAssignment(c *call) {
+	var body struct {
+		Controller string
+		Cluster    string
+		Workerpool string
+		Zone       string
+		HostID     string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	location := server.requireLocation(c, body.Controller)
+	if location == nil {
+		return
+	}
+	host := location.findHost(body.HostID)
+	if host == nil {
+		c.notFound("host", body.HostID)
+		return
+	}
+	if host.state == HostStateAssigned {
+		c.error(http.StatusConflict, "E0009", fmt.Sprintf("The host '%s' is already assigned.", body.HostID))
+		return
+	}
+	if body.Zone != "" && !contains(location.zones, body.Zone) {
+		c.badRequest(fmt.Sprintf("The zone '%s' is not a zone of the location.", body.Zone))
+		return
+	}
+
+	if body.Cluster == "" {
+		host.state, host.zone = HostStateAssigned, body.Zone
+		if location.controlPlaneHosts() == LocationControlPlaneHosts {
+			location.state = newScript(LocationStateDeploying, LocationStateNormal)
+		}
+	} else {
+		cluster := server.requireCluster(c, body.Cluster)
+		if cluster == nil {
+			return
+		}
+		poolName := body.Workerpool
+		if poolName == "" {
+			poolName = "default"
+		}
+		pool := cluster.findPool(poolName)
+		if pool == nil {
+			c.notFound("worker pool", poolName)
+			return
+		}
+		worker := server.addWorker(cluster, pool, body.Zone)
+		worker.flavor = "upi"
+		host.state, host.zone = HostStateAssigned, body.Zone
+		host.clusterID, host.workerPool, host.workerID = cluster.id, pool.name, worker.id
+	}
+	c.json(http.StatusCreated, kubernetesserviceapiv1.MultishiftCreateAssignmentResponse{
+		HostID: stringPtr(host.id),
+		ID:     stringPtr(host.id),
+	})
+}
+
+// removeHost serves RemoveSatelliteHost, removing the worker of the host if it is assigned to a cluster.
+func (server *Server) removeHost(c *call) {
+	var body struct {
+		Controller string
+		HostID     string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	location := server.requireLocation(c, body.Controller)
+	if location == nil {
+		return
+	}
+	for i, host := range location.hosts {
+		if host.id == body.HostID || host.name == body.HostID {
+			if cluster := server.findCluster(host.clusterID); cluster != nil {
+				cluster.removeWorker(host.workerID)
+			}
+			location.hosts = append(location.hosts[:i], location.hosts[i+1:]...)
+			c.noContent()
+			return
+		}
+	}
+	c.notFound("host", body.HostID)
+}
+
+// requireLocation returns the Satellite location with the ID or name idOrName, or reports a 404.
+func (server *Server) requireLocation(c *call, idOrName string) *location {
+	location := server.findLocation(idOrName)
+	if location == nil {
+		c.notFound("location", idOrName)
+	}
+	return location
+}
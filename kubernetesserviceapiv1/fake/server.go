@@ -0,0 +1,353 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fake provides an in-memory Kubernetes Service API server for testing code that uses the
+// kubernetesserviceapiv1 package without access to IBM Cloud.
+//
+// The server keeps clusters, worker pools, workers, ALBs, subnets and Satellite locations in memory and serves the
+// main v1 and v2 routes for them. Resources that are created or updated go through scripted state transitions, one
+// state every Options.ReadsPerTransition reads, and faults can be injected to test error handling:
+//
+//	server := fake.NewServer(nil)
+//	defer server.Close()
+//	kubernetesServiceApi.SetServiceURL(server.URL)
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// DefaultKubeVersion is the master version of the clusters created without a version.
+const DefaultKubeVersion = "1.29.3_1547"
+
+// DefaultVersions are the versions listed by GetVersions when Options.Versions is not set, by platform.
+var DefaultVersions = map[string][]string{
+	"kubernetes": {"1.28.11", "1.29.3", "1.30.2"},
+	"openshift":  {"4.14.10", "4.15.8"},
+}
+
+// Options : The options of NewServer.
+type Options struct {
+	// How many times a resource is read before moving to the next state of its script. Defaults to 1.
+	ReadsPerTransition int
+
+	// The master version of the clusters created without a version. Defaults to DefaultKubeVersion.
+	KubeVersion string
+
+	// The versions listed by GetVersions, by platform, and by GetKubeVersions, for the "kubernetes" platform.
+	// Defaults to DefaultVersions.
+	Versions map[string][]string
+
+	// The end of service dates listed by GetVersions and GetKubeVersions, by major and minor version such as "1.28",
+	// in the format "2006-01-02". None if empty.
+	EndOfService map[string]string
+
+	// The quotas listed by GetQuota. None if empty.
+	Quotas []kubernetesserviceapiv1.GetQuotaResource
+}
+
+// Fault : A failure injected into the responses of the server.
+type Fault struct {
+	// The HTTP method of the requests to fail. All methods if empty.
+	Method string
+
+	// The path of the requests to fail, for example "/v2/vpc/getCluster". All paths if empty.
+	Path string
+
+	// The status code to respond with. The request is served normally after Latency if 0.
+	StatusCode int
+
+	// The value of the Retry-After header of the response, if not empty.
+	RetryAfter string
+
+	// How long to wait before responding.
+	Latency time.Duration
+
+	// How many requests to fail. All of them if 0.
+	Count int
+}
+
+// Request : A request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Query  map[string][]string
+	Header http.Header
+	Body   []byte
+}
+
+// Server : An in-memory Kubernetes Service API server.
+type Server struct {
+	// The URL of the server, to pass to SetServiceURL.
+	URL string
+
+	httpServer *httptest.Server
+	options    Options
+	routes     []route
+
+	mutex     sync.Mutex
+	nextID    int
+	faults    []*Fault
+	requests  []Request
+	clusters  []*cluster
+	albs      []*alb
+	subnets   []kubernetesserviceapiv1.Subnet
+	locations []*location
+}
+
+// NewServer starts a server. Close it when done.
+func NewServer(options *Options) *Server {
+	server := &Server{}
+	if options != nil {
+		server.options = *options
+	}
+	if server.options.ReadsPerTransition <= 0 {
+		server.options.ReadsPerTransition = 1
+	}
+	if server.options.KubeVersion == "" {
+		server.options.KubeVersion = DefaultKubeVersion
+	}
+	if server.options.Versions == nil {
+		server.options.Versions = DefaultVersions
+	}
+	server.addClusterRoutes()
+	server.addWorkerRoutes()
+	server.addNetworkRoutes()
+	server.addSatelliteRoutes()
+	server.addAddonRoutes()
+	server.addVersionRoutes()
+	server.addQuotaRoutes()
+
+	server.httpServer = httptest.NewServer(server)
+	server.URL = server.httpServer.URL
+	return server
+}
+
+// Close shuts the server down.
+func (server *Server) Close() {
+	server.httpServer.Close()
+}
+
+// InjectFault makes the server fail the requests matching fault.
+func (server *Server) InjectFault(fault Fault) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = append(server.faults, &fault)
+}
+
+// ClearFaults removes the injected faults.
+func (server *Server) ClearFaults() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = nil
+}
+
+// Requests returns the requests received so far.
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]Request(nil), server.requests...)
+}
+
+// ServeHTTP serves a request, applying the injected faults first.
+func (server *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	body, _ := readBody(req)
+	server.mutex.Lock()
+	server.requests = append(server.requests, Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	})
+	fault := server.takeFault(req)
+	server.mutex.Unlock()
+
+	if fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-req.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			if fault.RetryAfter != "" {
+				res.Header().Set("Retry-After", fault.RetryAfter)
+			}
+			writeError(res, fault.StatusCode, "E0001", "Injected fault.")
+			return
+		}
+	}
+
+	for _, route := range server.routes {
+		params, ok := route.match(req.Method, req.URL.Path)
+		if !ok {
+			continue
+		}
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		route.handler(&call{res: res, req: req, params: params, body: body})
+		return
+	}
+	writeError(res, http.StatusNotFound, "E0404", fmt.Sprintf("The route %s %s is not implemented by the fake server.", req.Method, req.URL.Path))
+}
+
+// takeFault returns the first fault matching req and consumes one of its requests.
+func (server *Server) takeFault(req *http.Request) *Fault {
+	for i, fault := range server.faults {
+		if (fault.Method != "" && fault.Method != req.Method) || (fault.Path != "" && fault.Path != req.URL.Path) {
+			continue
+		}
+		if fault.Count > 0 {
+			fault.Count--
+			if fault.Count == 0 {
+				server.faults = append(server.faults[:i:i], server.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+// newID returns a new resource ID starting with prefix.
+func (server *Server) newID(prefix string) string {
+	server.nextID++
+	return fmt.Sprintf("%s%014d", prefix, server.nextID)
+}
+
+// route : A handler for a method and a path pattern, where segments in braces are parameters.
+type route struct {
+	method   string
+	segments []string
+	handler  func(c *call)
+}
+
+// handle adds a route to the server.
+func (server *Server) handle(method string, pattern string, handler func(c *call)) {
+	server.routes = append(server.routes, route{method: method, segments: strings.Split(strings.Trim(pattern, "/"), "/"), handler: handler})
+}
+
+// match returns the path parameters if the route serves method and path.
+func (route route) match(method string, path string) (map[string]string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if method != route.method || len(segments) != len(route.segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, segment := range route.segments {
+		if strings.HasPrefix(segment, "{") {
+			params[strings.Trim(segment, "{}")] = segments[i]
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// call : A request being served.
+type call struct {
+	res    http.ResponseWriter
+	req    *http.Request
+	params map[string]string
+	body   []byte
+}
+
+// query returns the query parameter name.
+func (c *call) query(name string) string {
+	return c.req.URL.Query().Get(name)
+}
+
+// decode decodes the JSON body into value and reports a 400 if it fails.
+func (c *call) decode(value interface{}) bool {
+	if len(c.body) == 0 {
+		return true
+	}
+	if err := json.Unmarshal(c.body, value); err != nil {
+		c.error(http.StatusBadRequest, "E0002", "The request body is not valid JSON: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// json writes value with status.
+func (c *call) json(status int, value interface{}) {
+	c.res.Header().Set("Content-Type", "application/json")
+	c.res.WriteHeader(status)
+	if value != nil {
+		json.NewEncoder(c.res).Encode(value)
+	}
+}
+
+// ok writes value with a 200.
+func (c *call) ok(value interface{}) {
+	c.json(http.StatusOK, value)
+}
+
+// noContent writes an empty 204.
+func (c *call) noContent() {
+	c.res.WriteHeader(http.StatusNoContent)
+}
+
+// error writes an error response.
+func (c *call) error(status int, code string, description string) {
+	writeError(c.res, status, code, description)
+}
+
+// notFound writes a 404 for the resource kind called name.
+func (c *call) notFound(kind string, name string) {
+	c.error(http.StatusNotFound, "E0040", fmt.Sprintf("The specified %s '%s' could not be found.", kind, name))
+}
+
+// badRequest writes a 400 with description.
+func (c *call) badRequest(description string) {
+	c.error(http.StatusBadRequest, "E0002", description)
+}
+
+// writeError writes an error response in the format of the Kubernetes Service API.
+func writeError(res http.ResponseWriter, status int, code string, description string) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	json.NewEncoder(res).Encode(map[string]interface{}{
+		"code":        code,
+		"description": description,
+		"type":        http.StatusText(status),
+		"incidentID":  fmt.Sprintf("fake-%d", time.Now().UnixNano()),
+	})
+}
+
+// readBody reads the body of req.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return ioutil.ReadAll(req.Body)
+}
+
+// now returns the current time in the format of the API dates.
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05-0700")
+}
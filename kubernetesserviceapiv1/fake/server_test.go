@@ -0,0 +1,229 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+)
+
+var _ = Describe(`Fake server`, func() {
+	var server *fake.Server
+	var kubernetesServiceApiService *kubernetesserviceapiv1.KubernetesServiceApiV1
+
+	var waitOptions = common.WaitOptions{Interval: time.Millisecond, Timeout: 5 * time.Second}
+
+	// createCluster creates a VPC cluster with a default worker pool of one worker in each of zones.
+	var createCluster = func(name string, zones ...string) string {
+		options := kubernetesServiceApiService.NewVpcCreateClusterOptions("rg1")
+		options.Name = core.StringPtr(name)
+		options.WorkerPool = &kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+			Flavor:      core.StringPtr("bx2.4x16"),
+			WorkerCount: core.Int64Ptr(1),
+		}
+		for _, zone := range zones {
+			options.WorkerPool.Zones = append(options.WorkerPool.Zones, kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{
+				ID:       core.StringPtr(zone),
+				SubnetID: core.StringPtr("subnet-" + zone),
+			})
+		}
+		result, _, err := kubernetesServiceApiService.VpcCreateCluster(options)
+		Expect(err).To(BeNil())
+		return *result.ClusterID
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		var serviceErr error
+		kubernetesServiceApiService, serviceErr = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Deploys a created cluster and its workers`, func() {
+		clusterID := createCluster("c1", "us-south-1", "us-south-2")
+
+		cluster, err := kubernetesServiceApiService.WaitForClusterNormal(context.Background(), clusterID, &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+		Expect(*cluster.Name).To(Equal("c1"))
+		Expect(*cluster.MasterKubeVersion).To(Equal(fake.DefaultKubeVersion))
+
+		workers, err := kubernetesServiceApiService.WaitForWorkerPoolReady(context.Background(), "c1", "default", "", &kubernetesserviceapiv1.WorkersWaitOptions{WaitOptions: waitOptions, MinWorkers: 2})
+		Expect(err).To(BeNil())
+		Expect(workers).To(HaveLen(2))
+		Expect(*workers[0].Location).To(Equal("us-south-1"))
+	})
+	It(`Lists, rejects duplicates of and deletes clusters`, func() {
+		clusterID := createCluster("c1", "us-south-1")
+		createCluster("c2", "us-south-1")
+
+		clusters, _, err := kubernetesServiceApiService.VpcGetClusters(kubernetesServiceApiService.NewVpcGetClustersOptions())
+		Expect(err).To(BeNil())
+		Expect(clusters).To(HaveLen(2))
+
+		_, _, err = kubernetesServiceApiService.VpcCreateCluster(&kubernetesserviceapiv1.VpcCreateClusterOptions{
+			XAuthResourceGroup: core.StringPtr("rg1"),
+			Name:               core.StringPtr("c1"),
+		})
+		var apiError *kubernetesserviceapiv1.APIError
+		Expect(errors.As(err, &apiError)).To(BeTrue())
+		Expect(apiError.StatusCode).To(Equal(409))
+
+		_, err = kubernetesServiceApiService.RemoveCluster(kubernetesServiceApiService.NewRemoveClusterOptions(clusterID))
+		Expect(err).To(BeNil())
+		err = kubernetesServiceApiService.WaitForClusterDeleted(context.Background(), clusterID, &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+
+		_, _, err = kubernetesServiceApiService.GetCluster(kubernetesServiceApiService.NewGetClusterOptions(clusterID))
+		Expect(errors.As(err, &apiError)).To(BeTrue())
+		Expect(apiError.StatusCode).To(Equal(404))
+		Expect(apiError.Code()).To(Equal("E0040"))
+	})
+	It(`Resizes worker pools and reloads workers`, func() {
+		createCluster("c1", "us-south-1", "us-south-2")
+
+		resizeOptions := kubernetesServiceApiService.NewV2ResizeWorkerPoolOptions()
+		resizeOptions.Cluster = core.StringPtr("c1")
+		resizeOptions.Workerpool = core.StringPtr("default")
+		resizeOptions.Size = core.Int64Ptr(3)
+		_, err := kubernetesServiceApiService.V2ResizeWorkerPool(resizeOptions)
+		Expect(err).To(BeNil())
+
+		workersOptions := kubernetesServiceApiService.NewVpcGetWorkersOptions("c1")
+		workersOptions.Pool = core.StringPtr("default")
+		workers, _, err := kubernetesServiceApiService.VpcGetWorkers(workersOptions)
+		Expect(err).To(BeNil())
+		Expect(workers).To(HaveLen(6))
+
+		workerID := *workers[0].ID
+		_, err = kubernetesServiceApiService.WaitForWorkerReady(context.Background(), "c1", workerID, &kubernetesserviceapiv1.WorkerWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+
+		updateOptions := kubernetesServiceApiService.NewUpdateClusterWorkerOptions("c1", workerID)
+		updateOptions.Action = core.StringPtr("reload")
+		_, err = kubernetesServiceApiService.UpdateClusterWorker(updateOptions)
+		Expect(err).To(BeNil())
+
+		worker, _, err := kubernetesServiceApiService.GetWorker(kubernetesServiceApiService.NewGetWorkerOptions("c1", workerID))
+		Expect(err).To(BeNil())
+		Expect(*worker.Lifecycle.ActualState).To(Equal(kubernetesserviceapiv1.WorkerStateReloading))
+		_, err = kubernetesServiceApiService.WaitForWorkerReady(context.Background(), "c1", workerID, &kubernetesserviceapiv1.WorkerWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+	})
+	It(`Follows the scripted states`, func() {
+		clusterID := createCluster("c1", "us-south-1")
+		Expect(server.SetClusterStates(clusterID, kubernetesserviceapiv1.ClusterStateDeploying, kubernetesserviceapiv1.ClusterStateDeployFailed)).To(BeNil())
+		Expect(server.SetClusterStates("missing")).ToNot(BeNil())
+
+		_, err := kubernetesServiceApiService.WaitForClusterNormal(context.Background(), clusterID, &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: waitOptions})
+		var terminalStateError *kubernetesserviceapiv1.TerminalStateError
+		Expect(errors.As(err, &terminalStateError)).To(BeTrue())
+		Expect(terminalStateError.State).To(Equal(kubernetesserviceapiv1.ClusterStateDeployFailed))
+	})
+	It(`Enables and disables ALBs`, func() {
+		createCluster("c1", "us-south-1")
+
+		albs, _, err := kubernetesServiceApiService.V2GetClusterALBs(kubernetesServiceApiService.NewV2GetClusterALBsOptions("c1"))
+		Expect(err).To(BeNil())
+		Expect(albs.Alb).To(HaveLen(2))
+		Expect(*albs.Alb[0].Enable).To(BeTrue())
+
+		disableOptions := kubernetesServiceApiService.NewVpcDisableALBOptions()
+		disableOptions.Cluster = core.StringPtr("c1")
+		disableOptions.AlbID = albs.Alb[0].AlbID
+		_, err = kubernetesServiceApiService.VpcDisableALB(disableOptions)
+		Expect(err).To(BeNil())
+
+		albs, _, err = kubernetesServiceApiService.V2GetClusterALBs(kubernetesServiceApiService.NewV2GetClusterALBsOptions("c1"))
+		Expect(err).To(BeNil())
+		Expect(*albs.Alb[0].Enable).To(BeFalse())
+		Expect(*albs.Alb[0].State).To(Equal("disabled"))
+	})
+	It(`Assigns Satellite hosts`, func() {
+		locationOptions := kubernetesServiceApiService.NewCreateSatelliteLocationOptions()
+		locationOptions.Name = core.StringPtr("loc1")
+		locationOptions.Location = core.StringPtr("wdc04")
+		location, _, err := kubernetesServiceApiService.CreateSatelliteLocation(locationOptions)
+		Expect(err).To(BeNil())
+
+		for _, name := range []string{"h1", "h2", "h3", "h4"} {
+			_, err := server.AddSatelliteHost("loc1", name, map[string]string{"cpu": "4"})
+			Expect(err).To(BeNil())
+		}
+		for _, name := range []string{"h1", "h2", "h3"} {
+			assignmentOptions := kubernetesServiceApiService.NewCreateSatelliteAssignmentOptions()
+			assignmentOptions.Controller = location.ID
+			assignmentOptions.HostID = core.StringPtr(name)
+			assignmentOptions.Zone = core.StringPtr("zone-1")
+			_, _, err := kubernetesServiceApiService.CreateSatelliteAssignment(assignmentOptions)
+			Expect(err).To(BeNil())
+		}
+
+		hosts, _, err := kubernetesServiceApiService.GetSatelliteHosts(kubernetesServiceApiService.NewGetSatelliteHostsOptions("loc1"))
+		Expect(err).To(BeNil())
+		Expect(hosts).To(HaveLen(4))
+		Expect(*hosts[0].State).To(Equal(fake.HostStateAssigned))
+		Expect(*hosts[3].State).To(Equal(fake.HostStateUnassigned))
+
+		var states []string
+		for i := 0; i < 2; i++ {
+			result, _, err := kubernetesServiceApiService.GetSatelliteLocation(kubernetesServiceApiService.NewGetSatelliteLocationOptions("loc1"))
+			Expect(err).To(BeNil())
+			states = append(states, *result.State)
+		}
+		Expect(states).To(Equal([]string{fake.LocationStateDeploying, fake.LocationStateNormal}))
+	})
+	It(`Injects faults`, func() {
+		createCluster("c1", "us-south-1")
+		server.InjectFault(fake.Fault{Path: "/v2/getCluster", StatusCode: 503, RetryAfter: "2", Count: 1})
+
+		_, response, err := kubernetesServiceApiService.GetCluster(kubernetesServiceApiService.NewGetClusterOptions("c1"))
+		var apiError *kubernetesserviceapiv1.APIError
+		Expect(errors.As(err, &apiError)).To(BeTrue())
+		Expect(apiError.StatusCode).To(Equal(503))
+		Expect(response.Headers.Get("Retry-After")).To(Equal("2"))
+
+		_, _, err = kubernetesServiceApiService.GetCluster(kubernetesServiceApiService.NewGetClusterOptions("c1"))
+		Expect(err).To(BeNil())
+
+		server.InjectFault(fake.Fault{Latency: time.Second})
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, _, err = kubernetesServiceApiService.GetClusterWithContext(ctx, kubernetesServiceApiService.NewGetClusterOptions("c1"))
+		Expect(err).ToNot(BeNil())
+		server.ClearFaults()
+
+		requests := server.Requests()
+		Expect(requests[0].Method).To(Equal("POST"))
+		Expect(requests[0].Path).To(Equal("/v2/vpc/createCluster"))
+		Expect(requests[0].Header.Get("X-Auth-Resource-Group")).To(Equal("rg1"))
+	})
+})
@@ -0,0 +1,495 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// script : The states a resource goes through, moving to the next one every readsPerTransition reads and staying in
+// the last one.
+type script struct {
+	states []string
+	reads  int
+}
+
+// newScript returns a script going through states.
+func newScript(states ...string) *script {
+	return &script{states: states}
+}
+
+// current returns the current state without counting a read.
+func (s *script) current() string {
+	return s.states[0]
+}
+
+// read returns the current state and counts a read.
+func (s *script) read(readsPerTransition int) string {
+	state := s.states[0]
+	s.reads++
+	if s.reads >= readsPerTransition && len(s.states) > 1 {
+		s.states = s.states[1:]
+		s.reads = 0
+	}
+	return state
+}
+
+// settled reports whether the script is in its last state.
+func (s *script) settled() bool {
+	return len(s.states) == 1
+}
+
+// cluster : A cluster and its worker pools and workers.
+type cluster struct {
+	id                string
+	name              string
+	provider          string
+	location          string
+	region            string
+	resourceGroup     string
+	satelliteLocation string
+	podSubnet         string
+	serviceSubnet     string
+	createdDate       string
+	masterVersion     string
+	targetVersion     string
+
+	state       *script
+	masterState *script
+
+	pools   []*workerPool
+	workers []*worker
+
+	addons            []addon
+	aclEnabled        bool
+	acls              []string
+	disableAutoUpdate bool
+}
+
+// addon : An addon enabled on a cluster.
+type addon struct {
+	name    string
+	version string
+}
+
+// workerPool : A worker pool of a cluster.
+type workerPool struct {
+	id         string
+	name       string
+	flavor     string
+	isolation  string
+	perZone    int
+	zones      []poolZone
+	labels     map[string]string
+	taints     map[string]string
+	hostLabels map[string]string
+}
+
+// poolZone : A zone of a worker pool.
+type poolZone struct {
+	id       string
+	subnetID string
+}
+
+// worker : A worker node of a cluster.
+type worker struct {
+	id               string
+	poolID           string
+	zone             string
+	flavor           string
+	version          string
+	targetVersion    string
+	pendingOperation string
+	state            *script
+}
+
+// clusterStates returns the states of a new cluster.
+func clusterStates() *script {
+	return newScript(kubernetesserviceapiv1.ClusterStateDeploying, kubernetesserviceapiv1.ClusterStatePending, kubernetesserviceapiv1.ClusterStateNormal)
+}
+
+// masterStates returns the states of the master of a new cluster.
+func masterStates() *script {
+	return newScript(kubernetesserviceapiv1.MasterStateDeploying, kubernetesserviceapiv1.MasterStateDeployed)
+}
+
+// workerStates returns the states of a new worker.
+func workerStates() *script {
+	return newScript(kubernetesserviceapiv1.WorkerStateProvisioning, kubernetesserviceapiv1.WorkerStateDeploying, kubernetesserviceapiv1.WorkerStateDeployed)
+}
+
+// findCluster returns the cluster with the ID or name idOrName.
+func (server *Server) findCluster(idOrName string) *cluster {
+	for _, cluster := range server.clusters {
+		if cluster.id == idOrName || cluster.name == idOrName {
+			return cluster
+		}
+	}
+	return nil
+}
+
+// removeCluster removes the cluster with the ID id.
+func (server *Server) removeCluster(id string) {
+	for i, cluster := range server.clusters {
+		if cluster.id == id {
+			server.clusters = append(server.clusters[:i], server.clusters[i+1:]...)
+			return
+		}
+	}
+}
+
+// findPool returns the worker pool with the ID or name idOrName.
+func (cluster *cluster) findPool(idOrName string) *workerPool {
+	for _, pool := range cluster.pools {
+		if pool.id == idOrName || pool.name == idOrName {
+			return pool
+		}
+	}
+	return nil
+}
+
+// findWorker returns the worker with the ID id.
+func (cluster *cluster) findWorker(id string) *worker {
+	for _, worker := range cluster.workers {
+		if worker.id == id {
+			return worker
+		}
+	}
+	return nil
+}
+
+// removeWorker removes the worker with the ID id.
+func (cluster *cluster) removeWorker(id string) {
+	for i, worker := range cluster.workers {
+		if worker.id == id {
+			cluster.workers = append(cluster.workers[:i], cluster.workers[i+1:]...)
+			return
+		}
+	}
+}
+
+// poolWorkers returns the workers of pool, or of zone of pool if zone is not empty.
+func (cluster *cluster) poolWorkers(pool *workerPool, zone string) []*worker {
+	var workers []*worker
+	for _, worker := range cluster.workers {
+		if worker.poolID == pool.id && (zone == "" || worker.zone == zone) {
+			workers = append(workers, worker)
+		}
+	}
+	return workers
+}
+
+// addPool adds a worker pool to the cluster and provisions its workers.
+func (server *Server) addPool(cluster *cluster, pool *workerPool) {
+	pool.id = fmt.Sprintf("%s-%s", cluster.id, server.newID("p"))
+	cluster.pools = append(cluster.pools, pool)
+	server.resizePool(cluster, pool)
+}
+
+// resizePool adds or removes workers so that every zone of pool has pool.perZone workers.
+func (server *Server) resizePool(cluster *cluster, pool *workerPool) {
+	for _, zone := range pool.zones {
+		workers := cluster.poolWorkers(pool, zone.id)
+		for i := len(workers); i < pool.perZone; i++ {
+			server.addWorker(cluster, pool, zone.id)
+		}
+		for i := pool.perZone; i < len(workers); i++ {
+			cluster.removeWorker(workers[i].id)
+		}
+	}
+}
+
+// addWorker provisions a worker in zone of pool.
+func (server *Server) addWorker(cluster *cluster, pool *workerPool, zone string) *worker {
+	worker := &worker{
+		id:      fmt.Sprintf("kube-%s-%s-%s", cluster.id, strings.ToLower(pool.name), server.newID("w")),
+		poolID:  pool.id,
+		zone:    zone,
+		flavor:  pool.flavor,
+		version: cluster.masterVersion,
+		state:   workerStates(),
+	}
+	cluster.workers = append(cluster.workers, worker)
+	return worker
+}
+
+// readCluster counts a read of the cluster and applies the transitions that complete.
+func (server *Server) readCluster(cluster *cluster) (state string, masterState string) {
+	state = cluster.state.read(server.options.ReadsPerTransition)
+	masterState = cluster.masterState.read(server.options.ReadsPerTransition)
+	if masterState == kubernetesserviceapiv1.MasterStateDeployed && cluster.targetVersion != "" {
+		cluster.masterVersion = cluster.targetVersion
+		cluster.targetVersion = ""
+	}
+	return
+}
+
+// readWorker counts a read of the worker and applies the transitions that complete.
+func (server *Server) readWorker(worker *worker) string {
+	state := worker.state.read(server.options.ReadsPerTransition)
+	if state == kubernetesserviceapiv1.WorkerStateDeployed {
+		worker.pendingOperation = ""
+		if worker.targetVersion != "" {
+			worker.version = worker.targetVersion
+			worker.targetVersion = ""
+		}
+	}
+	return state
+}
+
+// masterHealth returns the master health reported in masterState.
+func masterHealth(masterState string) string {
+	switch masterState {
+	case kubernetesserviceapiv1.MasterStateDeployed:
+		return kubernetesserviceapiv1.MasterHealthNormal
+	case kubernetesserviceapiv1.MasterStateDeployFailed, kubernetesserviceapiv1.MasterStateUpdateFailed:
+		return kubernetesserviceapiv1.MasterHealthError
+	}
+	return kubernetesserviceapiv1.MasterHealthUnavailable
+}
+
+// workerHealth returns the worker health reported in state.
+func workerHealth(state string) string {
+	switch state {
+	case kubernetesserviceapiv1.WorkerStateDeployed:
+		return kubernetesserviceapiv1.WorkerHealthNormal
+	case kubernetesserviceapiv1.WorkerStateDeployFailed, kubernetesserviceapiv1.WorkerStateProvisionFailed, kubernetesserviceapiv1.WorkerStateReloadFailed:
+		return kubernetesserviceapiv1.WorkerHealthCritical
+	}
+	return kubernetesserviceapiv1.WorkerHealthPending
+}
+
+// getClusterResponse reads the cluster as a GetClusterResponse.
+func (server *Server) getClusterResponse(cluster *cluster) kubernetesserviceapiv1.GetClusterResponse {
+	state, masterState := server.readCluster(cluster)
+	return kubernetesserviceapiv1.GetClusterResponse{
+		CreatedDate:       stringPtr(cluster.createdDate),
+		Crn:               stringPtr(fmt.Sprintf("crn:v1:bluemix:public:containers-kubernetes:%s:a/fake:%s::", cluster.region, cluster.id)),
+		DisableAutoUpdate: boolPtr(cluster.disableAutoUpdate),
+		ID:                stringPtr(cluster.id),
+		Lifecycle: &kubernetesserviceapiv1.CommonClusterLifecycle{
+			MasterHealth: stringPtr(masterHealth(masterState)),
+			MasterState:  stringPtr(masterState),
+			MasterStatus: stringPtr(masterState),
+		},
+		Location:          stringPtr(cluster.location),
+		MasterKubeVersion: stringPtr(cluster.masterVersion),
+		MasterURL:         stringPtr(fmt.Sprintf("https://%s.containers.cloud.ibm.com:30000", cluster.id)),
+		Name:              stringPtr(cluster.name),
+		PodSubnet:         stringPtr(cluster.podSubnet),
+		Provider:          stringPtr(cluster.provider),
+		Region:            stringPtr(cluster.region),
+		ResourceGroup:     stringPtr(cluster.resourceGroup),
+		ServiceSubnet:     stringPtr(cluster.serviceSubnet),
+		State:             stringPtr(state),
+		Status:            stringPtr(state),
+		TargetVersion:     stringPtr(cluster.targetVersion),
+		Type:              stringPtr("kubernetes"),
+		WorkerCount:       int64Ptr(int64(len(cluster.workers))),
+		WorkerZones:       cluster.zones(),
+	}
+}
+
+// getClustersResponse reads the cluster as a GetClustersResponse.
+func (server *Server) getClustersResponse(cluster *cluster) kubernetesserviceapiv1.GetClustersResponse {
+	response := server.getClusterResponse(cluster)
+	return kubernetesserviceapiv1.GetClustersResponse{
+		CreatedDate:       response.CreatedDate,
+		ID:                response.ID,
+		Location:          response.Location,
+		MasterKubeVersion: response.MasterKubeVersion,
+		MasterURL:         response.MasterURL,
+		Name:              response.Name,
+		PodSubnet:         response.PodSubnet,
+		Provider:          response.Provider,
+		Region:            response.Region,
+		ResourceGroup:     response.ResourceGroup,
+		ServiceSubnet:     response.ServiceSubnet,
+		State:             response.State,
+		Status:            response.Status,
+		TargetVersion:     response.TargetVersion,
+		Type:              response.Type,
+		WorkerCount:       response.WorkerCount,
+	}
+}
+
+// clusterV1 reads the cluster as a v1 Cluster.
+func (server *Server) clusterV1(cluster *cluster) kubernetesserviceapiv1.Cluster {
+	response := server.getClusterResponse(cluster)
+	return kubernetesserviceapiv1.Cluster{
+		CreatedDate:       response.CreatedDate,
+		Crn:               response.Crn,
+		DataCenter:        response.Location,
+		ID:                response.ID,
+		Location:          response.Location,
+		MasterHealth:      response.Lifecycle.MasterHealth,
+		MasterKubeVersion: response.MasterKubeVersion,
+		MasterState:       response.Lifecycle.MasterState,
+		MasterStatus:      response.Lifecycle.MasterStatus,
+		Name:              response.Name,
+		PodSubnet:         response.PodSubnet,
+		Region:            response.Region,
+		ResourceGroup:     response.ResourceGroup,
+		ServerURL:         response.MasterURL,
+		ServiceSubnet:     response.ServiceSubnet,
+		State:             response.State,
+		Status:            response.Status,
+		TargetVersion:     response.TargetVersion,
+		Type:              response.Type,
+		WorkerCount:       response.WorkerCount,
+		WorkerZones:       response.WorkerZones,
+	}
+}
+
+// zones returns the zones of the worker pools of the cluster.
+func (cluster *cluster) zones() []string {
+	var zones []string
+	for _, pool := range cluster.pools {
+		for _, zone := range pool.zones {
+			if !contains(zones, zone.id) {
+				zones = append(zones, zone.id)
+			}
+		}
+	}
+	return zones
+}
+
+// getWorkerPoolResponse returns the worker pool as a GetWorkerPoolResponse.
+func (cluster *cluster) getWorkerPoolResponse(pool *workerPool) kubernetesserviceapiv1.GetWorkerPoolResponse {
+	response := kubernetesserviceapiv1.GetWorkerPoolResponse{
+		Flavor:     stringPtr(pool.flavor),
+		HostLabels: pool.hostLabels,
+		ID:         stringPtr(pool.id),
+		IsBalanced: boolPtr(true),
+		Isolation:  stringPtr(pool.isolation),
+		Labels:     pool.labels,
+		Lifecycle: &kubernetesserviceapiv1.GetWorkerPoolResponseLifecycle{
+			ActualState:  stringPtr("active"),
+			DesiredState: stringPtr("active"),
+		},
+		PoolName:    stringPtr(pool.name),
+		Provider:    stringPtr(cluster.provider),
+		Taints:      pool.taints,
+		WorkerCount: int64Ptr(int64(pool.perZone)),
+	}
+	for _, zone := range pool.zones {
+		response.Zones = append(response.Zones, kubernetesserviceapiv1.WorkerPoolZoneResponse{
+			ID:          stringPtr(zone.id),
+			WorkerCount: int64Ptr(int64(len(cluster.poolWorkers(pool, zone.id)))),
+		})
+	}
+	return response
+}
+
+// workerPoolV1 returns the worker pool as a v1 WorkerPoolResponse.
+func (cluster *cluster) workerPoolV1(pool *workerPool) kubernetesserviceapiv1.WorkerPoolResponse {
+	response := kubernetesserviceapiv1.WorkerPoolResponse{
+		ID:          stringPtr(pool.id),
+		IsBalanced:  boolPtr(true),
+		Isolation:   stringPtr(pool.isolation),
+		Labels:      pool.labels,
+		MachineType: stringPtr(pool.flavor),
+		Name:        stringPtr(pool.name),
+		Region:      stringPtr(cluster.region),
+		SizePerZone: int64Ptr(int64(pool.perZone)),
+		State:       stringPtr("active"),
+	}
+	for _, zone := range pool.zones {
+		response.Zones = append(response.Zones, kubernetesserviceapiv1.WorkerPoolZoneResponse{
+			ID:          stringPtr(zone.id),
+			WorkerCount: int64Ptr(int64(len(cluster.poolWorkers(pool, zone.id)))),
+		})
+	}
+	return response
+}
+
+// getWorkerResponse reads the worker as a GetWorkerResponse.
+func (server *Server) getWorkerResponse(cluster *cluster, worker *worker) kubernetesserviceapiv1.GetWorkerResponse {
+	state := server.readWorker(worker)
+	pool := cluster.findPool(worker.poolID)
+	response := kubernetesserviceapiv1.GetWorkerResponse{
+		Flavor: stringPtr(worker.flavor),
+		Health: &kubernetesserviceapiv1.GetWorkerResponseHealth{
+			State: stringPtr(workerHealth(state)),
+		},
+		ID: stringPtr(worker.id),
+		KubeVersion: &kubernetesserviceapiv1.GetWorkerResponseKubeVersion{
+			Actual:  stringPtr(worker.version),
+			Desired: stringPtr(worker.targetVersion),
+			Target:  stringPtr(cluster.masterVersion),
+		},
+		Lifecycle: &kubernetesserviceapiv1.GetWorkerResponseLifecycle{
+			ActualState:      stringPtr(state),
+			DesiredState:     stringPtr(kubernetesserviceapiv1.WorkerStateDeployed),
+			PendingOperation: stringPtr(worker.pendingOperation),
+		},
+		Location: stringPtr(worker.zone),
+		PoolID:   stringPtr(worker.poolID),
+	}
+	if pool != nil {
+		response.PoolName = stringPtr(pool.name)
+	}
+	return response
+}
+
+// workerV1 reads the worker as a v1 Worker.
+func (server *Server) workerV1(cluster *cluster, worker *worker) kubernetesserviceapiv1.Worker {
+	response := server.getWorkerResponse(cluster, worker)
+	return kubernetesserviceapiv1.Worker{
+		ID:               response.ID,
+		KubeVersion:      response.KubeVersion.Actual,
+		Location:         response.Location,
+		MachineType:      response.Flavor,
+		PendingOperation: response.Lifecycle.PendingOperation,
+		PoolName:         response.PoolName,
+		Poolid:           response.PoolID,
+		State:            response.Lifecycle.ActualState,
+		Status:           response.Health.State,
+		TargetVersion:    stringPtr(cluster.masterVersion),
+	}
+}
+
+// stringPtr returns a pointer to value, or nil if it is empty.
+func stringPtr(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// int64Ptr returns a pointer to value.
+func int64Ptr(value int64) *int64 {
+	return &value
+}
+
+// boolPtr returns a pointer to value.
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+// contains reports whether values contains value.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// addVersionRoutes adds the routes of the supported versions.
+func (server *Server) addVersionRoutes() {
+	server.handle("GET", "/v1/versions", server.getVersions)
+	server.handle("GET", "/v1/kube-versions", server.getKubeVersions)
+}
+
+// getVersions serves GetVersions.
+func (server *Server) getVersions(c *call) {
+	versions := map[string][]kubernetesserviceapiv1.KubeVersion{}
+	for platform := range server.options.Versions {
+		versions[platform] = server.kubeVersions(platform)
+	}
+	c.ok(versions)
+}
+
+// getKubeVersions serves GetKubeVersions.
+func (server *Server) getKubeVersions(c *call) {
+	c.ok(server.kubeVersions("kubernetes"))
+}
+
+// kubeVersions returns the versions of platform, with their end of service date if any. The version of the clusters
+// created without a version is the default one.
+func (server *Server) kubeVersions(platform string) []kubernetesserviceapiv1.KubeVersion {
+	versions := []kubernetesserviceapiv1.KubeVersion{}
+	for _, version := range server.options.Versions[platform] {
+		components := append(common.VersionComponents(version), 0, 0, 0)
+		kubeVersion := kubernetesserviceapiv1.KubeVersion{
+			Default: boolPtr(strings.HasPrefix(server.options.KubeVersion, version+"_") || server.options.KubeVersion == version),
+			Major:   int64Ptr(int64(components[0])),
+			Minor:   int64Ptr(int64(components[1])),
+			Patch:   int64Ptr(int64(components[2])),
+		}
+		if endOfService, ok := server.options.EndOfService[fmt.Sprintf("%d.%d", components[0], components[1])]; ok {
+			kubeVersion.EndOfService = stringPtr(endOfService)
+		}
+		versions = append(versions, kubeVersion)
+	}
+	return versions
+}
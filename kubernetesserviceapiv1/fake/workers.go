@@ -0,0 +1,566 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// addWorkerRoutes adds the worker pool and worker routes.
+func (server *Server) addWorkerRoutes() {
+	server.handle("POST", "/v2/vpc/createWorkerPool", server.createWorkerPool)
+	server.handle("POST", "/v2/satellite/createWorkerPool", server.createWorkerPool)
+	server.handle("POST", "/v1/clusters/{idOrName}/workerpools", server.createWorkerPoolV1)
+
+	server.handle("GET", "/v2/getWorkerPools", server.getWorkerPools)
+	server.handle("GET", "/v2/vpc/getWorkerPools", server.getWorkerPools)
+	server.handle("GET", "/v2/classic/getWorkerPools", server.getWorkerPools)
+	server.handle("GET", "/v1/clusters/{idOrName}/workerpools", server.getWorkerPoolsV1)
+
+	server.handle("GET", "/v2/getWorkerPool", server.getWorkerPool)
+	server.handle("GET", "/v2/vpc/getWorkerPool", server.getWorkerPool)
+	server.handle("GET", "/v2/classic/getWorkerPool", server.getWorkerPool)
+	server.handle("GET", "/v1/clusters/{idOrName}/workerpools/{poolidOrName}", server.getWorkerPoolV1)
+
+	server.handle("POST", "/v2/resizeWorkerPool", server.resizeWorkerPool)
+	server.handle("PATCH", "/v1/clusters/{idOrName}/workerpools/{poolidOrName}", server.patchWorkerPool)
+	server.handle("POST", "/v2/setWorkerPoolLabels", server.setWorkerPoolLabels)
+	server.handle("POST", "/v2/setWorkerPoolTaints", server.setWorkerPoolTaints)
+	server.handle("POST", "/v2/removeWorkerPool", server.removeWorkerPool)
+	server.handle("DELETE", "/v1/clusters/{idOrName}/workerpools/{poolidOrName}", server.removeWorkerPoolV1)
+
+	server.handle("POST", "/v2/vpc/createWorkerPoolZone", server.createWorkerPoolZone)
+	server.handle("POST", "/v2/satellite/createWorkerPoolZone", server.createWorkerPoolZone)
+	server.handle("POST", "/v1/clusters/{idOrName}/workerpools/{poolidOrName}/zones", server.addWorkerPoolZoneV1)
+	server.handle("POST", "/v2/removeWorkerPoolZone", server.removeWorkerPoolZone)
+	server.handle("DELETE", "/v1/clusters/{idOrName}/workerpools/{poolidOrName}/zones/{zoneid}", server.removeWorkerPoolZoneV1)
+
+	server.handle("GET", "/v2/getWorkers", server.getWorkers)
+	server.handle("GET", "/v2/vpc/getWorkers", server.getWorkers)
+	server.handle("GET", "/v2/classic/getWorkers", server.getWorkers)
+	server.handle("GET", "/v1/clusters/{idOrName}/workers", server.getWorkersV1)
+
+	server.handle("GET", "/v2/getWorker", server.getWorker)
+	server.handle("GET", "/v2/vpc/getWorker", server.getWorker)
+	server.handle("GET", "/v2/classic/getWorker", server.getWorker)
+	server.handle("GET", "/v1/clusters/{idOrName}/workers/{workerId}", server.getWorkerV1)
+
+	server.handle("POST", "/v2/replaceWorker", server.replaceWorker)
+	server.handle("POST", "/v2/vpc/replaceWorker", server.replaceWorker)
+	server.handle("PUT", "/v1/clusters/{idOrName}/workers/{workerId}", server.updateWorkerV1)
+	server.handle("POST", "/v2/removeWorker", server.removeWorker)
+	server.handle("DELETE", "/v1/clusters/{idOrName}/workers/{workerId}", server.removeWorkerV1)
+}
+
+// SetWorkerStates scripts the states the worker goes through from now on.
+func (server *Server) SetWorkerStates(clusterIDOrName string, workerID string, states ...string) error {
+	return server.withCluster(clusterIDOrName, func(cluster *cluster) {
+		if worker := cluster.findWorker(workerID); worker != nil {
+			worker.state = newScript(states...)
+		}
+	})
+}
+
+// poolBody : The cluster and worker pool of the v2 worker pool requests.
+type poolBody struct {
+	Cluster    string
+	Workerpool string
+}
+
+// createWorkerPool serves VpcCreateWorkerPool and CreateSatelliteWorkerPool.
+func (server *Server) createWorkerPool(c *call) {
+	var body struct {
+		Cluster     string
+		Name        string
+		Flavor      string
+		Isolation   string
+		WorkerCount int
+		Labels      map[string]string
+		HostLabels  map[string]string
+		Zones       []struct {
+			ID       string
+			SubnetID string
+		}
+	}
+	if !c.decode(&body) {
+		return
+	}
+	cluster := server.requireCluster(c, body.Cluster)
+	if cluster == nil {
+		return
+	}
+	pool := &workerPool{
+		name:       body.Name,
+		flavor:     body.Flavor,
+		isolation:  body.Isolation,
+		perZone:    body.WorkerCount,
+		labels:     body.Labels,
+		hostLabels: body.HostLabels,
+	}
+	for _, zone := range body.Zones {
+		pool.zones = append(pool.zones, poolZone{id: zone.ID, subnetID: zone.SubnetID})
+	}
+	if !server.checkNewPool(c, cluster, pool) {
+		return
+	}
+	server.addPool(cluster, pool)
+	c.json(http.StatusCreated, kubernetesserviceapiv1.CreateWorkerpoolResponse{WorkerPoolID: stringPtr(pool.id)})
+}
+
+// createWorkerPoolV1 serves CreateWorkerPool.
+func (server *Server) createWorkerPoolV1(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	var body struct {
+		Name        string
+		MachineType string
+		Isolation   string
+		SizePerZone int
+		Labels      map[string]string
+		Zones       []struct {
+			ID string
+		}
+	}
+	if !c.decode(&body) {
+		return
+	}
+	pool := &workerPool{
+		name:      body.Name,
+		flavor:    body.MachineType,
+		isolation: body.Isolation,
+		perZone:   body.SizePerZone,
+		labels:    body.Labels,
+	}
+	for _, zone := range body.Zones {
+		pool.zones = append(pool.zones, poolZone{id: zone.ID})
+	}
+	if !server.checkNewPool(c, cluster, pool) {
+		return
+	}
+	server.addPool(cluster, pool)
+	c.json(http.StatusCreated, cluster.workerPoolV1(pool))
+}
+
+// checkNewPool reports a 400 or 409 if pool cannot be added to the cluster.
+func (server *Server) checkNewPool(c *call, cluster *cluster, pool *workerPool) bool {
+	if pool.name == "" {
+		c.badRequest("The worker pool name is required.")
+		return false
+	}
+	if cluster.findPool(pool.name) != nil {
+		c.error(http.StatusConflict, "E0007", fmt.Sprintf("A worker pool with the name '%s' already exists.", pool.name))
+		return false
+	}
+	return true
+}
+
+// getWorkerPools serves GetWorkerPools1, VpcGetWorkerPools and ClassicGetWorkerPools.
+func (server *Server) getWorkerPools(c *call) {
+	cluster := server.queryCluster(c)
+	if cluster == nil {
+		return
+	}
+	pools := []kubernetesserviceapiv1.GetWorkerPoolResponse{}
+	for _, pool := range cluster.pools {
+		pools = append(pools, cluster.getWorkerPoolResponse(pool))
+	}
+	c.ok(pools)
+}
+
+// getWorkerPoolsV1 serves GetWorkerPools.
+func (server *Server) getWorkerPoolsV1(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	pools := []kubernetesserviceapiv1.WorkerPoolResponse{}
+	for _, pool := range cluster.pools {
+		pools = append(pools, cluster.workerPoolV1(pool))
+	}
+	c.ok(pools)
+}
+
+// getWorkerPool serves GetWorkerPool, VpcGetWorkerPool and ClassicGetWorkerPool.
+func (server *Server) getWorkerPool(c *call) {
+	cluster, pool := server.requirePool(c, c.query("cluster"), c.query("workerpool"))
+	if pool == nil {
+		return
+	}
+	c.ok(cluster.getWorkerPoolResponse(pool))
+}
+
+// getWorkerPoolV1 serves GetWorkerPool1.
+func (server *Server) getWorkerPoolV1(c *call) {
+	cluster, pool := server.requirePool(c, c.params["idOrName"], c.params["poolidOrName"])
+	if pool == nil {
+		return
+	}
+	c.ok(cluster.workerPoolV1(pool))
+}
+
+// resizeWorkerPool serves V2ResizeWorkerPool.
+func (server *Server) resizeWorkerPool(c *call) {
+	var body struct {
+		poolBody
+		Size int
+	}
+	if !c.decode(&body) {
+		return
+	}
+	cluster, pool := server.requirePool(c, body.Cluster, body.Workerpool)
+	if pool == nil {
+		return
+	}
+	pool.perZone = body.Size
+	server.resizePool(cluster, pool)
+	c.noContent()
+}
+
+// patchWorkerPool serves PatchWorkerPool, which resizes, rebalances or labels the worker pool.
+func (server *Server) patchWorkerPool(c *call) {
+	cluster, pool := server.requirePool(c, c.params["idOrName"], c.params["poolidOrName"])
+	if pool == nil {
+		return
+	}
+	var body struct {
+		State       string
+		SizePerZone int
+		Labels      map[string]string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	switch body.State {
+	case "resizing":
+		pool.perZone = body.SizePerZone
+		server.resizePool(cluster, pool)
+	case "rebalancing":
+		server.resizePool(cluster, pool)
+	case "labels":
+		pool.labels = body.Labels
+	default:
+		c.badRequest(fmt.Sprintf("The state '%s' is not supported.", body.State))
+		return
+	}
+	c.noContent()
+}
+
+// setWorkerPoolLabels serves V2SetWorkerPoolLabels.
+func (server *Server) setWorkerPoolLabels(c *call) {
+	var body struct {
+		poolBody
+		Labels map[string]string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	if _, pool := server.requirePool(c, body.Cluster, body.Workerpool); pool != nil {
+		pool.labels = body.Labels
+		c.noContent()
+	}
+}
+
+// setWorkerPoolTaints serves V2SetWorkerPoolTaints.
+func (server *Server) setWorkerPoolTaints(c *call) {
+	var body struct {
+		poolBody
+		Taints map[string]string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	if _, pool := server.requirePool(c, body.Cluster, body.Workerpool); pool != nil {
+		pool.taints = body.Taints
+		c.noContent()
+	}
+}
+
+// removeWorkerPool serves RemoveWorkerPool1.
+func (server *Server) removeWorkerPool(c *call) {
+	var body poolBody
+	if !c.decode(&body) {
+		return
+	}
+	server.deletePool(c, body.Cluster, body.Workerpool)
+}
+
+// removeWorkerPoolV1 serves RemoveWorkerPool.
+func (server *Server) removeWorkerPoolV1(c *call) {
+	server.deletePool(c, c.params["idOrName"], c.params["poolidOrName"])
+}
+
+// deletePool removes the worker pool and its workers.
+func (server *Server) deletePool(c *call, clusterIDOrName string, poolIDOrName string) {
+	cluster, pool := server.requirePool(c, clusterIDOrName, poolIDOrName)
+	if pool == nil {
+		return
+	}
+	for _, worker := range cluster.poolWorkers(pool, "") {
+		cluster.removeWorker(worker.id)
+	}
+	for i := range cluster.pools {
+		if cluster.pools[i] == pool {
+			cluster.pools = append(cluster.pools[:i], cluster.pools[i+1:]...)
+			break
+		}
+	}
+	c.noContent()
+}
+
+// createWorkerPoolZone serves VpcCreateWorkerPoolZone and CreateSatelliteWorkerPoolZone.
+func (server *Server) createWorkerPoolZone(c *call) {
+	var body struct {
+		poolBody
+		ID       string
+		SubnetID string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	server.addZone(c, body.Cluster, body.Workerpool, poolZone{id: body.ID, subnetID: body.SubnetID})
+}
+
+// addWorkerPoolZoneV1 serves AddWorkerPoolZone.
+func (server *Server) addWorkerPoolZoneV1(c *call) {
+	var body struct {
+		ID string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	server.addZone(c, c.params["idOrName"], c.params["poolidOrName"], poolZone{id: body.ID})
+}
+
+// addZone adds zone to the worker pool and provisions its workers.
+func (server *Server) addZone(c *call, clusterIDOrName string, poolIDOrName string, zone poolZone) {
+	cluster, pool := server.requirePool(c, clusterIDOrName, poolIDOrName)
+	if pool == nil {
+		return
+	}
+	if zone.id == "" {
+		c.badRequest("The zone is required.")
+		return
+	}
+	for _, existing := range pool.zones {
+		if existing.id == zone.id {
+			c.error(http.StatusConflict, "E0007", fmt.Sprintf("The zone '%s' is already in the worker pool.", zone.id))
+			return
+		}
+	}
+	pool.zones = append(pool.zones, zone)
+	server.resizePool(cluster, pool)
+	c.json(http.StatusCreated, nil)
+}
+
+// removeWorkerPoolZone serves RemoveWorkerPoolZone1.
+func (server *Server) removeWorkerPoolZone(c *call) {
+	var body struct {
+		poolBody
+		Zone string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	server.deleteZone(c, body.Cluster, body.Workerpool, body.Zone)
+}
+
+// removeWorkerPoolZoneV1 serves RemoveWorkerPoolZone.
+func (server *Server) removeWorkerPoolZoneV1(c *call) {
+	server.deleteZone(c, c.params["idOrName"], c.params["poolidOrName"], c.params["zoneid"])
+}
+
+// deleteZone removes zone and its workers from the worker pool.
+func (server *Server) deleteZone(c *call, clusterIDOrName string, poolIDOrName string, zone string) {
+	cluster, pool := server.requirePool(c, clusterIDOrName, poolIDOrName)
+	if pool == nil {
+		return
+	}
+	for i, existing := range pool.zones {
+		if existing.id == zone {
+			for _, worker := range cluster.poolWorkers(pool, zone) {
+				cluster.removeWorker(worker.id)
+			}
+			pool.zones = append(pool.zones[:i], pool.zones[i+1:]...)
+			c.noContent()
+			return
+		}
+	}
+	c.notFound("zone", zone)
+}
+
+// getWorkers serves GetWorkers1, VpcGetWorkers and ClassicGetWorkers.
+func (server *Server) getWorkers(c *call) {
+	cluster := server.queryCluster(c)
+	if cluster == nil {
+		return
+	}
+	poolFilter := c.query("pool")
+	workers := []kubernetesserviceapiv1.GetWorkerResponse{}
+	for _, worker := range cluster.workers {
+		if poolFilter != "" {
+			if pool := cluster.findPool(poolFilter); pool == nil || pool.id != worker.poolID {
+				continue
+			}
+		}
+		workers = append(workers, server.getWorkerResponse(cluster, worker))
+	}
+	c.ok(workers)
+}
+
+// getWorkersV1 serves GetClusterWorkers.
+func (server *Server) getWorkersV1(c *call) {
+	cluster := server.pathCluster(c)
+	if cluster == nil {
+		return
+	}
+	workers := []kubernetesserviceapiv1.Worker{}
+	for _, worker := range cluster.workers {
+		workers = append(workers, server.workerV1(cluster, worker))
+	}
+	c.ok(workers)
+}
+
+// getWorker serves GetWorker, VpcGetWorker and ClassicGetWorker.
+func (server *Server) getWorker(c *call) {
+	cluster, worker := server.requireWorker(c, c.query("cluster"), c.query("worker"))
+	if worker == nil {
+		return
+	}
+	c.ok(server.getWorkerResponse(cluster, worker))
+}
+
+// getWorkerV1 serves GetWorkers.
+func (server *Server) getWorkerV1(c *call) {
+	cluster, worker := server.requireWorker(c, c.params["idOrName"], c.params["workerId"])
+	if worker == nil {
+		return
+	}
+	c.ok(server.workerV1(cluster, worker))
+}
+
+// replaceWorker serves ReplaceWorker and VpcReplaceWorker. The worker is removed and a new one, with a new ID, is
+// provisioned in its zone. The new worker runs the master version if the body asks for an update, and the version
+// of the worker it replaces otherwise.
+func (server *Server) replaceWorker(c *call) {
+	var body struct {
+		Cluster  string
+		WorkerID string
+		Update   bool
+	}
+	if !c.decode(&body) {
+		return
+	}
+	cluster, worker := server.requireWorker(c, body.Cluster, body.WorkerID)
+	if worker == nil {
+		return
+	}
+	pool := cluster.findPool(worker.poolID)
+	cluster.removeWorker(worker.id)
+	if pool != nil {
+		replacement := server.addWorker(cluster, pool, worker.zone)
+		if !body.Update {
+			replacement.version = worker.version
+		}
+	}
+	c.noContent()
+}
+
+// updateWorkerV1 serves UpdateClusterWorker, which reloads, reboots or updates the worker.
+func (server *Server) updateWorkerV1(c *call) {
+	cluster, worker := server.requireWorker(c, c.params["idOrName"], c.params["workerId"])
+	if worker == nil {
+		return
+	}
+	var body struct {
+		Action string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	switch body.Action {
+	case "reload":
+		worker.pendingOperation = "reloading"
+	case "reboot", "os_reboot":
+		worker.pendingOperation = "rebooting"
+	case "update":
+		worker.pendingOperation = "updating"
+		worker.targetVersion = cluster.masterVersion
+	default:
+		c.badRequest(fmt.Sprintf("The action '%s' is not supported.", body.Action))
+		return
+	}
+	worker.state = newScript(kubernetesserviceapiv1.WorkerStateReloading, kubernetesserviceapiv1.WorkerStateDeployed)
+	c.noContent()
+}
+
+// removeWorker serves V2RemoveWorker.
+func (server *Server) removeWorker(c *call) {
+	var body struct {
+		Cluster  string
+		WorkerID string
+	}
+	if !c.decode(&body) {
+		return
+	}
+	server.deleteWorker(c, body.Cluster, body.WorkerID)
+}
+
+// removeWorkerV1 serves RemoveClusterWorker.
+func (server *Server) removeWorkerV1(c *call) {
+	server.deleteWorker(c, c.params["idOrName"], c.params["workerId"])
+}
+
+// deleteWorker removes the worker.
+func (server *Server) deleteWorker(c *call, clusterIDOrName string, workerID string) {
+	cluster, worker := server.requireWorker(c, clusterIDOrName, workerID)
+	if worker == nil {
+		return
+	}
+	cluster.removeWorker(worker.id)
+	c.noContent()
+}
+
+// requirePool returns the cluster and its worker pool, or reports a 404.
+func (server *Server) requirePool(c *call, clusterIDOrName string, poolIDOrName string) (*cluster, *workerPool) {
+	cluster := server.requireCluster(c, clusterIDOrName)
+	if cluster == nil {
+		return nil, nil
+	}
+	pool := cluster.findPool(poolIDOrName)
+	if pool == nil {
+		c.notFound("worker pool", poolIDOrName)
+	}
+	return cluster, pool
+}
+
+// requireWorker returns the cluster and its worker, or reports a 404.
+func (server *Server) requireWorker(c *call, clusterIDOrName string, workerID string) (*cluster, *worker) {
+	cluster := server.requireCluster(c, clusterIDOrName)
+	if cluster == nil {
+		return nil, nil
+	}
+	worker := cluster.findWorker(workerID)
+	if worker == nil {
+		c.notFound("worker", workerID)
+	}
+	return cluster, worker
+}
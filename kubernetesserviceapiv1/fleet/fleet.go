@@ -0,0 +1,434 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fleet reports the versions of the clusters of an account and their end of service.
+//
+// A Reporter lists the classic, VPC and Satellite clusters with ClassicGetClusters, VpcGetClusters and
+// GetSatelliteClusters, and the workers of each cluster. It compares the versions of the masters and of the workers
+// with the versions listed by GetVersions, and reports for each cluster the date its oldest version reaches its end
+// of service, the patch update available to its master, and the workers that run an older version than the master:
+//
+//	report, err := fleet.NewReporter(kubernetesServiceApi, nil).Report(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	err = report.WriteCSV(os.Stdout)
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+const (
+	// DefaultWarningDays is the number of days before the end of service of a version when Options.WarningDays is
+	// not set.
+	DefaultWarningDays = 90
+
+	// DefaultConcurrency is the number of clusters whose workers are read at once when Options.Concurrency is not
+	// set.
+	DefaultConcurrency = 4
+)
+
+// Status : The support status of the versions of a cluster.
+type Status string
+
+// Support statuses of the clusters.
+const (
+	// StatusSupported clusters run versions that are supported for more than the warning period.
+	StatusSupported Status = "supported"
+
+	// StatusEndingSoon clusters run a version that reaches its end of service within the warning period.
+	StatusEndingSoon Status = "ending_soon"
+
+	// StatusUnsupported clusters run a version past its end of service, or no longer listed.
+	StatusUnsupported Status = "unsupported"
+
+	// StatusUnknown clusters run versions whose end of service is not listed.
+	StatusUnknown Status = "unknown"
+)
+
+// Options : The options of NewReporter.
+type Options struct {
+	// The number of days before the end of service of a version from which the clusters running it are
+	// StatusEndingSoon. Defaults to DefaultWarningDays.
+	WarningDays int
+
+	// The number of clusters whose workers are read at once. Defaults to DefaultConcurrency.
+	Concurrency int
+
+	// The resource group of the clusters to report. Defaults to the resource group of the client.
+	XAuthResourceGroup *string
+
+	// Returns the time of the report. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// Worker : A worker running an older version than the master of its cluster.
+type Worker struct {
+	// The ID of the worker.
+	ID string `json:"id"`
+
+	// The name of the worker pool of the worker.
+	Pool string `json:"pool,omitempty"`
+
+	// The version the worker runs, such as "1.28.11_1560".
+	Version string `json:"version"`
+}
+
+// Cluster : The versions of a cluster and their end of service.
+type Cluster struct {
+	// The ID of the cluster.
+	ID string `json:"id"`
+
+	// The name of the cluster.
+	Name string `json:"name"`
+
+	// The infrastructure provider of the cluster, such as "classic", "vpc-gen2" or "satellite".
+	Provider string `json:"provider"`
+
+	// The region of the cluster.
+	Region string `json:"region,omitempty"`
+
+	// The location of the cluster, such as a zone, a data center or a Satellite location.
+	Location string `json:"location,omitempty"`
+
+	// The resource group of the cluster.
+	ResourceGroup string `json:"resource_group,omitempty"`
+
+	// The container platform of the cluster, "kubernetes" or "openshift".
+	Platform string `json:"platform"`
+
+	// The version the master runs, such as "1.29.3_1547".
+	MasterVersion string `json:"master_version"`
+
+	// The version the master is being updated to, if any.
+	TargetVersion string `json:"target_version,omitempty"`
+
+	// The oldest version run by a worker, if it is older than the master.
+	OldestWorkerVersion string `json:"oldest_worker_version,omitempty"`
+
+	// The date the oldest version run by the master or by a worker reaches its end of service, such as
+	// "2024-08-02", if it is listed.
+	EndOfService string `json:"end_of_service,omitempty"`
+
+	// The number of days from the report until EndOfService, negative once it has passed. Nil if EndOfService is
+	// not listed.
+	DaysToEndOfService *int `json:"days_to_end_of_service,omitempty"`
+
+	// The support status of the versions of the cluster.
+	Status Status `json:"status"`
+
+	// The latest patch version of the major and minor version of the master, such as "1.29.5", if it is newer than
+	// the master.
+	PatchUpdate string `json:"patch_update,omitempty"`
+
+	// The number of workers of the cluster.
+	Workers int `json:"workers"`
+
+	// The workers that run an older version than the master.
+	LaggingWorkers []Worker `json:"lagging_workers,omitempty"`
+
+	// The error reading the workers of the cluster, if any. The worker fields are not set then.
+	Error string `json:"error,omitempty"`
+}
+
+// Report : The versions of the clusters of an account and their end of service.
+type Report struct {
+	// The time of the report.
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// The clusters, by provider, region and name.
+	Clusters []Cluster `json:"clusters"`
+}
+
+// Reporter : Reports the versions of the clusters of the account of a client.
+type Reporter struct {
+	service *kubernetesserviceapiv1.KubernetesServiceApiV1
+	options Options
+}
+
+// NewReporter returns a Reporter of the clusters of service, configured by options, which may be nil.
+func NewReporter(service *kubernetesserviceapiv1.KubernetesServiceApiV1, options *Options) *Reporter {
+	reporter := &Reporter{service: service}
+	if options != nil {
+		reporter.options = *options
+	}
+	if reporter.options.WarningDays <= 0 {
+		reporter.options.WarningDays = DefaultWarningDays
+	}
+	if reporter.options.Concurrency <= 0 {
+		reporter.options.Concurrency = DefaultConcurrency
+	}
+	if reporter.options.Now == nil {
+		reporter.options.Now = time.Now
+	}
+	return reporter
+}
+
+// Report lists the clusters and their workers and returns their report. It fails if the clusters or the versions
+// cannot be listed, but reports the errors reading the workers of a cluster in its Error field.
+func (reporter *Reporter) Report(ctx context.Context) (*Report, error) {
+	versions, _, err := reporter.service.GetVersionsWithContext(ctx, &kubernetesserviceapiv1.GetVersionsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("fleet: listing the versions: %w", err)
+	}
+	clusters, err := reporter.listClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{GeneratedAt: reporter.options.Now(), Clusters: make([]Cluster, len(clusters))}
+	semaphore := make(chan struct{}, reporter.options.Concurrency)
+	var wg sync.WaitGroup
+	for i := range clusters {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			report.Clusters[i] = reporter.cluster(ctx, &clusters[i], versions, report.GeneratedAt)
+		}(i)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(report.Clusters, func(i int, j int) bool {
+		a, b := report.Clusters[i], report.Clusters[j]
+		if a.Provider != b.Provider {
+			return a.Provider < b.Provider
+		}
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		return a.Name < b.Name
+	})
+	return report, nil
+}
+
+// listClusters lists the classic, VPC and Satellite clusters.
+func (reporter *Reporter) listClusters(ctx context.Context) ([]kubernetesserviceapiv1.GetClustersResponse, error) {
+	classic, _, err := reporter.service.ClassicGetClustersWithContext(ctx, &kubernetesserviceapiv1.ClassicGetClustersOptions{
+		XAuthResourceGroup: reporter.options.XAuthResourceGroup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fleet: listing the classic clusters: %w", err)
+	}
+	vpc, _, err := reporter.service.VpcGetClustersWithContext(ctx, &kubernetesserviceapiv1.VpcGetClustersOptions{
+		XAuthResourceGroup: reporter.options.XAuthResourceGroup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fleet: listing the VPC clusters: %w", err)
+	}
+	satellite, _, err := reporter.service.GetSatelliteClustersWithContext(ctx, &kubernetesserviceapiv1.GetSatelliteClustersOptions{
+		XAuthResourceGroup: reporter.options.XAuthResourceGroup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fleet: listing the Satellite clusters: %w", err)
+	}
+	return append(append(classic, vpc...), satellite...), nil
+}
+
+// cluster returns the report of cluster at now, given the listed versions.
+func (reporter *Reporter) cluster(ctx context.Context, cluster *kubernetesserviceapiv1.GetClustersResponse, versions map[string][]kubernetesserviceapiv1.KubeVersion, now time.Time) Cluster {
+	result := Cluster{
+		ID:            core.StringNilMapper(cluster.ID),
+		Name:          core.StringNilMapper(cluster.Name),
+		Provider:      core.StringNilMapper(cluster.Provider),
+		Region:        core.StringNilMapper(cluster.Region),
+		Location:      core.StringNilMapper(cluster.Location),
+		ResourceGroup: core.StringNilMapper(cluster.ResourceGroupName),
+		Platform:      platform(cluster),
+		MasterVersion: core.StringNilMapper(cluster.MasterKubeVersion),
+		TargetVersion: core.StringNilMapper(cluster.TargetVersion),
+	}
+	if result.ResourceGroup == "" {
+		result.ResourceGroup = core.StringNilMapper(cluster.ResourceGroup)
+	}
+	if result.TargetVersion == result.MasterVersion {
+		result.TargetVersion = ""
+	}
+	listed := versions[result.Platform]
+
+	workers, _, err := reporter.service.GetWorkers1WithContext(ctx, &kubernetesserviceapiv1.GetWorkers1Options{
+		Cluster:            cluster.ID,
+		XAuthResourceGroup: reporter.options.XAuthResourceGroup,
+	})
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Workers = len(workers)
+		for _, worker := range workers {
+			version := workerVersion(&worker)
+			if version == "" || common.CompareVersions(version, numeric(result.MasterVersion)) >= 0 {
+				continue
+			}
+			result.LaggingWorkers = append(result.LaggingWorkers, Worker{
+				ID:      core.StringNilMapper(worker.ID),
+				Pool:    core.StringNilMapper(worker.PoolName),
+				Version: version,
+			})
+			if result.OldestWorkerVersion == "" || common.CompareVersions(version, numeric(result.OldestWorkerVersion)) < 0 {
+				result.OldestWorkerVersion = version
+			}
+		}
+	}
+
+	result.Status = StatusSupported
+	for _, version := range []string{result.MasterVersion, result.OldestWorkerVersion} {
+		if version == "" {
+			continue
+		}
+		endOfService, ok := endOfService(listed, version)
+		if !ok {
+			result.Status = worse(result.Status, StatusUnsupported)
+			continue
+		}
+		if endOfService.IsZero() {
+			result.Status = worse(result.Status, StatusUnknown)
+			continue
+		}
+		if result.EndOfService == "" || endOfService.Format(dateLayout) < result.EndOfService {
+			result.EndOfService = endOfService.Format(dateLayout)
+			days := daysBetween(now, endOfService)
+			result.DaysToEndOfService = &days
+		}
+	}
+	if days := result.DaysToEndOfService; days != nil {
+		switch {
+		case *days < 0:
+			result.Status = worse(result.Status, StatusUnsupported)
+		case *days <= reporter.options.WarningDays:
+			result.Status = worse(result.Status, StatusEndingSoon)
+		}
+	}
+	result.PatchUpdate = patchUpdate(listed, result.MasterVersion)
+	return result
+}
+
+// dateLayout is the layout of the end of service dates.
+const dateLayout = "2006-01-02"
+
+// severities orders the statuses from the best to the worst.
+var severities = map[Status]int{StatusSupported: 0, StatusUnknown: 1, StatusEndingSoon: 2, StatusUnsupported: 3}
+
+// worse returns the worse of the statuses a and b.
+func worse(a Status, b Status) Status {
+	if severities[b] > severities[a] {
+		return b
+	}
+	return a
+}
+
+// endOfService returns the end of service date of the major and minor version of version among listed, which is
+// zero if it is not set, and whether the version is listed.
+func endOfService(listed []kubernetesserviceapiv1.KubeVersion, version string) (time.Time, bool) {
+	var date time.Time
+	found := false
+	for _, kubeVersion := range listed {
+		if common.CompareVersions(version, majorMinor(kubeVersion)) != 0 {
+			continue
+		}
+		found = true
+		if parsed, ok := parseDate(core.StringNilMapper(kubeVersion.EndOfService)); ok && (date.IsZero() || parsed.Before(date)) {
+			date = parsed
+		}
+	}
+	return date, found
+}
+
+// patchUpdate returns the latest patch version listed for the major and minor version of master, if it is newer
+// than master.
+func patchUpdate(listed []kubernetesserviceapiv1.KubeVersion, master string) string {
+	latest := numeric(master)
+	update := ""
+	for _, kubeVersion := range listed {
+		version := fmt.Sprintf("%d.%d.%d", int64Value(kubeVersion.Major), int64Value(kubeVersion.Minor), int64Value(kubeVersion.Patch))
+		if common.CompareVersions(master, majorMinor(kubeVersion)) == 0 && common.CompareVersions(version, latest) > 0 {
+			latest, update = version, version
+		}
+	}
+	return update
+}
+
+// parseDate parses an end of service date, such as "2024-08-02" or "2024-08-02T00:00:00Z".
+func parseDate(date string) (time.Time, bool) {
+	for _, layout := range []string{dateLayout, time.RFC3339} {
+		if parsed, err := time.Parse(layout, date); err == nil {
+			return parsed.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// daysBetween returns the number of calendar days, in UTC, from now until date.
+func daysBetween(now time.Time, date time.Time) int {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return int(math.Round(day.Sub(today).Hours() / 24))
+}
+
+// platform returns the container platform of cluster, "kubernetes" or "openshift".
+func platform(cluster *kubernetesserviceapiv1.GetClustersResponse) string {
+	if strings.HasSuffix(core.StringNilMapper(cluster.MasterKubeVersion), "_openshift") || core.StringNilMapper(cluster.Type) == "openshift" {
+		return "openshift"
+	}
+	return "kubernetes"
+}
+
+// workerVersion returns the version the worker runs.
+func workerVersion(worker *kubernetesserviceapiv1.GetWorkerResponse) string {
+	if worker.KubeVersion == nil {
+		return ""
+	}
+	return core.StringNilMapper(worker.KubeVersion.Actual)
+}
+
+// majorMinor returns the major and minor components of version, such as "1.29".
+func majorMinor(version kubernetesserviceapiv1.KubeVersion) string {
+	return fmt.Sprintf("%d.%d", int64Value(version.Major), int64Value(version.Minor))
+}
+
+// numeric returns the numeric components of version, such as "1.29.3" for "1.29.3_1547".
+func numeric(version string) string {
+	components := common.VersionComponents(version)
+	parts := make([]string, len(components))
+	for i, component := range components {
+		parts[i] = fmt.Sprint(component)
+	}
+	return strings.Join(parts, ".")
+}
+
+// int64Value returns the value of i, or 0 if i is nil.
+func int64Value(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
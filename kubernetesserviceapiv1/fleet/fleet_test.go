@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fleet_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fleet"
+)
+
+var _ = Describe(`Reporter`, func() {
+	var (
+		server   *fake.Server
+		service  *kubernetesserviceapiv1.KubernetesServiceApiV1
+		reporter *fleet.Reporter
+		ctx      = context.Background()
+		wait     = common.WaitOptions{Interval: 5 * time.Millisecond}
+		now      = time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		server = fake.NewServer(&fake.Options{
+			Versions: map[string][]string{"kubernetes": {"1.28.11", "1.29.3", "1.29.5", "1.30.2"}},
+			EndOfService: map[string]string{
+				"1.28": "2026-11-01",
+				"1.29": "2027-04-30",
+				"1.30": "2027-09-30T00:00:00Z",
+			},
+		})
+		var err error
+		service, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		reporter = fleet.NewReporter(service, &fleet.Options{Now: func() time.Time { return now }})
+
+		vpcCreateClusterOptions := service.NewVpcCreateClusterOptions("rg1")
+		vpcCreateClusterOptions.SetName("vpc1")
+		vpcCreateClusterOptions.SetWorkerPool(&kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+			Flavor:      core.StringPtr("bx2.4x16"),
+			WorkerCount: core.Int64Ptr(2),
+			Zones:       []kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{{ID: core.StringPtr("us-south-1")}},
+		})
+		_, _, err = service.VpcCreateCluster(vpcCreateClusterOptions)
+		Expect(err).To(BeNil())
+
+		createClusterOptions := service.NewCreateClusterOptions("rg1")
+		createClusterOptions.SetName("classic1")
+		createClusterOptions.SetDataCenter("dal10")
+		createClusterOptions.SetMasterVersion("1.28.11_1560")
+		createClusterOptions.SetWorkerNum(1)
+		_, _, err = service.CreateCluster(createClusterOptions)
+		Expect(err).To(BeNil())
+
+		createSatelliteLocationOptions := service.NewCreateSatelliteLocationOptions()
+		createSatelliteLocationOptions.SetName("loc1")
+		createSatelliteLocationOptions.SetLocation("wdc04")
+		_, _, err = service.CreateSatelliteLocation(createSatelliteLocationOptions)
+		Expect(err).To(BeNil())
+		createSatelliteClusterOptions := service.NewCreateSatelliteClusterOptions()
+		createSatelliteClusterOptions.SetName("sat1")
+		createSatelliteClusterOptions.SetController("loc1")
+		createSatelliteClusterOptions.SetKubeVersion("1.27.16_1570")
+		_, _, err = service.CreateSatelliteCluster(createSatelliteClusterOptions)
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Reports the end of service, patch updates and lagging workers of the clusters`, func() {
+		_, err := service.WaitForClusterState(ctx, "vpc1", kubernetesserviceapiv1.ClusterMasterReady, &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: wait})
+		Expect(err).To(BeNil())
+		report, err := reporter.Report(ctx)
+		Expect(err).To(BeNil())
+		Expect(report.GeneratedAt).To(Equal(now))
+		Expect(report.Clusters).To(HaveLen(3))
+		vpc := report.Clusters[2]
+		Expect(vpc.Name).To(Equal("vpc1"))
+		Expect(vpc.EndOfService).To(Equal("2027-04-30"))
+		Expect(*vpc.DaysToEndOfService).To(Equal(194))
+		Expect(vpc.Status).To(Equal(fleet.StatusSupported))
+		Expect(vpc.PatchUpdate).To(Equal("1.29.5"))
+		Expect(vpc.Workers).To(Equal(2))
+		Expect(vpc.LaggingWorkers).To(BeEmpty())
+
+		updateClusterOptions := service.NewUpdateClusterOptions("vpc1")
+		updateClusterOptions.SetAction(kubernetesserviceapiv1.UpdateClusterOptions_Action_Update)
+		updateClusterOptions.SetVersion("1.30.2")
+		_, err = service.UpdateCluster(updateClusterOptions)
+		Expect(err).To(BeNil())
+		_, err = service.WaitForClusterState(ctx, "vpc1", kubernetesserviceapiv1.ClusterMasterVersion("1.30.2"), &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: wait})
+		Expect(err).To(BeNil())
+
+		report, err = reporter.Report(ctx)
+		Expect(err).To(BeNil())
+		classic, satellite, vpc := report.Clusters[0], report.Clusters[1], report.Clusters[2]
+
+		Expect(classic.Name).To(Equal("classic1"))
+		Expect(classic.Provider).To(Equal(fake.ProviderClassic))
+		Expect(classic.MasterVersion).To(Equal("1.28.11_1560"))
+		Expect(classic.EndOfService).To(Equal("2026-11-01"))
+		Expect(*classic.DaysToEndOfService).To(Equal(14))
+		Expect(classic.Status).To(Equal(fleet.StatusEndingSoon))
+		Expect(classic.PatchUpdate).To(BeEmpty())
+
+		Expect(satellite.Name).To(Equal("sat1"))
+		Expect(satellite.EndOfService).To(BeEmpty())
+		Expect(satellite.DaysToEndOfService).To(BeNil())
+		Expect(satellite.Status).To(Equal(fleet.StatusUnsupported))
+
+		Expect(vpc.MasterVersion).To(HavePrefix("1.30.2"))
+		Expect(vpc.TargetVersion).To(BeEmpty())
+		Expect(vpc.OldestWorkerVersion).To(Equal("1.29.3_1547"))
+		Expect(vpc.EndOfService).To(Equal("2027-04-30"))
+		Expect(vpc.PatchUpdate).To(BeEmpty())
+		Expect(vpc.LaggingWorkers).To(HaveLen(2))
+		Expect(vpc.LaggingWorkers[0].Pool).To(Equal("default"))
+		Expect(vpc.LaggingWorkers[0].Version).To(Equal("1.29.3_1547"))
+
+		var buffer bytes.Buffer
+		Expect(report.WriteJSON(&buffer)).To(Succeed())
+		decoded := &fleet.Report{}
+		Expect(json.Unmarshal(buffer.Bytes(), decoded)).To(Succeed())
+		Expect(decoded).To(Equal(report))
+
+		buffer.Reset()
+		Expect(report.WriteCSV(&buffer)).To(Succeed())
+		rows, err := csv.NewReader(&buffer).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(rows).To(HaveLen(4))
+		Expect(rows[0][10:14]).To(Equal([]string{"end_of_service", "days_to_end_of_service", "status", "patch_update"}))
+		Expect(rows[1][1]).To(Equal("classic1"))
+		Expect(rows[1][10:14]).To(Equal([]string{"2026-11-01", "14", "ending_soon", ""}))
+		Expect(rows[2][11]).To(BeEmpty())
+		Expect(rows[3][14:]).To(Equal([]string{"2", vpc.LaggingWorkers[0].ID + " (1.29.3_1547) " + vpc.LaggingWorkers[1].ID + " (1.29.3_1547)", ""}))
+	})
+
+	It(`Reports the errors reading the workers by cluster`, func() {
+		server.InjectFault(fake.Fault{Path: "/v2/getWorkers", StatusCode: 500})
+		report, err := reporter.Report(ctx)
+		Expect(err).To(BeNil())
+		for _, cluster := range report.Clusters {
+			Expect(cluster.Error).ToNot(BeEmpty())
+			Expect(cluster.Workers).To(BeZero())
+		}
+		Expect(report.Clusters[0].Status).To(Equal(fleet.StatusEndingSoon))
+
+		server.InjectFault(fake.Fault{Path: "/v2/satellite/getClusters", StatusCode: 403})
+		_, err = reporter.Report(ctx)
+		Expect(err).To(MatchError(HavePrefix("fleet: listing the Satellite clusters: ")))
+	})
+})
@@ -0,0 +1,69 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fleet
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// csvHeader is the header row of WriteCSV.
+var csvHeader = []string{
+	"id", "name", "provider", "region", "location", "resource_group", "platform",
+	"master_version", "target_version", "oldest_worker_version", "end_of_service", "days_to_end_of_service",
+	"status", "patch_update", "workers", "lagging_workers", "error",
+}
+
+// WriteJSON writes the report to w as an indented JSON document.
+func (report *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// WriteCSV writes the report to w as CSV, with a header row and a row per cluster. The lagging workers are listed
+// as "ID (version)", separated by spaces.
+func (report *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, cluster := range report.Clusters {
+		days := ""
+		if cluster.DaysToEndOfService != nil {
+			days = strconv.Itoa(*cluster.DaysToEndOfService)
+		}
+		lagging := make([]string, len(cluster.LaggingWorkers))
+		for i, worker := range cluster.LaggingWorkers {
+			lagging[i] = worker.ID + " (" + worker.Version + ")"
+		}
+		err := writer.Write([]string{
+			cluster.ID, cluster.Name, cluster.Provider, cluster.Region, cluster.Location, cluster.ResourceGroup,
+			cluster.Platform, cluster.MasterVersion, cluster.TargetVersion, cluster.OldestWorkerVersion,
+			cluster.EndOfService, days, string(cluster.Status), cluster.PatchUpdate, strconv.Itoa(cluster.Workers),
+			strings.Join(lagging, " "), cluster.Error,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+var _ = Describe(`Interceptors`, func() {
+	var testServer *httptest.Server
+	var kubernetesServiceApiService *kubernetesserviceapiv1.KubernetesServiceApiV1
+	var requests []*http.Request
+
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			res.Header().Set("Content-type", "application/json")
+			if strings.Contains(req.URL.String(), "missing") {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"code": "E0040", "description": "The specified cluster could not be found."}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "c1", "name": "myCluster"}`)
+		}))
+		var serviceErr error
+		kubernetesServiceApiService, serviceErr = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Wrap the operations in the order they were added`, func() {
+		var calls []string
+		for _, name := range []string{"first", "second"} {
+			name := name
+			kubernetesServiceApiService.AddInterceptor(func(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+				calls = append(calls, name+" "+operationID)
+				request.Header.Add("X-Audit", name)
+				response, err := next(request)
+				calls = append(calls, fmt.Sprintf("%s %d", name, response.StatusCode))
+				return response, err
+			})
+		}
+
+		result, _, err := kubernetesServiceApiService.GetCluster(kubernetesServiceApiService.NewGetClusterOptions("c1"))
+		Expect(err).To(BeNil())
+		Expect(*result.Name).To(Equal("myCluster"))
+		Expect(calls).To(Equal([]string{"first GetCluster", "second GetCluster", "second 200", "first 200"}))
+		Expect(requests[0].Header.Values("X-Audit")).To(Equal([]string{"first", "second"}))
+	})
+	It(`Find the operation in the request context`, func() {
+		var operation common.Operation
+		kubernetesServiceApiService.AddInterceptor(func(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+			operation, _ = common.OperationFromContext(request.Context())
+			return next(request)
+		})
+
+		_, _, err := kubernetesServiceApiService.GetCluster(kubernetesServiceApiService.NewGetClusterOptions("c1"))
+		Expect(err).To(BeNil())
+		Expect(operation).To(Equal(common.Operation{
+			ServiceName:  "kubernetes_service_api",
+			ID:           "GetCluster",
+			Method:       "GET",
+			PathTemplate: "/v2/getCluster",
+		}))
+	})
+	It(`Observe the APIError of a failed operation`, func() {
+		var observed error
+		kubernetesServiceApiService.AddInterceptor(func(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+			response, err := next(request)
+			observed = err
+			return response, err
+		})
+
+		_, _, err := kubernetesServiceApiService.GetCluster(kubernetesServiceApiService.NewGetClusterOptions("missing"))
+		var apiError *kubernetesserviceapiv1.APIError
+		Expect(errors.As(observed, &apiError)).To(BeTrue())
+		Expect(apiError.Code()).To(Equal("E0040"))
+		Expect(err).To(Equal(observed))
+	})
+	It(`Can reject an operation without sending it`, func() {
+		denied := errors.New("removing clusters is not allowed")
+		kubernetesServiceApiService.AddInterceptor(func(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+			if operationID == "RemoveCluster" {
+				return nil, denied
+			}
+			return next(request)
+		})
+
+		_, err := kubernetesServiceApiService.RemoveCluster(kubernetesServiceApiService.NewRemoveClusterOptions("c1"))
+		Expect(err).To(Equal(denied))
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Are copied by Clone`, func() {
+		var operations []string
+		kubernetesServiceApiService.AddInterceptor(func(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+			operations = append(operations, operationID)
+			return next(request)
+		})
+		clone := kubernetesServiceApiService.Clone()
+		clone.AddInterceptor(func(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+			return nil, errors.New("clone only")
+		})
+
+		_, _, err := kubernetesServiceApiService.GetCluster(kubernetesServiceApiService.NewGetClusterOptions("c1"))
+		Expect(err).To(BeNil())
+		_, _, err = clone.GetCluster(clone.NewGetClusterOptions("c1"))
+		Expect(err).To(MatchError("clone only"))
+		Expect(operations).To(Equal([]string{"GetCluster", "GetCluster"}))
+	})
+})
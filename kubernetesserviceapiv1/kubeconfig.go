@@ -0,0 +1,514 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats accepted by the Format option of GetKubeconfig and ApplyRBACAndGetKubeconfig.
+const (
+	KubeconfigFormatJSON = "json"
+	KubeconfigFormatYAML = "yaml"
+	KubeconfigFormatZip  = "zip"
+)
+
+// Kubeconfig : A Kubernetes client configuration file (kubeconfig).
+// Fields that are not modelled are kept in Extra, so that a parsed file is written back without losing them.
+type Kubeconfig struct {
+	APIVersion string `yaml:"apiVersion,omitempty" json:"apiVersion,omitempty"`
+
+	Kind string `yaml:"kind,omitempty" json:"kind,omitempty"`
+
+	Clusters []KubeconfigNamedCluster `yaml:"clusters" json:"clusters"`
+
+	Contexts []KubeconfigNamedContext `yaml:"contexts" json:"contexts"`
+
+	Users []KubeconfigNamedUser `yaml:"users" json:"users"`
+
+	CurrentContext string `yaml:"current-context" json:"current-context"`
+
+	Extra map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// KubeconfigNamedCluster : A cluster entry of a kubeconfig.
+type KubeconfigNamedCluster struct {
+	Name string `yaml:"name" json:"name"`
+
+	Cluster KubeconfigCluster `yaml:"cluster" json:"cluster"`
+}
+
+// KubeconfigCluster : How to reach the API server of a cluster.
+type KubeconfigCluster struct {
+	Server string `yaml:"server" json:"server"`
+
+	// The path of the CA certificate file.
+	CertificateAuthority string `yaml:"certificate-authority,omitempty" json:"certificate-authority,omitempty"`
+
+	// The base64 encoded PEM CA certificate.
+	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty" json:"certificate-authority-data,omitempty"`
+
+	InsecureSkipTLSVerify bool `yaml:"insecure-skip-tls-verify,omitempty" json:"insecure-skip-tls-verify,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// KubeconfigNamedContext : A context entry of a kubeconfig.
+type KubeconfigNamedContext struct {
+	Name string `yaml:"name" json:"name"`
+
+	Context KubeconfigContext `yaml:"context" json:"context"`
+}
+
+// KubeconfigContext : A cluster, user and namespace combination.
+type KubeconfigContext struct {
+	Cluster string `yaml:"cluster" json:"cluster"`
+
+	User string `yaml:"user" json:"user"`
+
+	Namespace string `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// KubeconfigNamedUser : A user entry of a kubeconfig.
+type KubeconfigNamedUser struct {
+	Name string `yaml:"name" json:"name"`
+
+	User KubeconfigUser `yaml:"user" json:"user"`
+}
+
+// KubeconfigUser : The credentials of a user. IAM based kubeconfigs authenticate with an auth provider or an exec
+// plugin, which are kept as is.
+type KubeconfigUser struct {
+	Token string `yaml:"token,omitempty" json:"token,omitempty"`
+
+	ClientCertificate string `yaml:"client-certificate,omitempty" json:"client-certificate,omitempty"`
+
+	ClientCertificateData string `yaml:"client-certificate-data,omitempty" json:"client-certificate-data,omitempty"`
+
+	ClientKey string `yaml:"client-key,omitempty" json:"client-key,omitempty"`
+
+	ClientKeyData string `yaml:"client-key-data,omitempty" json:"client-key-data,omitempty"`
+
+	AuthProvider map[string]interface{} `yaml:"auth-provider,omitempty" json:"auth-provider,omitempty"`
+
+	Exec map[string]interface{} `yaml:"exec,omitempty" json:"exec,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// ParseKubeconfig parses a kubeconfig in YAML or JSON format.
+func ParseKubeconfig(data []byte) (*Kubeconfig, error) {
+	kubeconfig := &Kubeconfig{}
+	if err := yaml.Unmarshal(data, kubeconfig); err != nil {
+		return nil, fmt.Errorf("error parsing kubeconfig: %s", err.Error())
+	}
+	return kubeconfig, nil
+}
+
+// ReadKubeconfigFile reads and parses the kubeconfig file at path.
+func ReadKubeconfigFile(path string) (*Kubeconfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKubeconfig(data)
+}
+
+// Marshal returns the kubeconfig in YAML format.
+func (kubeconfig *Kubeconfig) Marshal() ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(kubeconfig); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Cluster returns the cluster entry called name, or nil.
+func (kubeconfig *Kubeconfig) Cluster(name string) *KubeconfigCluster {
+	for i := range kubeconfig.Clusters {
+		if kubeconfig.Clusters[i].Name == name {
+			return &kubeconfig.Clusters[i].Cluster
+		}
+	}
+	return nil
+}
+
+// Context returns the context entry called name, or nil.
+func (kubeconfig *Kubeconfig) Context(name string) *KubeconfigContext {
+	for i := range kubeconfig.Contexts {
+		if kubeconfig.Contexts[i].Name == name {
+			return &kubeconfig.Contexts[i].Context
+		}
+	}
+	return nil
+}
+
+// User returns the user entry called name, or nil.
+func (kubeconfig *Kubeconfig) User(name string) *KubeconfigUser {
+	for i := range kubeconfig.Users {
+		if kubeconfig.Users[i].Name == name {
+			return &kubeconfig.Users[i].User
+		}
+	}
+	return nil
+}
+
+// CurrentCluster returns the cluster entry of the current context, or nil.
+func (kubeconfig *Kubeconfig) CurrentCluster() *KubeconfigCluster {
+	currentContext := kubeconfig.Context(kubeconfig.CurrentContext)
+	if currentContext == nil {
+		return nil
+	}
+	return kubeconfig.Cluster(currentContext.Cluster)
+}
+
+// CACertificate returns the decoded PEM CA certificate of the cluster, or nil if it is not embedded.
+func (cluster *KubeconfigCluster) CACertificate() ([]byte, error) {
+	if cluster.CertificateAuthorityData == "" {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(cluster.CertificateAuthorityData)
+}
+
+// Merge adds the clusters, contexts and users of other to the kubeconfig. Entries with the same name are replaced
+// and the other entries are kept. The current context is set to the one of other if setCurrentContext is true or
+// the kubeconfig has none.
+func (kubeconfig *Kubeconfig) Merge(other *Kubeconfig, setCurrentContext bool) {
+	for _, cluster := range other.Clusters {
+		if existing := kubeconfig.Cluster(cluster.Name); existing != nil {
+			*existing = cluster.Cluster
+		} else {
+			kubeconfig.Clusters = append(kubeconfig.Clusters, cluster)
+		}
+	}
+	for _, namedContext := range other.Contexts {
+		if existing := kubeconfig.Context(namedContext.Name); existing != nil {
+			*existing = namedContext.Context
+		} else {
+			kubeconfig.Contexts = append(kubeconfig.Contexts, namedContext)
+		}
+	}
+	for _, user := range other.Users {
+		if existing := kubeconfig.User(user.Name); existing != nil {
+			*existing = user.User
+		} else {
+			kubeconfig.Users = append(kubeconfig.Users, user)
+		}
+	}
+	if kubeconfig.APIVersion == "" {
+		kubeconfig.APIVersion = other.APIVersion
+	}
+	if kubeconfig.Kind == "" {
+		kubeconfig.Kind = other.Kind
+	}
+	if other.CurrentContext != "" && (setCurrentContext || kubeconfig.CurrentContext == "") {
+		kubeconfig.CurrentContext = other.CurrentContext
+	}
+}
+
+// WriteFile writes the kubeconfig to path with 0600 permissions, creating its directory if needed. The file is
+// replaced atomically, so that a concurrent reader never sees a partial kubeconfig.
+func (kubeconfig *Kubeconfig) WriteFile(path string) error {
+	data, err := kubeconfig.Marshal()
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data, 0600)
+}
+
+// MergeIntoFile merges the kubeconfig into the kubeconfig file at path, keeping its other clusters, contexts and
+// users, and writes it back with 0600 permissions. The file is created if it does not exist.
+func (kubeconfig *Kubeconfig) MergeIntoFile(path string, setCurrentContext bool) error {
+	existing, err := ReadKubeconfigFile(path)
+	if os.IsNotExist(err) {
+		existing, err = &Kubeconfig{}, nil
+	}
+	if err != nil {
+		return err
+	}
+	existing.Merge(kubeconfig, setCurrentContext)
+	return existing.WriteFile(path)
+}
+
+// MaxKubeconfigBundleFileSize is the largest file that ReadKubeconfigBundle reads from a bundle, and
+// MaxKubeconfigBundleSize the largest total size of its files, uncompressed.
+const (
+	MaxKubeconfigBundleFileSize = 4 << 20
+	MaxKubeconfigBundleSize     = 16 << 20
+)
+
+// KubeconfigBundle : The zip archive returned by GetKubeconfig when Format is KubeconfigFormatZip. It holds the
+// kubeconfig and the certificate files it refers to.
+type KubeconfigBundle struct {
+	files map[string][]byte
+}
+
+// ReadKubeconfigBundle reads a kubeconfig zip archive. Bundles with a file larger than MaxKubeconfigBundleFileSize, or
+// with files larger than MaxKubeconfigBundleSize in total, are rejected.
+func ReadKubeconfigBundle(data []byte) (*KubeconfigBundle, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("error reading kubeconfig bundle: %s", err.Error())
+	}
+	bundle := &KubeconfigBundle{files: make(map[string][]byte)}
+	var size int64
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		max := int64(MaxKubeconfigBundleFileSize)
+		if remaining := MaxKubeconfigBundleSize - size; remaining < max {
+			max = remaining
+		}
+		content, err := readZipFile(file, max)
+		if err != nil {
+			return nil, fmt.Errorf("error reading kubeconfig bundle: %s", err.Error())
+		}
+		if int64(len(content)) > max {
+			if max < MaxKubeconfigBundleFileSize {
+				return nil, fmt.Errorf("error reading kubeconfig bundle: the files are larger than %d bytes in total", MaxKubeconfigBundleSize)
+			}
+			return nil, fmt.Errorf("error reading kubeconfig bundle: file '%s' is larger than %d bytes", file.Name, MaxKubeconfigBundleFileSize)
+		}
+		size += int64(len(content))
+		bundle.files[file.Name] = content
+	}
+	return bundle, nil
+}
+
+// Names returns the names of the files in the bundle, sorted.
+func (bundle *KubeconfigBundle) Names() []string {
+	names := make([]string, 0, len(bundle.files))
+	for name := range bundle.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// File returns the content of the file called name, which is matched against the full name and then the base name.
+func (bundle *KubeconfigBundle) File(name string) ([]byte, bool) {
+	if content, ok := bundle.files[name]; ok {
+		return content, true
+	}
+	for _, fileName := range bundle.Names() {
+		if path.Base(fileName) == path.Base(name) {
+			return bundle.files[fileName], true
+		}
+	}
+	return nil, false
+}
+
+// Kubeconfig returns the kubeconfig of the bundle with the certificate files it refers to embedded as data, so that
+// it can be written or merged on its own.
+func (bundle *KubeconfigBundle) Kubeconfig() (*Kubeconfig, error) {
+	for _, name := range bundle.Names() {
+		extension := strings.ToLower(path.Ext(name))
+		if extension != ".yml" && extension != ".yaml" {
+			continue
+		}
+		kubeconfig, err := ParseKubeconfig(bundle.files[name])
+		if err != nil || len(kubeconfig.Clusters) == 0 {
+			continue
+		}
+		if err := bundle.embedFiles(kubeconfig); err != nil {
+			return nil, err
+		}
+		return kubeconfig, nil
+	}
+	return nil, fmt.Errorf("no kubeconfig found in bundle")
+}
+
+// embedFiles replaces the certificate file references of kubeconfig with their content.
+func (bundle *KubeconfigBundle) embedFiles(kubeconfig *Kubeconfig) error {
+	embed := func(fileName *string, data *string) error {
+		if *fileName == "" {
+			return nil
+		}
+		content, ok := bundle.File(*fileName)
+		if !ok {
+			return fmt.Errorf("file '%s' referenced by the kubeconfig not found in bundle", *fileName)
+		}
+		*data = base64.StdEncoding.EncodeToString(content)
+		*fileName = ""
+		return nil
+	}
+	for i := range kubeconfig.Clusters {
+		cluster := &kubeconfig.Clusters[i].Cluster
+		if err := embed(&cluster.CertificateAuthority, &cluster.CertificateAuthorityData); err != nil {
+			return err
+		}
+	}
+	for i := range kubeconfig.Users {
+		user := &kubeconfig.Users[i].User
+		if err := embed(&user.ClientCertificate, &user.ClientCertificateData); err != nil {
+			return err
+		}
+		if err := embed(&user.ClientKey, &user.ClientKeyData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetParsedKubeconfig is an alternate form of the GetKubeconfig method which returns the kubeconfig parsed. The
+// YAML, JSON and zip formats are all accepted.
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetParsedKubeconfig(getKubeconfigOptions *GetKubeconfigOptions) (*Kubeconfig, *core.DetailedResponse, error) {
+	return kubernetesServiceApi.GetParsedKubeconfigWithContext(context.Background(), getKubeconfigOptions)
+}
+
+// GetParsedKubeconfigWithContext is an alternate form of the GetParsedKubeconfig method which supports a Context
+// parameter
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetParsedKubeconfigWithContext(ctx context.Context, getKubeconfigOptions *GetKubeconfigOptions) (result *Kubeconfig, response *core.DetailedResponse, err error) {
+	response, err = kubernetesServiceApi.GetKubeconfigWithContext(ctx, getKubeconfigOptions)
+	if err != nil {
+		return
+	}
+	result, err = parseKubeconfigResponse(response)
+	return
+}
+
+// GetKubeconfigBundle is an alternate form of the GetKubeconfig method which requests the zip format and returns the
+// archive.
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetKubeconfigBundle(getKubeconfigOptions *GetKubeconfigOptions) (*KubeconfigBundle, *core.DetailedResponse, error) {
+	return kubernetesServiceApi.GetKubeconfigBundleWithContext(context.Background(), getKubeconfigOptions)
+}
+
+// GetKubeconfigBundleWithContext is an alternate form of the GetKubeconfigBundle method which supports a Context
+// parameter
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetKubeconfigBundleWithContext(ctx context.Context, getKubeconfigOptions *GetKubeconfigOptions) (result *KubeconfigBundle, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(getKubeconfigOptions, "getKubeconfigOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	zipOptions := *getKubeconfigOptions
+	zipOptions.Format = core.StringPtr(KubeconfigFormatZip)
+
+	response, err = kubernetesServiceApi.GetKubeconfigWithContext(ctx, &zipOptions)
+	if err != nil {
+		return
+	}
+	data, err := readResponseBody(response)
+	if err != nil {
+		return
+	}
+	result, err = ReadKubeconfigBundle(data)
+	return
+}
+
+// ApplyRBACAndGetParsedKubeconfig is an alternate form of the ApplyRBACAndGetKubeconfig method which returns the
+// kubeconfig parsed. The YAML, JSON and zip formats are all accepted.
+func (kubernetesServiceApi *KubernetesServiceApiV1) ApplyRBACAndGetParsedKubeconfig(applyRBACAndGetKubeconfigOptions *ApplyRBACAndGetKubeconfigOptions) (*Kubeconfig, *core.DetailedResponse, error) {
+	return kubernetesServiceApi.ApplyRBACAndGetParsedKubeconfigWithContext(context.Background(), applyRBACAndGetKubeconfigOptions)
+}
+
+// ApplyRBACAndGetParsedKubeconfigWithContext is an alternate form of the ApplyRBACAndGetParsedKubeconfig method which
+// supports a Context parameter
+func (kubernetesServiceApi *KubernetesServiceApiV1) ApplyRBACAndGetParsedKubeconfigWithContext(ctx context.Context, applyRBACAndGetKubeconfigOptions *ApplyRBACAndGetKubeconfigOptions) (result *Kubeconfig, response *core.DetailedResponse, err error) {
+	response, err = kubernetesServiceApi.ApplyRBACAndGetKubeconfigWithContext(ctx, applyRBACAndGetKubeconfigOptions)
+	if err != nil {
+		return
+	}
+	result, err = parseKubeconfigResponse(response)
+	return
+}
+
+// parseKubeconfigResponse parses the kubeconfig in the body of response, whichever its format.
+func parseKubeconfigResponse(response *core.DetailedResponse) (*Kubeconfig, error) {
+	data, err := readResponseBody(response)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		bundle, err := ReadKubeconfigBundle(data)
+		if err != nil {
+			return nil, err
+		}
+		return bundle.Kubeconfig()
+	}
+	return ParseKubeconfig(data)
+}
+
+// readResponseBody reads and closes the body streamed in response.Result.
+func readResponseBody(response *core.DetailedResponse) ([]byte, error) {
+	body, ok := response.Result.(io.ReadCloser)
+	if !ok {
+		return nil, fmt.Errorf("the response has no body")
+	}
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+// readZipFile returns the uncompressed content of file, up to max+1 bytes so that a larger file can be told apart.
+func readZipFile(file *zip.File, max int64) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(io.LimitReader(reader, max+1))
+}
+
+// writeFileAtomic writes data to a temporary file next to name and renames it to name.
+func writeFileAtomic(name string, data []byte, perm os.FileMode) (err error) {
+	dir := filepath.Dir(name)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return
+	}
+	file, err := ioutil.TempFile(dir, "."+filepath.Base(name)+".tmp")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(file.Name())
+		}
+	}()
+	if _, err = file.Write(data); err != nil {
+		file.Close()
+		return
+	}
+	if err = file.Chmod(perm); err != nil {
+		file.Close()
+		return
+	}
+	if err = file.Close(); err != nil {
+		return
+	}
+	return os.Rename(file.Name(), name)
+}
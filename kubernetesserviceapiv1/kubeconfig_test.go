@@ -0,0 +1,196 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+const kubeconfigYAML = `apiVersion: v1
+kind: Config
+clusters:
+- name: mycluster/c1
+  cluster:
+    server: https://c1.containers.cloud.ibm.com:30000
+    certificate-authority: ca-dal10-mycluster.pem
+contexts:
+- name: mycluster/c1
+  context:
+    cluster: mycluster/c1
+    user: user@ibm.com/c1
+    namespace: default
+users:
+- name: user@ibm.com/c1
+  user:
+    auth-provider:
+      name: oidc
+current-context: mycluster/c1
+`
+
+const kubeconfigJSON = `{"apiVersion": "v1", "kind": "Config",
+	"clusters": [{"name": "c2", "cluster": {"server": "https://c2.example.com", "certificate-authority-data": "Q0EgREFUQQ=="}}],
+	"contexts": [{"name": "c2", "context": {"cluster": "c2", "user": "admin"}}],
+	"users": [{"name": "admin", "user": {"token": "abc"}}],
+	"current-context": "c2"}`
+
+var _ = Describe(`Kubeconfig`, func() {
+	var testServer *httptest.Server
+	var kubernetesServiceApiService *kubernetesserviceapiv1.KubernetesServiceApiV1
+	var requestQuery url.Values
+
+	var startServer = func(contentType string, body []byte) {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requestQuery = req.URL.Query()
+			res.Header().Set("Content-type", contentType)
+			res.WriteHeader(200)
+			res.Write(body)
+		}))
+		var serviceErr error
+		kubernetesServiceApiService, serviceErr = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	var bundle = func() []byte {
+		var buffer bytes.Buffer
+		writer := zip.NewWriter(&buffer)
+		for name, content := range map[string]string{
+			"kubeConfig123/kube-config-dal10-mycluster.yml": kubeconfigYAML,
+			"kubeConfig123/ca-dal10-mycluster.pem":          "CA PEM",
+		} {
+			file, err := writer.Create(name)
+			Expect(err).To(BeNil())
+			file.Write([]byte(content))
+		}
+		Expect(writer.Close()).To(BeNil())
+		return buffer.Bytes()
+	}
+
+	AfterEach(func() {
+		if testServer != nil {
+			testServer.Close()
+			testServer = nil
+		}
+	})
+
+	It(`Parses the JSON kubeconfig returned by GetKubeconfig`, func() {
+		startServer("application/json", []byte(kubeconfigJSON))
+
+		kubeconfig, response, err := kubernetesServiceApiService.GetParsedKubeconfig(kubernetesServiceApiService.NewGetKubeconfigOptions("token", "c2"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(kubeconfig.CurrentContext).To(Equal("c2"))
+		Expect(kubeconfig.User("admin").Token).To(Equal("abc"))
+		ca, err := kubeconfig.CurrentCluster().CACertificate()
+		Expect(err).To(BeNil())
+		Expect(string(ca)).To(Equal("CA DATA"))
+	})
+	It(`Reads the zip bundle and embeds the CA certificate`, func() {
+		startServer("application/zip", bundle())
+
+		kubeconfigBundle, _, err := kubernetesServiceApiService.GetKubeconfigBundle(kubernetesServiceApiService.NewGetKubeconfigOptions("token", "c1"))
+		Expect(err).To(BeNil())
+		Expect(requestQuery.Get("format")).To(Equal("zip"))
+		Expect(kubeconfigBundle.Names()).To(HaveLen(2))
+
+		kubeconfig, err := kubeconfigBundle.Kubeconfig()
+		Expect(err).To(BeNil())
+		cluster := kubeconfig.Cluster("mycluster/c1")
+		Expect(cluster.CertificateAuthority).To(BeEmpty())
+		ca, _ := cluster.CACertificate()
+		Expect(string(ca)).To(Equal("CA PEM"))
+		Expect(kubeconfig.User("user@ibm.com/c1").AuthProvider["name"]).To(Equal("oidc"))
+	})
+	It(`Parses a zip bundle returned by ApplyRBACAndGetKubeconfig`, func() {
+		startServer("application/zip", bundle())
+
+		kubeconfig, _, err := kubernetesServiceApiService.ApplyRBACAndGetParsedKubeconfig(kubernetesServiceApiService.NewApplyRBACAndGetKubeconfigOptions("token"))
+		Expect(err).To(BeNil())
+		Expect(kubeconfig.Context("mycluster/c1").Namespace).To(Equal("default"))
+	})
+	It(`Rejects bundles that are too large once uncompressed`, func() {
+		var largeBundle = func(files int, size int) []byte {
+			var buffer bytes.Buffer
+			writer := zip.NewWriter(&buffer)
+			content := make([]byte, size)
+			for i := 0; i < files; i++ {
+				file, err := writer.Create(fmt.Sprintf("kubeConfig123/file%d", i))
+				Expect(err).To(BeNil())
+				file.Write(content)
+			}
+			Expect(writer.Close()).To(BeNil())
+			return buffer.Bytes()
+		}
+
+		_, err := kubernetesserviceapiv1.ReadKubeconfigBundle(largeBundle(1, kubernetesserviceapiv1.MaxKubeconfigBundleFileSize))
+		Expect(err).To(BeNil())
+		_, err = kubernetesserviceapiv1.ReadKubeconfigBundle(largeBundle(1, kubernetesserviceapiv1.MaxKubeconfigBundleFileSize+1))
+		Expect(err).To(MatchError(fmt.Sprintf("error reading kubeconfig bundle: file 'kubeConfig123/file0' is larger than %d bytes", kubernetesserviceapiv1.MaxKubeconfigBundleFileSize)))
+		_, err = kubernetesserviceapiv1.ReadKubeconfigBundle(largeBundle(5, kubernetesserviceapiv1.MaxKubeconfigBundleFileSize))
+		Expect(err).To(MatchError(fmt.Sprintf("error reading kubeconfig bundle: the files are larger than %d bytes in total", kubernetesserviceapiv1.MaxKubeconfigBundleSize)))
+	})
+	It(`Merges into an existing kubeconfig file`, func() {
+		dir, err := ioutil.TempDir("", "kubeconfig")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, ".kube", "config")
+
+		existing, err := kubernetesserviceapiv1.ParseKubeconfig([]byte(kubeconfigJSON))
+		Expect(err).To(BeNil())
+		existing.Extra = map[string]interface{}{"preferences": map[string]interface{}{"colors": true}}
+		Expect(existing.WriteFile(path)).To(BeNil())
+
+		kubeconfig, err := kubernetesserviceapiv1.ParseKubeconfig([]byte(kubeconfigYAML))
+		Expect(err).To(BeNil())
+		Expect(kubeconfig.MergeIntoFile(path, false)).To(BeNil())
+
+		info, err := os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		merged, err := kubernetesserviceapiv1.ReadKubeconfigFile(path)
+		Expect(err).To(BeNil())
+		Expect(merged.Contexts).To(HaveLen(2))
+		Expect(merged.Users).To(HaveLen(2))
+		Expect(merged.CurrentContext).To(Equal("c2"))
+		Expect(merged.Extra).To(HaveKey("preferences"))
+
+		kubeconfig.Clusters[0].Cluster.Server = "https://new.example.com"
+		Expect(kubeconfig.MergeIntoFile(path, true)).To(BeNil())
+		merged, err = kubernetesserviceapiv1.ReadKubeconfigFile(path)
+		Expect(err).To(BeNil())
+		Expect(merged.Clusters).To(HaveLen(2))
+		Expect(merged.Cluster("mycluster/c1").Server).To(Equal("https://new.example.com"))
+		Expect(merged.CurrentContext).To(Equal("mycluster/c1"))
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"time"
@@ -47,6 +48,26 @@ import (
 // Version: 1.1
 type KubernetesServiceApiV1 struct {
 	Service *core.BaseService
+
+	// region is sent as the X-Region header by operations whose options leave XRegion unset.
+	region string
+
+	// resourceGroup and resourceGroupID are sent as the X-Auth-Resource-Group and X-Auth-Resource-Group-ID headers
+	// by operations whose options leave XAuthResourceGroup and XAuthResourceGroupID unset.
+	resourceGroup   string
+	resourceGroupID string
+
+	// refreshToken, or the result of refreshTokenProvider if set, is sent as the X-Auth-Refresh-Token header by
+	// operations whose options leave XAuthRefreshToken unset.
+	refreshToken         string
+	refreshTokenProvider func(ctx context.Context) (string, error)
+
+	// strictValidation makes the operations check the constraints of their options that core.ValidateStruct does not
+	// check, as the Validate methods of the options do, before sending them.
+	strictValidation bool
+
+	// interceptors wrap the sending of the request of every operation, in order.
+	interceptors []common.Interceptor
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -60,6 +81,31 @@ type KubernetesServiceApiV1Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// Region is the default Kubernetes Service region (e.g. "us-south") used for the X-Region header
+	// when an operation's options do not set XRegion.
+	Region string
+
+	// ResourceGroup is the default resource group used for the X-Auth-Resource-Group header
+	// when an operation's options do not set XAuthResourceGroup.
+	ResourceGroup string
+
+	// ResourceGroupID is the default resource group ID used for the X-Auth-Resource-Group-ID header
+	// when an operation's options do not set XAuthResourceGroupID.
+	ResourceGroupID string
+
+	// RefreshToken is the default IAM refresh token used for the X-Auth-Refresh-Token header
+	// when an operation's options do not set XAuthRefreshToken.
+	RefreshToken string
+
+	// RefreshTokenProvider, if set, is called for the refresh token of every operation whose options do not set
+	// XAuthRefreshToken, instead of using RefreshToken. Its error is returned by the operation.
+	RefreshTokenProvider func(ctx context.Context) (string, error)
+
+	// StrictValidation makes the operations whose options have a Validate method call it before sending them, and
+	// return the violations of the constraints of their options, such as enums, ranges and CIDR blocks, that
+	// core.ValidateStruct does not check.
+	StrictValidation bool
 }
 
 // NewKubernetesServiceApiV1UsingExternalConfig : constructs an instance of KubernetesServiceApiV1 with passed in options and external configuration.
@@ -111,17 +157,18 @@ func NewKubernetesServiceApiV1(options *KubernetesServiceApiV1Options) (service
 	}
 
 	service = &KubernetesServiceApiV1{
-		Service: baseService,
+		Service:              baseService,
+		region:               options.Region,
+		resourceGroup:        options.ResourceGroup,
+		resourceGroupID:      options.ResourceGroupID,
+		refreshToken:         options.RefreshToken,
+		refreshTokenProvider: options.RefreshTokenProvider,
+		strictValidation:     options.StrictValidation,
 	}
 
 	return
 }
 
-// GetServiceURLForRegion returns the service URL to be used for the specified region
-func GetServiceURLForRegion(region string) (string, error) {
-	return "", fmt.Errorf("service does not support regional URLs")
-}
-
 // Clone makes a copy of "kubernetesServiceApi" suitable for processing requests.
 func (kubernetesServiceApi *KubernetesServiceApiV1) Clone() *KubernetesServiceApiV1 {
 	if core.IsNil(kubernetesServiceApi) {
@@ -129,6 +176,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) Clone() *KubernetesServiceAp
 	}
 	clone := *kubernetesServiceApi
 	clone.Service = kubernetesServiceApi.Service.Clone()
+	clone.interceptors = append([]common.Interceptor(nil), kubernetesServiceApi.interceptors...)
 	return &clone
 }
 
@@ -142,6 +190,71 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetServiceURL() string {
 	return kubernetesServiceApi.Service.GetServiceURL()
 }
 
+// SetRegion sets the default region sent as the X-Region header
+func (kubernetesServiceApi *KubernetesServiceApiV1) SetRegion(region string) {
+	kubernetesServiceApi.region = region
+}
+
+// GetRegion returns the default region sent as the X-Region header
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetRegion() string {
+	return kubernetesServiceApi.region
+}
+
+// SetResourceGroup sets the default resource group sent as the X-Auth-Resource-Group header
+func (kubernetesServiceApi *KubernetesServiceApiV1) SetResourceGroup(resourceGroup string) {
+	kubernetesServiceApi.resourceGroup = resourceGroup
+}
+
+// GetResourceGroup returns the default resource group sent as the X-Auth-Resource-Group header
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetResourceGroup() string {
+	return kubernetesServiceApi.resourceGroup
+}
+
+// SetResourceGroupID sets the default resource group ID sent as the X-Auth-Resource-Group-ID header
+func (kubernetesServiceApi *KubernetesServiceApiV1) SetResourceGroupID(resourceGroupID string) {
+	kubernetesServiceApi.resourceGroupID = resourceGroupID
+}
+
+// GetResourceGroupID returns the default resource group ID sent as the X-Auth-Resource-Group-ID header
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetResourceGroupID() string {
+	return kubernetesServiceApi.resourceGroupID
+}
+
+// SetRefreshToken sets the default refresh token sent as the X-Auth-Refresh-Token header
+func (kubernetesServiceApi *KubernetesServiceApiV1) SetRefreshToken(refreshToken string) {
+	kubernetesServiceApi.refreshToken = refreshToken
+}
+
+// SetRefreshTokenProvider sets the function called for the refresh token sent as the X-Auth-Refresh-Token header,
+// instead of using the default refresh token
+func (kubernetesServiceApi *KubernetesServiceApiV1) SetRefreshTokenProvider(provider func(ctx context.Context) (string, error)) {
+	kubernetesServiceApi.refreshTokenProvider = provider
+}
+
+// defaultRefreshToken returns the refresh token sent by operations whose options leave XAuthRefreshToken unset
+func (kubernetesServiceApi *KubernetesServiceApiV1) defaultRefreshToken(ctx context.Context) (string, error) {
+	if kubernetesServiceApi.refreshTokenProvider != nil {
+		return kubernetesServiceApi.refreshTokenProvider(ctx)
+	}
+	return kubernetesServiceApi.refreshToken, nil
+}
+
+// SetStrictValidation sets whether the operations validate their options with their Validate method
+func (kubernetesServiceApi *KubernetesServiceApiV1) SetStrictValidation(strictValidation bool) {
+	kubernetesServiceApi.strictValidation = strictValidation
+}
+
+// GetStrictValidation returns whether the operations validate their options with their Validate method
+func (kubernetesServiceApi *KubernetesServiceApiV1) GetStrictValidation() bool {
+	return kubernetesServiceApi.strictValidation
+}
+
+// AddInterceptor adds an interceptor wrapping the sending of the request of every operation, after the ones
+// already added
+func (kubernetesServiceApi *KubernetesServiceApiV1) AddInterceptor(interceptor common.Interceptor) {
+	kubernetesServiceApi.interceptors = append(kubernetesServiceApi.interceptors, interceptor)
+}
+
 // SetDefaultHeaders sets HTTP headers to be sent in every request
 func (kubernetesServiceApi *KubernetesServiceApiV1) SetDefaultHeaders(headers http.Header) {
 	kubernetesServiceApi.Service.SetDefaultHeaders(headers)
@@ -168,6 +281,19 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DisableRetries() {
 	kubernetesServiceApi.Service.DisableRetries()
 }
 
+// invoke sends the request for operationID through the interceptors, with the operation in its context, and returns an *APIError if the service responds
+// with a non-2xx status code.
+func (kubernetesServiceApi *KubernetesServiceApiV1) invoke(operationID string, request *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	request = request.WithContext(common.WithOperation(request.Context(), operations[operationID]))
+	return common.Intercept(kubernetesServiceApi.interceptors, operationID, request, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = kubernetesServiceApi.Service.Request(request, result)
+		if err != nil && response != nil && (response.StatusCode < 200 || response.StatusCode >= 300) && !isAuthenticationError(err) {
+			err = newAPIError(operationID, response, err)
+		}
+		return
+	})
+}
+
 // GetUserCredentials : View the IBM Cloud classic infrastructure account credentials that are set for your IBM Cloud Kubernetes Service account
 // Get the infrastructure user name of the credentials that are used to access the IBM Cloud classic infrastructure
 // portfolio. Infrastructure credentials are set per region and resource group.
@@ -181,6 +307,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetUserCredentialsWithContex
 	if err != nil {
 		return
 	}
+	if getUserCredentialsOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		getUserCredentialsOptionsCopy := *getUserCredentialsOptions
+		getUserCredentialsOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		getUserCredentialsOptions = &getUserCredentialsOptionsCopy
+	}
 	err = core.ValidateStruct(getUserCredentialsOptions, "getUserCredentialsOptions")
 	if err != nil {
 		return
@@ -213,7 +344,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetUserCredentialsWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetUserCredentials", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -242,6 +373,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) StoreUserCredentialsWithCont
 	if err != nil {
 		return
 	}
+	if storeUserCredentialsOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		storeUserCredentialsOptionsCopy := *storeUserCredentialsOptions
+		storeUserCredentialsOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		storeUserCredentialsOptions = &storeUserCredentialsOptionsCopy
+	}
+	if storeUserCredentialsOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			storeUserCredentialsOptionsCopy := *storeUserCredentialsOptions
+			storeUserCredentialsOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			storeUserCredentialsOptions = &storeUserCredentialsOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(storeUserCredentialsOptions, "storeUserCredentialsOptions")
 	if err != nil {
 		return
@@ -281,7 +429,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) StoreUserCredentialsWithCont
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("StoreUserCredentials", request, nil)
 
 	return
 }
@@ -304,6 +452,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveUserCredentialsWithCon
 	if err != nil {
 		return
 	}
+	if removeUserCredentialsOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		removeUserCredentialsOptionsCopy := *removeUserCredentialsOptions
+		removeUserCredentialsOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		removeUserCredentialsOptions = &removeUserCredentialsOptionsCopy
+	}
 	err = core.ValidateStruct(removeUserCredentialsOptions, "removeUserCredentialsOptions")
 	if err != nil {
 		return
@@ -334,7 +487,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveUserCredentialsWithCon
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveUserCredentials", request, nil)
 
 	return
 }
@@ -353,6 +506,16 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetInfraPermissionsWithConte
 	if err != nil {
 		return
 	}
+	if getInfraPermissionsOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		getInfraPermissionsOptionsCopy := *getInfraPermissionsOptions
+		getInfraPermissionsOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		getInfraPermissionsOptions = &getInfraPermissionsOptionsCopy
+	}
+	if getInfraPermissionsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getInfraPermissionsOptionsCopy := *getInfraPermissionsOptions
+		getInfraPermissionsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getInfraPermissionsOptions = &getInfraPermissionsOptionsCopy
+	}
 	err = core.ValidateStruct(getInfraPermissionsOptions, "getInfraPermissionsOptions")
 	if err != nil {
 		return
@@ -388,7 +551,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetInfraPermissionsWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetInfraPermissions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -415,6 +578,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ResetUserAPIKeyWithContext(c
 	if err != nil {
 		return
 	}
+	if resetUserAPIKeyOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		resetUserAPIKeyOptionsCopy := *resetUserAPIKeyOptions
+		resetUserAPIKeyOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		resetUserAPIKeyOptions = &resetUserAPIKeyOptionsCopy
+	}
+	if resetUserAPIKeyOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			resetUserAPIKeyOptionsCopy := *resetUserAPIKeyOptions
+			resetUserAPIKeyOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			resetUserAPIKeyOptions = &resetUserAPIKeyOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(resetUserAPIKeyOptions, "resetUserAPIKeyOptions")
 	if err != nil {
 		return
@@ -448,7 +628,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ResetUserAPIKeyWithContext(c
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("ResetUserAPIKey", request, nil)
 
 	return
 }
@@ -466,6 +646,16 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVlanSpanningWithContext(c
 	if err != nil {
 		return
 	}
+	if getVlanSpanningOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		getVlanSpanningOptionsCopy := *getVlanSpanningOptions
+		getVlanSpanningOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		getVlanSpanningOptions = &getVlanSpanningOptionsCopy
+	}
+	if getVlanSpanningOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getVlanSpanningOptionsCopy := *getVlanSpanningOptions
+		getVlanSpanningOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getVlanSpanningOptions = &getVlanSpanningOptionsCopy
+	}
 	err = core.ValidateStruct(getVlanSpanningOptions, "getVlanSpanningOptions")
 	if err != nil {
 		return
@@ -501,7 +691,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVlanSpanningWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetVlanSpanning", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -528,6 +718,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterACLsWithContext(ct
 	if err != nil {
 		return
 	}
+	if getClusterACLsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClusterACLsOptionsCopy := *getClusterACLsOptions
+		getClusterACLsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClusterACLsOptions = &getClusterACLsOptionsCopy
+	}
 	err = core.ValidateStruct(getClusterACLsOptions, "getClusterACLsOptions")
 	if err != nil {
 		return
@@ -564,7 +759,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterACLsWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusterACLs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -591,6 +786,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DisableClusterACLsWithContex
 	if err != nil {
 		return
 	}
+	if disableClusterACLsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		disableClusterACLsOptionsCopy := *disableClusterACLsOptions
+		disableClusterACLsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		disableClusterACLsOptions = &disableClusterACLsOptionsCopy
+	}
 	err = core.ValidateStruct(disableClusterACLsOptions, "disableClusterACLsOptions")
 	if err != nil {
 		return
@@ -625,7 +825,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DisableClusterACLsWithContex
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DisableClusterACLs", request, nil)
 
 	return
 }
@@ -646,6 +846,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterACLsWithContext(ct
 	if err != nil {
 		return
 	}
+	if addClusterACLsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		addClusterACLsOptionsCopy := *addClusterACLsOptions
+		addClusterACLsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		addClusterACLsOptions = &addClusterACLsOptionsCopy
+	}
 	err = core.ValidateStruct(addClusterACLsOptions, "addClusterACLsOptions")
 	if err != nil {
 		return
@@ -690,7 +895,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterACLsWithContext(ct
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("AddClusterACLs", request, nil)
 
 	return
 }
@@ -711,6 +916,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) EnableClusterACLsWithContext
 	if err != nil {
 		return
 	}
+	if enableClusterACLsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		enableClusterACLsOptionsCopy := *enableClusterACLsOptions
+		enableClusterACLsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		enableClusterACLsOptions = &enableClusterACLsOptionsCopy
+	}
 	err = core.ValidateStruct(enableClusterACLsOptions, "enableClusterACLsOptions")
 	if err != nil {
 		return
@@ -745,7 +955,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) EnableClusterACLsWithContext
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("EnableClusterACLs", request, nil)
 
 	return
 }
@@ -764,6 +974,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveClusterACLsWithContext
 	if err != nil {
 		return
 	}
+	if removeClusterACLsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeClusterACLsOptionsCopy := *removeClusterACLsOptions
+		removeClusterACLsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeClusterACLsOptions = &removeClusterACLsOptionsCopy
+	}
 	err = core.ValidateStruct(removeClusterACLsOptions, "removeClusterACLsOptions")
 	if err != nil {
 		return
@@ -808,7 +1023,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveClusterACLsWithContext
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveClusterACLs", request, nil)
 
 	return
 }
@@ -910,7 +1125,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) EnableALBWithContext(ctx con
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("EnableALB", request, nil)
 
 	return
 }
@@ -960,7 +1175,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterALBWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusterALB", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1024,7 +1239,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DisableALBWithContext(ctx co
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DisableALB", request, nil)
 
 	return
 }
@@ -1065,7 +1280,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAvailableALBTypesWithCont
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, &result)
+	response, err = kubernetesServiceApi.invoke("GetAvailableALBTypes", request, &result)
 
 	return
 }
@@ -1082,6 +1297,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterALBsWithContext(ct
 	if err != nil {
 		return
 	}
+	if getClusterALBsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClusterALBsOptionsCopy := *getClusterALBsOptions
+		getClusterALBsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClusterALBsOptions = &getClusterALBsOptionsCopy
+	}
 	err = core.ValidateStruct(getClusterALBsOptions, "getClusterALBsOptions")
 	if err != nil {
 		return
@@ -1118,7 +1338,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterALBsWithContext(ct
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusterALBs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1145,6 +1365,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateALBsWithContext(ctx co
 	if err != nil {
 		return
 	}
+	if updateALBsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		updateALBsOptionsCopy := *updateALBsOptions
+		updateALBsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		updateALBsOptions = &updateALBsOptionsCopy
+	}
 	err = core.ValidateStruct(updateALBsOptions, "updateALBsOptions")
 	if err != nil {
 		return
@@ -1179,7 +1404,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateALBsWithContext(ctx co
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UpdateALBs", request, nil)
 
 	return
 }
@@ -1197,6 +1422,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetUpdatePolicyWithContext(c
 	if err != nil {
 		return
 	}
+	if getUpdatePolicyOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getUpdatePolicyOptionsCopy := *getUpdatePolicyOptions
+		getUpdatePolicyOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getUpdatePolicyOptions = &getUpdatePolicyOptionsCopy
+	}
 	err = core.ValidateStruct(getUpdatePolicyOptions, "getUpdatePolicyOptions")
 	if err != nil {
 		return
@@ -1233,7 +1463,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetUpdatePolicyWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetUpdatePolicy", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1265,6 +1495,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ChangeUpdatePolicyWithContex
 	if err != nil {
 		return
 	}
+	if changeUpdatePolicyOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		changeUpdatePolicyOptionsCopy := *changeUpdatePolicyOptions
+		changeUpdatePolicyOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		changeUpdatePolicyOptions = &changeUpdatePolicyOptionsCopy
+	}
 	err = core.ValidateStruct(changeUpdatePolicyOptions, "changeUpdatePolicyOptions")
 	if err != nil {
 		return
@@ -1312,7 +1547,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ChangeUpdatePolicyWithContex
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("ChangeUpdatePolicy", request, nil)
 
 	return
 }
@@ -1332,6 +1567,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RollbackUpdateWithContext(ct
 	if err != nil {
 		return
 	}
+	if rollbackUpdateOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		rollbackUpdateOptionsCopy := *rollbackUpdateOptions
+		rollbackUpdateOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		rollbackUpdateOptions = &rollbackUpdateOptionsCopy
+	}
 	err = core.ValidateStruct(rollbackUpdateOptions, "rollbackUpdateOptions")
 	if err != nil {
 		return
@@ -1366,7 +1606,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RollbackUpdateWithContext(ct
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RollbackUpdate", request, nil)
 
 	return
 }
@@ -1384,6 +1624,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateALBWithContext(ctx con
 	if err != nil {
 		return
 	}
+	if createALBOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createALBOptionsCopy := *createALBOptions
+		createALBOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createALBOptions = &createALBOptionsCopy
+	}
 	err = core.ValidateStruct(createALBOptions, "createALBOptions")
 	if err != nil {
 		return
@@ -1449,7 +1694,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateALBWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateALB", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1539,7 +1784,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateALBSecretWithContext(c
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UpdateALBSecret", request, nil)
 
 	return
 }
@@ -1621,7 +1866,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateALBSecretWithContext(c
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("CreateALBSecret", request, nil)
 
 	return
 }
@@ -1639,6 +1884,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ViewClusterALBSecretsWithCon
 	if err != nil {
 		return
 	}
+	if viewClusterALBSecretsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		viewClusterALBSecretsOptionsCopy := *viewClusterALBSecretsOptions
+		viewClusterALBSecretsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		viewClusterALBSecretsOptions = &viewClusterALBSecretsOptionsCopy
+	}
 	err = core.ValidateStruct(viewClusterALBSecretsOptions, "viewClusterALBSecretsOptions")
 	if err != nil {
 		return
@@ -1682,7 +1932,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ViewClusterALBSecretsWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ViewClusterALBSecrets", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1708,6 +1958,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteClusterALBSecretsWithC
 	if err != nil {
 		return
 	}
+	if deleteClusterALBSecretsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		deleteClusterALBSecretsOptionsCopy := *deleteClusterALBSecretsOptions
+		deleteClusterALBSecretsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		deleteClusterALBSecretsOptions = &deleteClusterALBSecretsOptionsCopy
+	}
 	err = core.ValidateStruct(deleteClusterALBSecretsOptions, "deleteClusterALBSecretsOptions")
 	if err != nil {
 		return
@@ -1749,7 +2004,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteClusterALBSecretsWithC
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DeleteClusterALBSecrets", request, nil)
 
 	return
 }
@@ -1766,6 +2021,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAuditWebhookWithContext(c
 	if err != nil {
 		return
 	}
+	if getAuditWebhookOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getAuditWebhookOptionsCopy := *getAuditWebhookOptions
+		getAuditWebhookOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getAuditWebhookOptions = &getAuditWebhookOptionsCopy
+	}
 	err = core.ValidateStruct(getAuditWebhookOptions, "getAuditWebhookOptions")
 	if err != nil {
 		return
@@ -1802,7 +2062,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAuditWebhookWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAuditWebhook", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1828,6 +2088,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateAuditWebhookWithContex
 	if err != nil {
 		return
 	}
+	if updateAuditWebhookOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		updateAuditWebhookOptionsCopy := *updateAuditWebhookOptions
+		updateAuditWebhookOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		updateAuditWebhookOptions = &updateAuditWebhookOptionsCopy
+	}
 	err = core.ValidateStruct(updateAuditWebhookOptions, "updateAuditWebhookOptions")
 	if err != nil {
 		return
@@ -1881,7 +2146,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateAuditWebhookWithContex
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UpdateAuditWebhook", request, nil)
 
 	return
 }
@@ -1899,6 +2164,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteAuditWebhookWithContex
 	if err != nil {
 		return
 	}
+	if deleteAuditWebhookOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		deleteAuditWebhookOptionsCopy := *deleteAuditWebhookOptions
+		deleteAuditWebhookOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		deleteAuditWebhookOptions = &deleteAuditWebhookOptionsCopy
+	}
 	err = core.ValidateStruct(deleteAuditWebhookOptions, "deleteAuditWebhookOptions")
 	if err != nil {
 		return
@@ -1933,7 +2203,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteAuditWebhookWithContex
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DeleteAuditWebhook", request, nil)
 
 	return
 }
@@ -1982,7 +2252,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetLBConfigWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetLBConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2074,7 +2344,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) PatchLBConfigWithContext(ctx
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("PatchLBConfig", request, nil)
 
 	return
 }
@@ -2142,7 +2412,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSecretWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateSecret", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2213,7 +2483,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteIngressSecretWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DeleteIngressSecret", request, nil)
 
 	return
 }
@@ -2263,7 +2533,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSecretWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetSecret", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2322,7 +2592,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSecretsWithContext(ctx co
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetSecrets", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2395,7 +2665,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateSecretWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("UpdateSecret", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2468,7 +2738,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CleanupMigrationWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("CleanupMigration", request, nil)
 
 	return
 }
@@ -2517,7 +2787,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2GetClusterALBWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("V2GetClusterALB", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2566,7 +2836,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSupportedImagesWithContex
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, &result)
+	response, err = kubernetesServiceApi.invoke("GetSupportedImages", request, &result)
 
 	return
 }
@@ -2586,6 +2856,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2GetClusterALBsWithContext(
 	if err != nil {
 		return
 	}
+	if v2GetClusterALBsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2GetClusterALBsOptionsCopy := *v2GetClusterALBsOptions
+		v2GetClusterALBsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2GetClusterALBsOptions = &v2GetClusterALBsOptionsCopy
+	}
 	err = core.ValidateStruct(v2GetClusterALBsOptions, "v2GetClusterALBsOptions")
 	if err != nil {
 		return
@@ -2620,7 +2895,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2GetClusterALBsWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("V2GetClusterALBs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2676,7 +2951,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetMigrationStatusWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetMigrationStatus", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2732,7 +3007,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetStatusWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetStatus", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2799,7 +3074,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) StartMigrationWithContext(ct
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("StartMigration", request, nil)
 
 	return
 }
@@ -2866,7 +3141,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2UpdateALBWithContext(ctx c
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2UpdateALB", request, nil)
 
 	return
 }
@@ -2934,7 +3209,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcCreateALBWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcCreateALB", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -3038,7 +3313,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcDisableALBWithContext(ctx
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("VpcDisableALB", request, nil)
 
 	return
 }
@@ -3134,7 +3409,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcEnableALBWithContext(ctx
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("VpcEnableALB", request, nil)
 
 	return
 }
@@ -3150,6 +3425,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2DisablePrivateServiceEndpo
 	if err != nil {
 		return
 	}
+	if v2DisablePrivateServiceEndpointOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2DisablePrivateServiceEndpointOptionsCopy := *v2DisablePrivateServiceEndpointOptions
+		v2DisablePrivateServiceEndpointOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2DisablePrivateServiceEndpointOptions = &v2DisablePrivateServiceEndpointOptionsCopy
+	}
 	err = core.ValidateStruct(v2DisablePrivateServiceEndpointOptions, "v2DisablePrivateServiceEndpointOptions")
 	if err != nil {
 		return
@@ -3190,7 +3470,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2DisablePrivateServiceEndpo
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2DisablePrivateServiceEndpoint", request, nil)
 
 	return
 }
@@ -3206,6 +3486,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2DisablePublicServiceEndpoi
 	if err != nil {
 		return
 	}
+	if v2DisablePublicServiceEndpointOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2DisablePublicServiceEndpointOptionsCopy := *v2DisablePublicServiceEndpointOptions
+		v2DisablePublicServiceEndpointOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2DisablePublicServiceEndpointOptions = &v2DisablePublicServiceEndpointOptionsCopy
+	}
 	err = core.ValidateStruct(v2DisablePublicServiceEndpointOptions, "v2DisablePublicServiceEndpointOptions")
 	if err != nil {
 		return
@@ -3246,7 +3531,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2DisablePublicServiceEndpoi
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2DisablePublicServiceEndpoint", request, nil)
 
 	return
 }
@@ -3262,6 +3547,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2EnablePrivateServiceEndpoi
 	if err != nil {
 		return
 	}
+	if v2EnablePrivateServiceEndpointOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2EnablePrivateServiceEndpointOptionsCopy := *v2EnablePrivateServiceEndpointOptions
+		v2EnablePrivateServiceEndpointOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2EnablePrivateServiceEndpointOptions = &v2EnablePrivateServiceEndpointOptionsCopy
+	}
 	err = core.ValidateStruct(v2EnablePrivateServiceEndpointOptions, "v2EnablePrivateServiceEndpointOptions")
 	if err != nil {
 		return
@@ -3302,7 +3592,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2EnablePrivateServiceEndpoi
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2EnablePrivateServiceEndpoint", request, nil)
 
 	return
 }
@@ -3318,6 +3608,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2EnablePublicServiceEndpoin
 	if err != nil {
 		return
 	}
+	if v2EnablePublicServiceEndpointOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2EnablePublicServiceEndpointOptionsCopy := *v2EnablePublicServiceEndpointOptions
+		v2EnablePublicServiceEndpointOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2EnablePublicServiceEndpointOptions = &v2EnablePublicServiceEndpointOptionsCopy
+	}
 	err = core.ValidateStruct(v2EnablePublicServiceEndpointOptions, "v2EnablePublicServiceEndpointOptions")
 	if err != nil {
 		return
@@ -3358,7 +3653,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2EnablePublicServiceEndpoin
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2EnablePublicServiceEndpoint", request, nil)
 
 	return
 }
@@ -3374,6 +3669,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2EnablePullSecretWithContex
 	if err != nil {
 		return
 	}
+	if v2EnablePullSecretOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2EnablePullSecretOptionsCopy := *v2EnablePullSecretOptions
+		v2EnablePullSecretOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2EnablePullSecretOptions = &v2EnablePullSecretOptionsCopy
+	}
 	err = core.ValidateStruct(v2EnablePullSecretOptions, "v2EnablePullSecretOptions")
 	if err != nil {
 		return
@@ -3414,7 +3714,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2EnablePullSecretWithContex
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2EnablePullSecret", request, nil)
 
 	return
 }
@@ -3459,7 +3759,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2GetVersionsWithContext(ctx
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("V2GetVersions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -3525,7 +3825,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteSecretWithContext(ctx
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DeleteSecret", request, nil)
 
 	return
 }
@@ -3574,7 +3874,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetNlbDNSListWithContext(ctx
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetNlbDNSList", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -3633,7 +3933,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatLocationNlbDNSListWith
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetSatLocationNlbDNSList", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -3699,7 +3999,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RegenerateCertWithContext(ct
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RegenerateCert", request, nil)
 
 	return
 }
@@ -3716,6 +4016,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RegisterMultishiftClusterWit
 	if err != nil {
 		return
 	}
+	if registerMultishiftClusterOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			registerMultishiftClusterOptionsCopy := *registerMultishiftClusterOptions
+			registerMultishiftClusterOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			registerMultishiftClusterOptions = &registerMultishiftClusterOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(registerMultishiftClusterOptions, "registerMultishiftClusterOptions")
 	if err != nil {
 		return
@@ -3761,7 +4073,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RegisterMultishiftClusterWit
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("RegisterMultishiftCluster", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -3842,7 +4154,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ReplaceLBHostnameWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ReplaceLBHostname", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -3923,7 +4235,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateNlbDNSWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateNlbDNS", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -4001,7 +4313,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveLBHostnameWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveLBHostname", request, nil)
 
 	return
 }
@@ -4022,6 +4334,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ReplaceWorkerWithContext(ctx
 	if err != nil {
 		return
 	}
+	if replaceWorkerOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		replaceWorkerOptionsCopy := *replaceWorkerOptions
+		replaceWorkerOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		replaceWorkerOptions = &replaceWorkerOptionsCopy
+	}
 	err = core.ValidateStruct(replaceWorkerOptions, "replaceWorkerOptions")
 	if err != nil {
 		return
@@ -4068,7 +4385,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ReplaceWorkerWithContext(ctx
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("ReplaceWorker", request, nil)
 
 	return
 }
@@ -4088,6 +4405,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2UpdateMasterWithContext(ct
 	if err != nil {
 		return
 	}
+	if v2UpdateMasterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2UpdateMasterOptionsCopy := *v2UpdateMasterOptions
+		v2UpdateMasterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2UpdateMasterOptions = &v2UpdateMasterOptionsCopy
+	}
 	err = core.ValidateStruct(v2UpdateMasterOptions, "v2UpdateMasterOptions")
 	if err != nil {
 		return
@@ -4134,7 +4456,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2UpdateMasterWithContext(ct
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2UpdateMaster", request, nil)
 
 	return
 }
@@ -4147,6 +4469,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusters(getClustersOptio
 
 // GetClustersWithContext is an alternate form of the GetClusters method which supports a Context parameter
 func (kubernetesServiceApi *KubernetesServiceApiV1) GetClustersWithContext(ctx context.Context, getClustersOptions *GetClustersOptions) (result []Cluster, response *core.DetailedResponse, err error) {
+	if getClustersOptions != nil && getClustersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClustersOptionsCopy := *getClustersOptions
+		getClustersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClustersOptions = &getClustersOptionsCopy
+	}
 	err = core.ValidateStruct(getClustersOptions, "getClustersOptions")
 	if err != nil {
 		return
@@ -4186,7 +4513,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClustersWithContext(ctx c
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusters", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -4213,10 +4540,31 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateClusterWithContext(ctx
 	if err != nil {
 		return
 	}
+	if createClusterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createClusterOptionsCopy := *createClusterOptions
+		createClusterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createClusterOptions = &createClusterOptionsCopy
+	}
+	if createClusterOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			createClusterOptionsCopy := *createClusterOptions
+			createClusterOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			createClusterOptions = &createClusterOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(createClusterOptions, "createClusterOptions")
 	if err != nil {
 		return
 	}
+	err = kubernetesServiceApi.validateStrict(createClusterOptions)
+	if err != nil {
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
@@ -4318,7 +4666,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateClusterWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateCluster", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -4343,6 +4691,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetCluster1WithContext(ctx c
 	if err != nil {
 		return
 	}
+	if getCluster1Options.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getCluster1OptionsCopy := *getCluster1Options
+		getCluster1OptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getCluster1Options = &getCluster1OptionsCopy
+	}
 	err = core.ValidateStruct(getCluster1Options, "getCluster1Options")
 	if err != nil {
 		return
@@ -4383,7 +4736,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetCluster1WithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetCluster1", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -4411,10 +4764,19 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateClusterWithContext(ctx
 	if err != nil {
 		return
 	}
+	if updateClusterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		updateClusterOptionsCopy := *updateClusterOptions
+		updateClusterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		updateClusterOptions = &updateClusterOptionsCopy
+	}
 	err = core.ValidateStruct(updateClusterOptions, "updateClusterOptions")
 	if err != nil {
 		return
 	}
+	err = kubernetesServiceApi.validateStrict(updateClusterOptions)
+	if err != nil {
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"idOrName": *updateClusterOptions.IdOrName,
@@ -4461,7 +4823,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateClusterWithContext(ctx
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UpdateCluster", request, nil)
 
 	return
 }
@@ -4479,6 +4841,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveClusterWithContext(ctx
 	if err != nil {
 		return
 	}
+	if removeClusterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeClusterOptionsCopy := *removeClusterOptions
+		removeClusterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeClusterOptions = &removeClusterOptionsCopy
+	}
 	err = core.ValidateStruct(removeClusterOptions, "removeClusterOptions")
 	if err != nil {
 		return
@@ -4517,7 +4884,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveClusterWithContext(ctx
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveCluster", request, nil)
 
 	return
 }
@@ -4534,6 +4901,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterAddonsWithContext(
 	if err != nil {
 		return
 	}
+	if getClusterAddonsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClusterAddonsOptionsCopy := *getClusterAddonsOptions
+		getClusterAddonsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClusterAddonsOptions = &getClusterAddonsOptionsCopy
+	}
 	err = core.ValidateStruct(getClusterAddonsOptions, "getClusterAddonsOptions")
 	if err != nil {
 		return
@@ -4570,7 +4942,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterAddonsWithContext(
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusterAddons", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -4595,6 +4967,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ManageClusterAddonsWithConte
 	if err != nil {
 		return
 	}
+	if manageClusterAddonsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		manageClusterAddonsOptionsCopy := *manageClusterAddonsOptions
+		manageClusterAddonsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		manageClusterAddonsOptions = &manageClusterAddonsOptionsCopy
+	}
 	err = core.ValidateStruct(manageClusterAddonsOptions, "manageClusterAddonsOptions")
 	if err != nil {
 		return
@@ -4647,7 +5024,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ManageClusterAddonsWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ManageClusterAddons", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -4664,6 +5041,8 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ManageClusterAddonsWithConte
 // Get the cluster-specific Kubernetes configuration data and certificates as a tar file to connect to your cluster and
 // run Kubernetes API calls. To retrieve the administrator certificates and keys, pass `admin` at the end of the path.
 // For example, `/v1/clusters/{idOrName}/config/admin`.
+// The archive is returned in response.Result as an io.ReadCloser that the caller must close; use
+// GetClusterConfigToWriter or ExtractClusterConfig to stream it.
 func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterConfig(getClusterConfigOptions *GetClusterConfigOptions) (response *core.DetailedResponse, err error) {
 	return kubernetesServiceApi.GetClusterConfigWithContext(context.Background(), getClusterConfigOptions)
 }
@@ -4674,6 +5053,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterConfigWithContext(
 	if err != nil {
 		return
 	}
+	if getClusterConfigOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClusterConfigOptionsCopy := *getClusterConfigOptions
+		getClusterConfigOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClusterConfigOptions = &getClusterConfigOptionsCopy
+	}
+	if getClusterConfigOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			getClusterConfigOptionsCopy := *getClusterConfigOptions
+			getClusterConfigOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			getClusterConfigOptions = &getClusterConfigOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(getClusterConfigOptions, "getClusterConfigOptions")
 	if err != nil {
 		return
@@ -4724,7 +5120,8 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterConfigWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	var result io.ReadCloser
+	response, err = kubernetesServiceApi.invoke("GetClusterConfig", request, &result)
 
 	return
 }
@@ -4750,6 +5147,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateKMSConfigWithContext(c
 	if err != nil {
 		return
 	}
+	if createKMSConfigOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createKMSConfigOptionsCopy := *createKMSConfigOptions
+		createKMSConfigOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createKMSConfigOptions = &createKMSConfigOptionsCopy
+	}
 	err = core.ValidateStruct(createKMSConfigOptions, "createKMSConfigOptions")
 	if err != nil {
 		return
@@ -4806,7 +5208,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateKMSConfigWithContext(c
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("CreateKMSConfig", request, nil)
 
 	return
 }
@@ -4823,6 +5225,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) HandleMasterAPIServerWithCon
 	if err != nil {
 		return
 	}
+	if handleMasterAPIServerOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		handleMasterAPIServerOptionsCopy := *handleMasterAPIServerOptions
+		handleMasterAPIServerOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		handleMasterAPIServerOptions = &handleMasterAPIServerOptionsCopy
+	}
 	err = core.ValidateStruct(handleMasterAPIServerOptions, "handleMasterAPIServerOptions")
 	if err != nil {
 		return
@@ -4867,7 +5274,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) HandleMasterAPIServerWithCon
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("HandleMasterAPIServer", request, nil)
 
 	return
 }
@@ -4884,6 +5291,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListServicesForAllNamespaces
 	if err != nil {
 		return
 	}
+	if listServicesForAllNamespacesOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		listServicesForAllNamespacesOptionsCopy := *listServicesForAllNamespacesOptions
+		listServicesForAllNamespacesOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		listServicesForAllNamespacesOptions = &listServicesForAllNamespacesOptionsCopy
+	}
 	err = core.ValidateStruct(listServicesForAllNamespacesOptions, "listServicesForAllNamespacesOptions")
 	if err != nil {
 		return
@@ -4920,7 +5332,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListServicesForAllNamespaces
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ListServicesForAllNamespaces", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -4949,6 +5361,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) BindServiceToNamespaceWithCo
 	if err != nil {
 		return
 	}
+	if bindServiceToNamespaceOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		bindServiceToNamespaceOptionsCopy := *bindServiceToNamespaceOptions
+		bindServiceToNamespaceOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		bindServiceToNamespaceOptions = &bindServiceToNamespaceOptionsCopy
+	}
 	err = core.ValidateStruct(bindServiceToNamespaceOptions, "bindServiceToNamespaceOptions")
 	if err != nil {
 		return
@@ -4991,7 +5408,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) BindServiceToNamespaceWithCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("BindServiceToNamespace", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -5016,6 +5433,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListServicesInNamespaceWithC
 	if err != nil {
 		return
 	}
+	if listServicesInNamespaceOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		listServicesInNamespaceOptionsCopy := *listServicesInNamespaceOptions
+		listServicesInNamespaceOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		listServicesInNamespaceOptions = &listServicesInNamespaceOptionsCopy
+	}
 	err = core.ValidateStruct(listServicesInNamespaceOptions, "listServicesInNamespaceOptions")
 	if err != nil {
 		return
@@ -5053,7 +5475,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListServicesInNamespaceWithC
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ListServicesInNamespace", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -5080,6 +5502,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UnbindServiceFromNamespaceWi
 	if err != nil {
 		return
 	}
+	if unbindServiceFromNamespaceOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		unbindServiceFromNamespaceOptionsCopy := *unbindServiceFromNamespaceOptions
+		unbindServiceFromNamespaceOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		unbindServiceFromNamespaceOptions = &unbindServiceFromNamespaceOptionsCopy
+	}
 	err = core.ValidateStruct(unbindServiceFromNamespaceOptions, "unbindServiceFromNamespaceOptions")
 	if err != nil {
 		return
@@ -5116,7 +5543,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UnbindServiceFromNamespaceWi
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UnbindServiceFromNamespace", request, nil)
 
 	return
 }
@@ -5134,6 +5561,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterSubnetsWithContext
 	if err != nil {
 		return
 	}
+	if getClusterSubnetsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClusterSubnetsOptionsCopy := *getClusterSubnetsOptions
+		getClusterSubnetsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClusterSubnetsOptions = &getClusterSubnetsOptionsCopy
+	}
 	err = core.ValidateStruct(getClusterSubnetsOptions, "getClusterSubnetsOptions")
 	if err != nil {
 		return
@@ -5170,7 +5602,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterSubnetsWithContext
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusterSubnets", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -5198,6 +5630,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterSubnetWithContext(
 	if err != nil {
 		return
 	}
+	if addClusterSubnetOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		addClusterSubnetOptionsCopy := *addClusterSubnetOptions
+		addClusterSubnetOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		addClusterSubnetOptions = &addClusterSubnetOptionsCopy
+	}
+	if addClusterSubnetOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			addClusterSubnetOptionsCopy := *addClusterSubnetOptions
+			addClusterSubnetOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			addClusterSubnetOptions = &addClusterSubnetOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(addClusterSubnetOptions, "addClusterSubnetOptions")
 	if err != nil {
 		return
@@ -5236,7 +5685,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterSubnetWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("AddClusterSubnet", request, nil)
 
 	return
 }
@@ -5254,6 +5703,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DetachClusterSubnetWithConte
 	if err != nil {
 		return
 	}
+	if detachClusterSubnetOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		detachClusterSubnetOptionsCopy := *detachClusterSubnetOptions
+		detachClusterSubnetOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		detachClusterSubnetOptions = &detachClusterSubnetOptionsCopy
+	}
 	err = core.ValidateStruct(detachClusterSubnetOptions, "detachClusterSubnetOptions")
 	if err != nil {
 		return
@@ -5289,7 +5743,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DetachClusterSubnetWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DetachClusterSubnet", request, nil)
 
 	return
 }
@@ -5306,6 +5760,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterUserSubnetWithCont
 	if err != nil {
 		return
 	}
+	if getClusterUserSubnetOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClusterUserSubnetOptionsCopy := *getClusterUserSubnetOptions
+		getClusterUserSubnetOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClusterUserSubnetOptions = &getClusterUserSubnetOptionsCopy
+	}
 	err = core.ValidateStruct(getClusterUserSubnetOptions, "getClusterUserSubnetOptions")
 	if err != nil {
 		return
@@ -5342,7 +5801,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterUserSubnetWithCont
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusterUserSubnet", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -5372,6 +5831,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterUserSubnetWithCont
 	if err != nil {
 		return
 	}
+	if addClusterUserSubnetOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		addClusterUserSubnetOptionsCopy := *addClusterUserSubnetOptions
+		addClusterUserSubnetOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		addClusterUserSubnetOptions = &addClusterUserSubnetOptionsCopy
+	}
+	if addClusterUserSubnetOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			addClusterUserSubnetOptionsCopy := *addClusterUserSubnetOptions
+			addClusterUserSubnetOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			addClusterUserSubnetOptions = &addClusterUserSubnetOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(addClusterUserSubnetOptions, "addClusterUserSubnetOptions")
 	if err != nil {
 		return
@@ -5422,7 +5898,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterUserSubnetWithCont
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("AddClusterUserSubnet", request, nil)
 
 	return
 }
@@ -5440,6 +5916,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveClusterUserSubnetWithC
 	if err != nil {
 		return
 	}
+	if removeClusterUserSubnetOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeClusterUserSubnetOptionsCopy := *removeClusterUserSubnetOptions
+		removeClusterUserSubnetOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeClusterUserSubnetOptions = &removeClusterUserSubnetOptionsCopy
+	}
 	err = core.ValidateStruct(removeClusterUserSubnetOptions, "removeClusterUserSubnetOptions")
 	if err != nil {
 		return
@@ -5476,7 +5957,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveClusterUserSubnetWithC
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveClusterUserSubnet", request, nil)
 
 	return
 }
@@ -5496,6 +5977,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateClusterSubnetWithConte
 	if err != nil {
 		return
 	}
+	if createClusterSubnetOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createClusterSubnetOptionsCopy := *createClusterSubnetOptions
+		createClusterSubnetOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createClusterSubnetOptions = &createClusterSubnetOptionsCopy
+	}
+	if createClusterSubnetOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			createClusterSubnetOptionsCopy := *createClusterSubnetOptions
+			createClusterSubnetOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			createClusterSubnetOptions = &createClusterSubnetOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(createClusterSubnetOptions, "createClusterSubnetOptions")
 	if err != nil {
 		return
@@ -5538,7 +6036,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateClusterSubnetWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("CreateClusterSubnet", request, nil)
 
 	return
 }
@@ -5555,6 +6053,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterWebhooksWithContex
 	if err != nil {
 		return
 	}
+	if getClusterWebhooksOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClusterWebhooksOptionsCopy := *getClusterWebhooksOptions
+		getClusterWebhooksOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClusterWebhooksOptions = &getClusterWebhooksOptionsCopy
+	}
 	err = core.ValidateStruct(getClusterWebhooksOptions, "getClusterWebhooksOptions")
 	if err != nil {
 		return
@@ -5591,7 +6094,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterWebhooksWithContex
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusterWebhooks", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -5616,6 +6119,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterWebhooksWithContex
 	if err != nil {
 		return
 	}
+	if addClusterWebhooksOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		addClusterWebhooksOptionsCopy := *addClusterWebhooksOptions
+		addClusterWebhooksOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		addClusterWebhooksOptions = &addClusterWebhooksOptionsCopy
+	}
 	err = core.ValidateStruct(addClusterWebhooksOptions, "addClusterWebhooksOptions")
 	if err != nil {
 		return
@@ -5666,7 +6174,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterWebhooksWithContex
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("AddClusterWebhooks", request, nil)
 
 	return
 }
@@ -5683,6 +6191,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerPoolsWithContext(ct
 	if err != nil {
 		return
 	}
+	if getWorkerPoolsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getWorkerPoolsOptionsCopy := *getWorkerPoolsOptions
+		getWorkerPoolsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getWorkerPoolsOptions = &getWorkerPoolsOptionsCopy
+	}
 	err = core.ValidateStruct(getWorkerPoolsOptions, "getWorkerPoolsOptions")
 	if err != nil {
 		return
@@ -5719,7 +6232,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerPoolsWithContext(ct
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetWorkerPools", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -5745,6 +6258,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateWorkerPoolWithContext(
 	if err != nil {
 		return
 	}
+	if createWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createWorkerPoolOptionsCopy := *createWorkerPoolOptions
+		createWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createWorkerPoolOptions = &createWorkerPoolOptionsCopy
+	}
+	if createWorkerPoolOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			createWorkerPoolOptionsCopy := *createWorkerPoolOptions
+			createWorkerPoolOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			createWorkerPoolOptions = &createWorkerPoolOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(createWorkerPoolOptions, "createWorkerPoolOptions")
 	if err != nil {
 		return
@@ -5815,7 +6345,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateWorkerPoolWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateWorkerPool", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -5840,6 +6370,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerPool1WithContext(ct
 	if err != nil {
 		return
 	}
+	if getWorkerPool1Options.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getWorkerPool1OptionsCopy := *getWorkerPool1Options
+		getWorkerPool1OptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getWorkerPool1Options = &getWorkerPool1OptionsCopy
+	}
 	err = core.ValidateStruct(getWorkerPool1Options, "getWorkerPool1Options")
 	if err != nil {
 		return
@@ -5877,7 +6412,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerPool1WithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetWorkerPool1", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -5903,6 +6438,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveWorkerPoolWithContext(
 	if err != nil {
 		return
 	}
+	if removeWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeWorkerPoolOptionsCopy := *removeWorkerPoolOptions
+		removeWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeWorkerPoolOptions = &removeWorkerPoolOptionsCopy
+	}
 	err = core.ValidateStruct(removeWorkerPoolOptions, "removeWorkerPoolOptions")
 	if err != nil {
 		return
@@ -5938,7 +6478,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveWorkerPoolWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveWorkerPool", request, nil)
 
 	return
 }
@@ -5963,6 +6503,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) PatchWorkerPoolWithContext(c
 	if err != nil {
 		return
 	}
+	if patchWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		patchWorkerPoolOptionsCopy := *patchWorkerPoolOptions
+		patchWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		patchWorkerPoolOptions = &patchWorkerPoolOptionsCopy
+	}
 	err = core.ValidateStruct(patchWorkerPoolOptions, "patchWorkerPoolOptions")
 	if err != nil {
 		return
@@ -6020,7 +6565,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) PatchWorkerPoolWithContext(c
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("PatchWorkerPool", request, nil)
 
 	return
 }
@@ -6038,6 +6583,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddWorkerPoolZoneWithContext
 	if err != nil {
 		return
 	}
+	if addWorkerPoolZoneOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		addWorkerPoolZoneOptionsCopy := *addWorkerPoolZoneOptions
+		addWorkerPoolZoneOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		addWorkerPoolZoneOptions = &addWorkerPoolZoneOptionsCopy
+	}
 	err = core.ValidateStruct(addWorkerPoolZoneOptions, "addWorkerPoolZoneOptions")
 	if err != nil {
 		return
@@ -6089,7 +6639,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddWorkerPoolZoneWithContext
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("AddWorkerPoolZone", request, nil)
 
 	return
 }
@@ -6107,6 +6657,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveWorkerPoolZoneWithCont
 	if err != nil {
 		return
 	}
+	if removeWorkerPoolZoneOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeWorkerPoolZoneOptionsCopy := *removeWorkerPoolZoneOptions
+		removeWorkerPoolZoneOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeWorkerPoolZoneOptions = &removeWorkerPoolZoneOptionsCopy
+	}
 	err = core.ValidateStruct(removeWorkerPoolZoneOptions, "removeWorkerPoolZoneOptions")
 	if err != nil {
 		return
@@ -6143,7 +6698,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveWorkerPoolZoneWithCont
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveWorkerPoolZone", request, nil)
 
 	return
 }
@@ -6159,6 +6714,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddWorkerPoolZoneNetworkWith
 	if err != nil {
 		return
 	}
+	if addWorkerPoolZoneNetworkOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		addWorkerPoolZoneNetworkOptionsCopy := *addWorkerPoolZoneNetworkOptions
+		addWorkerPoolZoneNetworkOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		addWorkerPoolZoneNetworkOptions = &addWorkerPoolZoneNetworkOptionsCopy
+	}
 	err = core.ValidateStruct(addWorkerPoolZoneNetworkOptions, "addWorkerPoolZoneNetworkOptions")
 	if err != nil {
 		return
@@ -6208,7 +6768,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddWorkerPoolZoneNetworkWith
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("AddWorkerPoolZoneNetwork", request, nil)
 
 	return
 }
@@ -6225,6 +6785,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterWorkersWithContext
 	if err != nil {
 		return
 	}
+	if getClusterWorkersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClusterWorkersOptionsCopy := *getClusterWorkersOptions
+		getClusterWorkersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClusterWorkersOptions = &getClusterWorkersOptionsCopy
+	}
 	err = core.ValidateStruct(getClusterWorkersOptions, "getClusterWorkersOptions")
 	if err != nil {
 		return
@@ -6268,7 +6833,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterWorkersWithContext
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusterWorkers", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -6294,6 +6859,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterWorkersWithContext
 	if err != nil {
 		return
 	}
+	if addClusterWorkersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		addClusterWorkersOptionsCopy := *addClusterWorkersOptions
+		addClusterWorkersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		addClusterWorkersOptions = &addClusterWorkersOptionsCopy
+	}
+	if addClusterWorkersOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			addClusterWorkersOptionsCopy := *addClusterWorkersOptions
+			addClusterWorkersOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			addClusterWorkersOptions = &addClusterWorkersOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(addClusterWorkersOptions, "addClusterWorkersOptions")
 	if err != nil {
 		return
@@ -6362,7 +6944,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddClusterWorkersWithContext
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("AddClusterWorkers", request, nil)
 
 	return
 }
@@ -6379,6 +6961,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkersWithContext(ctx co
 	if err != nil {
 		return
 	}
+	if getWorkersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getWorkersOptionsCopy := *getWorkersOptions
+		getWorkersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getWorkersOptions = &getWorkersOptionsCopy
+	}
 	err = core.ValidateStruct(getWorkersOptions, "getWorkersOptions")
 	if err != nil {
 		return
@@ -6416,7 +7003,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkersWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetWorkers", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -6446,10 +7033,19 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateClusterWorkerWithConte
 	if err != nil {
 		return
 	}
+	if updateClusterWorkerOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		updateClusterWorkerOptionsCopy := *updateClusterWorkerOptions
+		updateClusterWorkerOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		updateClusterWorkerOptions = &updateClusterWorkerOptionsCopy
+	}
 	err = core.ValidateStruct(updateClusterWorkerOptions, "updateClusterWorkerOptions")
 	if err != nil {
 		return
 	}
+	err = kubernetesServiceApi.validateStrict(updateClusterWorkerOptions)
+	if err != nil {
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"idOrName": *updateClusterWorkerOptions.IdOrName,
@@ -6494,7 +7090,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateClusterWorkerWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UpdateClusterWorker", request, nil)
 
 	return
 }
@@ -6514,6 +7110,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveClusterWorkerWithConte
 	if err != nil {
 		return
 	}
+	if removeClusterWorkerOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeClusterWorkerOptionsCopy := *removeClusterWorkerOptions
+		removeClusterWorkerOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeClusterWorkerOptions = &removeClusterWorkerOptionsCopy
+	}
 	err = core.ValidateStruct(removeClusterWorkerOptions, "removeClusterWorkerOptions")
 	if err != nil {
 		return
@@ -6549,7 +7150,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveClusterWorkerWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveClusterWorker", request, nil)
 
 	return
 }
@@ -6570,6 +7171,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetUserConfigWithContext(ctx
 	if err != nil {
 		return
 	}
+	if getUserConfigOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		getUserConfigOptionsCopy := *getUserConfigOptions
+		getUserConfigOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		getUserConfigOptions = &getUserConfigOptionsCopy
+	}
 	err = core.ValidateStruct(getUserConfigOptions, "getUserConfigOptions")
 	if err != nil {
 		return
@@ -6602,7 +7208,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetUserConfigWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetUserConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -6627,6 +7233,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2DisableImageSecurityWithCo
 	if err != nil {
 		return
 	}
+	if v2DisableImageSecurityOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2DisableImageSecurityOptionsCopy := *v2DisableImageSecurityOptions
+		v2DisableImageSecurityOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2DisableImageSecurityOptions = &v2DisableImageSecurityOptionsCopy
+	}
 	err = core.ValidateStruct(v2DisableImageSecurityOptions, "v2DisableImageSecurityOptions")
 	if err != nil {
 		return
@@ -6667,7 +7278,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2DisableImageSecurityWithCo
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2DisableImageSecurity", request, nil)
 
 	return
 }
@@ -6684,6 +7295,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2EnableImageSecurityWithCon
 	if err != nil {
 		return
 	}
+	if v2EnableImageSecurityOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2EnableImageSecurityOptionsCopy := *v2EnableImageSecurityOptions
+		v2EnableImageSecurityOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2EnableImageSecurityOptions = &v2EnableImageSecurityOptionsCopy
+	}
 	err = core.ValidateStruct(v2EnableImageSecurityOptions, "v2EnableImageSecurityOptions")
 	if err != nil {
 		return
@@ -6724,7 +7340,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2EnableImageSecurityWithCon
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2EnableImageSecurity", request, nil)
 
 	return
 }
@@ -6741,6 +7357,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) FetchFilterConfigsWithContex
 	if err != nil {
 		return
 	}
+	if fetchFilterConfigsOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		fetchFilterConfigsOptionsCopy := *fetchFilterConfigsOptions
+		fetchFilterConfigsOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		fetchFilterConfigsOptions = &fetchFilterConfigsOptionsCopy
+	}
 	err = core.ValidateStruct(fetchFilterConfigsOptions, "fetchFilterConfigsOptions")
 	if err != nil {
 		return
@@ -6781,7 +7402,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) FetchFilterConfigsWithContex
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("FetchFilterConfigs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -6807,6 +7428,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateFilterConfigWithContex
 	if err != nil {
 		return
 	}
+	if createFilterConfigOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		createFilterConfigOptionsCopy := *createFilterConfigOptions
+		createFilterConfigOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		createFilterConfigOptions = &createFilterConfigOptionsCopy
+	}
 	err = core.ValidateStruct(createFilterConfigOptions, "createFilterConfigOptions")
 	if err != nil {
 		return
@@ -6875,7 +7501,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateFilterConfigWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateFilterConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -6900,6 +7526,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteFilterConfigsWithConte
 	if err != nil {
 		return
 	}
+	if deleteFilterConfigsOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		deleteFilterConfigsOptionsCopy := *deleteFilterConfigsOptions
+		deleteFilterConfigsOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		deleteFilterConfigsOptions = &deleteFilterConfigsOptionsCopy
+	}
 	err = core.ValidateStruct(deleteFilterConfigsOptions, "deleteFilterConfigsOptions")
 	if err != nil {
 		return
@@ -6938,7 +7569,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteFilterConfigsWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DeleteFilterConfigs", request, nil)
 
 	return
 }
@@ -6955,6 +7586,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) FetchFilterConfigWithContext
 	if err != nil {
 		return
 	}
+	if fetchFilterConfigOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		fetchFilterConfigOptionsCopy := *fetchFilterConfigOptions
+		fetchFilterConfigOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		fetchFilterConfigOptions = &fetchFilterConfigOptionsCopy
+	}
 	err = core.ValidateStruct(fetchFilterConfigOptions, "fetchFilterConfigOptions")
 	if err != nil {
 		return
@@ -6996,7 +7632,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) FetchFilterConfigWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("FetchFilterConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -7021,6 +7657,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateFilterConfigWithContex
 	if err != nil {
 		return
 	}
+	if updateFilterConfigOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		updateFilterConfigOptionsCopy := *updateFilterConfigOptions
+		updateFilterConfigOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		updateFilterConfigOptions = &updateFilterConfigOptionsCopy
+	}
 	err = core.ValidateStruct(updateFilterConfigOptions, "updateFilterConfigOptions")
 	if err != nil {
 		return
@@ -7090,7 +7731,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateFilterConfigWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("UpdateFilterConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -7115,6 +7756,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteFilterConfigWithContex
 	if err != nil {
 		return
 	}
+	if deleteFilterConfigOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		deleteFilterConfigOptionsCopy := *deleteFilterConfigOptions
+		deleteFilterConfigOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		deleteFilterConfigOptions = &deleteFilterConfigOptionsCopy
+	}
 	err = core.ValidateStruct(deleteFilterConfigOptions, "deleteFilterConfigOptions")
 	if err != nil {
 		return
@@ -7154,7 +7800,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteFilterConfigWithContex
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DeleteFilterConfig", request, nil)
 
 	return
 }
@@ -7171,6 +7817,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetMasterLogCollectionStatus
 	if err != nil {
 		return
 	}
+	if getMasterLogCollectionStatusOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getMasterLogCollectionStatusOptionsCopy := *getMasterLogCollectionStatusOptions
+		getMasterLogCollectionStatusOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getMasterLogCollectionStatusOptions = &getMasterLogCollectionStatusOptionsCopy
+	}
 	err = core.ValidateStruct(getMasterLogCollectionStatusOptions, "getMasterLogCollectionStatusOptions")
 	if err != nil {
 		return
@@ -7207,7 +7858,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetMasterLogCollectionStatus
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetMasterLogCollectionStatus", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -7232,6 +7883,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateMasterLogCollectionWit
 	if err != nil {
 		return
 	}
+	if createMasterLogCollectionOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createMasterLogCollectionOptionsCopy := *createMasterLogCollectionOptions
+		createMasterLogCollectionOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createMasterLogCollectionOptions = &createMasterLogCollectionOptionsCopy
+	}
 	err = core.ValidateStruct(createMasterLogCollectionOptions, "createMasterLogCollectionOptions")
 	if err != nil {
 		return
@@ -7285,7 +7941,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateMasterLogCollectionWit
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("CreateMasterLogCollection", request, nil)
 
 	return
 }
@@ -7302,6 +7958,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterKeyOwnerWithContex
 	if err != nil {
 		return
 	}
+	if getClusterKeyOwnerOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		getClusterKeyOwnerOptionsCopy := *getClusterKeyOwnerOptions
+		getClusterKeyOwnerOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		getClusterKeyOwnerOptions = &getClusterKeyOwnerOptionsCopy
+	}
 	err = core.ValidateStruct(getClusterKeyOwnerOptions, "getClusterKeyOwnerOptions")
 	if err != nil {
 		return
@@ -7338,7 +7999,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterKeyOwnerWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetClusterKeyOwner", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -7363,6 +8024,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetDefaultLoggingEndpointWit
 	if err != nil {
 		return
 	}
+	if getDefaultLoggingEndpointOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		getDefaultLoggingEndpointOptionsCopy := *getDefaultLoggingEndpointOptions
+		getDefaultLoggingEndpointOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		getDefaultLoggingEndpointOptions = &getDefaultLoggingEndpointOptionsCopy
+	}
 	err = core.ValidateStruct(getDefaultLoggingEndpointOptions, "getDefaultLoggingEndpointOptions")
 	if err != nil {
 		return
@@ -7399,7 +8065,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetDefaultLoggingEndpointWit
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetDefaultLoggingEndpoint", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -7424,6 +8090,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) FetchLoggingConfigsWithConte
 	if err != nil {
 		return
 	}
+	if fetchLoggingConfigsOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		fetchLoggingConfigsOptionsCopy := *fetchLoggingConfigsOptions
+		fetchLoggingConfigsOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		fetchLoggingConfigsOptions = &fetchLoggingConfigsOptionsCopy
+	}
 	err = core.ValidateStruct(fetchLoggingConfigsOptions, "fetchLoggingConfigsOptions")
 	if err != nil {
 		return
@@ -7460,7 +8131,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) FetchLoggingConfigsWithConte
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("FetchLoggingConfigs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -7485,6 +8156,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteLoggingConfigsWithCont
 	if err != nil {
 		return
 	}
+	if deleteLoggingConfigsOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		deleteLoggingConfigsOptionsCopy := *deleteLoggingConfigsOptions
+		deleteLoggingConfigsOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		deleteLoggingConfigsOptions = &deleteLoggingConfigsOptionsCopy
+	}
 	err = core.ValidateStruct(deleteLoggingConfigsOptions, "deleteLoggingConfigsOptions")
 	if err != nil {
 		return
@@ -7523,7 +8199,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteLoggingConfigsWithCont
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DeleteLoggingConfigs", request, nil)
 
 	return
 }
@@ -7540,6 +8216,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) FetchLoggingConfigsForSource
 	if err != nil {
 		return
 	}
+	if fetchLoggingConfigsForSourceOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		fetchLoggingConfigsForSourceOptionsCopy := *fetchLoggingConfigsForSourceOptions
+		fetchLoggingConfigsForSourceOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		fetchLoggingConfigsForSourceOptions = &fetchLoggingConfigsForSourceOptionsCopy
+	}
 	err = core.ValidateStruct(fetchLoggingConfigsForSourceOptions, "fetchLoggingConfigsForSourceOptions")
 	if err != nil {
 		return
@@ -7577,7 +8258,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) FetchLoggingConfigsForSource
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("FetchLoggingConfigsForSource", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -7604,6 +8285,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateLoggingConfigWithConte
 	if err != nil {
 		return
 	}
+	if createLoggingConfigOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		createLoggingConfigOptionsCopy := *createLoggingConfigOptions
+		createLoggingConfigOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		createLoggingConfigOptions = &createLoggingConfigOptionsCopy
+	}
 	err = core.ValidateStruct(createLoggingConfigOptions, "createLoggingConfigOptions")
 	if err != nil {
 		return
@@ -7688,7 +8374,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateLoggingConfigWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateLoggingConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -7713,6 +8399,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateLoggingConfigWithConte
 	if err != nil {
 		return
 	}
+	if updateLoggingConfigOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		updateLoggingConfigOptionsCopy := *updateLoggingConfigOptions
+		updateLoggingConfigOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		updateLoggingConfigOptions = &updateLoggingConfigOptionsCopy
+	}
 	err = core.ValidateStruct(updateLoggingConfigOptions, "updateLoggingConfigOptions")
 	if err != nil {
 		return
@@ -7798,7 +8489,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateLoggingConfigWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("UpdateLoggingConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -7823,6 +8514,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteLoggingConfigWithConte
 	if err != nil {
 		return
 	}
+	if deleteLoggingConfigOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		deleteLoggingConfigOptionsCopy := *deleteLoggingConfigOptions
+		deleteLoggingConfigOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		deleteLoggingConfigOptions = &deleteLoggingConfigOptionsCopy
+	}
 	err = core.ValidateStruct(deleteLoggingConfigOptions, "deleteLoggingConfigOptions")
 	if err != nil {
 		return
@@ -7863,7 +8559,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteLoggingConfigWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DeleteLoggingConfig", request, nil)
 
 	return
 }
@@ -7881,6 +8577,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RefreshLoggingConfigWithCont
 	if err != nil {
 		return
 	}
+	if refreshLoggingConfigOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		refreshLoggingConfigOptionsCopy := *refreshLoggingConfigOptions
+		refreshLoggingConfigOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		refreshLoggingConfigOptions = &refreshLoggingConfigOptionsCopy
+	}
 	err = core.ValidateStruct(refreshLoggingConfigOptions, "refreshLoggingConfigOptions")
 	if err != nil {
 		return
@@ -7919,7 +8620,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RefreshLoggingConfigWithCont
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RefreshLoggingConfig", request, nil)
 
 	return
 }
@@ -7937,6 +8638,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetFluentdUpdatePolicyWithCo
 	if err != nil {
 		return
 	}
+	if getFluentdUpdatePolicyOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		getFluentdUpdatePolicyOptionsCopy := *getFluentdUpdatePolicyOptions
+		getFluentdUpdatePolicyOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		getFluentdUpdatePolicyOptions = &getFluentdUpdatePolicyOptionsCopy
+	}
 	err = core.ValidateStruct(getFluentdUpdatePolicyOptions, "getFluentdUpdatePolicyOptions")
 	if err != nil {
 		return
@@ -7973,7 +8679,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetFluentdUpdatePolicyWithCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetFluentdUpdatePolicy", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8001,6 +8707,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ChangeFluentdUpdatePolicyWit
 	if err != nil {
 		return
 	}
+	if changeFluentdUpdatePolicyOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		changeFluentdUpdatePolicyOptionsCopy := *changeFluentdUpdatePolicyOptions
+		changeFluentdUpdatePolicyOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		changeFluentdUpdatePolicyOptions = &changeFluentdUpdatePolicyOptionsCopy
+	}
 	err = core.ValidateStruct(changeFluentdUpdatePolicyOptions, "changeFluentdUpdatePolicyOptions")
 	if err != nil {
 		return
@@ -8048,7 +8759,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ChangeFluentdUpdatePolicyWit
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("ChangeFluentdUpdatePolicy", request, nil)
 
 	return
 }
@@ -8070,6 +8781,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateLoggingInstanceWithCon
 	if err != nil {
 		return
 	}
+	if createLoggingInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			createLoggingInstanceOptionsCopy := *createLoggingInstanceOptions
+			createLoggingInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			createLoggingInstanceOptions = &createLoggingInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(createLoggingInstanceOptions, "createLoggingInstanceOptions")
 	if err != nil {
 		return
@@ -8121,7 +8844,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateLoggingInstanceWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateLoggingInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8146,6 +8869,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DiscoverLoggingInstanceWithC
 	if err != nil {
 		return
 	}
+	if discoverLoggingInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			discoverLoggingInstanceOptionsCopy := *discoverLoggingInstanceOptions
+			discoverLoggingInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			discoverLoggingInstanceOptions = &discoverLoggingInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(discoverLoggingInstanceOptions, "discoverLoggingInstanceOptions")
 	if err != nil {
 		return
@@ -8191,7 +8926,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DiscoverLoggingInstanceWithC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("DiscoverLoggingInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8218,6 +8953,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetLoggingInstanceWithContex
 	if err != nil {
 		return
 	}
+	if getLoggingInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			getLoggingInstanceOptionsCopy := *getLoggingInstanceOptions
+			getLoggingInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			getLoggingInstanceOptions = &getLoggingInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(getLoggingInstanceOptions, "getLoggingInstanceOptions")
 	if err != nil {
 		return
@@ -8253,7 +9000,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetLoggingInstanceWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetLoggingInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8279,6 +9026,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetLoggingInstancesWithConte
 	if err != nil {
 		return
 	}
+	if getLoggingInstancesOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			getLoggingInstancesOptionsCopy := *getLoggingInstancesOptions
+			getLoggingInstancesOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			getLoggingInstancesOptions = &getLoggingInstancesOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(getLoggingInstancesOptions, "getLoggingInstancesOptions")
 	if err != nil {
 		return
@@ -8313,7 +9072,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetLoggingInstancesWithConte
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetLoggingInstances", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8339,6 +9098,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ModifyLoggingInstanceWithCon
 	if err != nil {
 		return
 	}
+	if modifyLoggingInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			modifyLoggingInstanceOptionsCopy := *modifyLoggingInstanceOptions
+			modifyLoggingInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			modifyLoggingInstanceOptions = &modifyLoggingInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(modifyLoggingInstanceOptions, "modifyLoggingInstanceOptions")
 	if err != nil {
 		return
@@ -8393,7 +9164,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ModifyLoggingInstanceWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ModifyLoggingInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8422,6 +9193,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveLoggingInstanceWithCon
 	if err != nil {
 		return
 	}
+	if removeLoggingInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			removeLoggingInstanceOptionsCopy := *removeLoggingInstanceOptions
+			removeLoggingInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			removeLoggingInstanceOptions = &removeLoggingInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(removeLoggingInstanceOptions, "removeLoggingInstanceOptions")
 	if err != nil {
 		return
@@ -8465,7 +9248,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveLoggingInstanceWithCon
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveLoggingInstance", request, nil)
 
 	return
 }
@@ -8488,6 +9271,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateMonitoringInstanceWith
 	if err != nil {
 		return
 	}
+	if createMonitoringInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			createMonitoringInstanceOptionsCopy := *createMonitoringInstanceOptions
+			createMonitoringInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			createMonitoringInstanceOptions = &createMonitoringInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(createMonitoringInstanceOptions, "createMonitoringInstanceOptions")
 	if err != nil {
 		return
@@ -8539,7 +9334,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateMonitoringInstanceWith
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateMonitoringInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8564,6 +9359,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DiscoverMonitoringInstanceWi
 	if err != nil {
 		return
 	}
+	if discoverMonitoringInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			discoverMonitoringInstanceOptionsCopy := *discoverMonitoringInstanceOptions
+			discoverMonitoringInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			discoverMonitoringInstanceOptions = &discoverMonitoringInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(discoverMonitoringInstanceOptions, "discoverMonitoringInstanceOptions")
 	if err != nil {
 		return
@@ -8609,7 +9416,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DiscoverMonitoringInstanceWi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("DiscoverMonitoringInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8636,6 +9443,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetMonitoringInstanceWithCon
 	if err != nil {
 		return
 	}
+	if getMonitoringInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			getMonitoringInstanceOptionsCopy := *getMonitoringInstanceOptions
+			getMonitoringInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			getMonitoringInstanceOptions = &getMonitoringInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(getMonitoringInstanceOptions, "getMonitoringInstanceOptions")
 	if err != nil {
 		return
@@ -8671,7 +9490,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetMonitoringInstanceWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetMonitoringInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8697,6 +9516,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetMonitoringInstancesWithCo
 	if err != nil {
 		return
 	}
+	if getMonitoringInstancesOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			getMonitoringInstancesOptionsCopy := *getMonitoringInstancesOptions
+			getMonitoringInstancesOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			getMonitoringInstancesOptions = &getMonitoringInstancesOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(getMonitoringInstancesOptions, "getMonitoringInstancesOptions")
 	if err != nil {
 		return
@@ -8731,7 +9562,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetMonitoringInstancesWithCo
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetMonitoringInstances", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8757,6 +9588,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ModifyMonitoringInstanceWith
 	if err != nil {
 		return
 	}
+	if modifyMonitoringInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			modifyMonitoringInstanceOptionsCopy := *modifyMonitoringInstanceOptions
+			modifyMonitoringInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			modifyMonitoringInstanceOptions = &modifyMonitoringInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(modifyMonitoringInstanceOptions, "modifyMonitoringInstanceOptions")
 	if err != nil {
 		return
@@ -8811,7 +9654,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ModifyMonitoringInstanceWith
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ModifyMonitoringInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -8840,6 +9683,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveMonitoringInstanceWith
 	if err != nil {
 		return
 	}
+	if removeMonitoringInstanceOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			removeMonitoringInstanceOptionsCopy := *removeMonitoringInstanceOptions
+			removeMonitoringInstanceOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			removeMonitoringInstanceOptions = &removeMonitoringInstanceOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(removeMonitoringInstanceOptions, "removeMonitoringInstanceOptions")
 	if err != nil {
 		return
@@ -8883,7 +9738,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveMonitoringInstanceWith
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveMonitoringInstance", request, nil)
 
 	return
 }
@@ -8902,6 +9757,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateDNSWithIPWithContext(c
 	if err != nil {
 		return
 	}
+	if updateDNSWithIPOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		updateDNSWithIPOptionsCopy := *updateDNSWithIPOptions
+		updateDNSWithIPOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		updateDNSWithIPOptions = &updateDNSWithIPOptionsCopy
+	}
 	err = core.ValidateStruct(updateDNSWithIPOptions, "updateDNSWithIPOptions")
 	if err != nil {
 		return
@@ -8970,7 +9830,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateDNSWithIPWithContext(c
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UpdateDNSWithIP", request, nil)
 
 	return
 }
@@ -8988,6 +9848,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UnregisterDNSWithIPWithConte
 	if err != nil {
 		return
 	}
+	if unregisterDNSWithIPOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		unregisterDNSWithIPOptionsCopy := *unregisterDNSWithIPOptions
+		unregisterDNSWithIPOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		unregisterDNSWithIPOptions = &unregisterDNSWithIPOptionsCopy
+	}
 	err = core.ValidateStruct(unregisterDNSWithIPOptions, "unregisterDNSWithIPOptions")
 	if err != nil {
 		return
@@ -9024,7 +9889,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UnregisterDNSWithIPWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UnregisterDNSWithIP", request, nil)
 
 	return
 }
@@ -9042,6 +9907,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListNLBIPsForSubdomainWithCo
 	if err != nil {
 		return
 	}
+	if listNLBIPsForSubdomainOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		listNLBIPsForSubdomainOptionsCopy := *listNLBIPsForSubdomainOptions
+		listNLBIPsForSubdomainOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		listNLBIPsForSubdomainOptions = &listNLBIPsForSubdomainOptionsCopy
+	}
 	err = core.ValidateStruct(listNLBIPsForSubdomainOptions, "listNLBIPsForSubdomainOptions")
 	if err != nil {
 		return
@@ -9078,7 +9948,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListNLBIPsForSubdomainWithCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ListNLBIPsForSubdomain", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9104,6 +9974,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RegisterDNSWithIPWithContext
 	if err != nil {
 		return
 	}
+	if registerDNSWithIPOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		registerDNSWithIPOptionsCopy := *registerDNSWithIPOptions
+		registerDNSWithIPOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		registerDNSWithIPOptions = &registerDNSWithIPOptionsCopy
+	}
 	err = core.ValidateStruct(registerDNSWithIPOptions, "registerDNSWithIPOptions")
 	if err != nil {
 		return
@@ -9174,7 +10049,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RegisterDNSWithIPWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("RegisterDNSWithIP", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9199,6 +10074,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateNlbDNSHealthMonitorWit
 	if err != nil {
 		return
 	}
+	if updateNlbDNSHealthMonitorOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		updateNlbDNSHealthMonitorOptionsCopy := *updateNlbDNSHealthMonitorOptions
+		updateNlbDNSHealthMonitorOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		updateNlbDNSHealthMonitorOptions = &updateNlbDNSHealthMonitorOptionsCopy
+	}
 	err = core.ValidateStruct(updateNlbDNSHealthMonitorOptions, "updateNlbDNSHealthMonitorOptions")
 	if err != nil {
 		return
@@ -9267,7 +10147,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateNlbDNSHealthMonitorWit
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UpdateNlbDNSHealthMonitor", request, nil)
 
 	return
 }
@@ -9284,6 +10164,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddNlbDNSHealthMonitorWithCo
 	if err != nil {
 		return
 	}
+	if addNlbDNSHealthMonitorOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		addNlbDNSHealthMonitorOptionsCopy := *addNlbDNSHealthMonitorOptions
+		addNlbDNSHealthMonitorOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		addNlbDNSHealthMonitorOptions = &addNlbDNSHealthMonitorOptionsCopy
+	}
 	err = core.ValidateStruct(addNlbDNSHealthMonitorOptions, "addNlbDNSHealthMonitorOptions")
 	if err != nil {
 		return
@@ -9357,7 +10242,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AddNlbDNSHealthMonitorWithCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("AddNlbDNSHealthMonitor", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9382,6 +10267,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetNlbDNSHealthMonitorWithCo
 	if err != nil {
 		return
 	}
+	if getNlbDNSHealthMonitorOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getNlbDNSHealthMonitorOptionsCopy := *getNlbDNSHealthMonitorOptions
+		getNlbDNSHealthMonitorOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getNlbDNSHealthMonitorOptions = &getNlbDNSHealthMonitorOptionsCopy
+	}
 	err = core.ValidateStruct(getNlbDNSHealthMonitorOptions, "getNlbDNSHealthMonitorOptions")
 	if err != nil {
 		return
@@ -9419,7 +10309,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetNlbDNSHealthMonitorWithCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetNlbDNSHealthMonitor", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9444,6 +10334,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListNlbDNSHealthMonitorsWith
 	if err != nil {
 		return
 	}
+	if listNlbDNSHealthMonitorsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		listNlbDNSHealthMonitorsOptionsCopy := *listNlbDNSHealthMonitorsOptions
+		listNlbDNSHealthMonitorsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		listNlbDNSHealthMonitorsOptions = &listNlbDNSHealthMonitorsOptionsCopy
+	}
 	err = core.ValidateStruct(listNlbDNSHealthMonitorsOptions, "listNlbDNSHealthMonitorsOptions")
 	if err != nil {
 		return
@@ -9480,7 +10375,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListNlbDNSHealthMonitorsWith
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ListNlbDNSHealthMonitors", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9506,6 +10401,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListNlbDNSHealthMonitorStatu
 	if err != nil {
 		return
 	}
+	if listNlbDNSHealthMonitorStatusOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		listNlbDNSHealthMonitorStatusOptionsCopy := *listNlbDNSHealthMonitorStatusOptions
+		listNlbDNSHealthMonitorStatusOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		listNlbDNSHealthMonitorStatusOptions = &listNlbDNSHealthMonitorStatusOptionsCopy
+	}
 	err = core.ValidateStruct(listNlbDNSHealthMonitorStatusOptions, "listNlbDNSHealthMonitorStatusOptions")
 	if err != nil {
 		return
@@ -9542,7 +10442,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListNlbDNSHealthMonitorStatu
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ListNlbDNSHealthMonitorStatus", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9570,6 +10470,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetDatacenterVLANsWithContex
 	if err != nil {
 		return
 	}
+	if getDatacenterVLANsOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			getDatacenterVLANsOptionsCopy := *getDatacenterVLANsOptions
+			getDatacenterVLANsOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			getDatacenterVLANsOptions = &getDatacenterVLANsOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(getDatacenterVLANsOptions, "getDatacenterVLANsOptions")
 	if err != nil {
 		return
@@ -9606,7 +10518,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetDatacenterVLANsWithContex
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetDatacenterVLANs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9634,6 +10546,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListSubnetsWithContext(ctx c
 	if err != nil {
 		return
 	}
+	if listSubnetsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		listSubnetsOptionsCopy := *listSubnetsOptions
+		listSubnetsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		listSubnetsOptions = &listSubnetsOptionsCopy
+	}
+	if listSubnetsOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			listSubnetsOptionsCopy := *listSubnetsOptions
+			listSubnetsOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			listSubnetsOptions = &listSubnetsOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(listSubnetsOptions, "listSubnetsOptions")
 	if err != nil {
 		return
@@ -9673,7 +10602,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListSubnetsWithContext(ctx c
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ListSubnets", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9700,10 +10629,19 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteClusterWithCo
 	if err != nil {
 		return
 	}
+	if createSatelliteClusterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createSatelliteClusterOptionsCopy := *createSatelliteClusterOptions
+		createSatelliteClusterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createSatelliteClusterOptions = &createSatelliteClusterOptionsCopy
+	}
 	err = core.ValidateStruct(createSatelliteClusterOptions, "createSatelliteClusterOptions")
 	if err != nil {
 		return
 	}
+	err = kubernetesServiceApi.validateStrict(createSatelliteClusterOptions)
+	if err != nil {
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
@@ -9781,7 +10719,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteClusterWithCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateSatelliteCluster", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9809,6 +10747,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteWorkerPoolWit
 	if err != nil {
 		return
 	}
+	if createSatelliteWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createSatelliteWorkerPoolOptionsCopy := *createSatelliteWorkerPoolOptions
+		createSatelliteWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createSatelliteWorkerPoolOptions = &createSatelliteWorkerPoolOptionsCopy
+	}
 	err = core.ValidateStruct(createSatelliteWorkerPoolOptions, "createSatelliteWorkerPoolOptions")
 	if err != nil {
 		return
@@ -9881,7 +10824,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteWorkerPoolWit
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateSatelliteWorkerPool", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -9907,6 +10850,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteWorkerPoolZon
 	if err != nil {
 		return
 	}
+	if createSatelliteWorkerPoolZoneOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createSatelliteWorkerPoolZoneOptionsCopy := *createSatelliteWorkerPoolZoneOptions
+		createSatelliteWorkerPoolZoneOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createSatelliteWorkerPoolZoneOptions = &createSatelliteWorkerPoolZoneOptionsCopy
+	}
 	err = core.ValidateStruct(createSatelliteWorkerPoolZoneOptions, "createSatelliteWorkerPoolZoneOptions")
 	if err != nil {
 		return
@@ -9953,7 +10901,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteWorkerPoolZon
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("CreateSatelliteWorkerPoolZone", request, nil)
 
 	return
 }
@@ -9966,6 +10914,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteClusters(getSate
 
 // GetSatelliteClustersWithContext is an alternate form of the GetSatelliteClusters method which supports a Context parameter
 func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteClustersWithContext(ctx context.Context, getSatelliteClustersOptions *GetSatelliteClustersOptions) (result []GetClustersResponse, response *core.DetailedResponse, err error) {
+	if getSatelliteClustersOptions != nil && getSatelliteClustersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getSatelliteClustersOptionsCopy := *getSatelliteClustersOptions
+		getSatelliteClustersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getSatelliteClustersOptions = &getSatelliteClustersOptionsCopy
+	}
 	err = core.ValidateStruct(getSatelliteClustersOptions, "getSatelliteClustersOptions")
 	if err != nil {
 		return
@@ -9998,7 +10951,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteClustersWithCont
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetSatelliteClusters", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -10026,6 +10979,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteAssignmentWit
 	if err != nil {
 		return
 	}
+	if createSatelliteAssignmentOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createSatelliteAssignmentOptionsCopy := *createSatelliteAssignmentOptions
+		createSatelliteAssignmentOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createSatelliteAssignmentOptions = &createSatelliteAssignmentOptionsCopy
+	}
 	err = core.ValidateStruct(createSatelliteAssignmentOptions, "createSatelliteAssignmentOptions")
 	if err != nil {
 		return
@@ -10083,7 +11041,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteAssignmentWit
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateSatelliteAssignment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -10110,6 +11068,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AttachSatelliteHostWithConte
 	if err != nil {
 		return
 	}
+	if attachSatelliteHostOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		attachSatelliteHostOptionsCopy := *attachSatelliteHostOptions
+		attachSatelliteHostOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		attachSatelliteHostOptions = &attachSatelliteHostOptionsCopy
+	}
 	err = core.ValidateStruct(attachSatelliteHostOptions, "attachSatelliteHostOptions")
 	if err != nil {
 		return
@@ -10160,7 +11123,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AttachSatelliteHostWithConte
 	}
 
 	var resultData []byte
-	respBody, err := kubernetesServiceApi.Service.Request(request, &resultData)
+	respBody, err := kubernetesServiceApi.invoke("AttachSatelliteHost", request, &resultData)
 	if err != nil {
 		return
 	}
@@ -10181,6 +11144,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteHostsWithContext
 	if err != nil {
 		return
 	}
+	if getSatelliteHostsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getSatelliteHostsOptionsCopy := *getSatelliteHostsOptions
+		getSatelliteHostsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getSatelliteHostsOptions = &getSatelliteHostsOptionsCopy
+	}
 	err = core.ValidateStruct(getSatelliteHostsOptions, "getSatelliteHostsOptions")
 	if err != nil {
 		return
@@ -10215,7 +11183,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteHostsWithContext
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetSatelliteHosts", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -10244,6 +11212,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveSatelliteHostWithConte
 	if err != nil {
 		return
 	}
+	if removeSatelliteHostOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeSatelliteHostOptionsCopy := *removeSatelliteHostOptions
+		removeSatelliteHostOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeSatelliteHostOptions = &removeSatelliteHostOptionsCopy
+	}
 	err = core.ValidateStruct(removeSatelliteHostOptions, "removeSatelliteHostOptions")
 	if err != nil {
 		return
@@ -10287,7 +11260,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveSatelliteHostWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveSatelliteHost", request, nil)
 
 	return
 }
@@ -10305,6 +11278,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateSatelliteHostWithConte
 	if err != nil {
 		return
 	}
+	if updateSatelliteHostOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		updateSatelliteHostOptionsCopy := *updateSatelliteHostOptions
+		updateSatelliteHostOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		updateSatelliteHostOptions = &updateSatelliteHostOptionsCopy
+	}
 	err = core.ValidateStruct(updateSatelliteHostOptions, "updateSatelliteHostOptions")
 	if err != nil {
 		return
@@ -10351,7 +11329,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateSatelliteHostWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("UpdateSatelliteHost", request, nil)
 
 	return
 }
@@ -10369,10 +11347,19 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteLocationWithC
 	if err != nil {
 		return
 	}
+	if createSatelliteLocationOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createSatelliteLocationOptionsCopy := *createSatelliteLocationOptions
+		createSatelliteLocationOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createSatelliteLocationOptions = &createSatelliteLocationOptionsCopy
+	}
 	err = core.ValidateStruct(createSatelliteLocationOptions, "createSatelliteLocationOptions")
 	if err != nil {
 		return
 	}
+	err = kubernetesServiceApi.validateStrict(createSatelliteLocationOptions)
+	if err != nil {
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
@@ -10447,7 +11434,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteLocationWithC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateSatelliteLocation", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -10472,6 +11459,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteLocationWithCont
 	if err != nil {
 		return
 	}
+	if getSatelliteLocationOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getSatelliteLocationOptionsCopy := *getSatelliteLocationOptions
+		getSatelliteLocationOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getSatelliteLocationOptions = &getSatelliteLocationOptionsCopy
+	}
 	err = core.ValidateStruct(getSatelliteLocationOptions, "getSatelliteLocationOptions")
 	if err != nil {
 		return
@@ -10506,7 +11498,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteLocationWithCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetSatelliteLocation", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -10527,6 +11519,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteLocations(getSat
 
 // GetSatelliteLocationsWithContext is an alternate form of the GetSatelliteLocations method which supports a Context parameter
 func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteLocationsWithContext(ctx context.Context, getSatelliteLocationsOptions *GetSatelliteLocationsOptions) (result []MultishiftController, response *core.DetailedResponse, err error) {
+	if getSatelliteLocationsOptions != nil && getSatelliteLocationsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getSatelliteLocationsOptionsCopy := *getSatelliteLocationsOptions
+		getSatelliteLocationsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getSatelliteLocationsOptions = &getSatelliteLocationsOptionsCopy
+	}
 	err = core.ValidateStruct(getSatelliteLocationsOptions, "getSatelliteLocationsOptions")
 	if err != nil {
 		return
@@ -10559,7 +11556,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteLocationsWithCon
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetSatelliteLocations", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -10582,6 +11579,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveSatelliteLocation(remo
 
 // RemoveSatelliteLocationWithContext is an alternate form of the RemoveSatelliteLocation method which supports a Context parameter
 func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveSatelliteLocationWithContext(ctx context.Context, removeSatelliteLocationOptions *RemoveSatelliteLocationOptions) (response *core.DetailedResponse, err error) {
+	if removeSatelliteLocationOptions != nil && removeSatelliteLocationOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeSatelliteLocationOptionsCopy := *removeSatelliteLocationOptions
+		removeSatelliteLocationOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeSatelliteLocationOptions = &removeSatelliteLocationOptionsCopy
+	}
 	err = core.ValidateStruct(removeSatelliteLocationOptions, "removeSatelliteLocationOptions")
 	if err != nil {
 		return
@@ -10625,7 +11627,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveSatelliteLocationWithC
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveSatelliteLocation", request, nil)
 
 	return
 }
@@ -10644,10 +11646,19 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteClusterRemote
 	if err != nil {
 		return
 	}
+	if createSatelliteClusterRemoteOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		createSatelliteClusterRemoteOptionsCopy := *createSatelliteClusterRemoteOptions
+		createSatelliteClusterRemoteOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		createSatelliteClusterRemoteOptions = &createSatelliteClusterRemoteOptionsCopy
+	}
 	err = core.ValidateStruct(createSatelliteClusterRemoteOptions, "createSatelliteClusterRemoteOptions")
 	if err != nil {
 		return
 	}
+	err = kubernetesServiceApi.validateStrict(createSatelliteClusterRemoteOptions)
+	if err != nil {
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
@@ -10722,7 +11733,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateSatelliteClusterRemote
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateSatelliteClusterRemote", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -10749,6 +11760,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteServiceClustersW
 	if err != nil {
 		return
 	}
+	if getSatelliteServiceClustersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getSatelliteServiceClustersOptionsCopy := *getSatelliteServiceClustersOptions
+		getSatelliteServiceClustersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getSatelliteServiceClustersOptions = &getSatelliteServiceClustersOptionsCopy
+	}
 	err = core.ValidateStruct(getSatelliteServiceClustersOptions, "getSatelliteServiceClustersOptions")
 	if err != nil {
 		return
@@ -10783,7 +11799,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSatelliteServiceClustersW
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetSatelliteServiceClusters", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -10808,6 +11824,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateAttachmentWithContext(
 	if err != nil {
 		return
 	}
+	if createAttachmentOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		createAttachmentOptionsCopy := *createAttachmentOptions
+		createAttachmentOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		createAttachmentOptions = &createAttachmentOptionsCopy
+	}
 	err = core.ValidateStruct(createAttachmentOptions, "createAttachmentOptions")
 	if err != nil {
 		return
@@ -10859,7 +11880,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateAttachmentWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateAttachment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -10883,6 +11904,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteAttachmentWithContext(
 	if err != nil {
 		return
 	}
+	if deleteAttachmentOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		deleteAttachmentOptionsCopy := *deleteAttachmentOptions
+		deleteAttachmentOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		deleteAttachmentOptions = &deleteAttachmentOptionsCopy
+	}
 	err = core.ValidateStruct(deleteAttachmentOptions, "deleteAttachmentOptions")
 	if err != nil {
 		return
@@ -10932,7 +11958,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) DeleteAttachmentWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("DeleteAttachment", request, nil)
 
 	return
 }
@@ -10949,6 +11975,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAttachmentWithContext(ctx
 	if err != nil {
 		return
 	}
+	if getAttachmentOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		getAttachmentOptionsCopy := *getAttachmentOptions
+		getAttachmentOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		getAttachmentOptions = &getAttachmentOptionsCopy
+	}
 	err = core.ValidateStruct(getAttachmentOptions, "getAttachmentOptions")
 	if err != nil {
 		return
@@ -10985,7 +12016,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAttachmentWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAttachment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11010,6 +12041,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAttachmentsWithContext(ct
 	if err != nil {
 		return
 	}
+	if getAttachmentsOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		getAttachmentsOptionsCopy := *getAttachmentsOptions
+		getAttachmentsOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		getAttachmentsOptions = &getAttachmentsOptionsCopy
+	}
 	err = core.ValidateStruct(getAttachmentsOptions, "getAttachmentsOptions")
 	if err != nil {
 		return
@@ -11045,7 +12081,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAttachmentsWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAttachments", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11069,6 +12105,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVolumeWithContext(ctx con
 	if err != nil {
 		return
 	}
+	if getVolumeOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		getVolumeOptionsCopy := *getVolumeOptions
+		getVolumeOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		getVolumeOptions = &getVolumeOptionsCopy
+	}
 	err = core.ValidateStruct(getVolumeOptions, "getVolumeOptions")
 	if err != nil {
 		return
@@ -11103,7 +12144,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVolumeWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetVolume", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11127,6 +12168,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVolumesWithContext(ctx co
 	if err != nil {
 		return
 	}
+	if getVolumesOptions.XAuthResourceGroupID == nil && kubernetesServiceApi.resourceGroupID != "" {
+		getVolumesOptionsCopy := *getVolumesOptions
+		getVolumesOptionsCopy.XAuthResourceGroupID = core.StringPtr(kubernetesServiceApi.resourceGroupID)
+		getVolumesOptions = &getVolumesOptionsCopy
+	}
 	err = core.ValidateStruct(getVolumesOptions, "getVolumesOptions")
 	if err != nil {
 		return
@@ -11169,7 +12215,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVolumesWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetVolumes", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11243,7 +12289,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateAssignmentWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateAssignment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11286,7 +12332,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateAssignmentByClusterWit
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("kubernetes_service_api", "V1", "CreateAssignment")
+	sdkHeaders := common.GetSdkHeaders("kubernetes_service_api", "V1", "CreateAssignmentByCluster")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -11317,7 +12363,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateAssignmentByClusterWit
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateAssignmentByCluster", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11413,7 +12459,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) CreateStorageConfigurationWi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("CreateStorageConfiguration", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11470,7 +12516,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAssignedStorageConfigsWit
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAssignedStorageConfigs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11526,7 +12572,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAssignmentWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAssignment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11582,7 +12628,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAssignmentByNameWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAssignmentByName", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11633,7 +12679,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAssignmentsWithContext(ct
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAssignments", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11690,7 +12736,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAssignmentsByConfigWithCo
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAssignmentsByConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11746,7 +12792,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAvailableStorageClassesWi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAvailableStorageClasses", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11802,7 +12848,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetStorageConfigurationWithC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetStorageConfiguration", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11857,7 +12903,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetStorageConfigurationsWith
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetStorageConfigurations", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11915,7 +12961,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetStorageTemplateWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetStorageTemplate", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -11966,7 +13012,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetStorageTemplatesWithConte
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetStorageTemplates", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12023,7 +13069,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveAssignmentWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("RemoveAssignment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12084,7 +13130,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveStorageConfigurationWi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("RemoveStorageConfiguration", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12153,7 +13199,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateAssignmentWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("UpdateAssignment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12220,7 +13266,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateAssignmentVersionWithC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("UpdateAssignmentVersion", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12315,7 +13361,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) UpdateStorageConfigurationWi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("UpdateStorageConfiguration", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12361,7 +13407,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetAddonsWithContext(ctx con
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetAddons", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12411,7 +13457,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetBluemixConfigWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetBluemixConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12471,7 +13517,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetDatacenterMachineTypesWit
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetDatacenterMachineTypes", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12522,7 +13568,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetKubeVersionsWithContext(c
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetKubeVersions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12572,7 +13618,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ListLocationsWithContext(ctx
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ListLocations", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12622,7 +13668,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetMessagesWithContext(ctx c
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetMessages", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12672,7 +13718,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetProductConfigWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetProductConfig", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12723,7 +13769,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetRegionsWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetRegions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12776,7 +13822,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVersionsWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetVersions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12833,7 +13879,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetZonesWithContext(ctx cont
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetZones", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12883,7 +13929,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2GetMessagesWithContext(ctx
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("V2GetMessages", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -12899,6 +13945,8 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2GetMessagesWithContext(ctx
 // ApplyRBACAndGetKubeconfig : Apply IAM roles to the cluster, then retrieve the cluster's kubeconfig file
 // Apply IAM roles to the cluster, then retrieve the cluster's kubeconfig file to connect to your cluster and run
 // Kubernetes API calls.
+// The kubeconfig is returned in response.Result as an io.ReadCloser that the caller must close; use
+// ApplyRBACAndGetParsedKubeconfig to read it as a Kubeconfig.
 func (kubernetesServiceApi *KubernetesServiceApiV1) ApplyRBACAndGetKubeconfig(applyRBACAndGetKubeconfigOptions *ApplyRBACAndGetKubeconfigOptions) (response *core.DetailedResponse, err error) {
 	return kubernetesServiceApi.ApplyRBACAndGetKubeconfigWithContext(context.Background(), applyRBACAndGetKubeconfigOptions)
 }
@@ -12909,6 +13957,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ApplyRBACAndGetKubeconfigWit
 	if err != nil {
 		return
 	}
+	if applyRBACAndGetKubeconfigOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		applyRBACAndGetKubeconfigOptionsCopy := *applyRBACAndGetKubeconfigOptions
+		applyRBACAndGetKubeconfigOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		applyRBACAndGetKubeconfigOptions = &applyRBACAndGetKubeconfigOptionsCopy
+	}
+	if applyRBACAndGetKubeconfigOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			applyRBACAndGetKubeconfigOptionsCopy := *applyRBACAndGetKubeconfigOptions
+			applyRBACAndGetKubeconfigOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			applyRBACAndGetKubeconfigOptions = &applyRBACAndGetKubeconfigOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(applyRBACAndGetKubeconfigOptions, "applyRBACAndGetKubeconfigOptions")
 	if err != nil {
 		return
@@ -12964,7 +14029,8 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ApplyRBACAndGetKubeconfigWit
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	var result io.ReadCloser
+	response, err = kubernetesServiceApi.invoke("ApplyRBACAndGetKubeconfig", request, &result)
 
 	return
 }
@@ -12981,6 +14047,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AutoUpdateMasterWithContext(
 	if err != nil {
 		return
 	}
+	if autoUpdateMasterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		autoUpdateMasterOptionsCopy := *autoUpdateMasterOptions
+		autoUpdateMasterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		autoUpdateMasterOptions = &autoUpdateMasterOptionsCopy
+	}
 	err = core.ValidateStruct(autoUpdateMasterOptions, "autoUpdateMasterOptions")
 	if err != nil {
 		return
@@ -13024,7 +14095,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) AutoUpdateMasterWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("AutoUpdateMaster", request, nil)
 
 	return
 }
@@ -13041,6 +14112,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetClusterWithContext
 	if err != nil {
 		return
 	}
+	if classicGetClusterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		classicGetClusterOptionsCopy := *classicGetClusterOptions
+		classicGetClusterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		classicGetClusterOptions = &classicGetClusterOptionsCopy
+	}
 	err = core.ValidateStruct(classicGetClusterOptions, "classicGetClusterOptions")
 	if err != nil {
 		return
@@ -13078,7 +14154,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetClusterWithContext
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ClassicGetCluster", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13099,6 +14175,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetClusters(classicGe
 
 // ClassicGetClustersWithContext is an alternate form of the ClassicGetClusters method which supports a Context parameter
 func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetClustersWithContext(ctx context.Context, classicGetClustersOptions *ClassicGetClustersOptions) (result []GetClustersResponse, response *core.DetailedResponse, err error) {
+	if classicGetClustersOptions != nil && classicGetClustersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		classicGetClustersOptionsCopy := *classicGetClustersOptions
+		classicGetClustersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		classicGetClustersOptions = &classicGetClustersOptionsCopy
+	}
 	err = core.ValidateStruct(classicGetClustersOptions, "classicGetClustersOptions")
 	if err != nil {
 		return
@@ -13138,7 +14219,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetClustersWithContex
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ClassicGetClusters", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13166,6 +14247,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVLANsWithContext(ctx cont
 	if err != nil {
 		return
 	}
+	if getVLANsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getVLANsOptionsCopy := *getVLANsOptions
+		getVLANsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getVLANsOptions = &getVLANsOptionsCopy
+	}
 	err = core.ValidateStruct(getVLANsOptions, "getVLANsOptions")
 	if err != nil {
 		return
@@ -13200,7 +14286,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVLANsWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetVLANs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13225,6 +14311,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetWorkerWithContext(
 	if err != nil {
 		return
 	}
+	if classicGetWorkerOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		classicGetWorkerOptionsCopy := *classicGetWorkerOptions
+		classicGetWorkerOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		classicGetWorkerOptions = &classicGetWorkerOptionsCopy
+	}
 	err = core.ValidateStruct(classicGetWorkerOptions, "classicGetWorkerOptions")
 	if err != nil {
 		return
@@ -13260,7 +14351,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetWorkerWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ClassicGetWorker", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13285,6 +14376,16 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetWorkerPoolWithCont
 	if err != nil {
 		return
 	}
+	if classicGetWorkerPoolOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		classicGetWorkerPoolOptionsCopy := *classicGetWorkerPoolOptions
+		classicGetWorkerPoolOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		classicGetWorkerPoolOptions = &classicGetWorkerPoolOptionsCopy
+	}
+	if classicGetWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		classicGetWorkerPoolOptionsCopy := *classicGetWorkerPoolOptions
+		classicGetWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		classicGetWorkerPoolOptions = &classicGetWorkerPoolOptionsCopy
+	}
 	err = core.ValidateStruct(classicGetWorkerPoolOptions, "classicGetWorkerPoolOptions")
 	if err != nil {
 		return
@@ -13323,7 +14424,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetWorkerPoolWithCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ClassicGetWorkerPool", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13348,6 +14449,16 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetWorkerPoolsWithCon
 	if err != nil {
 		return
 	}
+	if classicGetWorkerPoolsOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		classicGetWorkerPoolsOptionsCopy := *classicGetWorkerPoolsOptions
+		classicGetWorkerPoolsOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		classicGetWorkerPoolsOptions = &classicGetWorkerPoolsOptionsCopy
+	}
+	if classicGetWorkerPoolsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		classicGetWorkerPoolsOptionsCopy := *classicGetWorkerPoolsOptions
+		classicGetWorkerPoolsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		classicGetWorkerPoolsOptions = &classicGetWorkerPoolsOptionsCopy
+	}
 	err = core.ValidateStruct(classicGetWorkerPoolsOptions, "classicGetWorkerPoolsOptions")
 	if err != nil {
 		return
@@ -13385,7 +14496,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetWorkerPoolsWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ClassicGetWorkerPools", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13410,6 +14521,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetWorkersWithContext
 	if err != nil {
 		return
 	}
+	if classicGetWorkersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		classicGetWorkersOptionsCopy := *classicGetWorkersOptions
+		classicGetWorkersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		classicGetWorkersOptions = &classicGetWorkersOptionsCopy
+	}
 	err = core.ValidateStruct(classicGetWorkersOptions, "classicGetWorkersOptions")
 	if err != nil {
 		return
@@ -13450,7 +14566,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) ClassicGetWorkersWithContext
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("ClassicGetWorkers", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13476,6 +14592,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) KmsEnableClusterWithContext(
 	if err != nil {
 		return
 	}
+	if kmsEnableClusterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		kmsEnableClusterOptionsCopy := *kmsEnableClusterOptions
+		kmsEnableClusterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		kmsEnableClusterOptions = &kmsEnableClusterOptionsCopy
+	}
 	err = core.ValidateStruct(kmsEnableClusterOptions, "kmsEnableClusterOptions")
 	if err != nil {
 		return
@@ -13525,7 +14646,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) KmsEnableClusterWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("KmsEnableCluster", request, nil)
 
 	return
 }
@@ -13543,6 +14664,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) KmsGetCRKsWithContext(ctx co
 	if err != nil {
 		return
 	}
+	if kmsGetCRKsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		kmsGetCRKsOptionsCopy := *kmsGetCRKsOptions
+		kmsGetCRKsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		kmsGetCRKsOptions = &kmsGetCRKsOptionsCopy
+	}
 	err = core.ValidateStruct(kmsGetCRKsOptions, "kmsGetCRKsOptions")
 	if err != nil {
 		return
@@ -13577,7 +14703,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) KmsGetCRKsWithContext(ctx co
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("KmsGetCRKs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13602,6 +14728,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterWithContext(ctx co
 	if err != nil {
 		return
 	}
+	if getClusterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getClusterOptionsCopy := *getClusterOptions
+		getClusterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getClusterOptions = &getClusterOptionsCopy
+	}
 	err = core.ValidateStruct(getClusterOptions, "getClusterOptions")
 	if err != nil {
 		return
@@ -13636,7 +14767,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetClusterWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetCluster", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13661,6 +14792,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2GetClusterAddonsWithContex
 	if err != nil {
 		return
 	}
+	if v2GetClusterAddonsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2GetClusterAddonsOptionsCopy := *v2GetClusterAddonsOptions
+		v2GetClusterAddonsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2GetClusterAddonsOptions = &v2GetClusterAddonsOptionsCopy
+	}
 	err = core.ValidateStruct(v2GetClusterAddonsOptions, "v2GetClusterAddonsOptions")
 	if err != nil {
 		return
@@ -13695,7 +14831,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2GetClusterAddonsWithContex
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("V2GetClusterAddons", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13756,7 +14892,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2GetFlavorsWithContext(ctx
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("V2GetFlavors", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13777,6 +14913,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) KmsGetInstances(kmsGetInstan
 
 // KmsGetInstancesWithContext is an alternate form of the KmsGetInstances method which supports a Context parameter
 func (kubernetesServiceApi *KubernetesServiceApiV1) KmsGetInstancesWithContext(ctx context.Context, kmsGetInstancesOptions *KmsGetInstancesOptions) (result []GetKMSInstanceResponse, response *core.DetailedResponse, err error) {
+	if kmsGetInstancesOptions != nil && kmsGetInstancesOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		kmsGetInstancesOptionsCopy := *kmsGetInstancesOptions
+		kmsGetInstancesOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		kmsGetInstancesOptions = &kmsGetInstancesOptionsCopy
+	}
 	err = core.ValidateStruct(kmsGetInstancesOptions, "kmsGetInstancesOptions")
 	if err != nil {
 		return
@@ -13809,7 +14950,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) KmsGetInstancesWithContext(c
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("KmsGetInstances", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13825,6 +14966,8 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) KmsGetInstancesWithContext(c
 // GetKubeconfig : Get the cluster's kubeconfig file
 // Get the cluster's Kubernetes configuration file (`kubeconfig`) to connect to your cluster and run Kubernetes API
 // calls. You can also get the networking and admin configuration files for the cluster.
+// The kubeconfig is returned in response.Result as an io.ReadCloser that the caller must close; use
+// GetParsedKubeconfig or GetKubeconfigBundle to read it.
 func (kubernetesServiceApi *KubernetesServiceApiV1) GetKubeconfig(getKubeconfigOptions *GetKubeconfigOptions) (response *core.DetailedResponse, err error) {
 	return kubernetesServiceApi.GetKubeconfigWithContext(context.Background(), getKubeconfigOptions)
 }
@@ -13835,6 +14978,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetKubeconfigWithContext(ctx
 	if err != nil {
 		return
 	}
+	if getKubeconfigOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getKubeconfigOptionsCopy := *getKubeconfigOptions
+		getKubeconfigOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getKubeconfigOptions = &getKubeconfigOptionsCopy
+	}
+	if getKubeconfigOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			getKubeconfigOptionsCopy := *getKubeconfigOptions
+			getKubeconfigOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			getKubeconfigOptions = &getKubeconfigOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(getKubeconfigOptions, "getKubeconfigOptions")
 	if err != nil {
 		return
@@ -13879,7 +15039,8 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetKubeconfigWithContext(ctx
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	var result io.ReadCloser
+	response, err = kubernetesServiceApi.invoke("GetKubeconfig", request, &result)
 
 	return
 }
@@ -13923,7 +15084,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetQuotaWithContext(ctx cont
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetQuota", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -13948,6 +15109,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerWithContext(ctx con
 	if err != nil {
 		return
 	}
+	if getWorkerOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getWorkerOptionsCopy := *getWorkerOptions
+		getWorkerOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getWorkerOptions = &getWorkerOptionsCopy
+	}
 	err = core.ValidateStruct(getWorkerOptions, "getWorkerOptions")
 	if err != nil {
 		return
@@ -13983,7 +15149,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetWorker", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -14008,6 +15174,16 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerPoolWithContext(ctx
 	if err != nil {
 		return
 	}
+	if getWorkerPoolOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		getWorkerPoolOptionsCopy := *getWorkerPoolOptions
+		getWorkerPoolOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		getWorkerPoolOptions = &getWorkerPoolOptionsCopy
+	}
+	if getWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getWorkerPoolOptionsCopy := *getWorkerPoolOptions
+		getWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getWorkerPoolOptions = &getWorkerPoolOptionsCopy
+	}
 	err = core.ValidateStruct(getWorkerPoolOptions, "getWorkerPoolOptions")
 	if err != nil {
 		return
@@ -14046,7 +15222,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerPoolWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetWorkerPool", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -14070,6 +15246,16 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerPools1WithContext(c
 	if err != nil {
 		return
 	}
+	if getWorkerPools1Options.XRegion == nil && kubernetesServiceApi.region != "" {
+		getWorkerPools1OptionsCopy := *getWorkerPools1Options
+		getWorkerPools1OptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		getWorkerPools1Options = &getWorkerPools1OptionsCopy
+	}
+	if getWorkerPools1Options.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getWorkerPools1OptionsCopy := *getWorkerPools1Options
+		getWorkerPools1OptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getWorkerPools1Options = &getWorkerPools1OptionsCopy
+	}
 	err = core.ValidateStruct(getWorkerPools1Options, "getWorkerPools1Options")
 	if err != nil {
 		return
@@ -14107,7 +15293,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkerPools1WithContext(c
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetWorkerPools1", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -14132,6 +15318,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkers1WithContext(ctx c
 	if err != nil {
 		return
 	}
+	if getWorkers1Options.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getWorkers1OptionsCopy := *getWorkers1Options
+		getWorkers1OptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getWorkers1Options = &getWorkers1OptionsCopy
+	}
 	err = core.ValidateStruct(getWorkers1Options, "getWorkers1Options")
 	if err != nil {
 		return
@@ -14169,7 +15360,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetWorkers1WithContext(ctx c
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetWorkers1", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -14195,6 +15386,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RebalanceWorkerPool(rebalanc
 
 // RebalanceWorkerPoolWithContext is an alternate form of the RebalanceWorkerPool method which supports a Context parameter
 func (kubernetesServiceApi *KubernetesServiceApiV1) RebalanceWorkerPoolWithContext(ctx context.Context, rebalanceWorkerPoolOptions *RebalanceWorkerPoolOptions) (response *core.DetailedResponse, err error) {
+	if rebalanceWorkerPoolOptions != nil && rebalanceWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		rebalanceWorkerPoolOptionsCopy := *rebalanceWorkerPoolOptions
+		rebalanceWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		rebalanceWorkerPoolOptions = &rebalanceWorkerPoolOptionsCopy
+	}
 	err = core.ValidateStruct(rebalanceWorkerPoolOptions, "rebalanceWorkerPoolOptions")
 	if err != nil {
 		return
@@ -14238,7 +15434,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RebalanceWorkerPoolWithConte
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RebalanceWorkerPool", request, nil)
 
 	return
 }
@@ -14255,6 +15451,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcRefreshMasterWithContext(
 	if err != nil {
 		return
 	}
+	if vpcRefreshMasterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcRefreshMasterOptionsCopy := *vpcRefreshMasterOptions
+		vpcRefreshMasterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcRefreshMasterOptions = &vpcRefreshMasterOptionsCopy
+	}
 	err = core.ValidateStruct(vpcRefreshMasterOptions, "vpcRefreshMasterOptions")
 	if err != nil {
 		return
@@ -14295,7 +15496,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcRefreshMasterWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("VpcRefreshMaster", request, nil)
 
 	return
 }
@@ -14315,6 +15516,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2RemoveWorkerWithContext(ct
 	if err != nil {
 		return
 	}
+	if v2RemoveWorkerOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2RemoveWorkerOptionsCopy := *v2RemoveWorkerOptions
+		v2RemoveWorkerOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2RemoveWorkerOptions = &v2RemoveWorkerOptionsCopy
+	}
 	err = core.ValidateStruct(v2RemoveWorkerOptions, "v2RemoveWorkerOptions")
 	if err != nil {
 		return
@@ -14358,7 +15564,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2RemoveWorkerWithContext(ct
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2RemoveWorker", request, nil)
 
 	return
 }
@@ -14376,6 +15582,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveWorkerPool1WithContext
 	if err != nil {
 		return
 	}
+	if removeWorkerPool1Options.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeWorkerPool1OptionsCopy := *removeWorkerPool1Options
+		removeWorkerPool1OptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeWorkerPool1Options = &removeWorkerPool1OptionsCopy
+	}
 	err = core.ValidateStruct(removeWorkerPool1Options, "removeWorkerPool1Options")
 	if err != nil {
 		return
@@ -14419,7 +15630,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveWorkerPool1WithContext
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveWorkerPool1", request, nil)
 
 	return
 }
@@ -14438,6 +15649,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveWorkerPoolZone1WithCon
 	if err != nil {
 		return
 	}
+	if removeWorkerPoolZone1Options.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		removeWorkerPoolZone1OptionsCopy := *removeWorkerPoolZone1Options
+		removeWorkerPoolZone1OptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		removeWorkerPoolZone1Options = &removeWorkerPoolZone1OptionsCopy
+	}
 	err = core.ValidateStruct(removeWorkerPoolZone1Options, "removeWorkerPoolZone1Options")
 	if err != nil {
 		return
@@ -14484,7 +15700,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) RemoveWorkerPoolZone1WithCon
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("RemoveWorkerPoolZone1", request, nil)
 
 	return
 }
@@ -14502,6 +15718,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2ResizeWorkerPoolWithContex
 	if err != nil {
 		return
 	}
+	if v2ResizeWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2ResizeWorkerPoolOptionsCopy := *v2ResizeWorkerPoolOptions
+		v2ResizeWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2ResizeWorkerPoolOptions = &v2ResizeWorkerPoolOptionsCopy
+	}
 	err = core.ValidateStruct(v2ResizeWorkerPoolOptions, "v2ResizeWorkerPoolOptions")
 	if err != nil {
 		return
@@ -14551,7 +15772,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2ResizeWorkerPoolWithContex
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2ResizeWorkerPool", request, nil)
 
 	return
 }
@@ -14573,6 +15794,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2SetWorkerPoolLabelsWithCon
 	if err != nil {
 		return
 	}
+	if v2SetWorkerPoolLabelsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2SetWorkerPoolLabelsOptionsCopy := *v2SetWorkerPoolLabelsOptions
+		v2SetWorkerPoolLabelsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2SetWorkerPoolLabelsOptions = &v2SetWorkerPoolLabelsOptionsCopy
+	}
 	err = core.ValidateStruct(v2SetWorkerPoolLabelsOptions, "v2SetWorkerPoolLabelsOptions")
 	if err != nil {
 		return
@@ -14619,7 +15845,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2SetWorkerPoolLabelsWithCon
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2SetWorkerPoolLabels", request, nil)
 
 	return
 }
@@ -14639,6 +15865,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2SetWorkerPoolTaintsWithCon
 	if err != nil {
 		return
 	}
+	if v2SetWorkerPoolTaintsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		v2SetWorkerPoolTaintsOptionsCopy := *v2SetWorkerPoolTaintsOptions
+		v2SetWorkerPoolTaintsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		v2SetWorkerPoolTaintsOptions = &v2SetWorkerPoolTaintsOptionsCopy
+	}
 	err = core.ValidateStruct(v2SetWorkerPoolTaintsOptions, "v2SetWorkerPoolTaintsOptions")
 	if err != nil {
 		return
@@ -14685,7 +15916,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) V2SetWorkerPoolTaintsWithCon
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("V2SetWorkerPoolTaints", request, nil)
 
 	return
 }
@@ -14704,10 +15935,31 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcCreateClusterWithContext(
 	if err != nil {
 		return
 	}
+	if vpcCreateClusterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcCreateClusterOptionsCopy := *vpcCreateClusterOptions
+		vpcCreateClusterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcCreateClusterOptions = &vpcCreateClusterOptionsCopy
+	}
+	if vpcCreateClusterOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			vpcCreateClusterOptionsCopy := *vpcCreateClusterOptions
+			vpcCreateClusterOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			vpcCreateClusterOptions = &vpcCreateClusterOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(vpcCreateClusterOptions, "vpcCreateClusterOptions")
 	if err != nil {
 		return
 	}
+	err = kubernetesServiceApi.validateStrict(vpcCreateClusterOptions)
+	if err != nil {
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
@@ -14776,7 +16028,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcCreateClusterWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcCreateCluster", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -14802,6 +16054,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcCreateWorkerPoolWithConte
 	if err != nil {
 		return
 	}
+	if vpcCreateWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcCreateWorkerPoolOptionsCopy := *vpcCreateWorkerPoolOptions
+		vpcCreateWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcCreateWorkerPoolOptions = &vpcCreateWorkerPoolOptionsCopy
+	}
+	if vpcCreateWorkerPoolOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			vpcCreateWorkerPoolOptionsCopy := *vpcCreateWorkerPoolOptions
+			vpcCreateWorkerPoolOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			vpcCreateWorkerPoolOptions = &vpcCreateWorkerPoolOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(vpcCreateWorkerPoolOptions, "vpcCreateWorkerPoolOptions")
 	if err != nil {
 		return
@@ -14880,7 +16149,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcCreateWorkerPoolWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcCreateWorkerPool", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -14906,6 +16175,23 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcCreateWorkerPoolZoneWithC
 	if err != nil {
 		return
 	}
+	if vpcCreateWorkerPoolZoneOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcCreateWorkerPoolZoneOptionsCopy := *vpcCreateWorkerPoolZoneOptions
+		vpcCreateWorkerPoolZoneOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcCreateWorkerPoolZoneOptions = &vpcCreateWorkerPoolZoneOptionsCopy
+	}
+	if vpcCreateWorkerPoolZoneOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			vpcCreateWorkerPoolZoneOptionsCopy := *vpcCreateWorkerPoolZoneOptions
+			vpcCreateWorkerPoolZoneOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			vpcCreateWorkerPoolZoneOptions = &vpcCreateWorkerPoolZoneOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(vpcCreateWorkerPoolZoneOptions, "vpcCreateWorkerPoolZoneOptions")
 	if err != nil {
 		return
@@ -14958,7 +16244,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcCreateWorkerPoolZoneWithC
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("VpcCreateWorkerPoolZone", request, nil)
 
 	return
 }
@@ -14975,6 +16261,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetClusterWithContext(ctx
 	if err != nil {
 		return
 	}
+	if vpcGetClusterOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcGetClusterOptionsCopy := *vpcGetClusterOptions
+		vpcGetClusterOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcGetClusterOptions = &vpcGetClusterOptionsCopy
+	}
 	err = core.ValidateStruct(vpcGetClusterOptions, "vpcGetClusterOptions")
 	if err != nil {
 		return
@@ -15012,7 +16303,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetClusterWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcGetCluster", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15034,6 +16325,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetClusters(vpcGetCluster
 
 // VpcGetClustersWithContext is an alternate form of the VpcGetClusters method which supports a Context parameter
 func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetClustersWithContext(ctx context.Context, vpcGetClustersOptions *VpcGetClustersOptions) (result []GetClustersResponse, response *core.DetailedResponse, err error) {
+	if vpcGetClustersOptions != nil && vpcGetClustersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcGetClustersOptionsCopy := *vpcGetClustersOptions
+		vpcGetClustersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcGetClustersOptions = &vpcGetClustersOptionsCopy
+	}
 	err = core.ValidateStruct(vpcGetClustersOptions, "vpcGetClustersOptions")
 	if err != nil {
 		return
@@ -15073,7 +16369,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetClustersWithContext(ct
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcGetClusters", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15098,6 +16394,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSubnetsWithContext(ctx co
 	if err != nil {
 		return
 	}
+	if getSubnetsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getSubnetsOptionsCopy := *getSubnetsOptions
+		getSubnetsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getSubnetsOptions = &getSubnetsOptionsCopy
+	}
 	err = core.ValidateStruct(getSubnetsOptions, "getSubnetsOptions")
 	if err != nil {
 		return
@@ -15134,7 +16435,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetSubnetsWithContext(ctx co
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetSubnets", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15159,6 +16460,16 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVPCWithContext(ctx contex
 	if err != nil {
 		return
 	}
+	if getVPCOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		getVPCOptionsCopy := *getVPCOptions
+		getVPCOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		getVPCOptions = &getVPCOptionsCopy
+	}
+	if getVPCOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getVPCOptionsCopy := *getVPCOptions
+		getVPCOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getVPCOptions = &getVPCOptionsCopy
+	}
 	err = core.ValidateStruct(getVPCOptions, "getVPCOptions")
 	if err != nil {
 		return
@@ -15197,7 +16508,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVPCWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetVPC", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15222,6 +16533,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVPCsWithContext(ctx conte
 	if err != nil {
 		return
 	}
+	if getVPCsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		getVPCsOptionsCopy := *getVPCsOptions
+		getVPCsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		getVPCsOptions = &getVPCsOptionsCopy
+	}
 	err = core.ValidateStruct(getVPCsOptions, "getVPCsOptions")
 	if err != nil {
 		return
@@ -15256,7 +16572,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) GetVPCsWithContext(ctx conte
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("GetVPCs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15281,6 +16597,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetWorkerWithContext(ctx
 	if err != nil {
 		return
 	}
+	if vpcGetWorkerOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcGetWorkerOptionsCopy := *vpcGetWorkerOptions
+		vpcGetWorkerOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcGetWorkerOptions = &vpcGetWorkerOptionsCopy
+	}
 	err = core.ValidateStruct(vpcGetWorkerOptions, "vpcGetWorkerOptions")
 	if err != nil {
 		return
@@ -15316,7 +16637,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetWorkerWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcGetWorker", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15341,6 +16662,16 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetWorkerPoolWithContext(
 	if err != nil {
 		return
 	}
+	if vpcGetWorkerPoolOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		vpcGetWorkerPoolOptionsCopy := *vpcGetWorkerPoolOptions
+		vpcGetWorkerPoolOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		vpcGetWorkerPoolOptions = &vpcGetWorkerPoolOptionsCopy
+	}
+	if vpcGetWorkerPoolOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcGetWorkerPoolOptionsCopy := *vpcGetWorkerPoolOptions
+		vpcGetWorkerPoolOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcGetWorkerPoolOptions = &vpcGetWorkerPoolOptionsCopy
+	}
 	err = core.ValidateStruct(vpcGetWorkerPoolOptions, "vpcGetWorkerPoolOptions")
 	if err != nil {
 		return
@@ -15379,7 +16710,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetWorkerPoolWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcGetWorkerPool", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15403,6 +16734,16 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetWorkerPoolsWithContext
 	if err != nil {
 		return
 	}
+	if vpcGetWorkerPoolsOptions.XRegion == nil && kubernetesServiceApi.region != "" {
+		vpcGetWorkerPoolsOptionsCopy := *vpcGetWorkerPoolsOptions
+		vpcGetWorkerPoolsOptionsCopy.XRegion = core.StringPtr(kubernetesServiceApi.region)
+		vpcGetWorkerPoolsOptions = &vpcGetWorkerPoolsOptionsCopy
+	}
+	if vpcGetWorkerPoolsOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcGetWorkerPoolsOptionsCopy := *vpcGetWorkerPoolsOptions
+		vpcGetWorkerPoolsOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcGetWorkerPoolsOptions = &vpcGetWorkerPoolsOptionsCopy
+	}
 	err = core.ValidateStruct(vpcGetWorkerPoolsOptions, "vpcGetWorkerPoolsOptions")
 	if err != nil {
 		return
@@ -15440,7 +16781,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetWorkerPoolsWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcGetWorkerPools", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15465,6 +16806,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetWorkersWithContext(ctx
 	if err != nil {
 		return
 	}
+	if vpcGetWorkersOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcGetWorkersOptionsCopy := *vpcGetWorkersOptions
+		vpcGetWorkersOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcGetWorkersOptions = &vpcGetWorkersOptionsCopy
+	}
 	err = core.ValidateStruct(vpcGetWorkersOptions, "vpcGetWorkersOptions")
 	if err != nil {
 		return
@@ -15505,7 +16851,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetWorkersWithContext(ctx
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcGetWorkers", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15526,6 +16872,18 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetZones(vpcGetZonesOptio
 
 // VpcGetZonesWithContext is an alternate form of the VpcGetZones method which supports a Context parameter
 func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetZonesWithContext(ctx context.Context, vpcGetZonesOptions *VpcGetZonesOptions) (result [][]ZoneResponse, response *core.DetailedResponse, err error) {
+	if vpcGetZonesOptions != nil && vpcGetZonesOptions.XAuthRefreshToken == nil {
+		var refreshToken string
+		refreshToken, err = kubernetesServiceApi.defaultRefreshToken(ctx)
+		if err != nil {
+			return
+		}
+		if refreshToken != "" {
+			vpcGetZonesOptionsCopy := *vpcGetZonesOptions
+			vpcGetZonesOptionsCopy.XAuthRefreshToken = core.StringPtr(refreshToken)
+			vpcGetZonesOptions = &vpcGetZonesOptionsCopy
+		}
+	}
 	err = core.ValidateStruct(vpcGetZonesOptions, "vpcGetZonesOptions")
 	if err != nil {
 		return
@@ -15565,7 +16923,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcGetZonesWithContext(ctx c
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = kubernetesServiceApi.Service.Request(request, &rawResponse)
+	response, err = kubernetesServiceApi.invoke("VpcGetZones", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -15597,6 +16955,11 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcReplaceWorkerWithContext(
 	if err != nil {
 		return
 	}
+	if vpcReplaceWorkerOptions.XAuthResourceGroup == nil && kubernetesServiceApi.resourceGroup != "" {
+		vpcReplaceWorkerOptionsCopy := *vpcReplaceWorkerOptions
+		vpcReplaceWorkerOptionsCopy.XAuthResourceGroup = core.StringPtr(kubernetesServiceApi.resourceGroup)
+		vpcReplaceWorkerOptions = &vpcReplaceWorkerOptionsCopy
+	}
 	err = core.ValidateStruct(vpcReplaceWorkerOptions, "vpcReplaceWorkerOptions")
 	if err != nil {
 		return
@@ -15643,7 +17006,7 @@ func (kubernetesServiceApi *KubernetesServiceApiV1) VpcReplaceWorkerWithContext(
 		return
 	}
 
-	response, err = kubernetesServiceApi.Service.Request(request, nil)
+	response, err = kubernetesServiceApi.invoke("VpcReplaceWorker", request, nil)
 
 	return
 }
@@ -0,0 +1,6508 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mock provides a mock implementation of the kubernetesserviceapiv1.API interface.
+//
+// Each method of API calls the function field of the same name with a Func suffix. A method without a
+// context falls back to the function of its WithContext form, called with context.Background(). Methods whose
+// function is not set return zero values and an error wrapping ErrNotStubbed.
+package mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrNotStubbed is wrapped by the errors of the methods whose function is not set.
+var ErrNotStubbed = errors.New("mock: method not stubbed")
+
+// Call : A call of a method of the mock.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API : A mock implementation of kubernetesserviceapiv1.API.
+type API struct {
+	GetClusterConfigToWriterFunc                   func(getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions, writer io.Writer) (int64, *core.DetailedResponse, error)
+	GetClusterConfigToWriterWithContextFunc        func(ctx context.Context, getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions, writer io.Writer) (int64, *core.DetailedResponse, error)
+	ExtractClusterConfigFunc                       func(getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions, dir string) (*kubernetesserviceapiv1.ClusterConfigManifest, *core.DetailedResponse, error)
+	ExtractClusterConfigWithContextFunc            func(ctx context.Context, getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions, dir string) (*kubernetesserviceapiv1.ClusterConfigManifest, *core.DetailedResponse, error)
+	WaitForClusterStateFunc                        func(ctx context.Context, cluster string, predicate kubernetesserviceapiv1.ClusterPredicate, options *kubernetesserviceapiv1.ClusterWaitOptions) (*kubernetesserviceapiv1.GetClusterResponse, error)
+	WaitForClusterNormalFunc                       func(ctx context.Context, cluster string, options *kubernetesserviceapiv1.ClusterWaitOptions) (*kubernetesserviceapiv1.GetClusterResponse, error)
+	WaitForClusterDeletedFunc                      func(ctx context.Context, cluster string, options *kubernetesserviceapiv1.ClusterWaitOptions) error
+	GetParsedKubeconfigFunc                        func(getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*kubernetesserviceapiv1.Kubeconfig, *core.DetailedResponse, error)
+	GetParsedKubeconfigWithContextFunc             func(ctx context.Context, getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*kubernetesserviceapiv1.Kubeconfig, *core.DetailedResponse, error)
+	GetKubeconfigBundleFunc                        func(getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*kubernetesserviceapiv1.KubeconfigBundle, *core.DetailedResponse, error)
+	GetKubeconfigBundleWithContextFunc             func(ctx context.Context, getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*kubernetesserviceapiv1.KubeconfigBundle, *core.DetailedResponse, error)
+	ApplyRBACAndGetParsedKubeconfigFunc            func(applyRBACAndGetKubeconfigOptions *kubernetesserviceapiv1.ApplyRBACAndGetKubeconfigOptions) (*kubernetesserviceapiv1.Kubeconfig, *core.DetailedResponse, error)
+	ApplyRBACAndGetParsedKubeconfigWithContextFunc func(ctx context.Context, applyRBACAndGetKubeconfigOptions *kubernetesserviceapiv1.ApplyRBACAndGetKubeconfigOptions) (*kubernetesserviceapiv1.Kubeconfig, *core.DetailedResponse, error)
+	GetUserCredentialsFunc                         func(getUserCredentialsOptions *kubernetesserviceapiv1.GetUserCredentialsOptions) (*kubernetesserviceapiv1.Credentials, *core.DetailedResponse, error)
+	GetUserCredentialsWithContextFunc              func(ctx context.Context, getUserCredentialsOptions *kubernetesserviceapiv1.GetUserCredentialsOptions) (*kubernetesserviceapiv1.Credentials, *core.DetailedResponse, error)
+	StoreUserCredentialsFunc                       func(storeUserCredentialsOptions *kubernetesserviceapiv1.StoreUserCredentialsOptions) (*core.DetailedResponse, error)
+	StoreUserCredentialsWithContextFunc            func(ctx context.Context, storeUserCredentialsOptions *kubernetesserviceapiv1.StoreUserCredentialsOptions) (*core.DetailedResponse, error)
+	RemoveUserCredentialsFunc                      func(removeUserCredentialsOptions *kubernetesserviceapiv1.RemoveUserCredentialsOptions) (*core.DetailedResponse, error)
+	RemoveUserCredentialsWithContextFunc           func(ctx context.Context, removeUserCredentialsOptions *kubernetesserviceapiv1.RemoveUserCredentialsOptions) (*core.DetailedResponse, error)
+	GetInfraPermissionsFunc                        func(getInfraPermissionsOptions *kubernetesserviceapiv1.GetInfraPermissionsOptions) (*kubernetesserviceapiv1.AccountStatus, *core.DetailedResponse, error)
+	GetInfraPermissionsWithContextFunc             func(ctx context.Context, getInfraPermissionsOptions *kubernetesserviceapiv1.GetInfraPermissionsOptions) (*kubernetesserviceapiv1.AccountStatus, *core.DetailedResponse, error)
+	ResetUserAPIKeyFunc                            func(resetUserAPIKeyOptions *kubernetesserviceapiv1.ResetUserAPIKeyOptions) (*core.DetailedResponse, error)
+	ResetUserAPIKeyWithContextFunc                 func(ctx context.Context, resetUserAPIKeyOptions *kubernetesserviceapiv1.ResetUserAPIKeyOptions) (*core.DetailedResponse, error)
+	GetVlanSpanningFunc                            func(getVlanSpanningOptions *kubernetesserviceapiv1.GetVlanSpanningOptions) (*kubernetesserviceapiv1.VlanSpan, *core.DetailedResponse, error)
+	GetVlanSpanningWithContextFunc                 func(ctx context.Context, getVlanSpanningOptions *kubernetesserviceapiv1.GetVlanSpanningOptions) (*kubernetesserviceapiv1.VlanSpan, *core.DetailedResponse, error)
+	GetClusterACLsFunc                             func(getClusterACLsOptions *kubernetesserviceapiv1.GetClusterACLsOptions) (*kubernetesserviceapiv1.ACLResponse, *core.DetailedResponse, error)
+	GetClusterACLsWithContextFunc                  func(ctx context.Context, getClusterACLsOptions *kubernetesserviceapiv1.GetClusterACLsOptions) (*kubernetesserviceapiv1.ACLResponse, *core.DetailedResponse, error)
+	DisableClusterACLsFunc                         func(disableClusterACLsOptions *kubernetesserviceapiv1.DisableClusterACLsOptions) (*core.DetailedResponse, error)
+	DisableClusterACLsWithContextFunc              func(ctx context.Context, disableClusterACLsOptions *kubernetesserviceapiv1.DisableClusterACLsOptions) (*core.DetailedResponse, error)
+	AddClusterACLsFunc                             func(addClusterACLsOptions *kubernetesserviceapiv1.AddClusterACLsOptions) (*core.DetailedResponse, error)
+	AddClusterACLsWithContextFunc                  func(ctx context.Context, addClusterACLsOptions *kubernetesserviceapiv1.AddClusterACLsOptions) (*core.DetailedResponse, error)
+	EnableClusterACLsFunc                          func(enableClusterACLsOptions *kubernetesserviceapiv1.EnableClusterACLsOptions) (*core.DetailedResponse, error)
+	EnableClusterACLsWithContextFunc               func(ctx context.Context, enableClusterACLsOptions *kubernetesserviceapiv1.EnableClusterACLsOptions) (*core.DetailedResponse, error)
+	RemoveClusterACLsFunc                          func(removeClusterACLsOptions *kubernetesserviceapiv1.RemoveClusterACLsOptions) (*core.DetailedResponse, error)
+	RemoveClusterACLsWithContextFunc               func(ctx context.Context, removeClusterACLsOptions *kubernetesserviceapiv1.RemoveClusterACLsOptions) (*core.DetailedResponse, error)
+	EnableALBFunc                                  func(enableALBOptions *kubernetesserviceapiv1.EnableALBOptions) (*core.DetailedResponse, error)
+	EnableALBWithContextFunc                       func(ctx context.Context, enableALBOptions *kubernetesserviceapiv1.EnableALBOptions) (*core.DetailedResponse, error)
+	GetClusterALBFunc                              func(getClusterALBOptions *kubernetesserviceapiv1.GetClusterALBOptions) (*kubernetesserviceapiv1.ALBConfig, *core.DetailedResponse, error)
+	GetClusterALBWithContextFunc                   func(ctx context.Context, getClusterALBOptions *kubernetesserviceapiv1.GetClusterALBOptions) (*kubernetesserviceapiv1.ALBConfig, *core.DetailedResponse, error)
+	DisableALBFunc                                 func(disableALBOptions *kubernetesserviceapiv1.DisableALBOptions) (*core.DetailedResponse, error)
+	DisableALBWithContextFunc                      func(ctx context.Context, disableALBOptions *kubernetesserviceapiv1.DisableALBOptions) (*core.DetailedResponse, error)
+	GetAvailableALBTypesFunc                       func(getAvailableALBTypesOptions *kubernetesserviceapiv1.GetAvailableALBTypesOptions) ([]string, *core.DetailedResponse, error)
+	GetAvailableALBTypesWithContextFunc            func(ctx context.Context, getAvailableALBTypesOptions *kubernetesserviceapiv1.GetAvailableALBTypesOptions) ([]string, *core.DetailedResponse, error)
+	GetClusterALBsFunc                             func(getClusterALBsOptions *kubernetesserviceapiv1.GetClusterALBsOptions) ([]kubernetesserviceapiv1.ClusterALB, *core.DetailedResponse, error)
+	GetClusterALBsWithContextFunc                  func(ctx context.Context, getClusterALBsOptions *kubernetesserviceapiv1.GetClusterALBsOptions) ([]kubernetesserviceapiv1.ClusterALB, *core.DetailedResponse, error)
+	UpdateALBsFunc                                 func(updateALBsOptions *kubernetesserviceapiv1.UpdateALBsOptions) (*core.DetailedResponse, error)
+	UpdateALBsWithContextFunc                      func(ctx context.Context, updateALBsOptions *kubernetesserviceapiv1.UpdateALBsOptions) (*core.DetailedResponse, error)
+	GetUpdatePolicyFunc                            func(getUpdatePolicyOptions *kubernetesserviceapiv1.GetUpdatePolicyOptions) (*kubernetesserviceapiv1.UpdatePolicy, *core.DetailedResponse, error)
+	GetUpdatePolicyWithContextFunc                 func(ctx context.Context, getUpdatePolicyOptions *kubernetesserviceapiv1.GetUpdatePolicyOptions) (*kubernetesserviceapiv1.UpdatePolicy, *core.DetailedResponse, error)
+	ChangeUpdatePolicyFunc                         func(changeUpdatePolicyOptions *kubernetesserviceapiv1.ChangeUpdatePolicyOptions) (*core.DetailedResponse, error)
+	ChangeUpdatePolicyWithContextFunc              func(ctx context.Context, changeUpdatePolicyOptions *kubernetesserviceapiv1.ChangeUpdatePolicyOptions) (*core.DetailedResponse, error)
+	RollbackUpdateFunc                             func(rollbackUpdateOptions *kubernetesserviceapiv1.RollbackUpdateOptions) (*core.DetailedResponse, error)
+	RollbackUpdateWithContextFunc                  func(ctx context.Context, rollbackUpdateOptions *kubernetesserviceapiv1.RollbackUpdateOptions) (*core.DetailedResponse, error)
+	CreateALBFunc                                  func(createALBOptions *kubernetesserviceapiv1.CreateALBOptions) (*kubernetesserviceapiv1.AlbCreateResp, *core.DetailedResponse, error)
+	CreateALBWithContextFunc                       func(ctx context.Context, createALBOptions *kubernetesserviceapiv1.CreateALBOptions) (*kubernetesserviceapiv1.AlbCreateResp, *core.DetailedResponse, error)
+	UpdateALBSecretFunc                            func(updateALBSecretOptions *kubernetesserviceapiv1.UpdateALBSecretOptions) (*core.DetailedResponse, error)
+	UpdateALBSecretWithContextFunc                 func(ctx context.Context, updateALBSecretOptions *kubernetesserviceapiv1.UpdateALBSecretOptions) (*core.DetailedResponse, error)
+	CreateALBSecretFunc                            func(createALBSecretOptions *kubernetesserviceapiv1.CreateALBSecretOptions) (*core.DetailedResponse, error)
+	CreateALBSecretWithContextFunc                 func(ctx context.Context, createALBSecretOptions *kubernetesserviceapiv1.CreateALBSecretOptions) (*core.DetailedResponse, error)
+	ViewClusterALBSecretsFunc                      func(viewClusterALBSecretsOptions *kubernetesserviceapiv1.ViewClusterALBSecretsOptions) (*kubernetesserviceapiv1.ClusterALBSecret, *core.DetailedResponse, error)
+	ViewClusterALBSecretsWithContextFunc           func(ctx context.Context, viewClusterALBSecretsOptions *kubernetesserviceapiv1.ViewClusterALBSecretsOptions) (*kubernetesserviceapiv1.ClusterALBSecret, *core.DetailedResponse, error)
+	DeleteClusterALBSecretsFunc                    func(deleteClusterALBSecretsOptions *kubernetesserviceapiv1.DeleteClusterALBSecretsOptions) (*core.DetailedResponse, error)
+	DeleteClusterALBSecretsWithContextFunc         func(ctx context.Context, deleteClusterALBSecretsOptions *kubernetesserviceapiv1.DeleteClusterALBSecretsOptions) (*core.DetailedResponse, error)
+	GetAuditWebhookFunc                            func(getAuditWebhookOptions *kubernetesserviceapiv1.GetAuditWebhookOptions) (*kubernetesserviceapiv1.AuditWebhookConfig, *core.DetailedResponse, error)
+	GetAuditWebhookWithContextFunc                 func(ctx context.Context, getAuditWebhookOptions *kubernetesserviceapiv1.GetAuditWebhookOptions) (*kubernetesserviceapiv1.AuditWebhookConfig, *core.DetailedResponse, error)
+	UpdateAuditWebhookFunc                         func(updateAuditWebhookOptions *kubernetesserviceapiv1.UpdateAuditWebhookOptions) (*core.DetailedResponse, error)
+	UpdateAuditWebhookWithContextFunc              func(ctx context.Context, updateAuditWebhookOptions *kubernetesserviceapiv1.UpdateAuditWebhookOptions) (*core.DetailedResponse, error)
+	DeleteAuditWebhookFunc                         func(deleteAuditWebhookOptions *kubernetesserviceapiv1.DeleteAuditWebhookOptions) (*core.DetailedResponse, error)
+	DeleteAuditWebhookWithContextFunc              func(ctx context.Context, deleteAuditWebhookOptions *kubernetesserviceapiv1.DeleteAuditWebhookOptions) (*core.DetailedResponse, error)
+	GetLBConfigFunc                                func(getLBConfigOptions *kubernetesserviceapiv1.GetLBConfigOptions) (*kubernetesserviceapiv1.LBConfig, *core.DetailedResponse, error)
+	GetLBConfigWithContextFunc                     func(ctx context.Context, getLBConfigOptions *kubernetesserviceapiv1.GetLBConfigOptions) (*kubernetesserviceapiv1.LBConfig, *core.DetailedResponse, error)
+	PatchLBConfigFunc                              func(patchLBConfigOptions *kubernetesserviceapiv1.PatchLBConfigOptions) (*core.DetailedResponse, error)
+	PatchLBConfigWithContextFunc                   func(ctx context.Context, patchLBConfigOptions *kubernetesserviceapiv1.PatchLBConfigOptions) (*core.DetailedResponse, error)
+	CreateSecretFunc                               func(createSecretOptions *kubernetesserviceapiv1.CreateSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error)
+	CreateSecretWithContextFunc                    func(ctx context.Context, createSecretOptions *kubernetesserviceapiv1.CreateSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error)
+	DeleteIngressSecretFunc                        func(deleteIngressSecretOptions *kubernetesserviceapiv1.DeleteIngressSecretOptions) (*core.DetailedResponse, error)
+	DeleteIngressSecretWithContextFunc             func(ctx context.Context, deleteIngressSecretOptions *kubernetesserviceapiv1.DeleteIngressSecretOptions) (*core.DetailedResponse, error)
+	GetSecretFunc                                  func(getSecretOptions *kubernetesserviceapiv1.GetSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error)
+	GetSecretWithContextFunc                       func(ctx context.Context, getSecretOptions *kubernetesserviceapiv1.GetSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error)
+	GetSecretsFunc                                 func(getSecretsOptions *kubernetesserviceapiv1.GetSecretsOptions) ([]kubernetesserviceapiv1.Secret, *core.DetailedResponse, error)
+	GetSecretsWithContextFunc                      func(ctx context.Context, getSecretsOptions *kubernetesserviceapiv1.GetSecretsOptions) ([]kubernetesserviceapiv1.Secret, *core.DetailedResponse, error)
+	UpdateSecretFunc                               func(updateSecretOptions *kubernetesserviceapiv1.UpdateSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error)
+	UpdateSecretWithContextFunc                    func(ctx context.Context, updateSecretOptions *kubernetesserviceapiv1.UpdateSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error)
+	CleanupMigrationFunc                           func(cleanupMigrationOptions *kubernetesserviceapiv1.CleanupMigrationOptions) (*core.DetailedResponse, error)
+	CleanupMigrationWithContextFunc                func(ctx context.Context, cleanupMigrationOptions *kubernetesserviceapiv1.CleanupMigrationOptions) (*core.DetailedResponse, error)
+	V2GetClusterALBFunc                            func(v2GetClusterALBOptions *kubernetesserviceapiv1.V2GetClusterALBOptions) (*kubernetesserviceapiv1.VpcALBConfig, *core.DetailedResponse, error)
+	V2GetClusterALBWithContextFunc                 func(ctx context.Context, v2GetClusterALBOptions *kubernetesserviceapiv1.V2GetClusterALBOptions) (*kubernetesserviceapiv1.VpcALBConfig, *core.DetailedResponse, error)
+	GetSupportedImagesFunc                         func(getSupportedImagesOptions *kubernetesserviceapiv1.GetSupportedImagesOptions) ([]string, *core.DetailedResponse, error)
+	GetSupportedImagesWithContextFunc              func(ctx context.Context, getSupportedImagesOptions *kubernetesserviceapiv1.GetSupportedImagesOptions) ([]string, *core.DetailedResponse, error)
+	V2GetClusterALBsFunc                           func(v2GetClusterALBsOptions *kubernetesserviceapiv1.V2GetClusterALBsOptions) (*kubernetesserviceapiv1.VpcClusterALB, *core.DetailedResponse, error)
+	V2GetClusterALBsWithContextFunc                func(ctx context.Context, v2GetClusterALBsOptions *kubernetesserviceapiv1.V2GetClusterALBsOptions) (*kubernetesserviceapiv1.VpcClusterALB, *core.DetailedResponse, error)
+	GetMigrationStatusFunc                         func(getMigrationStatusOptions *kubernetesserviceapiv1.GetMigrationStatusOptions) (*kubernetesserviceapiv1.MigrationStatus, *core.DetailedResponse, error)
+	GetMigrationStatusWithContextFunc              func(ctx context.Context, getMigrationStatusOptions *kubernetesserviceapiv1.GetMigrationStatusOptions) (*kubernetesserviceapiv1.MigrationStatus, *core.DetailedResponse, error)
+	GetStatusFunc                                  func(getStatusOptions *kubernetesserviceapiv1.GetStatusOptions) (*kubernetesserviceapiv1.IngressStatus, *core.DetailedResponse, error)
+	GetStatusWithContextFunc                       func(ctx context.Context, getStatusOptions *kubernetesserviceapiv1.GetStatusOptions) (*kubernetesserviceapiv1.IngressStatus, *core.DetailedResponse, error)
+	StartMigrationFunc                             func(startMigrationOptions *kubernetesserviceapiv1.StartMigrationOptions) (*core.DetailedResponse, error)
+	StartMigrationWithContextFunc                  func(ctx context.Context, startMigrationOptions *kubernetesserviceapiv1.StartMigrationOptions) (*core.DetailedResponse, error)
+	V2UpdateALBFunc                                func(v2UpdateALBOptions *kubernetesserviceapiv1.V2UpdateALBOptions) (*core.DetailedResponse, error)
+	V2UpdateALBWithContextFunc                     func(ctx context.Context, v2UpdateALBOptions *kubernetesserviceapiv1.V2UpdateALBOptions) (*core.DetailedResponse, error)
+	VpcCreateALBFunc                               func(vpcCreateALBOptions *kubernetesserviceapiv1.VpcCreateALBOptions) (*kubernetesserviceapiv1.AlbCreateResp, *core.DetailedResponse, error)
+	VpcCreateALBWithContextFunc                    func(ctx context.Context, vpcCreateALBOptions *kubernetesserviceapiv1.VpcCreateALBOptions) (*kubernetesserviceapiv1.AlbCreateResp, *core.DetailedResponse, error)
+	VpcDisableALBFunc                              func(vpcDisableALBOptions *kubernetesserviceapiv1.VpcDisableALBOptions) (*core.DetailedResponse, error)
+	VpcDisableALBWithContextFunc                   func(ctx context.Context, vpcDisableALBOptions *kubernetesserviceapiv1.VpcDisableALBOptions) (*core.DetailedResponse, error)
+	VpcEnableALBFunc                               func(vpcEnableALBOptions *kubernetesserviceapiv1.VpcEnableALBOptions) (*core.DetailedResponse, error)
+	VpcEnableALBWithContextFunc                    func(ctx context.Context, vpcEnableALBOptions *kubernetesserviceapiv1.VpcEnableALBOptions) (*core.DetailedResponse, error)
+	V2DisablePrivateServiceEndpointFunc            func(v2DisablePrivateServiceEndpointOptions *kubernetesserviceapiv1.V2DisablePrivateServiceEndpointOptions) (*core.DetailedResponse, error)
+	V2DisablePrivateServiceEndpointWithContextFunc func(ctx context.Context, v2DisablePrivateServiceEndpointOptions *kubernetesserviceapiv1.V2DisablePrivateServiceEndpointOptions) (*core.DetailedResponse, error)
+	V2DisablePublicServiceEndpointFunc             func(v2DisablePublicServiceEndpointOptions *kubernetesserviceapiv1.V2DisablePublicServiceEndpointOptions) (*core.DetailedResponse, error)
+	V2DisablePublicServiceEndpointWithContextFunc  func(ctx context.Context, v2DisablePublicServiceEndpointOptions *kubernetesserviceapiv1.V2DisablePublicServiceEndpointOptions) (*core.DetailedResponse, error)
+	V2EnablePrivateServiceEndpointFunc             func(v2EnablePrivateServiceEndpointOptions *kubernetesserviceapiv1.V2EnablePrivateServiceEndpointOptions) (*core.DetailedResponse, error)
+	V2EnablePrivateServiceEndpointWithContextFunc  func(ctx context.Context, v2EnablePrivateServiceEndpointOptions *kubernetesserviceapiv1.V2EnablePrivateServiceEndpointOptions) (*core.DetailedResponse, error)
+	V2EnablePublicServiceEndpointFunc              func(v2EnablePublicServiceEndpointOptions *kubernetesserviceapiv1.V2EnablePublicServiceEndpointOptions) (*core.DetailedResponse, error)
+	V2EnablePublicServiceEndpointWithContextFunc   func(ctx context.Context, v2EnablePublicServiceEndpointOptions *kubernetesserviceapiv1.V2EnablePublicServiceEndpointOptions) (*core.DetailedResponse, error)
+	V2EnablePullSecretFunc                         func(v2EnablePullSecretOptions *kubernetesserviceapiv1.V2EnablePullSecretOptions) (*core.DetailedResponse, error)
+	V2EnablePullSecretWithContextFunc              func(ctx context.Context, v2EnablePullSecretOptions *kubernetesserviceapiv1.V2EnablePullSecretOptions) (*core.DetailedResponse, error)
+	V2GetVersionsFunc                              func(v2GetVersionsOptions *kubernetesserviceapiv1.V2GetVersionsOptions) ([]kubernetesserviceapiv1.IKSVersion, *core.DetailedResponse, error)
+	V2GetVersionsWithContextFunc                   func(ctx context.Context, v2GetVersionsOptions *kubernetesserviceapiv1.V2GetVersionsOptions) ([]kubernetesserviceapiv1.IKSVersion, *core.DetailedResponse, error)
+	DeleteSecretFunc                               func(deleteSecretOptions *kubernetesserviceapiv1.DeleteSecretOptions) (*core.DetailedResponse, error)
+	DeleteSecretWithContextFunc                    func(ctx context.Context, deleteSecretOptions *kubernetesserviceapiv1.DeleteSecretOptions) (*core.DetailedResponse, error)
+	GetNlbDNSListFunc                              func(getNlbDNSListOptions *kubernetesserviceapiv1.GetNlbDNSListOptions) ([]kubernetesserviceapiv1.NlbVPCListConfig, *core.DetailedResponse, error)
+	GetNlbDNSListWithContextFunc                   func(ctx context.Context, getNlbDNSListOptions *kubernetesserviceapiv1.GetNlbDNSListOptions) ([]kubernetesserviceapiv1.NlbVPCListConfig, *core.DetailedResponse, error)
+	GetSatLocationNlbDNSListFunc                   func(getSatLocationNlbDNSListOptions *kubernetesserviceapiv1.GetSatLocationNlbDNSListOptions) ([]kubernetesserviceapiv1.NlbVPCListConfig, *core.DetailedResponse, error)
+	GetSatLocationNlbDNSListWithContextFunc        func(ctx context.Context, getSatLocationNlbDNSListOptions *kubernetesserviceapiv1.GetSatLocationNlbDNSListOptions) ([]kubernetesserviceapiv1.NlbVPCListConfig, *core.DetailedResponse, error)
+	RegenerateCertFunc                             func(regenerateCertOptions *kubernetesserviceapiv1.RegenerateCertOptions) (*core.DetailedResponse, error)
+	RegenerateCertWithContextFunc                  func(ctx context.Context, regenerateCertOptions *kubernetesserviceapiv1.RegenerateCertOptions) (*core.DetailedResponse, error)
+	RegisterMultishiftClusterFunc                  func(registerMultishiftClusterOptions *kubernetesserviceapiv1.RegisterMultishiftClusterOptions) (*kubernetesserviceapiv1.MSCRegisterResp, *core.DetailedResponse, error)
+	RegisterMultishiftClusterWithContextFunc       func(ctx context.Context, registerMultishiftClusterOptions *kubernetesserviceapiv1.RegisterMultishiftClusterOptions) (*kubernetesserviceapiv1.MSCRegisterResp, *core.DetailedResponse, error)
+	ReplaceLBHostnameFunc                          func(replaceLBHostnameOptions *kubernetesserviceapiv1.ReplaceLBHostnameOptions) (*kubernetesserviceapiv1.NlbVPCConfig, *core.DetailedResponse, error)
+	ReplaceLBHostnameWithContextFunc               func(ctx context.Context, replaceLBHostnameOptions *kubernetesserviceapiv1.ReplaceLBHostnameOptions) (*kubernetesserviceapiv1.NlbVPCConfig, *core.DetailedResponse, error)
+	CreateNlbDNSFunc                               func(createNlbDNSOptions *kubernetesserviceapiv1.CreateNlbDNSOptions) (*kubernetesserviceapiv1.NlbVPCConfig, *core.DetailedResponse, error)
+	CreateNlbDNSWithContextFunc                    func(ctx context.Context, createNlbDNSOptions *kubernetesserviceapiv1.CreateNlbDNSOptions) (*kubernetesserviceapiv1.NlbVPCConfig, *core.DetailedResponse, error)
+	RemoveLBHostnameFunc                           func(removeLBHostnameOptions *kubernetesserviceapiv1.RemoveLBHostnameOptions) (*core.DetailedResponse, error)
+	RemoveLBHostnameWithContextFunc                func(ctx context.Context, removeLBHostnameOptions *kubernetesserviceapiv1.RemoveLBHostnameOptions) (*core.DetailedResponse, error)
+	ReplaceWorkerFunc                              func(replaceWorkerOptions *kubernetesserviceapiv1.ReplaceWorkerOptions) (*core.DetailedResponse, error)
+	ReplaceWorkerWithContextFunc                   func(ctx context.Context, replaceWorkerOptions *kubernetesserviceapiv1.ReplaceWorkerOptions) (*core.DetailedResponse, error)
+	V2UpdateMasterFunc                             func(v2UpdateMasterOptions *kubernetesserviceapiv1.V2UpdateMasterOptions) (*core.DetailedResponse, error)
+	V2UpdateMasterWithContextFunc                  func(ctx context.Context, v2UpdateMasterOptions *kubernetesserviceapiv1.V2UpdateMasterOptions) (*core.DetailedResponse, error)
+	GetClustersFunc                                func(getClustersOptions *kubernetesserviceapiv1.GetClustersOptions) ([]kubernetesserviceapiv1.Cluster, *core.DetailedResponse, error)
+	GetClustersWithContextFunc                     func(ctx context.Context, getClustersOptions *kubernetesserviceapiv1.GetClustersOptions) ([]kubernetesserviceapiv1.Cluster, *core.DetailedResponse, error)
+	CreateClusterFunc                              func(createClusterOptions *kubernetesserviceapiv1.CreateClusterOptions) (*kubernetesserviceapiv1.ClusterCreateResponse, *core.DetailedResponse, error)
+	CreateClusterWithContextFunc                   func(ctx context.Context, createClusterOptions *kubernetesserviceapiv1.CreateClusterOptions) (*kubernetesserviceapiv1.ClusterCreateResponse, *core.DetailedResponse, error)
+	GetCluster1Func                                func(getCluster1Options *kubernetesserviceapiv1.GetCluster1Options) (*kubernetesserviceapiv1.Cluster, *core.DetailedResponse, error)
+	GetCluster1WithContextFunc                     func(ctx context.Context, getCluster1Options *kubernetesserviceapiv1.GetCluster1Options) (*kubernetesserviceapiv1.Cluster, *core.DetailedResponse, error)
+	UpdateClusterFunc                              func(updateClusterOptions *kubernetesserviceapiv1.UpdateClusterOptions) (*core.DetailedResponse, error)
+	UpdateClusterWithContextFunc                   func(ctx context.Context, updateClusterOptions *kubernetesserviceapiv1.UpdateClusterOptions) (*core.DetailedResponse, error)
+	RemoveClusterFunc                              func(removeClusterOptions *kubernetesserviceapiv1.RemoveClusterOptions) (*core.DetailedResponse, error)
+	RemoveClusterWithContextFunc                   func(ctx context.Context, removeClusterOptions *kubernetesserviceapiv1.RemoveClusterOptions) (*core.DetailedResponse, error)
+	GetClusterAddonsFunc                           func(getClusterAddonsOptions *kubernetesserviceapiv1.GetClusterAddonsOptions) ([]kubernetesserviceapiv1.ClusterAddon, *core.DetailedResponse, error)
+	GetClusterAddonsWithContextFunc                func(ctx context.Context, getClusterAddonsOptions *kubernetesserviceapiv1.GetClusterAddonsOptions) ([]kubernetesserviceapiv1.ClusterAddon, *core.DetailedResponse, error)
+	ManageClusterAddonsFunc                        func(manageClusterAddonsOptions *kubernetesserviceapiv1.ManageClusterAddonsOptions) (*kubernetesserviceapiv1.AddonResponse, *core.DetailedResponse, error)
+	ManageClusterAddonsWithContextFunc             func(ctx context.Context, manageClusterAddonsOptions *kubernetesserviceapiv1.ManageClusterAddonsOptions) (*kubernetesserviceapiv1.AddonResponse, *core.DetailedResponse, error)
+	GetClusterConfigFunc                           func(getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions) (*core.DetailedResponse, error)
+	GetClusterConfigWithContextFunc                func(ctx context.Context, getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions) (*core.DetailedResponse, error)
+	CreateKMSConfigFunc                            func(createKMSConfigOptions *kubernetesserviceapiv1.CreateKMSConfigOptions) (*core.DetailedResponse, error)
+	CreateKMSConfigWithContextFunc                 func(ctx context.Context, createKMSConfigOptions *kubernetesserviceapiv1.CreateKMSConfigOptions) (*core.DetailedResponse, error)
+	HandleMasterAPIServerFunc                      func(handleMasterAPIServerOptions *kubernetesserviceapiv1.HandleMasterAPIServerOptions) (*core.DetailedResponse, error)
+	HandleMasterAPIServerWithContextFunc           func(ctx context.Context, handleMasterAPIServerOptions *kubernetesserviceapiv1.HandleMasterAPIServerOptions) (*core.DetailedResponse, error)
+	ListServicesForAllNamespacesFunc               func(listServicesForAllNamespacesOptions *kubernetesserviceapiv1.ListServicesForAllNamespacesOptions) ([]kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error)
+	ListServicesForAllNamespacesWithContextFunc    func(ctx context.Context, listServicesForAllNamespacesOptions *kubernetesserviceapiv1.ListServicesForAllNamespacesOptions) ([]kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error)
+	BindServiceToNamespaceFunc                     func(bindServiceToNamespaceOptions *kubernetesserviceapiv1.BindServiceToNamespaceOptions) (*kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error)
+	BindServiceToNamespaceWithContextFunc          func(ctx context.Context, bindServiceToNamespaceOptions *kubernetesserviceapiv1.BindServiceToNamespaceOptions) (*kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error)
+	ListServicesInNamespaceFunc                    func(listServicesInNamespaceOptions *kubernetesserviceapiv1.ListServicesInNamespaceOptions) ([]kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error)
+	ListServicesInNamespaceWithContextFunc         func(ctx context.Context, listServicesInNamespaceOptions *kubernetesserviceapiv1.ListServicesInNamespaceOptions) ([]kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error)
+	UnbindServiceFromNamespaceFunc                 func(unbindServiceFromNamespaceOptions *kubernetesserviceapiv1.UnbindServiceFromNamespaceOptions) (*core.DetailedResponse, error)
+	UnbindServiceFromNamespaceWithContextFunc      func(ctx context.Context, unbindServiceFromNamespaceOptions *kubernetesserviceapiv1.UnbindServiceFromNamespaceOptions) (*core.DetailedResponse, error)
+	GetClusterSubnetsFunc                          func(getClusterSubnetsOptions *kubernetesserviceapiv1.GetClusterSubnetsOptions) ([]kubernetesserviceapiv1.VlanConfigField, *core.DetailedResponse, error)
+	GetClusterSubnetsWithContextFunc               func(ctx context.Context, getClusterSubnetsOptions *kubernetesserviceapiv1.GetClusterSubnetsOptions) ([]kubernetesserviceapiv1.VlanConfigField, *core.DetailedResponse, error)
+	AddClusterSubnetFunc                           func(addClusterSubnetOptions *kubernetesserviceapiv1.AddClusterSubnetOptions) (*core.DetailedResponse, error)
+	AddClusterSubnetWithContextFunc                func(ctx context.Context, addClusterSubnetOptions *kubernetesserviceapiv1.AddClusterSubnetOptions) (*core.DetailedResponse, error)
+	DetachClusterSubnetFunc                        func(detachClusterSubnetOptions *kubernetesserviceapiv1.DetachClusterSubnetOptions) (*core.DetailedResponse, error)
+	DetachClusterSubnetWithContextFunc             func(ctx context.Context, detachClusterSubnetOptions *kubernetesserviceapiv1.DetachClusterSubnetOptions) (*core.DetailedResponse, error)
+	GetClusterUserSubnetFunc                       func(getClusterUserSubnetOptions *kubernetesserviceapiv1.GetClusterUserSubnetOptions) ([]kubernetesserviceapiv1.VlanConfigField, *core.DetailedResponse, error)
+	GetClusterUserSubnetWithContextFunc            func(ctx context.Context, getClusterUserSubnetOptions *kubernetesserviceapiv1.GetClusterUserSubnetOptions) ([]kubernetesserviceapiv1.VlanConfigField, *core.DetailedResponse, error)
+	AddClusterUserSubnetFunc                       func(addClusterUserSubnetOptions *kubernetesserviceapiv1.AddClusterUserSubnetOptions) (*core.DetailedResponse, error)
+	AddClusterUserSubnetWithContextFunc            func(ctx context.Context, addClusterUserSubnetOptions *kubernetesserviceapiv1.AddClusterUserSubnetOptions) (*core.DetailedResponse, error)
+	RemoveClusterUserSubnetFunc                    func(removeClusterUserSubnetOptions *kubernetesserviceapiv1.RemoveClusterUserSubnetOptions) (*core.DetailedResponse, error)
+	RemoveClusterUserSubnetWithContextFunc         func(ctx context.Context, removeClusterUserSubnetOptions *kubernetesserviceapiv1.RemoveClusterUserSubnetOptions) (*core.DetailedResponse, error)
+	CreateClusterSubnetFunc                        func(createClusterSubnetOptions *kubernetesserviceapiv1.CreateClusterSubnetOptions) (*core.DetailedResponse, error)
+	CreateClusterSubnetWithContextFunc             func(ctx context.Context, createClusterSubnetOptions *kubernetesserviceapiv1.CreateClusterSubnetOptions) (*core.DetailedResponse, error)
+	GetClusterWebhooksFunc                         func(getClusterWebhooksOptions *kubernetesserviceapiv1.GetClusterWebhooksOptions) ([]kubernetesserviceapiv1.ClusterWebhook, *core.DetailedResponse, error)
+	GetClusterWebhooksWithContextFunc              func(ctx context.Context, getClusterWebhooksOptions *kubernetesserviceapiv1.GetClusterWebhooksOptions) ([]kubernetesserviceapiv1.ClusterWebhook, *core.DetailedResponse, error)
+	AddClusterWebhooksFunc                         func(addClusterWebhooksOptions *kubernetesserviceapiv1.AddClusterWebhooksOptions) (*core.DetailedResponse, error)
+	AddClusterWebhooksWithContextFunc              func(ctx context.Context, addClusterWebhooksOptions *kubernetesserviceapiv1.AddClusterWebhooksOptions) (*core.DetailedResponse, error)
+	GetWorkerPoolsFunc                             func(getWorkerPoolsOptions *kubernetesserviceapiv1.GetWorkerPoolsOptions) ([]kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error)
+	GetWorkerPoolsWithContextFunc                  func(ctx context.Context, getWorkerPoolsOptions *kubernetesserviceapiv1.GetWorkerPoolsOptions) ([]kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error)
+	CreateWorkerPoolFunc                           func(createWorkerPoolOptions *kubernetesserviceapiv1.CreateWorkerPoolOptions) (*kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error)
+	CreateWorkerPoolWithContextFunc                func(ctx context.Context, createWorkerPoolOptions *kubernetesserviceapiv1.CreateWorkerPoolOptions) (*kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error)
+	GetWorkerPool1Func                             func(getWorkerPool1Options *kubernetesserviceapiv1.GetWorkerPool1Options) (*kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error)
+	GetWorkerPool1WithContextFunc                  func(ctx context.Context, getWorkerPool1Options *kubernetesserviceapiv1.GetWorkerPool1Options) (*kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error)
+	RemoveWorkerPoolFunc                           func(removeWorkerPoolOptions *kubernetesserviceapiv1.RemoveWorkerPoolOptions) (*core.DetailedResponse, error)
+	RemoveWorkerPoolWithContextFunc                func(ctx context.Context, removeWorkerPoolOptions *kubernetesserviceapiv1.RemoveWorkerPoolOptions) (*core.DetailedResponse, error)
+	PatchWorkerPoolFunc                            func(patchWorkerPoolOptions *kubernetesserviceapiv1.PatchWorkerPoolOptions) (*core.DetailedResponse, error)
+	PatchWorkerPoolWithContextFunc                 func(ctx context.Context, patchWorkerPoolOptions *kubernetesserviceapiv1.PatchWorkerPoolOptions) (*core.DetailedResponse, error)
+	AddWorkerPoolZoneFunc                          func(addWorkerPoolZoneOptions *kubernetesserviceapiv1.AddWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+	AddWorkerPoolZoneWithContextFunc               func(ctx context.Context, addWorkerPoolZoneOptions *kubernetesserviceapiv1.AddWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+	RemoveWorkerPoolZoneFunc                       func(removeWorkerPoolZoneOptions *kubernetesserviceapiv1.RemoveWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+	RemoveWorkerPoolZoneWithContextFunc            func(ctx context.Context, removeWorkerPoolZoneOptions *kubernetesserviceapiv1.RemoveWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+	AddWorkerPoolZoneNetworkFunc                   func(addWorkerPoolZoneNetworkOptions *kubernetesserviceapiv1.AddWorkerPoolZoneNetworkOptions) (*core.DetailedResponse, error)
+	AddWorkerPoolZoneNetworkWithContextFunc        func(ctx context.Context, addWorkerPoolZoneNetworkOptions *kubernetesserviceapiv1.AddWorkerPoolZoneNetworkOptions) (*core.DetailedResponse, error)
+	GetClusterWorkersFunc                          func(getClusterWorkersOptions *kubernetesserviceapiv1.GetClusterWorkersOptions) ([]kubernetesserviceapiv1.Worker, *core.DetailedResponse, error)
+	GetClusterWorkersWithContextFunc               func(ctx context.Context, getClusterWorkersOptions *kubernetesserviceapiv1.GetClusterWorkersOptions) ([]kubernetesserviceapiv1.Worker, *core.DetailedResponse, error)
+	AddClusterWorkersFunc                          func(addClusterWorkersOptions *kubernetesserviceapiv1.AddClusterWorkersOptions) (*core.DetailedResponse, error)
+	AddClusterWorkersWithContextFunc               func(ctx context.Context, addClusterWorkersOptions *kubernetesserviceapiv1.AddClusterWorkersOptions) (*core.DetailedResponse, error)
+	GetWorkersFunc                                 func(getWorkersOptions *kubernetesserviceapiv1.GetWorkersOptions) (*kubernetesserviceapiv1.Worker, *core.DetailedResponse, error)
+	GetWorkersWithContextFunc                      func(ctx context.Context, getWorkersOptions *kubernetesserviceapiv1.GetWorkersOptions) (*kubernetesserviceapiv1.Worker, *core.DetailedResponse, error)
+	UpdateClusterWorkerFunc                        func(updateClusterWorkerOptions *kubernetesserviceapiv1.UpdateClusterWorkerOptions) (*core.DetailedResponse, error)
+	UpdateClusterWorkerWithContextFunc             func(ctx context.Context, updateClusterWorkerOptions *kubernetesserviceapiv1.UpdateClusterWorkerOptions) (*core.DetailedResponse, error)
+	RemoveClusterWorkerFunc                        func(removeClusterWorkerOptions *kubernetesserviceapiv1.RemoveClusterWorkerOptions) (*core.DetailedResponse, error)
+	RemoveClusterWorkerWithContextFunc             func(ctx context.Context, removeClusterWorkerOptions *kubernetesserviceapiv1.RemoveClusterWorkerOptions) (*core.DetailedResponse, error)
+	GetUserConfigFunc                              func(getUserConfigOptions *kubernetesserviceapiv1.GetUserConfigOptions) (*kubernetesserviceapiv1.UserConfig, *core.DetailedResponse, error)
+	GetUserConfigWithContextFunc                   func(ctx context.Context, getUserConfigOptions *kubernetesserviceapiv1.GetUserConfigOptions) (*kubernetesserviceapiv1.UserConfig, *core.DetailedResponse, error)
+	V2DisableImageSecurityFunc                     func(v2DisableImageSecurityOptions *kubernetesserviceapiv1.V2DisableImageSecurityOptions) (*core.DetailedResponse, error)
+	V2DisableImageSecurityWithContextFunc          func(ctx context.Context, v2DisableImageSecurityOptions *kubernetesserviceapiv1.V2DisableImageSecurityOptions) (*core.DetailedResponse, error)
+	V2EnableImageSecurityFunc                      func(v2EnableImageSecurityOptions *kubernetesserviceapiv1.V2EnableImageSecurityOptions) (*core.DetailedResponse, error)
+	V2EnableImageSecurityWithContextFunc           func(ctx context.Context, v2EnableImageSecurityOptions *kubernetesserviceapiv1.V2EnableImageSecurityOptions) (*core.DetailedResponse, error)
+	FetchFilterConfigsFunc                         func(fetchFilterConfigsOptions *kubernetesserviceapiv1.FetchFilterConfigsOptions) ([]kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error)
+	FetchFilterConfigsWithContextFunc              func(ctx context.Context, fetchFilterConfigsOptions *kubernetesserviceapiv1.FetchFilterConfigsOptions) ([]kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error)
+	CreateFilterConfigFunc                         func(createFilterConfigOptions *kubernetesserviceapiv1.CreateFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error)
+	CreateFilterConfigWithContextFunc              func(ctx context.Context, createFilterConfigOptions *kubernetesserviceapiv1.CreateFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error)
+	DeleteFilterConfigsFunc                        func(deleteFilterConfigsOptions *kubernetesserviceapiv1.DeleteFilterConfigsOptions) (*core.DetailedResponse, error)
+	DeleteFilterConfigsWithContextFunc             func(ctx context.Context, deleteFilterConfigsOptions *kubernetesserviceapiv1.DeleteFilterConfigsOptions) (*core.DetailedResponse, error)
+	FetchFilterConfigFunc                          func(fetchFilterConfigOptions *kubernetesserviceapiv1.FetchFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error)
+	FetchFilterConfigWithContextFunc               func(ctx context.Context, fetchFilterConfigOptions *kubernetesserviceapiv1.FetchFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error)
+	UpdateFilterConfigFunc                         func(updateFilterConfigOptions *kubernetesserviceapiv1.UpdateFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error)
+	UpdateFilterConfigWithContextFunc              func(ctx context.Context, updateFilterConfigOptions *kubernetesserviceapiv1.UpdateFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error)
+	DeleteFilterConfigFunc                         func(deleteFilterConfigOptions *kubernetesserviceapiv1.DeleteFilterConfigOptions) (*core.DetailedResponse, error)
+	DeleteFilterConfigWithContextFunc              func(ctx context.Context, deleteFilterConfigOptions *kubernetesserviceapiv1.DeleteFilterConfigOptions) (*core.DetailedResponse, error)
+	GetMasterLogCollectionStatusFunc               func(getMasterLogCollectionStatusOptions *kubernetesserviceapiv1.GetMasterLogCollectionStatusOptions) ([]kubernetesserviceapiv1.MasterLogCollectionStatusResponseBody, *core.DetailedResponse, error)
+	GetMasterLogCollectionStatusWithContextFunc    func(ctx context.Context, getMasterLogCollectionStatusOptions *kubernetesserviceapiv1.GetMasterLogCollectionStatusOptions) ([]kubernetesserviceapiv1.MasterLogCollectionStatusResponseBody, *core.DetailedResponse, error)
+	CreateMasterLogCollectionFunc                  func(createMasterLogCollectionOptions *kubernetesserviceapiv1.CreateMasterLogCollectionOptions) (*core.DetailedResponse, error)
+	CreateMasterLogCollectionWithContextFunc       func(ctx context.Context, createMasterLogCollectionOptions *kubernetesserviceapiv1.CreateMasterLogCollectionOptions) (*core.DetailedResponse, error)
+	GetClusterKeyOwnerFunc                         func(getClusterKeyOwnerOptions *kubernetesserviceapiv1.GetClusterKeyOwnerOptions) (*kubernetesserviceapiv1.ClusterKeyOwnerInfo, *core.DetailedResponse, error)
+	GetClusterKeyOwnerWithContextFunc              func(ctx context.Context, getClusterKeyOwnerOptions *kubernetesserviceapiv1.GetClusterKeyOwnerOptions) (*kubernetesserviceapiv1.ClusterKeyOwnerInfo, *core.DetailedResponse, error)
+	GetDefaultLoggingEndpointFunc                  func(getDefaultLoggingEndpointOptions *kubernetesserviceapiv1.GetDefaultLoggingEndpointOptions) (*kubernetesserviceapiv1.DefaultLoggingEndpointResponse, *core.DetailedResponse, error)
+	GetDefaultLoggingEndpointWithContextFunc       func(ctx context.Context, getDefaultLoggingEndpointOptions *kubernetesserviceapiv1.GetDefaultLoggingEndpointOptions) (*kubernetesserviceapiv1.DefaultLoggingEndpointResponse, *core.DetailedResponse, error)
+	FetchLoggingConfigsFunc                        func(fetchLoggingConfigsOptions *kubernetesserviceapiv1.FetchLoggingConfigsOptions) ([]kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error)
+	FetchLoggingConfigsWithContextFunc             func(ctx context.Context, fetchLoggingConfigsOptions *kubernetesserviceapiv1.FetchLoggingConfigsOptions) ([]kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error)
+	DeleteLoggingConfigsFunc                       func(deleteLoggingConfigsOptions *kubernetesserviceapiv1.DeleteLoggingConfigsOptions) (*core.DetailedResponse, error)
+	DeleteLoggingConfigsWithContextFunc            func(ctx context.Context, deleteLoggingConfigsOptions *kubernetesserviceapiv1.DeleteLoggingConfigsOptions) (*core.DetailedResponse, error)
+	FetchLoggingConfigsForSourceFunc               func(fetchLoggingConfigsForSourceOptions *kubernetesserviceapiv1.FetchLoggingConfigsForSourceOptions) ([]kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error)
+	FetchLoggingConfigsForSourceWithContextFunc    func(ctx context.Context, fetchLoggingConfigsForSourceOptions *kubernetesserviceapiv1.FetchLoggingConfigsForSourceOptions) ([]kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error)
+	CreateLoggingConfigFunc                        func(createLoggingConfigOptions *kubernetesserviceapiv1.CreateLoggingConfigOptions) (*kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error)
+	CreateLoggingConfigWithContextFunc             func(ctx context.Context, createLoggingConfigOptions *kubernetesserviceapiv1.CreateLoggingConfigOptions) (*kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error)
+	UpdateLoggingConfigFunc                        func(updateLoggingConfigOptions *kubernetesserviceapiv1.UpdateLoggingConfigOptions) (*kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error)
+	UpdateLoggingConfigWithContextFunc             func(ctx context.Context, updateLoggingConfigOptions *kubernetesserviceapiv1.UpdateLoggingConfigOptions) (*kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error)
+	DeleteLoggingConfigFunc                        func(deleteLoggingConfigOptions *kubernetesserviceapiv1.DeleteLoggingConfigOptions) (*core.DetailedResponse, error)
+	DeleteLoggingConfigWithContextFunc             func(ctx context.Context, deleteLoggingConfigOptions *kubernetesserviceapiv1.DeleteLoggingConfigOptions) (*core.DetailedResponse, error)
+	RefreshLoggingConfigFunc                       func(refreshLoggingConfigOptions *kubernetesserviceapiv1.RefreshLoggingConfigOptions) (*core.DetailedResponse, error)
+	RefreshLoggingConfigWithContextFunc            func(ctx context.Context, refreshLoggingConfigOptions *kubernetesserviceapiv1.RefreshLoggingConfigOptions) (*core.DetailedResponse, error)
+	GetFluentdUpdatePolicyFunc                     func(getFluentdUpdatePolicyOptions *kubernetesserviceapiv1.GetFluentdUpdatePolicyOptions) (*kubernetesserviceapiv1.UpdatePolicy, *core.DetailedResponse, error)
+	GetFluentdUpdatePolicyWithContextFunc          func(ctx context.Context, getFluentdUpdatePolicyOptions *kubernetesserviceapiv1.GetFluentdUpdatePolicyOptions) (*kubernetesserviceapiv1.UpdatePolicy, *core.DetailedResponse, error)
+	ChangeFluentdUpdatePolicyFunc                  func(changeFluentdUpdatePolicyOptions *kubernetesserviceapiv1.ChangeFluentdUpdatePolicyOptions) (*core.DetailedResponse, error)
+	ChangeFluentdUpdatePolicyWithContextFunc       func(ctx context.Context, changeFluentdUpdatePolicyOptions *kubernetesserviceapiv1.ChangeFluentdUpdatePolicyOptions) (*core.DetailedResponse, error)
+	CreateLoggingInstanceFunc                      func(createLoggingInstanceOptions *kubernetesserviceapiv1.CreateLoggingInstanceOptions) (*kubernetesserviceapiv1.ConfigResponse, *core.DetailedResponse, error)
+	CreateLoggingInstanceWithContextFunc           func(ctx context.Context, createLoggingInstanceOptions *kubernetesserviceapiv1.CreateLoggingInstanceOptions) (*kubernetesserviceapiv1.ConfigResponse, *core.DetailedResponse, error)
+	DiscoverLoggingInstanceFunc                    func(discoverLoggingInstanceOptions *kubernetesserviceapiv1.DiscoverLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	DiscoverLoggingInstanceWithContextFunc         func(ctx context.Context, discoverLoggingInstanceOptions *kubernetesserviceapiv1.DiscoverLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	GetLoggingInstanceFunc                         func(getLoggingInstanceOptions *kubernetesserviceapiv1.GetLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	GetLoggingInstanceWithContextFunc              func(ctx context.Context, getLoggingInstanceOptions *kubernetesserviceapiv1.GetLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	GetLoggingInstancesFunc                        func(getLoggingInstancesOptions *kubernetesserviceapiv1.GetLoggingInstancesOptions) ([]kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	GetLoggingInstancesWithContextFunc             func(ctx context.Context, getLoggingInstancesOptions *kubernetesserviceapiv1.GetLoggingInstancesOptions) ([]kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	ModifyLoggingInstanceFunc                      func(modifyLoggingInstanceOptions *kubernetesserviceapiv1.ModifyLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	ModifyLoggingInstanceWithContextFunc           func(ctx context.Context, modifyLoggingInstanceOptions *kubernetesserviceapiv1.ModifyLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	RemoveLoggingInstanceFunc                      func(removeLoggingInstanceOptions *kubernetesserviceapiv1.RemoveLoggingInstanceOptions) (*core.DetailedResponse, error)
+	RemoveLoggingInstanceWithContextFunc           func(ctx context.Context, removeLoggingInstanceOptions *kubernetesserviceapiv1.RemoveLoggingInstanceOptions) (*core.DetailedResponse, error)
+	CreateMonitoringInstanceFunc                   func(createMonitoringInstanceOptions *kubernetesserviceapiv1.CreateMonitoringInstanceOptions) (*kubernetesserviceapiv1.ConfigResponse, *core.DetailedResponse, error)
+	CreateMonitoringInstanceWithContextFunc        func(ctx context.Context, createMonitoringInstanceOptions *kubernetesserviceapiv1.CreateMonitoringInstanceOptions) (*kubernetesserviceapiv1.ConfigResponse, *core.DetailedResponse, error)
+	DiscoverMonitoringInstanceFunc                 func(discoverMonitoringInstanceOptions *kubernetesserviceapiv1.DiscoverMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	DiscoverMonitoringInstanceWithContextFunc      func(ctx context.Context, discoverMonitoringInstanceOptions *kubernetesserviceapiv1.DiscoverMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	GetMonitoringInstanceFunc                      func(getMonitoringInstanceOptions *kubernetesserviceapiv1.GetMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	GetMonitoringInstanceWithContextFunc           func(ctx context.Context, getMonitoringInstanceOptions *kubernetesserviceapiv1.GetMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	GetMonitoringInstancesFunc                     func(getMonitoringInstancesOptions *kubernetesserviceapiv1.GetMonitoringInstancesOptions) ([]kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	GetMonitoringInstancesWithContextFunc          func(ctx context.Context, getMonitoringInstancesOptions *kubernetesserviceapiv1.GetMonitoringInstancesOptions) ([]kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	ModifyMonitoringInstanceFunc                   func(modifyMonitoringInstanceOptions *kubernetesserviceapiv1.ModifyMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	ModifyMonitoringInstanceWithContextFunc        func(ctx context.Context, modifyMonitoringInstanceOptions *kubernetesserviceapiv1.ModifyMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error)
+	RemoveMonitoringInstanceFunc                   func(removeMonitoringInstanceOptions *kubernetesserviceapiv1.RemoveMonitoringInstanceOptions) (*core.DetailedResponse, error)
+	RemoveMonitoringInstanceWithContextFunc        func(ctx context.Context, removeMonitoringInstanceOptions *kubernetesserviceapiv1.RemoveMonitoringInstanceOptions) (*core.DetailedResponse, error)
+	UpdateDNSWithIPFunc                            func(updateDNSWithIPOptions *kubernetesserviceapiv1.UpdateDNSWithIPOptions) (*core.DetailedResponse, error)
+	UpdateDNSWithIPWithContextFunc                 func(ctx context.Context, updateDNSWithIPOptions *kubernetesserviceapiv1.UpdateDNSWithIPOptions) (*core.DetailedResponse, error)
+	UnregisterDNSWithIPFunc                        func(unregisterDNSWithIPOptions *kubernetesserviceapiv1.UnregisterDNSWithIPOptions) (*core.DetailedResponse, error)
+	UnregisterDNSWithIPWithContextFunc             func(ctx context.Context, unregisterDNSWithIPOptions *kubernetesserviceapiv1.UnregisterDNSWithIPOptions) (*core.DetailedResponse, error)
+	ListNLBIPsForSubdomainFunc                     func(listNLBIPsForSubdomainOptions *kubernetesserviceapiv1.ListNLBIPsForSubdomainOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error)
+	ListNLBIPsForSubdomainWithContextFunc          func(ctx context.Context, listNLBIPsForSubdomainOptions *kubernetesserviceapiv1.ListNLBIPsForSubdomainOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error)
+	RegisterDNSWithIPFunc                          func(registerDNSWithIPOptions *kubernetesserviceapiv1.RegisterDNSWithIPOptions) (*kubernetesserviceapiv1.NlbConfig, *core.DetailedResponse, error)
+	RegisterDNSWithIPWithContextFunc               func(ctx context.Context, registerDNSWithIPOptions *kubernetesserviceapiv1.RegisterDNSWithIPOptions) (*kubernetesserviceapiv1.NlbConfig, *core.DetailedResponse, error)
+	UpdateNlbDNSHealthMonitorFunc                  func(updateNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.UpdateNlbDNSHealthMonitorOptions) (*core.DetailedResponse, error)
+	UpdateNlbDNSHealthMonitorWithContextFunc       func(ctx context.Context, updateNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.UpdateNlbDNSHealthMonitorOptions) (*core.DetailedResponse, error)
+	AddNlbDNSHealthMonitorFunc                     func(addNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.AddNlbDNSHealthMonitorOptions) (*kubernetesserviceapiv1.NlbHealthConfig, *core.DetailedResponse, error)
+	AddNlbDNSHealthMonitorWithContextFunc          func(ctx context.Context, addNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.AddNlbDNSHealthMonitorOptions) (*kubernetesserviceapiv1.NlbHealthConfig, *core.DetailedResponse, error)
+	GetNlbDNSHealthMonitorFunc                     func(getNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.GetNlbDNSHealthMonitorOptions) (*kubernetesserviceapiv1.NlbHealthConfig, *core.DetailedResponse, error)
+	GetNlbDNSHealthMonitorWithContextFunc          func(ctx context.Context, getNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.GetNlbDNSHealthMonitorOptions) (*kubernetesserviceapiv1.NlbHealthConfig, *core.DetailedResponse, error)
+	ListNlbDNSHealthMonitorsFunc                   func(listNlbDNSHealthMonitorsOptions *kubernetesserviceapiv1.ListNlbDNSHealthMonitorsOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error)
+	ListNlbDNSHealthMonitorsWithContextFunc        func(ctx context.Context, listNlbDNSHealthMonitorsOptions *kubernetesserviceapiv1.ListNlbDNSHealthMonitorsOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error)
+	ListNlbDNSHealthMonitorStatusFunc              func(listNlbDNSHealthMonitorStatusOptions *kubernetesserviceapiv1.ListNlbDNSHealthMonitorStatusOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error)
+	ListNlbDNSHealthMonitorStatusWithContextFunc   func(ctx context.Context, listNlbDNSHealthMonitorStatusOptions *kubernetesserviceapiv1.ListNlbDNSHealthMonitorStatusOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error)
+	GetDatacenterVLANsFunc                         func(getDatacenterVLANsOptions *kubernetesserviceapiv1.GetDatacenterVLANsOptions) ([]kubernetesserviceapiv1.VLAN, *core.DetailedResponse, error)
+	GetDatacenterVLANsWithContextFunc              func(ctx context.Context, getDatacenterVLANsOptions *kubernetesserviceapiv1.GetDatacenterVLANsOptions) ([]kubernetesserviceapiv1.VLAN, *core.DetailedResponse, error)
+	ListSubnetsFunc                                func(listSubnetsOptions *kubernetesserviceapiv1.ListSubnetsOptions) ([]kubernetesserviceapiv1.Subnet, *core.DetailedResponse, error)
+	ListSubnetsWithContextFunc                     func(ctx context.Context, listSubnetsOptions *kubernetesserviceapiv1.ListSubnetsOptions) ([]kubernetesserviceapiv1.Subnet, *core.DetailedResponse, error)
+	CreateSatelliteClusterFunc                     func(createSatelliteClusterOptions *kubernetesserviceapiv1.CreateSatelliteClusterOptions) (*kubernetesserviceapiv1.MultishiftCreateClusterResponse, *core.DetailedResponse, error)
+	CreateSatelliteClusterWithContextFunc          func(ctx context.Context, createSatelliteClusterOptions *kubernetesserviceapiv1.CreateSatelliteClusterOptions) (*kubernetesserviceapiv1.MultishiftCreateClusterResponse, *core.DetailedResponse, error)
+	CreateSatelliteWorkerPoolFunc                  func(createSatelliteWorkerPoolOptions *kubernetesserviceapiv1.CreateSatelliteWorkerPoolOptions) (*kubernetesserviceapiv1.CreateWorkerpoolResponse, *core.DetailedResponse, error)
+	CreateSatelliteWorkerPoolWithContextFunc       func(ctx context.Context, createSatelliteWorkerPoolOptions *kubernetesserviceapiv1.CreateSatelliteWorkerPoolOptions) (*kubernetesserviceapiv1.CreateWorkerpoolResponse, *core.DetailedResponse, error)
+	CreateSatelliteWorkerPoolZoneFunc              func(createSatelliteWorkerPoolZoneOptions *kubernetesserviceapiv1.CreateSatelliteWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+	CreateSatelliteWorkerPoolZoneWithContextFunc   func(ctx context.Context, createSatelliteWorkerPoolZoneOptions *kubernetesserviceapiv1.CreateSatelliteWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+	GetSatelliteClustersFunc                       func(getSatelliteClustersOptions *kubernetesserviceapiv1.GetSatelliteClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error)
+	GetSatelliteClustersWithContextFunc            func(ctx context.Context, getSatelliteClustersOptions *kubernetesserviceapiv1.GetSatelliteClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error)
+	CreateSatelliteAssignmentFunc                  func(createSatelliteAssignmentOptions *kubernetesserviceapiv1.CreateSatelliteAssignmentOptions) (*kubernetesserviceapiv1.MultishiftCreateAssignmentResponse, *core.DetailedResponse, error)
+	CreateSatelliteAssignmentWithContextFunc       func(ctx context.Context, createSatelliteAssignmentOptions *kubernetesserviceapiv1.CreateSatelliteAssignmentOptions) (*kubernetesserviceapiv1.MultishiftCreateAssignmentResponse, *core.DetailedResponse, error)
+	AttachSatelliteHostFunc                        func(attachSatelliteHostOptions *kubernetesserviceapiv1.AttachSatelliteHostOptions) ([]byte, error)
+	AttachSatelliteHostWithContextFunc             func(ctx context.Context, attachSatelliteHostOptions *kubernetesserviceapiv1.AttachSatelliteHostOptions) ([]byte, error)
+	GetSatelliteHostsFunc                          func(getSatelliteHostsOptions *kubernetesserviceapiv1.GetSatelliteHostsOptions) ([]kubernetesserviceapiv1.MultishiftQueueNode, *core.DetailedResponse, error)
+	GetSatelliteHostsWithContextFunc               func(ctx context.Context, getSatelliteHostsOptions *kubernetesserviceapiv1.GetSatelliteHostsOptions) ([]kubernetesserviceapiv1.MultishiftQueueNode, *core.DetailedResponse, error)
+	RemoveSatelliteHostFunc                        func(removeSatelliteHostOptions *kubernetesserviceapiv1.RemoveSatelliteHostOptions) (*core.DetailedResponse, error)
+	RemoveSatelliteHostWithContextFunc             func(ctx context.Context, removeSatelliteHostOptions *kubernetesserviceapiv1.RemoveSatelliteHostOptions) (*core.DetailedResponse, error)
+	UpdateSatelliteHostFunc                        func(updateSatelliteHostOptions *kubernetesserviceapiv1.UpdateSatelliteHostOptions) (*core.DetailedResponse, error)
+	UpdateSatelliteHostWithContextFunc             func(ctx context.Context, updateSatelliteHostOptions *kubernetesserviceapiv1.UpdateSatelliteHostOptions) (*core.DetailedResponse, error)
+	CreateSatelliteLocationFunc                    func(createSatelliteLocationOptions *kubernetesserviceapiv1.CreateSatelliteLocationOptions) (*kubernetesserviceapiv1.MultishiftCreateControllerResponse, *core.DetailedResponse, error)
+	CreateSatelliteLocationWithContextFunc         func(ctx context.Context, createSatelliteLocationOptions *kubernetesserviceapiv1.CreateSatelliteLocationOptions) (*kubernetesserviceapiv1.MultishiftCreateControllerResponse, *core.DetailedResponse, error)
+	GetSatelliteLocationFunc                       func(getSatelliteLocationOptions *kubernetesserviceapiv1.GetSatelliteLocationOptions) (*kubernetesserviceapiv1.MultishiftGetController, *core.DetailedResponse, error)
+	GetSatelliteLocationWithContextFunc            func(ctx context.Context, getSatelliteLocationOptions *kubernetesserviceapiv1.GetSatelliteLocationOptions) (*kubernetesserviceapiv1.MultishiftGetController, *core.DetailedResponse, error)
+	GetSatelliteLocationsFunc                      func(getSatelliteLocationsOptions *kubernetesserviceapiv1.GetSatelliteLocationsOptions) ([]kubernetesserviceapiv1.MultishiftController, *core.DetailedResponse, error)
+	GetSatelliteLocationsWithContextFunc           func(ctx context.Context, getSatelliteLocationsOptions *kubernetesserviceapiv1.GetSatelliteLocationsOptions) ([]kubernetesserviceapiv1.MultishiftController, *core.DetailedResponse, error)
+	RemoveSatelliteLocationFunc                    func(removeSatelliteLocationOptions *kubernetesserviceapiv1.RemoveSatelliteLocationOptions) (*core.DetailedResponse, error)
+	RemoveSatelliteLocationWithContextFunc         func(ctx context.Context, removeSatelliteLocationOptions *kubernetesserviceapiv1.RemoveSatelliteLocationOptions) (*core.DetailedResponse, error)
+	CreateSatelliteClusterRemoteFunc               func(createSatelliteClusterRemoteOptions *kubernetesserviceapiv1.CreateSatelliteClusterRemoteOptions) (*kubernetesserviceapiv1.MultishiftCreateClusterResponse, *core.DetailedResponse, error)
+	CreateSatelliteClusterRemoteWithContextFunc    func(ctx context.Context, createSatelliteClusterRemoteOptions *kubernetesserviceapiv1.CreateSatelliteClusterRemoteOptions) (*kubernetesserviceapiv1.MultishiftCreateClusterResponse, *core.DetailedResponse, error)
+	GetSatelliteServiceClustersFunc                func(getSatelliteServiceClustersOptions *kubernetesserviceapiv1.GetSatelliteServiceClustersOptions) ([]kubernetesserviceapiv1.MultishiftClustersResponse, *core.DetailedResponse, error)
+	GetSatelliteServiceClustersWithContextFunc     func(ctx context.Context, getSatelliteServiceClustersOptions *kubernetesserviceapiv1.GetSatelliteServiceClustersOptions) ([]kubernetesserviceapiv1.MultishiftClustersResponse, *core.DetailedResponse, error)
+	CreateAttachmentFunc                           func(createAttachmentOptions *kubernetesserviceapiv1.CreateAttachmentOptions) (*kubernetesserviceapiv1.VolumeAttachment, *core.DetailedResponse, error)
+	CreateAttachmentWithContextFunc                func(ctx context.Context, createAttachmentOptions *kubernetesserviceapiv1.CreateAttachmentOptions) (*kubernetesserviceapiv1.VolumeAttachment, *core.DetailedResponse, error)
+	DeleteAttachmentFunc                           func(deleteAttachmentOptions *kubernetesserviceapiv1.DeleteAttachmentOptions) (*core.DetailedResponse, error)
+	DeleteAttachmentWithContextFunc                func(ctx context.Context, deleteAttachmentOptions *kubernetesserviceapiv1.DeleteAttachmentOptions) (*core.DetailedResponse, error)
+	GetAttachmentFunc                              func(getAttachmentOptions *kubernetesserviceapiv1.GetAttachmentOptions) (*kubernetesserviceapiv1.VolumeAttachment, *core.DetailedResponse, error)
+	GetAttachmentWithContextFunc                   func(ctx context.Context, getAttachmentOptions *kubernetesserviceapiv1.GetAttachmentOptions) (*kubernetesserviceapiv1.VolumeAttachment, *core.DetailedResponse, error)
+	GetAttachmentsFunc                             func(getAttachmentsOptions *kubernetesserviceapiv1.GetAttachmentsOptions) (*kubernetesserviceapiv1.VolumeAttachmentList, *core.DetailedResponse, error)
+	GetAttachmentsWithContextFunc                  func(ctx context.Context, getAttachmentsOptions *kubernetesserviceapiv1.GetAttachmentsOptions) (*kubernetesserviceapiv1.VolumeAttachmentList, *core.DetailedResponse, error)
+	GetVolumeFunc                                  func(getVolumeOptions *kubernetesserviceapiv1.GetVolumeOptions) (*kubernetesserviceapiv1.Volume, *core.DetailedResponse, error)
+	GetVolumeWithContextFunc                       func(ctx context.Context, getVolumeOptions *kubernetesserviceapiv1.GetVolumeOptions) (*kubernetesserviceapiv1.Volume, *core.DetailedResponse, error)
+	GetVolumesFunc                                 func(getVolumesOptions *kubernetesserviceapiv1.GetVolumesOptions) (*kubernetesserviceapiv1.VolumesList, *core.DetailedResponse, error)
+	GetVolumesWithContextFunc                      func(ctx context.Context, getVolumesOptions *kubernetesserviceapiv1.GetVolumesOptions) (*kubernetesserviceapiv1.VolumesList, *core.DetailedResponse, error)
+	CreateAssignmentFunc                           func(createAssignmentOptions *kubernetesserviceapiv1.CreateAssignmentOptions) (*kubernetesserviceapiv1.CreateSubscriptionData, *core.DetailedResponse, error)
+	CreateAssignmentWithContextFunc                func(ctx context.Context, createAssignmentOptions *kubernetesserviceapiv1.CreateAssignmentOptions) (*kubernetesserviceapiv1.CreateSubscriptionData, *core.DetailedResponse, error)
+	CreateAssignmentByClusterFunc                  func(createAssignmentOptions *kubernetesserviceapiv1.CreateAssignmentOptions) (*kubernetesserviceapiv1.CreateSubscriptionData, *core.DetailedResponse, error)
+	CreateAssignmentByClusterWithContextFunc       func(ctx context.Context, createAssignmentOptions *kubernetesserviceapiv1.CreateAssignmentOptions) (*kubernetesserviceapiv1.CreateSubscriptionData, *core.DetailedResponse, error)
+	CreateStorageConfigurationFunc                 func(createStorageConfigurationOptions *kubernetesserviceapiv1.CreateStorageConfigurationOptions) (*kubernetesserviceapiv1.CreateConfigurationData, *core.DetailedResponse, error)
+	CreateStorageConfigurationWithContextFunc      func(ctx context.Context, createStorageConfigurationOptions *kubernetesserviceapiv1.CreateStorageConfigurationOptions) (*kubernetesserviceapiv1.CreateConfigurationData, *core.DetailedResponse, error)
+	GetAssignedStorageConfigsFunc                  func(getAssignedStorageConfigsOptions *kubernetesserviceapiv1.GetAssignedStorageConfigsOptions) ([]kubernetesserviceapiv1.AppliedStorageConfig, *core.DetailedResponse, error)
+	GetAssignedStorageConfigsWithContextFunc       func(ctx context.Context, getAssignedStorageConfigsOptions *kubernetesserviceapiv1.GetAssignedStorageConfigsOptions) ([]kubernetesserviceapiv1.AppliedStorageConfig, *core.DetailedResponse, error)
+	GetAssignmentFunc                              func(getAssignmentOptions *kubernetesserviceapiv1.GetAssignmentOptions) (*kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error)
+	GetAssignmentWithContextFunc                   func(ctx context.Context, getAssignmentOptions *kubernetesserviceapiv1.GetAssignmentOptions) (*kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error)
+	GetAssignmentByNameFunc                        func(getAssignmentByNameOptions *kubernetesserviceapiv1.GetAssignmentByNameOptions) (*kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error)
+	GetAssignmentByNameWithContextFunc             func(ctx context.Context, getAssignmentByNameOptions *kubernetesserviceapiv1.GetAssignmentByNameOptions) (*kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error)
+	GetAssignmentsFunc                             func(getAssignmentsOptions *kubernetesserviceapiv1.GetAssignmentsOptions) ([]kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error)
+	GetAssignmentsWithContextFunc                  func(ctx context.Context, getAssignmentsOptions *kubernetesserviceapiv1.GetAssignmentsOptions) ([]kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error)
+	GetAssignmentsByConfigFunc                     func(getAssignmentsByConfigOptions *kubernetesserviceapiv1.GetAssignmentsByConfigOptions) ([]kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error)
+	GetAssignmentsByConfigWithContextFunc          func(ctx context.Context, getAssignmentsByConfigOptions *kubernetesserviceapiv1.GetAssignmentsByConfigOptions) ([]kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error)
+	GetAvailableStorageClassesFunc                 func(getAvailableStorageClassesOptions *kubernetesserviceapiv1.GetAvailableStorageClassesOptions) (*kubernetesserviceapiv1.AvailableStorageClasses, *core.DetailedResponse, error)
+	GetAvailableStorageClassesWithContextFunc      func(ctx context.Context, getAvailableStorageClassesOptions *kubernetesserviceapiv1.GetAvailableStorageClassesOptions) (*kubernetesserviceapiv1.AvailableStorageClasses, *core.DetailedResponse, error)
+	GetStorageConfigurationFunc                    func(getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions) (*kubernetesserviceapiv1.StorageConfig, *core.DetailedResponse, error)
+	GetStorageConfigurationWithContextFunc         func(ctx context.Context, getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions) (*kubernetesserviceapiv1.StorageConfig, *core.DetailedResponse, error)
+	GetStorageConfigurationsFunc                   func(getStorageConfigurationsOptions *kubernetesserviceapiv1.GetStorageConfigurationsOptions) ([]kubernetesserviceapiv1.StorageConfig, *core.DetailedResponse, error)
+	GetStorageConfigurationsWithContextFunc        func(ctx context.Context, getStorageConfigurationsOptions *kubernetesserviceapiv1.GetStorageConfigurationsOptions) ([]kubernetesserviceapiv1.StorageConfig, *core.DetailedResponse, error)
+	GetStorageTemplateFunc                         func(getStorageTemplateOptions *kubernetesserviceapiv1.GetStorageTemplateOptions) (*kubernetesserviceapiv1.RegisteredStorageVersion, *core.DetailedResponse, error)
+	GetStorageTemplateWithContextFunc              func(ctx context.Context, getStorageTemplateOptions *kubernetesserviceapiv1.GetStorageTemplateOptions) (*kubernetesserviceapiv1.RegisteredStorageVersion, *core.DetailedResponse, error)
+	GetStorageTemplatesFunc                        func(getStorageTemplatesOptions *kubernetesserviceapiv1.GetStorageTemplatesOptions) ([]kubernetesserviceapiv1.RegisteredStorage, *core.DetailedResponse, error)
+	GetStorageTemplatesWithContextFunc             func(ctx context.Context, getStorageTemplatesOptions *kubernetesserviceapiv1.GetStorageTemplatesOptions) ([]kubernetesserviceapiv1.RegisteredStorage, *core.DetailedResponse, error)
+	RemoveAssignmentFunc                           func(removeAssignmentOptions *kubernetesserviceapiv1.RemoveAssignmentOptions) (*kubernetesserviceapiv1.RemoveSubscriptionData, *core.DetailedResponse, error)
+	RemoveAssignmentWithContextFunc                func(ctx context.Context, removeAssignmentOptions *kubernetesserviceapiv1.RemoveAssignmentOptions) (*kubernetesserviceapiv1.RemoveSubscriptionData, *core.DetailedResponse, error)
+	RemoveStorageConfigurationFunc                 func(removeStorageConfigurationOptions *kubernetesserviceapiv1.RemoveStorageConfigurationOptions) (*kubernetesserviceapiv1.ErrorResponse, *core.DetailedResponse, error)
+	RemoveStorageConfigurationWithContextFunc      func(ctx context.Context, removeStorageConfigurationOptions *kubernetesserviceapiv1.RemoveStorageConfigurationOptions) (*kubernetesserviceapiv1.ErrorResponse, *core.DetailedResponse, error)
+	UpdateAssignmentFunc                           func(updateAssignmentOptions *kubernetesserviceapiv1.UpdateAssignmentOptions) (*kubernetesserviceapiv1.UpdateSubscriptionData, *core.DetailedResponse, error)
+	UpdateAssignmentWithContextFunc                func(ctx context.Context, updateAssignmentOptions *kubernetesserviceapiv1.UpdateAssignmentOptions) (*kubernetesserviceapiv1.UpdateSubscriptionData, *core.DetailedResponse, error)
+	UpdateAssignmentVersionFunc                    func(updateAssignmentVersionOptions *kubernetesserviceapiv1.UpdateAssignmentVersionOptions) (*kubernetesserviceapiv1.UpdateSubscriptionVersionData, *core.DetailedResponse, error)
+	UpdateAssignmentVersionWithContextFunc         func(ctx context.Context, updateAssignmentVersionOptions *kubernetesserviceapiv1.UpdateAssignmentVersionOptions) (*kubernetesserviceapiv1.UpdateSubscriptionVersionData, *core.DetailedResponse, error)
+	UpdateStorageConfigurationFunc                 func(updateStorageConfigurationOptions *kubernetesserviceapiv1.UpdateStorageConfigurationOptions) (*kubernetesserviceapiv1.ErrorResponse, *core.DetailedResponse, error)
+	UpdateStorageConfigurationWithContextFunc      func(ctx context.Context, updateStorageConfigurationOptions *kubernetesserviceapiv1.UpdateStorageConfigurationOptions) (*kubernetesserviceapiv1.ErrorResponse, *core.DetailedResponse, error)
+	GetAddonsFunc                                  func(getAddonsOptions *kubernetesserviceapiv1.GetAddonsOptions) ([]kubernetesserviceapiv1.AddonCommon, *core.DetailedResponse, error)
+	GetAddonsWithContextFunc                       func(ctx context.Context, getAddonsOptions *kubernetesserviceapiv1.GetAddonsOptions) ([]kubernetesserviceapiv1.AddonCommon, *core.DetailedResponse, error)
+	GetBluemixConfigFunc                           func(getBluemixConfigOptions *kubernetesserviceapiv1.GetBluemixConfigOptions) (*kubernetesserviceapiv1.BluemixConfig, *core.DetailedResponse, error)
+	GetBluemixConfigWithContextFunc                func(ctx context.Context, getBluemixConfigOptions *kubernetesserviceapiv1.GetBluemixConfigOptions) (*kubernetesserviceapiv1.BluemixConfig, *core.DetailedResponse, error)
+	GetDatacenterMachineTypesFunc                  func(getDatacenterMachineTypesOptions *kubernetesserviceapiv1.GetDatacenterMachineTypesOptions) ([]kubernetesserviceapiv1.MachineType, *core.DetailedResponse, error)
+	GetDatacenterMachineTypesWithContextFunc       func(ctx context.Context, getDatacenterMachineTypesOptions *kubernetesserviceapiv1.GetDatacenterMachineTypesOptions) ([]kubernetesserviceapiv1.MachineType, *core.DetailedResponse, error)
+	GetKubeVersionsFunc                            func(getKubeVersionsOptions *kubernetesserviceapiv1.GetKubeVersionsOptions) ([]kubernetesserviceapiv1.KubeVersion, *core.DetailedResponse, error)
+	GetKubeVersionsWithContextFunc                 func(ctx context.Context, getKubeVersionsOptions *kubernetesserviceapiv1.GetKubeVersionsOptions) ([]kubernetesserviceapiv1.KubeVersion, *core.DetailedResponse, error)
+	ListLocationsFunc                              func(listLocationsOptions *kubernetesserviceapiv1.ListLocationsOptions) ([]kubernetesserviceapiv1.IKSLocation, *core.DetailedResponse, error)
+	ListLocationsWithContextFunc                   func(ctx context.Context, listLocationsOptions *kubernetesserviceapiv1.ListLocationsOptions) ([]kubernetesserviceapiv1.IKSLocation, *core.DetailedResponse, error)
+	GetMessagesFunc                                func(getMessagesOptions *kubernetesserviceapiv1.GetMessagesOptions) ([]kubernetesserviceapiv1.MessageResponse, *core.DetailedResponse, error)
+	GetMessagesWithContextFunc                     func(ctx context.Context, getMessagesOptions *kubernetesserviceapiv1.GetMessagesOptions) ([]kubernetesserviceapiv1.MessageResponse, *core.DetailedResponse, error)
+	GetProductConfigFunc                           func(getProductConfigOptions *kubernetesserviceapiv1.GetProductConfigOptions) (*kubernetesserviceapiv1.ProductConfig, *core.DetailedResponse, error)
+	GetProductConfigWithContextFunc                func(ctx context.Context, getProductConfigOptions *kubernetesserviceapiv1.GetProductConfigOptions) (*kubernetesserviceapiv1.ProductConfig, *core.DetailedResponse, error)
+	GetRegionsFunc                                 func(getRegionsOptions *kubernetesserviceapiv1.GetRegionsOptions) (*kubernetesserviceapiv1.Regions, *core.DetailedResponse, error)
+	GetRegionsWithContextFunc                      func(ctx context.Context, getRegionsOptions *kubernetesserviceapiv1.GetRegionsOptions) (*kubernetesserviceapiv1.Regions, *core.DetailedResponse, error)
+	GetVersionsFunc                                func(getVersionsOptions *kubernetesserviceapiv1.GetVersionsOptions) (map[string][]kubernetesserviceapiv1.KubeVersion, *core.DetailedResponse, error)
+	GetVersionsWithContextFunc                     func(ctx context.Context, getVersionsOptions *kubernetesserviceapiv1.GetVersionsOptions) (map[string][]kubernetesserviceapiv1.KubeVersion, *core.DetailedResponse, error)
+	GetZonesFunc                                   func(getZonesOptions *kubernetesserviceapiv1.GetZonesOptions) ([]kubernetesserviceapiv1.ZoneResponse, *core.DetailedResponse, error)
+	GetZonesWithContextFunc                        func(ctx context.Context, getZonesOptions *kubernetesserviceapiv1.GetZonesOptions) ([]kubernetesserviceapiv1.ZoneResponse, *core.DetailedResponse, error)
+	V2GetMessagesFunc                              func(v2GetMessagesOptions *kubernetesserviceapiv1.V2GetMessagesOptions) ([]kubernetesserviceapiv1.MessageResponse, *core.DetailedResponse, error)
+	V2GetMessagesWithContextFunc                   func(ctx context.Context, v2GetMessagesOptions *kubernetesserviceapiv1.V2GetMessagesOptions) ([]kubernetesserviceapiv1.MessageResponse, *core.DetailedResponse, error)
+	ApplyRBACAndGetKubeconfigFunc                  func(applyRBACAndGetKubeconfigOptions *kubernetesserviceapiv1.ApplyRBACAndGetKubeconfigOptions) (*core.DetailedResponse, error)
+	ApplyRBACAndGetKubeconfigWithContextFunc       func(ctx context.Context, applyRBACAndGetKubeconfigOptions *kubernetesserviceapiv1.ApplyRBACAndGetKubeconfigOptions) (*core.DetailedResponse, error)
+	AutoUpdateMasterFunc                           func(autoUpdateMasterOptions *kubernetesserviceapiv1.AutoUpdateMasterOptions) (*core.DetailedResponse, error)
+	AutoUpdateMasterWithContextFunc                func(ctx context.Context, autoUpdateMasterOptions *kubernetesserviceapiv1.AutoUpdateMasterOptions) (*core.DetailedResponse, error)
+	ClassicGetClusterFunc                          func(classicGetClusterOptions *kubernetesserviceapiv1.ClassicGetClusterOptions) ([]kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error)
+	ClassicGetClusterWithContextFunc               func(ctx context.Context, classicGetClusterOptions *kubernetesserviceapiv1.ClassicGetClusterOptions) ([]kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error)
+	ClassicGetClustersFunc                         func(classicGetClustersOptions *kubernetesserviceapiv1.ClassicGetClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error)
+	ClassicGetClustersWithContextFunc              func(ctx context.Context, classicGetClustersOptions *kubernetesserviceapiv1.ClassicGetClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error)
+	GetVLANsFunc                                   func(getVLANsOptions *kubernetesserviceapiv1.GetVLANsOptions) (*kubernetesserviceapiv1.VLAN, *core.DetailedResponse, error)
+	GetVLANsWithContextFunc                        func(ctx context.Context, getVLANsOptions *kubernetesserviceapiv1.GetVLANsOptions) (*kubernetesserviceapiv1.VLAN, *core.DetailedResponse, error)
+	ClassicGetWorkerFunc                           func(classicGetWorkerOptions *kubernetesserviceapiv1.ClassicGetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	ClassicGetWorkerWithContextFunc                func(ctx context.Context, classicGetWorkerOptions *kubernetesserviceapiv1.ClassicGetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	ClassicGetWorkerPoolFunc                       func(classicGetWorkerPoolOptions *kubernetesserviceapiv1.ClassicGetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	ClassicGetWorkerPoolWithContextFunc            func(ctx context.Context, classicGetWorkerPoolOptions *kubernetesserviceapiv1.ClassicGetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	ClassicGetWorkerPoolsFunc                      func(classicGetWorkerPoolsOptions *kubernetesserviceapiv1.ClassicGetWorkerPoolsOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	ClassicGetWorkerPoolsWithContextFunc           func(ctx context.Context, classicGetWorkerPoolsOptions *kubernetesserviceapiv1.ClassicGetWorkerPoolsOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	ClassicGetWorkersFunc                          func(classicGetWorkersOptions *kubernetesserviceapiv1.ClassicGetWorkersOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	ClassicGetWorkersWithContextFunc               func(ctx context.Context, classicGetWorkersOptions *kubernetesserviceapiv1.ClassicGetWorkersOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	KmsEnableClusterFunc                           func(kmsEnableClusterOptions *kubernetesserviceapiv1.KmsEnableClusterOptions) (*core.DetailedResponse, error)
+	KmsEnableClusterWithContextFunc                func(ctx context.Context, kmsEnableClusterOptions *kubernetesserviceapiv1.KmsEnableClusterOptions) (*core.DetailedResponse, error)
+	KmsGetCRKsFunc                                 func(kmsGetCRKsOptions *kubernetesserviceapiv1.KmsGetCRKsOptions) ([]kubernetesserviceapiv1.GetCRKResponse, *core.DetailedResponse, error)
+	KmsGetCRKsWithContextFunc                      func(ctx context.Context, kmsGetCRKsOptions *kubernetesserviceapiv1.KmsGetCRKsOptions) ([]kubernetesserviceapiv1.GetCRKResponse, *core.DetailedResponse, error)
+	GetClusterFunc                                 func(getClusterOptions *kubernetesserviceapiv1.GetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error)
+	GetClusterWithContextFunc                      func(ctx context.Context, getClusterOptions *kubernetesserviceapiv1.GetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error)
+	V2GetClusterAddonsFunc                         func(v2GetClusterAddonsOptions *kubernetesserviceapiv1.V2GetClusterAddonsOptions) ([]kubernetesserviceapiv1.ClusterAddon, *core.DetailedResponse, error)
+	V2GetClusterAddonsWithContextFunc              func(ctx context.Context, v2GetClusterAddonsOptions *kubernetesserviceapiv1.V2GetClusterAddonsOptions) ([]kubernetesserviceapiv1.ClusterAddon, *core.DetailedResponse, error)
+	V2GetFlavorsFunc                               func(v2GetFlavorsOptions *kubernetesserviceapiv1.V2GetFlavorsOptions) ([]kubernetesserviceapiv1.Flavor, *core.DetailedResponse, error)
+	V2GetFlavorsWithContextFunc                    func(ctx context.Context, v2GetFlavorsOptions *kubernetesserviceapiv1.V2GetFlavorsOptions) ([]kubernetesserviceapiv1.Flavor, *core.DetailedResponse, error)
+	KmsGetInstancesFunc                            func(kmsGetInstancesOptions *kubernetesserviceapiv1.KmsGetInstancesOptions) ([]kubernetesserviceapiv1.GetKMSInstanceResponse, *core.DetailedResponse, error)
+	KmsGetInstancesWithContextFunc                 func(ctx context.Context, kmsGetInstancesOptions *kubernetesserviceapiv1.KmsGetInstancesOptions) ([]kubernetesserviceapiv1.GetKMSInstanceResponse, *core.DetailedResponse, error)
+	GetKubeconfigFunc                              func(getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*core.DetailedResponse, error)
+	GetKubeconfigWithContextFunc                   func(ctx context.Context, getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*core.DetailedResponse, error)
+	GetQuotaFunc                                   func(getQuotaOptions *kubernetesserviceapiv1.GetQuotaOptions) ([]kubernetesserviceapiv1.GetQuotaResource, *core.DetailedResponse, error)
+	GetQuotaWithContextFunc                        func(ctx context.Context, getQuotaOptions *kubernetesserviceapiv1.GetQuotaOptions) ([]kubernetesserviceapiv1.GetQuotaResource, *core.DetailedResponse, error)
+	GetWorkerFunc                                  func(getWorkerOptions *kubernetesserviceapiv1.GetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	GetWorkerWithContextFunc                       func(ctx context.Context, getWorkerOptions *kubernetesserviceapiv1.GetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	GetWorkerPoolFunc                              func(getWorkerPoolOptions *kubernetesserviceapiv1.GetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	GetWorkerPoolWithContextFunc                   func(ctx context.Context, getWorkerPoolOptions *kubernetesserviceapiv1.GetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	GetWorkerPools1Func                            func(getWorkerPools1Options *kubernetesserviceapiv1.GetWorkerPools1Options) ([]kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	GetWorkerPools1WithContextFunc                 func(ctx context.Context, getWorkerPools1Options *kubernetesserviceapiv1.GetWorkerPools1Options) ([]kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	GetWorkers1Func                                func(getWorkers1Options *kubernetesserviceapiv1.GetWorkers1Options) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	GetWorkers1WithContextFunc                     func(ctx context.Context, getWorkers1Options *kubernetesserviceapiv1.GetWorkers1Options) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	RebalanceWorkerPoolFunc                        func(rebalanceWorkerPoolOptions *kubernetesserviceapiv1.RebalanceWorkerPoolOptions) (*core.DetailedResponse, error)
+	RebalanceWorkerPoolWithContextFunc             func(ctx context.Context, rebalanceWorkerPoolOptions *kubernetesserviceapiv1.RebalanceWorkerPoolOptions) (*core.DetailedResponse, error)
+	VpcRefreshMasterFunc                           func(vpcRefreshMasterOptions *kubernetesserviceapiv1.VpcRefreshMasterOptions) (*core.DetailedResponse, error)
+	VpcRefreshMasterWithContextFunc                func(ctx context.Context, vpcRefreshMasterOptions *kubernetesserviceapiv1.VpcRefreshMasterOptions) (*core.DetailedResponse, error)
+	V2RemoveWorkerFunc                             func(v2RemoveWorkerOptions *kubernetesserviceapiv1.V2RemoveWorkerOptions) (*core.DetailedResponse, error)
+	V2RemoveWorkerWithContextFunc                  func(ctx context.Context, v2RemoveWorkerOptions *kubernetesserviceapiv1.V2RemoveWorkerOptions) (*core.DetailedResponse, error)
+	RemoveWorkerPool1Func                          func(removeWorkerPool1Options *kubernetesserviceapiv1.RemoveWorkerPool1Options) (*core.DetailedResponse, error)
+	RemoveWorkerPool1WithContextFunc               func(ctx context.Context, removeWorkerPool1Options *kubernetesserviceapiv1.RemoveWorkerPool1Options) (*core.DetailedResponse, error)
+	RemoveWorkerPoolZone1Func                      func(removeWorkerPoolZone1Options *kubernetesserviceapiv1.RemoveWorkerPoolZone1Options) (*core.DetailedResponse, error)
+	RemoveWorkerPoolZone1WithContextFunc           func(ctx context.Context, removeWorkerPoolZone1Options *kubernetesserviceapiv1.RemoveWorkerPoolZone1Options) (*core.DetailedResponse, error)
+	V2ResizeWorkerPoolFunc                         func(v2ResizeWorkerPoolOptions *kubernetesserviceapiv1.V2ResizeWorkerPoolOptions) (*core.DetailedResponse, error)
+	V2ResizeWorkerPoolWithContextFunc              func(ctx context.Context, v2ResizeWorkerPoolOptions *kubernetesserviceapiv1.V2ResizeWorkerPoolOptions) (*core.DetailedResponse, error)
+	V2SetWorkerPoolLabelsFunc                      func(v2SetWorkerPoolLabelsOptions *kubernetesserviceapiv1.V2SetWorkerPoolLabelsOptions) (*core.DetailedResponse, error)
+	V2SetWorkerPoolLabelsWithContextFunc           func(ctx context.Context, v2SetWorkerPoolLabelsOptions *kubernetesserviceapiv1.V2SetWorkerPoolLabelsOptions) (*core.DetailedResponse, error)
+	V2SetWorkerPoolTaintsFunc                      func(v2SetWorkerPoolTaintsOptions *kubernetesserviceapiv1.V2SetWorkerPoolTaintsOptions) (*core.DetailedResponse, error)
+	V2SetWorkerPoolTaintsWithContextFunc           func(ctx context.Context, v2SetWorkerPoolTaintsOptions *kubernetesserviceapiv1.V2SetWorkerPoolTaintsOptions) (*core.DetailedResponse, error)
+	VpcCreateClusterFunc                           func(vpcCreateClusterOptions *kubernetesserviceapiv1.VpcCreateClusterOptions) (*kubernetesserviceapiv1.CreateClusterResponse, *core.DetailedResponse, error)
+	VpcCreateClusterWithContextFunc                func(ctx context.Context, vpcCreateClusterOptions *kubernetesserviceapiv1.VpcCreateClusterOptions) (*kubernetesserviceapiv1.CreateClusterResponse, *core.DetailedResponse, error)
+	VpcCreateWorkerPoolFunc                        func(vpcCreateWorkerPoolOptions *kubernetesserviceapiv1.VpcCreateWorkerPoolOptions) (*kubernetesserviceapiv1.CreateWorkerpoolResponse, *core.DetailedResponse, error)
+	VpcCreateWorkerPoolWithContextFunc             func(ctx context.Context, vpcCreateWorkerPoolOptions *kubernetesserviceapiv1.VpcCreateWorkerPoolOptions) (*kubernetesserviceapiv1.CreateWorkerpoolResponse, *core.DetailedResponse, error)
+	VpcCreateWorkerPoolZoneFunc                    func(vpcCreateWorkerPoolZoneOptions *kubernetesserviceapiv1.VpcCreateWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+	VpcCreateWorkerPoolZoneWithContextFunc         func(ctx context.Context, vpcCreateWorkerPoolZoneOptions *kubernetesserviceapiv1.VpcCreateWorkerPoolZoneOptions) (*core.DetailedResponse, error)
+	VpcGetClusterFunc                              func(vpcGetClusterOptions *kubernetesserviceapiv1.VpcGetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error)
+	VpcGetClusterWithContextFunc                   func(ctx context.Context, vpcGetClusterOptions *kubernetesserviceapiv1.VpcGetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error)
+	VpcGetClustersFunc                             func(vpcGetClustersOptions *kubernetesserviceapiv1.VpcGetClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error)
+	VpcGetClustersWithContextFunc                  func(ctx context.Context, vpcGetClustersOptions *kubernetesserviceapiv1.VpcGetClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error)
+	GetSubnetsFunc                                 func(getSubnetsOptions *kubernetesserviceapiv1.GetSubnetsOptions) ([]kubernetesserviceapiv1.Subnet, *core.DetailedResponse, error)
+	GetSubnetsWithContextFunc                      func(ctx context.Context, getSubnetsOptions *kubernetesserviceapiv1.GetSubnetsOptions) ([]kubernetesserviceapiv1.Subnet, *core.DetailedResponse, error)
+	GetVPCFunc                                     func(getVPCOptions *kubernetesserviceapiv1.GetVPCOptions) (*kubernetesserviceapiv1.GetVPCResponse, *core.DetailedResponse, error)
+	GetVPCWithContextFunc                          func(ctx context.Context, getVPCOptions *kubernetesserviceapiv1.GetVPCOptions) (*kubernetesserviceapiv1.GetVPCResponse, *core.DetailedResponse, error)
+	GetVPCsFunc                                    func(getVPCsOptions *kubernetesserviceapiv1.GetVPCsOptions) ([]kubernetesserviceapiv1.VirtualPrivateCloudResponse, *core.DetailedResponse, error)
+	GetVPCsWithContextFunc                         func(ctx context.Context, getVPCsOptions *kubernetesserviceapiv1.GetVPCsOptions) ([]kubernetesserviceapiv1.VirtualPrivateCloudResponse, *core.DetailedResponse, error)
+	VpcGetWorkerFunc                               func(vpcGetWorkerOptions *kubernetesserviceapiv1.VpcGetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	VpcGetWorkerWithContextFunc                    func(ctx context.Context, vpcGetWorkerOptions *kubernetesserviceapiv1.VpcGetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	VpcGetWorkerPoolFunc                           func(vpcGetWorkerPoolOptions *kubernetesserviceapiv1.VpcGetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	VpcGetWorkerPoolWithContextFunc                func(ctx context.Context, vpcGetWorkerPoolOptions *kubernetesserviceapiv1.VpcGetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	VpcGetWorkerPoolsFunc                          func(vpcGetWorkerPoolsOptions *kubernetesserviceapiv1.VpcGetWorkerPoolsOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	VpcGetWorkerPoolsWithContextFunc               func(ctx context.Context, vpcGetWorkerPoolsOptions *kubernetesserviceapiv1.VpcGetWorkerPoolsOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error)
+	VpcGetWorkersFunc                              func(vpcGetWorkersOptions *kubernetesserviceapiv1.VpcGetWorkersOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	VpcGetWorkersWithContextFunc                   func(ctx context.Context, vpcGetWorkersOptions *kubernetesserviceapiv1.VpcGetWorkersOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error)
+	VpcGetZonesFunc                                func(vpcGetZonesOptions *kubernetesserviceapiv1.VpcGetZonesOptions) ([][]kubernetesserviceapiv1.ZoneResponse, *core.DetailedResponse, error)
+	VpcGetZonesWithContextFunc                     func(ctx context.Context, vpcGetZonesOptions *kubernetesserviceapiv1.VpcGetZonesOptions) ([][]kubernetesserviceapiv1.ZoneResponse, *core.DetailedResponse, error)
+	VpcReplaceWorkerFunc                           func(vpcReplaceWorkerOptions *kubernetesserviceapiv1.VpcReplaceWorkerOptions) (*core.DetailedResponse, error)
+	VpcReplaceWorkerWithContextFunc                func(ctx context.Context, vpcReplaceWorkerOptions *kubernetesserviceapiv1.VpcReplaceWorkerOptions) (*core.DetailedResponse, error)
+	NewClusterAddonFunc                            func(name string) (*kubernetesserviceapiv1.ClusterAddon, error)
+	WaitForWorkerStateFunc                         func(ctx context.Context, cluster string, worker string, predicate kubernetesserviceapiv1.WorkerPredicate, options *kubernetesserviceapiv1.WorkerWaitOptions) (*kubernetesserviceapiv1.GetWorkerResponse, error)
+	WaitForWorkerReadyFunc                         func(ctx context.Context, cluster string, worker string, options *kubernetesserviceapiv1.WorkerWaitOptions) (*kubernetesserviceapiv1.GetWorkerResponse, error)
+	WaitForWorkersFunc                             func(ctx context.Context, cluster string, predicate kubernetesserviceapiv1.WorkerPredicate, options *kubernetesserviceapiv1.WorkersWaitOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, error)
+	WaitForWorkerPoolReadyFunc                     func(ctx context.Context, cluster string, pool string, version string, options *kubernetesserviceapiv1.WorkersWaitOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, error)
+
+	mutex sync.Mutex
+	calls []Call
+}
+
+var _ kubernetesserviceapiv1.API = (*API)(nil)
+
+// Calls returns the calls of the methods of the mock, in order.
+func (m *API) Calls() []Call {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the calls of the method called name, in order.
+func (m *API) CallsTo(name string) []Call {
+	var calls []Call
+	for _, call := range m.Calls() {
+		if call.Method == name {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls recorded so far.
+func (m *API) Reset() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.calls = nil
+}
+
+// record records a call of the method called name.
+func (m *API) record(name string, args ...interface{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.calls = append(m.calls, Call{Method: name, Args: args})
+}
+
+// notStubbed returns the error of the method called name when its function is not set.
+func notStubbed(name string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, name)
+}
+
+// GetClusterConfigToWriter calls GetClusterConfigToWriterFunc.
+func (m *API) GetClusterConfigToWriter(getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions, writer io.Writer) (int64, *core.DetailedResponse, error) {
+	m.record("GetClusterConfigToWriter", getClusterConfigOptions, writer)
+	if m.GetClusterConfigToWriterFunc != nil {
+		return m.GetClusterConfigToWriterFunc(getClusterConfigOptions, writer)
+	}
+	if m.GetClusterConfigToWriterWithContextFunc != nil {
+		return m.GetClusterConfigToWriterWithContextFunc(context.Background(), getClusterConfigOptions, writer)
+	}
+	var r0 int64
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterConfigToWriter")
+}
+
+// GetClusterConfigToWriterWithContext calls GetClusterConfigToWriterWithContextFunc.
+func (m *API) GetClusterConfigToWriterWithContext(ctx context.Context, getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions, writer io.Writer) (int64, *core.DetailedResponse, error) {
+	m.record("GetClusterConfigToWriterWithContext", ctx, getClusterConfigOptions, writer)
+	if m.GetClusterConfigToWriterWithContextFunc != nil {
+		return m.GetClusterConfigToWriterWithContextFunc(ctx, getClusterConfigOptions, writer)
+	}
+	var r0 int64
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterConfigToWriterWithContext")
+}
+
+// ExtractClusterConfig calls ExtractClusterConfigFunc.
+func (m *API) ExtractClusterConfig(getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions, dir string) (*kubernetesserviceapiv1.ClusterConfigManifest, *core.DetailedResponse, error) {
+	m.record("ExtractClusterConfig", getClusterConfigOptions, dir)
+	if m.ExtractClusterConfigFunc != nil {
+		return m.ExtractClusterConfigFunc(getClusterConfigOptions, dir)
+	}
+	if m.ExtractClusterConfigWithContextFunc != nil {
+		return m.ExtractClusterConfigWithContextFunc(context.Background(), getClusterConfigOptions, dir)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterConfigManifest
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ExtractClusterConfig")
+}
+
+// ExtractClusterConfigWithContext calls ExtractClusterConfigWithContextFunc.
+func (m *API) ExtractClusterConfigWithContext(ctx context.Context, getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions, dir string) (*kubernetesserviceapiv1.ClusterConfigManifest, *core.DetailedResponse, error) {
+	m.record("ExtractClusterConfigWithContext", ctx, getClusterConfigOptions, dir)
+	if m.ExtractClusterConfigWithContextFunc != nil {
+		return m.ExtractClusterConfigWithContextFunc(ctx, getClusterConfigOptions, dir)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterConfigManifest
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ExtractClusterConfigWithContext")
+}
+
+// WaitForClusterState calls WaitForClusterStateFunc.
+func (m *API) WaitForClusterState(ctx context.Context, cluster string, predicate kubernetesserviceapiv1.ClusterPredicate, options *kubernetesserviceapiv1.ClusterWaitOptions) (*kubernetesserviceapiv1.GetClusterResponse, error) {
+	m.record("WaitForClusterState", ctx, cluster, predicate, options)
+	if m.WaitForClusterStateFunc != nil {
+		return m.WaitForClusterStateFunc(ctx, cluster, predicate, options)
+	}
+	var r0 *kubernetesserviceapiv1.GetClusterResponse
+	return r0, notStubbed("WaitForClusterState")
+}
+
+// WaitForClusterNormal calls WaitForClusterNormalFunc.
+func (m *API) WaitForClusterNormal(ctx context.Context, cluster string, options *kubernetesserviceapiv1.ClusterWaitOptions) (*kubernetesserviceapiv1.GetClusterResponse, error) {
+	m.record("WaitForClusterNormal", ctx, cluster, options)
+	if m.WaitForClusterNormalFunc != nil {
+		return m.WaitForClusterNormalFunc(ctx, cluster, options)
+	}
+	var r0 *kubernetesserviceapiv1.GetClusterResponse
+	return r0, notStubbed("WaitForClusterNormal")
+}
+
+// WaitForClusterDeleted calls WaitForClusterDeletedFunc.
+func (m *API) WaitForClusterDeleted(ctx context.Context, cluster string, options *kubernetesserviceapiv1.ClusterWaitOptions) error {
+	m.record("WaitForClusterDeleted", ctx, cluster, options)
+	if m.WaitForClusterDeletedFunc != nil {
+		return m.WaitForClusterDeletedFunc(ctx, cluster, options)
+	}
+	return notStubbed("WaitForClusterDeleted")
+}
+
+// GetParsedKubeconfig calls GetParsedKubeconfigFunc.
+func (m *API) GetParsedKubeconfig(getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*kubernetesserviceapiv1.Kubeconfig, *core.DetailedResponse, error) {
+	m.record("GetParsedKubeconfig", getKubeconfigOptions)
+	if m.GetParsedKubeconfigFunc != nil {
+		return m.GetParsedKubeconfigFunc(getKubeconfigOptions)
+	}
+	if m.GetParsedKubeconfigWithContextFunc != nil {
+		return m.GetParsedKubeconfigWithContextFunc(context.Background(), getKubeconfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Kubeconfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetParsedKubeconfig")
+}
+
+// GetParsedKubeconfigWithContext calls GetParsedKubeconfigWithContextFunc.
+func (m *API) GetParsedKubeconfigWithContext(ctx context.Context, getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*kubernetesserviceapiv1.Kubeconfig, *core.DetailedResponse, error) {
+	m.record("GetParsedKubeconfigWithContext", ctx, getKubeconfigOptions)
+	if m.GetParsedKubeconfigWithContextFunc != nil {
+		return m.GetParsedKubeconfigWithContextFunc(ctx, getKubeconfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Kubeconfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetParsedKubeconfigWithContext")
+}
+
+// GetKubeconfigBundle calls GetKubeconfigBundleFunc.
+func (m *API) GetKubeconfigBundle(getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*kubernetesserviceapiv1.KubeconfigBundle, *core.DetailedResponse, error) {
+	m.record("GetKubeconfigBundle", getKubeconfigOptions)
+	if m.GetKubeconfigBundleFunc != nil {
+		return m.GetKubeconfigBundleFunc(getKubeconfigOptions)
+	}
+	if m.GetKubeconfigBundleWithContextFunc != nil {
+		return m.GetKubeconfigBundleWithContextFunc(context.Background(), getKubeconfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.KubeconfigBundle
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetKubeconfigBundle")
+}
+
+// GetKubeconfigBundleWithContext calls GetKubeconfigBundleWithContextFunc.
+func (m *API) GetKubeconfigBundleWithContext(ctx context.Context, getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*kubernetesserviceapiv1.KubeconfigBundle, *core.DetailedResponse, error) {
+	m.record("GetKubeconfigBundleWithContext", ctx, getKubeconfigOptions)
+	if m.GetKubeconfigBundleWithContextFunc != nil {
+		return m.GetKubeconfigBundleWithContextFunc(ctx, getKubeconfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.KubeconfigBundle
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetKubeconfigBundleWithContext")
+}
+
+// ApplyRBACAndGetParsedKubeconfig calls ApplyRBACAndGetParsedKubeconfigFunc.
+func (m *API) ApplyRBACAndGetParsedKubeconfig(applyRBACAndGetKubeconfigOptions *kubernetesserviceapiv1.ApplyRBACAndGetKubeconfigOptions) (*kubernetesserviceapiv1.Kubeconfig, *core.DetailedResponse, error) {
+	m.record("ApplyRBACAndGetParsedKubeconfig", applyRBACAndGetKubeconfigOptions)
+	if m.ApplyRBACAndGetParsedKubeconfigFunc != nil {
+		return m.ApplyRBACAndGetParsedKubeconfigFunc(applyRBACAndGetKubeconfigOptions)
+	}
+	if m.ApplyRBACAndGetParsedKubeconfigWithContextFunc != nil {
+		return m.ApplyRBACAndGetParsedKubeconfigWithContextFunc(context.Background(), applyRBACAndGetKubeconfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Kubeconfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ApplyRBACAndGetParsedKubeconfig")
+}
+
+// ApplyRBACAndGetParsedKubeconfigWithContext calls ApplyRBACAndGetParsedKubeconfigWithContextFunc.
+func (m *API) ApplyRBACAndGetParsedKubeconfigWithContext(ctx context.Context, applyRBACAndGetKubeconfigOptions *kubernetesserviceapiv1.ApplyRBACAndGetKubeconfigOptions) (*kubernetesserviceapiv1.Kubeconfig, *core.DetailedResponse, error) {
+	m.record("ApplyRBACAndGetParsedKubeconfigWithContext", ctx, applyRBACAndGetKubeconfigOptions)
+	if m.ApplyRBACAndGetParsedKubeconfigWithContextFunc != nil {
+		return m.ApplyRBACAndGetParsedKubeconfigWithContextFunc(ctx, applyRBACAndGetKubeconfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Kubeconfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ApplyRBACAndGetParsedKubeconfigWithContext")
+}
+
+// GetUserCredentials calls GetUserCredentialsFunc.
+func (m *API) GetUserCredentials(getUserCredentialsOptions *kubernetesserviceapiv1.GetUserCredentialsOptions) (*kubernetesserviceapiv1.Credentials, *core.DetailedResponse, error) {
+	m.record("GetUserCredentials", getUserCredentialsOptions)
+	if m.GetUserCredentialsFunc != nil {
+		return m.GetUserCredentialsFunc(getUserCredentialsOptions)
+	}
+	if m.GetUserCredentialsWithContextFunc != nil {
+		return m.GetUserCredentialsWithContextFunc(context.Background(), getUserCredentialsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Credentials
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetUserCredentials")
+}
+
+// GetUserCredentialsWithContext calls GetUserCredentialsWithContextFunc.
+func (m *API) GetUserCredentialsWithContext(ctx context.Context, getUserCredentialsOptions *kubernetesserviceapiv1.GetUserCredentialsOptions) (*kubernetesserviceapiv1.Credentials, *core.DetailedResponse, error) {
+	m.record("GetUserCredentialsWithContext", ctx, getUserCredentialsOptions)
+	if m.GetUserCredentialsWithContextFunc != nil {
+		return m.GetUserCredentialsWithContextFunc(ctx, getUserCredentialsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Credentials
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetUserCredentialsWithContext")
+}
+
+// StoreUserCredentials calls StoreUserCredentialsFunc.
+func (m *API) StoreUserCredentials(storeUserCredentialsOptions *kubernetesserviceapiv1.StoreUserCredentialsOptions) (*core.DetailedResponse, error) {
+	m.record("StoreUserCredentials", storeUserCredentialsOptions)
+	if m.StoreUserCredentialsFunc != nil {
+		return m.StoreUserCredentialsFunc(storeUserCredentialsOptions)
+	}
+	if m.StoreUserCredentialsWithContextFunc != nil {
+		return m.StoreUserCredentialsWithContextFunc(context.Background(), storeUserCredentialsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("StoreUserCredentials")
+}
+
+// StoreUserCredentialsWithContext calls StoreUserCredentialsWithContextFunc.
+func (m *API) StoreUserCredentialsWithContext(ctx context.Context, storeUserCredentialsOptions *kubernetesserviceapiv1.StoreUserCredentialsOptions) (*core.DetailedResponse, error) {
+	m.record("StoreUserCredentialsWithContext", ctx, storeUserCredentialsOptions)
+	if m.StoreUserCredentialsWithContextFunc != nil {
+		return m.StoreUserCredentialsWithContextFunc(ctx, storeUserCredentialsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("StoreUserCredentialsWithContext")
+}
+
+// RemoveUserCredentials calls RemoveUserCredentialsFunc.
+func (m *API) RemoveUserCredentials(removeUserCredentialsOptions *kubernetesserviceapiv1.RemoveUserCredentialsOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveUserCredentials", removeUserCredentialsOptions)
+	if m.RemoveUserCredentialsFunc != nil {
+		return m.RemoveUserCredentialsFunc(removeUserCredentialsOptions)
+	}
+	if m.RemoveUserCredentialsWithContextFunc != nil {
+		return m.RemoveUserCredentialsWithContextFunc(context.Background(), removeUserCredentialsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveUserCredentials")
+}
+
+// RemoveUserCredentialsWithContext calls RemoveUserCredentialsWithContextFunc.
+func (m *API) RemoveUserCredentialsWithContext(ctx context.Context, removeUserCredentialsOptions *kubernetesserviceapiv1.RemoveUserCredentialsOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveUserCredentialsWithContext", ctx, removeUserCredentialsOptions)
+	if m.RemoveUserCredentialsWithContextFunc != nil {
+		return m.RemoveUserCredentialsWithContextFunc(ctx, removeUserCredentialsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveUserCredentialsWithContext")
+}
+
+// GetInfraPermissions calls GetInfraPermissionsFunc.
+func (m *API) GetInfraPermissions(getInfraPermissionsOptions *kubernetesserviceapiv1.GetInfraPermissionsOptions) (*kubernetesserviceapiv1.AccountStatus, *core.DetailedResponse, error) {
+	m.record("GetInfraPermissions", getInfraPermissionsOptions)
+	if m.GetInfraPermissionsFunc != nil {
+		return m.GetInfraPermissionsFunc(getInfraPermissionsOptions)
+	}
+	if m.GetInfraPermissionsWithContextFunc != nil {
+		return m.GetInfraPermissionsWithContextFunc(context.Background(), getInfraPermissionsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AccountStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetInfraPermissions")
+}
+
+// GetInfraPermissionsWithContext calls GetInfraPermissionsWithContextFunc.
+func (m *API) GetInfraPermissionsWithContext(ctx context.Context, getInfraPermissionsOptions *kubernetesserviceapiv1.GetInfraPermissionsOptions) (*kubernetesserviceapiv1.AccountStatus, *core.DetailedResponse, error) {
+	m.record("GetInfraPermissionsWithContext", ctx, getInfraPermissionsOptions)
+	if m.GetInfraPermissionsWithContextFunc != nil {
+		return m.GetInfraPermissionsWithContextFunc(ctx, getInfraPermissionsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AccountStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetInfraPermissionsWithContext")
+}
+
+// ResetUserAPIKey calls ResetUserAPIKeyFunc.
+func (m *API) ResetUserAPIKey(resetUserAPIKeyOptions *kubernetesserviceapiv1.ResetUserAPIKeyOptions) (*core.DetailedResponse, error) {
+	m.record("ResetUserAPIKey", resetUserAPIKeyOptions)
+	if m.ResetUserAPIKeyFunc != nil {
+		return m.ResetUserAPIKeyFunc(resetUserAPIKeyOptions)
+	}
+	if m.ResetUserAPIKeyWithContextFunc != nil {
+		return m.ResetUserAPIKeyWithContextFunc(context.Background(), resetUserAPIKeyOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ResetUserAPIKey")
+}
+
+// ResetUserAPIKeyWithContext calls ResetUserAPIKeyWithContextFunc.
+func (m *API) ResetUserAPIKeyWithContext(ctx context.Context, resetUserAPIKeyOptions *kubernetesserviceapiv1.ResetUserAPIKeyOptions) (*core.DetailedResponse, error) {
+	m.record("ResetUserAPIKeyWithContext", ctx, resetUserAPIKeyOptions)
+	if m.ResetUserAPIKeyWithContextFunc != nil {
+		return m.ResetUserAPIKeyWithContextFunc(ctx, resetUserAPIKeyOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ResetUserAPIKeyWithContext")
+}
+
+// GetVlanSpanning calls GetVlanSpanningFunc.
+func (m *API) GetVlanSpanning(getVlanSpanningOptions *kubernetesserviceapiv1.GetVlanSpanningOptions) (*kubernetesserviceapiv1.VlanSpan, *core.DetailedResponse, error) {
+	m.record("GetVlanSpanning", getVlanSpanningOptions)
+	if m.GetVlanSpanningFunc != nil {
+		return m.GetVlanSpanningFunc(getVlanSpanningOptions)
+	}
+	if m.GetVlanSpanningWithContextFunc != nil {
+		return m.GetVlanSpanningWithContextFunc(context.Background(), getVlanSpanningOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VlanSpan
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVlanSpanning")
+}
+
+// GetVlanSpanningWithContext calls GetVlanSpanningWithContextFunc.
+func (m *API) GetVlanSpanningWithContext(ctx context.Context, getVlanSpanningOptions *kubernetesserviceapiv1.GetVlanSpanningOptions) (*kubernetesserviceapiv1.VlanSpan, *core.DetailedResponse, error) {
+	m.record("GetVlanSpanningWithContext", ctx, getVlanSpanningOptions)
+	if m.GetVlanSpanningWithContextFunc != nil {
+		return m.GetVlanSpanningWithContextFunc(ctx, getVlanSpanningOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VlanSpan
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVlanSpanningWithContext")
+}
+
+// GetClusterACLs calls GetClusterACLsFunc.
+func (m *API) GetClusterACLs(getClusterACLsOptions *kubernetesserviceapiv1.GetClusterACLsOptions) (*kubernetesserviceapiv1.ACLResponse, *core.DetailedResponse, error) {
+	m.record("GetClusterACLs", getClusterACLsOptions)
+	if m.GetClusterACLsFunc != nil {
+		return m.GetClusterACLsFunc(getClusterACLsOptions)
+	}
+	if m.GetClusterACLsWithContextFunc != nil {
+		return m.GetClusterACLsWithContextFunc(context.Background(), getClusterACLsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ACLResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterACLs")
+}
+
+// GetClusterACLsWithContext calls GetClusterACLsWithContextFunc.
+func (m *API) GetClusterACLsWithContext(ctx context.Context, getClusterACLsOptions *kubernetesserviceapiv1.GetClusterACLsOptions) (*kubernetesserviceapiv1.ACLResponse, *core.DetailedResponse, error) {
+	m.record("GetClusterACLsWithContext", ctx, getClusterACLsOptions)
+	if m.GetClusterACLsWithContextFunc != nil {
+		return m.GetClusterACLsWithContextFunc(ctx, getClusterACLsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ACLResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterACLsWithContext")
+}
+
+// DisableClusterACLs calls DisableClusterACLsFunc.
+func (m *API) DisableClusterACLs(disableClusterACLsOptions *kubernetesserviceapiv1.DisableClusterACLsOptions) (*core.DetailedResponse, error) {
+	m.record("DisableClusterACLs", disableClusterACLsOptions)
+	if m.DisableClusterACLsFunc != nil {
+		return m.DisableClusterACLsFunc(disableClusterACLsOptions)
+	}
+	if m.DisableClusterACLsWithContextFunc != nil {
+		return m.DisableClusterACLsWithContextFunc(context.Background(), disableClusterACLsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DisableClusterACLs")
+}
+
+// DisableClusterACLsWithContext calls DisableClusterACLsWithContextFunc.
+func (m *API) DisableClusterACLsWithContext(ctx context.Context, disableClusterACLsOptions *kubernetesserviceapiv1.DisableClusterACLsOptions) (*core.DetailedResponse, error) {
+	m.record("DisableClusterACLsWithContext", ctx, disableClusterACLsOptions)
+	if m.DisableClusterACLsWithContextFunc != nil {
+		return m.DisableClusterACLsWithContextFunc(ctx, disableClusterACLsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DisableClusterACLsWithContext")
+}
+
+// AddClusterACLs calls AddClusterACLsFunc.
+func (m *API) AddClusterACLs(addClusterACLsOptions *kubernetesserviceapiv1.AddClusterACLsOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterACLs", addClusterACLsOptions)
+	if m.AddClusterACLsFunc != nil {
+		return m.AddClusterACLsFunc(addClusterACLsOptions)
+	}
+	if m.AddClusterACLsWithContextFunc != nil {
+		return m.AddClusterACLsWithContextFunc(context.Background(), addClusterACLsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterACLs")
+}
+
+// AddClusterACLsWithContext calls AddClusterACLsWithContextFunc.
+func (m *API) AddClusterACLsWithContext(ctx context.Context, addClusterACLsOptions *kubernetesserviceapiv1.AddClusterACLsOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterACLsWithContext", ctx, addClusterACLsOptions)
+	if m.AddClusterACLsWithContextFunc != nil {
+		return m.AddClusterACLsWithContextFunc(ctx, addClusterACLsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterACLsWithContext")
+}
+
+// EnableClusterACLs calls EnableClusterACLsFunc.
+func (m *API) EnableClusterACLs(enableClusterACLsOptions *kubernetesserviceapiv1.EnableClusterACLsOptions) (*core.DetailedResponse, error) {
+	m.record("EnableClusterACLs", enableClusterACLsOptions)
+	if m.EnableClusterACLsFunc != nil {
+		return m.EnableClusterACLsFunc(enableClusterACLsOptions)
+	}
+	if m.EnableClusterACLsWithContextFunc != nil {
+		return m.EnableClusterACLsWithContextFunc(context.Background(), enableClusterACLsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("EnableClusterACLs")
+}
+
+// EnableClusterACLsWithContext calls EnableClusterACLsWithContextFunc.
+func (m *API) EnableClusterACLsWithContext(ctx context.Context, enableClusterACLsOptions *kubernetesserviceapiv1.EnableClusterACLsOptions) (*core.DetailedResponse, error) {
+	m.record("EnableClusterACLsWithContext", ctx, enableClusterACLsOptions)
+	if m.EnableClusterACLsWithContextFunc != nil {
+		return m.EnableClusterACLsWithContextFunc(ctx, enableClusterACLsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("EnableClusterACLsWithContext")
+}
+
+// RemoveClusterACLs calls RemoveClusterACLsFunc.
+func (m *API) RemoveClusterACLs(removeClusterACLsOptions *kubernetesserviceapiv1.RemoveClusterACLsOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveClusterACLs", removeClusterACLsOptions)
+	if m.RemoveClusterACLsFunc != nil {
+		return m.RemoveClusterACLsFunc(removeClusterACLsOptions)
+	}
+	if m.RemoveClusterACLsWithContextFunc != nil {
+		return m.RemoveClusterACLsWithContextFunc(context.Background(), removeClusterACLsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveClusterACLs")
+}
+
+// RemoveClusterACLsWithContext calls RemoveClusterACLsWithContextFunc.
+func (m *API) RemoveClusterACLsWithContext(ctx context.Context, removeClusterACLsOptions *kubernetesserviceapiv1.RemoveClusterACLsOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveClusterACLsWithContext", ctx, removeClusterACLsOptions)
+	if m.RemoveClusterACLsWithContextFunc != nil {
+		return m.RemoveClusterACLsWithContextFunc(ctx, removeClusterACLsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveClusterACLsWithContext")
+}
+
+// EnableALB calls EnableALBFunc.
+func (m *API) EnableALB(enableALBOptions *kubernetesserviceapiv1.EnableALBOptions) (*core.DetailedResponse, error) {
+	m.record("EnableALB", enableALBOptions)
+	if m.EnableALBFunc != nil {
+		return m.EnableALBFunc(enableALBOptions)
+	}
+	if m.EnableALBWithContextFunc != nil {
+		return m.EnableALBWithContextFunc(context.Background(), enableALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("EnableALB")
+}
+
+// EnableALBWithContext calls EnableALBWithContextFunc.
+func (m *API) EnableALBWithContext(ctx context.Context, enableALBOptions *kubernetesserviceapiv1.EnableALBOptions) (*core.DetailedResponse, error) {
+	m.record("EnableALBWithContext", ctx, enableALBOptions)
+	if m.EnableALBWithContextFunc != nil {
+		return m.EnableALBWithContextFunc(ctx, enableALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("EnableALBWithContext")
+}
+
+// GetClusterALB calls GetClusterALBFunc.
+func (m *API) GetClusterALB(getClusterALBOptions *kubernetesserviceapiv1.GetClusterALBOptions) (*kubernetesserviceapiv1.ALBConfig, *core.DetailedResponse, error) {
+	m.record("GetClusterALB", getClusterALBOptions)
+	if m.GetClusterALBFunc != nil {
+		return m.GetClusterALBFunc(getClusterALBOptions)
+	}
+	if m.GetClusterALBWithContextFunc != nil {
+		return m.GetClusterALBWithContextFunc(context.Background(), getClusterALBOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ALBConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterALB")
+}
+
+// GetClusterALBWithContext calls GetClusterALBWithContextFunc.
+func (m *API) GetClusterALBWithContext(ctx context.Context, getClusterALBOptions *kubernetesserviceapiv1.GetClusterALBOptions) (*kubernetesserviceapiv1.ALBConfig, *core.DetailedResponse, error) {
+	m.record("GetClusterALBWithContext", ctx, getClusterALBOptions)
+	if m.GetClusterALBWithContextFunc != nil {
+		return m.GetClusterALBWithContextFunc(ctx, getClusterALBOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ALBConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterALBWithContext")
+}
+
+// DisableALB calls DisableALBFunc.
+func (m *API) DisableALB(disableALBOptions *kubernetesserviceapiv1.DisableALBOptions) (*core.DetailedResponse, error) {
+	m.record("DisableALB", disableALBOptions)
+	if m.DisableALBFunc != nil {
+		return m.DisableALBFunc(disableALBOptions)
+	}
+	if m.DisableALBWithContextFunc != nil {
+		return m.DisableALBWithContextFunc(context.Background(), disableALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DisableALB")
+}
+
+// DisableALBWithContext calls DisableALBWithContextFunc.
+func (m *API) DisableALBWithContext(ctx context.Context, disableALBOptions *kubernetesserviceapiv1.DisableALBOptions) (*core.DetailedResponse, error) {
+	m.record("DisableALBWithContext", ctx, disableALBOptions)
+	if m.DisableALBWithContextFunc != nil {
+		return m.DisableALBWithContextFunc(ctx, disableALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DisableALBWithContext")
+}
+
+// GetAvailableALBTypes calls GetAvailableALBTypesFunc.
+func (m *API) GetAvailableALBTypes(getAvailableALBTypesOptions *kubernetesserviceapiv1.GetAvailableALBTypesOptions) ([]string, *core.DetailedResponse, error) {
+	m.record("GetAvailableALBTypes", getAvailableALBTypesOptions)
+	if m.GetAvailableALBTypesFunc != nil {
+		return m.GetAvailableALBTypesFunc(getAvailableALBTypesOptions)
+	}
+	if m.GetAvailableALBTypesWithContextFunc != nil {
+		return m.GetAvailableALBTypesWithContextFunc(context.Background(), getAvailableALBTypesOptions)
+	}
+	var r0 []string
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAvailableALBTypes")
+}
+
+// GetAvailableALBTypesWithContext calls GetAvailableALBTypesWithContextFunc.
+func (m *API) GetAvailableALBTypesWithContext(ctx context.Context, getAvailableALBTypesOptions *kubernetesserviceapiv1.GetAvailableALBTypesOptions) ([]string, *core.DetailedResponse, error) {
+	m.record("GetAvailableALBTypesWithContext", ctx, getAvailableALBTypesOptions)
+	if m.GetAvailableALBTypesWithContextFunc != nil {
+		return m.GetAvailableALBTypesWithContextFunc(ctx, getAvailableALBTypesOptions)
+	}
+	var r0 []string
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAvailableALBTypesWithContext")
+}
+
+// GetClusterALBs calls GetClusterALBsFunc.
+func (m *API) GetClusterALBs(getClusterALBsOptions *kubernetesserviceapiv1.GetClusterALBsOptions) ([]kubernetesserviceapiv1.ClusterALB, *core.DetailedResponse, error) {
+	m.record("GetClusterALBs", getClusterALBsOptions)
+	if m.GetClusterALBsFunc != nil {
+		return m.GetClusterALBsFunc(getClusterALBsOptions)
+	}
+	if m.GetClusterALBsWithContextFunc != nil {
+		return m.GetClusterALBsWithContextFunc(context.Background(), getClusterALBsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ClusterALB
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterALBs")
+}
+
+// GetClusterALBsWithContext calls GetClusterALBsWithContextFunc.
+func (m *API) GetClusterALBsWithContext(ctx context.Context, getClusterALBsOptions *kubernetesserviceapiv1.GetClusterALBsOptions) ([]kubernetesserviceapiv1.ClusterALB, *core.DetailedResponse, error) {
+	m.record("GetClusterALBsWithContext", ctx, getClusterALBsOptions)
+	if m.GetClusterALBsWithContextFunc != nil {
+		return m.GetClusterALBsWithContextFunc(ctx, getClusterALBsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ClusterALB
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterALBsWithContext")
+}
+
+// UpdateALBs calls UpdateALBsFunc.
+func (m *API) UpdateALBs(updateALBsOptions *kubernetesserviceapiv1.UpdateALBsOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateALBs", updateALBsOptions)
+	if m.UpdateALBsFunc != nil {
+		return m.UpdateALBsFunc(updateALBsOptions)
+	}
+	if m.UpdateALBsWithContextFunc != nil {
+		return m.UpdateALBsWithContextFunc(context.Background(), updateALBsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateALBs")
+}
+
+// UpdateALBsWithContext calls UpdateALBsWithContextFunc.
+func (m *API) UpdateALBsWithContext(ctx context.Context, updateALBsOptions *kubernetesserviceapiv1.UpdateALBsOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateALBsWithContext", ctx, updateALBsOptions)
+	if m.UpdateALBsWithContextFunc != nil {
+		return m.UpdateALBsWithContextFunc(ctx, updateALBsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateALBsWithContext")
+}
+
+// GetUpdatePolicy calls GetUpdatePolicyFunc.
+func (m *API) GetUpdatePolicy(getUpdatePolicyOptions *kubernetesserviceapiv1.GetUpdatePolicyOptions) (*kubernetesserviceapiv1.UpdatePolicy, *core.DetailedResponse, error) {
+	m.record("GetUpdatePolicy", getUpdatePolicyOptions)
+	if m.GetUpdatePolicyFunc != nil {
+		return m.GetUpdatePolicyFunc(getUpdatePolicyOptions)
+	}
+	if m.GetUpdatePolicyWithContextFunc != nil {
+		return m.GetUpdatePolicyWithContextFunc(context.Background(), getUpdatePolicyOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UpdatePolicy
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetUpdatePolicy")
+}
+
+// GetUpdatePolicyWithContext calls GetUpdatePolicyWithContextFunc.
+func (m *API) GetUpdatePolicyWithContext(ctx context.Context, getUpdatePolicyOptions *kubernetesserviceapiv1.GetUpdatePolicyOptions) (*kubernetesserviceapiv1.UpdatePolicy, *core.DetailedResponse, error) {
+	m.record("GetUpdatePolicyWithContext", ctx, getUpdatePolicyOptions)
+	if m.GetUpdatePolicyWithContextFunc != nil {
+		return m.GetUpdatePolicyWithContextFunc(ctx, getUpdatePolicyOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UpdatePolicy
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetUpdatePolicyWithContext")
+}
+
+// ChangeUpdatePolicy calls ChangeUpdatePolicyFunc.
+func (m *API) ChangeUpdatePolicy(changeUpdatePolicyOptions *kubernetesserviceapiv1.ChangeUpdatePolicyOptions) (*core.DetailedResponse, error) {
+	m.record("ChangeUpdatePolicy", changeUpdatePolicyOptions)
+	if m.ChangeUpdatePolicyFunc != nil {
+		return m.ChangeUpdatePolicyFunc(changeUpdatePolicyOptions)
+	}
+	if m.ChangeUpdatePolicyWithContextFunc != nil {
+		return m.ChangeUpdatePolicyWithContextFunc(context.Background(), changeUpdatePolicyOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ChangeUpdatePolicy")
+}
+
+// ChangeUpdatePolicyWithContext calls ChangeUpdatePolicyWithContextFunc.
+func (m *API) ChangeUpdatePolicyWithContext(ctx context.Context, changeUpdatePolicyOptions *kubernetesserviceapiv1.ChangeUpdatePolicyOptions) (*core.DetailedResponse, error) {
+	m.record("ChangeUpdatePolicyWithContext", ctx, changeUpdatePolicyOptions)
+	if m.ChangeUpdatePolicyWithContextFunc != nil {
+		return m.ChangeUpdatePolicyWithContextFunc(ctx, changeUpdatePolicyOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ChangeUpdatePolicyWithContext")
+}
+
+// RollbackUpdate calls RollbackUpdateFunc.
+func (m *API) RollbackUpdate(rollbackUpdateOptions *kubernetesserviceapiv1.RollbackUpdateOptions) (*core.DetailedResponse, error) {
+	m.record("RollbackUpdate", rollbackUpdateOptions)
+	if m.RollbackUpdateFunc != nil {
+		return m.RollbackUpdateFunc(rollbackUpdateOptions)
+	}
+	if m.RollbackUpdateWithContextFunc != nil {
+		return m.RollbackUpdateWithContextFunc(context.Background(), rollbackUpdateOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RollbackUpdate")
+}
+
+// RollbackUpdateWithContext calls RollbackUpdateWithContextFunc.
+func (m *API) RollbackUpdateWithContext(ctx context.Context, rollbackUpdateOptions *kubernetesserviceapiv1.RollbackUpdateOptions) (*core.DetailedResponse, error) {
+	m.record("RollbackUpdateWithContext", ctx, rollbackUpdateOptions)
+	if m.RollbackUpdateWithContextFunc != nil {
+		return m.RollbackUpdateWithContextFunc(ctx, rollbackUpdateOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RollbackUpdateWithContext")
+}
+
+// CreateALB calls CreateALBFunc.
+func (m *API) CreateALB(createALBOptions *kubernetesserviceapiv1.CreateALBOptions) (*kubernetesserviceapiv1.AlbCreateResp, *core.DetailedResponse, error) {
+	m.record("CreateALB", createALBOptions)
+	if m.CreateALBFunc != nil {
+		return m.CreateALBFunc(createALBOptions)
+	}
+	if m.CreateALBWithContextFunc != nil {
+		return m.CreateALBWithContextFunc(context.Background(), createALBOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AlbCreateResp
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateALB")
+}
+
+// CreateALBWithContext calls CreateALBWithContextFunc.
+func (m *API) CreateALBWithContext(ctx context.Context, createALBOptions *kubernetesserviceapiv1.CreateALBOptions) (*kubernetesserviceapiv1.AlbCreateResp, *core.DetailedResponse, error) {
+	m.record("CreateALBWithContext", ctx, createALBOptions)
+	if m.CreateALBWithContextFunc != nil {
+		return m.CreateALBWithContextFunc(ctx, createALBOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AlbCreateResp
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateALBWithContext")
+}
+
+// UpdateALBSecret calls UpdateALBSecretFunc.
+func (m *API) UpdateALBSecret(updateALBSecretOptions *kubernetesserviceapiv1.UpdateALBSecretOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateALBSecret", updateALBSecretOptions)
+	if m.UpdateALBSecretFunc != nil {
+		return m.UpdateALBSecretFunc(updateALBSecretOptions)
+	}
+	if m.UpdateALBSecretWithContextFunc != nil {
+		return m.UpdateALBSecretWithContextFunc(context.Background(), updateALBSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateALBSecret")
+}
+
+// UpdateALBSecretWithContext calls UpdateALBSecretWithContextFunc.
+func (m *API) UpdateALBSecretWithContext(ctx context.Context, updateALBSecretOptions *kubernetesserviceapiv1.UpdateALBSecretOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateALBSecretWithContext", ctx, updateALBSecretOptions)
+	if m.UpdateALBSecretWithContextFunc != nil {
+		return m.UpdateALBSecretWithContextFunc(ctx, updateALBSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateALBSecretWithContext")
+}
+
+// CreateALBSecret calls CreateALBSecretFunc.
+func (m *API) CreateALBSecret(createALBSecretOptions *kubernetesserviceapiv1.CreateALBSecretOptions) (*core.DetailedResponse, error) {
+	m.record("CreateALBSecret", createALBSecretOptions)
+	if m.CreateALBSecretFunc != nil {
+		return m.CreateALBSecretFunc(createALBSecretOptions)
+	}
+	if m.CreateALBSecretWithContextFunc != nil {
+		return m.CreateALBSecretWithContextFunc(context.Background(), createALBSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateALBSecret")
+}
+
+// CreateALBSecretWithContext calls CreateALBSecretWithContextFunc.
+func (m *API) CreateALBSecretWithContext(ctx context.Context, createALBSecretOptions *kubernetesserviceapiv1.CreateALBSecretOptions) (*core.DetailedResponse, error) {
+	m.record("CreateALBSecretWithContext", ctx, createALBSecretOptions)
+	if m.CreateALBSecretWithContextFunc != nil {
+		return m.CreateALBSecretWithContextFunc(ctx, createALBSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateALBSecretWithContext")
+}
+
+// ViewClusterALBSecrets calls ViewClusterALBSecretsFunc.
+func (m *API) ViewClusterALBSecrets(viewClusterALBSecretsOptions *kubernetesserviceapiv1.ViewClusterALBSecretsOptions) (*kubernetesserviceapiv1.ClusterALBSecret, *core.DetailedResponse, error) {
+	m.record("ViewClusterALBSecrets", viewClusterALBSecretsOptions)
+	if m.ViewClusterALBSecretsFunc != nil {
+		return m.ViewClusterALBSecretsFunc(viewClusterALBSecretsOptions)
+	}
+	if m.ViewClusterALBSecretsWithContextFunc != nil {
+		return m.ViewClusterALBSecretsWithContextFunc(context.Background(), viewClusterALBSecretsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterALBSecret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ViewClusterALBSecrets")
+}
+
+// ViewClusterALBSecretsWithContext calls ViewClusterALBSecretsWithContextFunc.
+func (m *API) ViewClusterALBSecretsWithContext(ctx context.Context, viewClusterALBSecretsOptions *kubernetesserviceapiv1.ViewClusterALBSecretsOptions) (*kubernetesserviceapiv1.ClusterALBSecret, *core.DetailedResponse, error) {
+	m.record("ViewClusterALBSecretsWithContext", ctx, viewClusterALBSecretsOptions)
+	if m.ViewClusterALBSecretsWithContextFunc != nil {
+		return m.ViewClusterALBSecretsWithContextFunc(ctx, viewClusterALBSecretsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterALBSecret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ViewClusterALBSecretsWithContext")
+}
+
+// DeleteClusterALBSecrets calls DeleteClusterALBSecretsFunc.
+func (m *API) DeleteClusterALBSecrets(deleteClusterALBSecretsOptions *kubernetesserviceapiv1.DeleteClusterALBSecretsOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteClusterALBSecrets", deleteClusterALBSecretsOptions)
+	if m.DeleteClusterALBSecretsFunc != nil {
+		return m.DeleteClusterALBSecretsFunc(deleteClusterALBSecretsOptions)
+	}
+	if m.DeleteClusterALBSecretsWithContextFunc != nil {
+		return m.DeleteClusterALBSecretsWithContextFunc(context.Background(), deleteClusterALBSecretsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteClusterALBSecrets")
+}
+
+// DeleteClusterALBSecretsWithContext calls DeleteClusterALBSecretsWithContextFunc.
+func (m *API) DeleteClusterALBSecretsWithContext(ctx context.Context, deleteClusterALBSecretsOptions *kubernetesserviceapiv1.DeleteClusterALBSecretsOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteClusterALBSecretsWithContext", ctx, deleteClusterALBSecretsOptions)
+	if m.DeleteClusterALBSecretsWithContextFunc != nil {
+		return m.DeleteClusterALBSecretsWithContextFunc(ctx, deleteClusterALBSecretsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteClusterALBSecretsWithContext")
+}
+
+// GetAuditWebhook calls GetAuditWebhookFunc.
+func (m *API) GetAuditWebhook(getAuditWebhookOptions *kubernetesserviceapiv1.GetAuditWebhookOptions) (*kubernetesserviceapiv1.AuditWebhookConfig, *core.DetailedResponse, error) {
+	m.record("GetAuditWebhook", getAuditWebhookOptions)
+	if m.GetAuditWebhookFunc != nil {
+		return m.GetAuditWebhookFunc(getAuditWebhookOptions)
+	}
+	if m.GetAuditWebhookWithContextFunc != nil {
+		return m.GetAuditWebhookWithContextFunc(context.Background(), getAuditWebhookOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AuditWebhookConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAuditWebhook")
+}
+
+// GetAuditWebhookWithContext calls GetAuditWebhookWithContextFunc.
+func (m *API) GetAuditWebhookWithContext(ctx context.Context, getAuditWebhookOptions *kubernetesserviceapiv1.GetAuditWebhookOptions) (*kubernetesserviceapiv1.AuditWebhookConfig, *core.DetailedResponse, error) {
+	m.record("GetAuditWebhookWithContext", ctx, getAuditWebhookOptions)
+	if m.GetAuditWebhookWithContextFunc != nil {
+		return m.GetAuditWebhookWithContextFunc(ctx, getAuditWebhookOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AuditWebhookConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAuditWebhookWithContext")
+}
+
+// UpdateAuditWebhook calls UpdateAuditWebhookFunc.
+func (m *API) UpdateAuditWebhook(updateAuditWebhookOptions *kubernetesserviceapiv1.UpdateAuditWebhookOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateAuditWebhook", updateAuditWebhookOptions)
+	if m.UpdateAuditWebhookFunc != nil {
+		return m.UpdateAuditWebhookFunc(updateAuditWebhookOptions)
+	}
+	if m.UpdateAuditWebhookWithContextFunc != nil {
+		return m.UpdateAuditWebhookWithContextFunc(context.Background(), updateAuditWebhookOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateAuditWebhook")
+}
+
+// UpdateAuditWebhookWithContext calls UpdateAuditWebhookWithContextFunc.
+func (m *API) UpdateAuditWebhookWithContext(ctx context.Context, updateAuditWebhookOptions *kubernetesserviceapiv1.UpdateAuditWebhookOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateAuditWebhookWithContext", ctx, updateAuditWebhookOptions)
+	if m.UpdateAuditWebhookWithContextFunc != nil {
+		return m.UpdateAuditWebhookWithContextFunc(ctx, updateAuditWebhookOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateAuditWebhookWithContext")
+}
+
+// DeleteAuditWebhook calls DeleteAuditWebhookFunc.
+func (m *API) DeleteAuditWebhook(deleteAuditWebhookOptions *kubernetesserviceapiv1.DeleteAuditWebhookOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteAuditWebhook", deleteAuditWebhookOptions)
+	if m.DeleteAuditWebhookFunc != nil {
+		return m.DeleteAuditWebhookFunc(deleteAuditWebhookOptions)
+	}
+	if m.DeleteAuditWebhookWithContextFunc != nil {
+		return m.DeleteAuditWebhookWithContextFunc(context.Background(), deleteAuditWebhookOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteAuditWebhook")
+}
+
+// DeleteAuditWebhookWithContext calls DeleteAuditWebhookWithContextFunc.
+func (m *API) DeleteAuditWebhookWithContext(ctx context.Context, deleteAuditWebhookOptions *kubernetesserviceapiv1.DeleteAuditWebhookOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteAuditWebhookWithContext", ctx, deleteAuditWebhookOptions)
+	if m.DeleteAuditWebhookWithContextFunc != nil {
+		return m.DeleteAuditWebhookWithContextFunc(ctx, deleteAuditWebhookOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteAuditWebhookWithContext")
+}
+
+// GetLBConfig calls GetLBConfigFunc.
+func (m *API) GetLBConfig(getLBConfigOptions *kubernetesserviceapiv1.GetLBConfigOptions) (*kubernetesserviceapiv1.LBConfig, *core.DetailedResponse, error) {
+	m.record("GetLBConfig", getLBConfigOptions)
+	if m.GetLBConfigFunc != nil {
+		return m.GetLBConfigFunc(getLBConfigOptions)
+	}
+	if m.GetLBConfigWithContextFunc != nil {
+		return m.GetLBConfigWithContextFunc(context.Background(), getLBConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.LBConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetLBConfig")
+}
+
+// GetLBConfigWithContext calls GetLBConfigWithContextFunc.
+func (m *API) GetLBConfigWithContext(ctx context.Context, getLBConfigOptions *kubernetesserviceapiv1.GetLBConfigOptions) (*kubernetesserviceapiv1.LBConfig, *core.DetailedResponse, error) {
+	m.record("GetLBConfigWithContext", ctx, getLBConfigOptions)
+	if m.GetLBConfigWithContextFunc != nil {
+		return m.GetLBConfigWithContextFunc(ctx, getLBConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.LBConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetLBConfigWithContext")
+}
+
+// PatchLBConfig calls PatchLBConfigFunc.
+func (m *API) PatchLBConfig(patchLBConfigOptions *kubernetesserviceapiv1.PatchLBConfigOptions) (*core.DetailedResponse, error) {
+	m.record("PatchLBConfig", patchLBConfigOptions)
+	if m.PatchLBConfigFunc != nil {
+		return m.PatchLBConfigFunc(patchLBConfigOptions)
+	}
+	if m.PatchLBConfigWithContextFunc != nil {
+		return m.PatchLBConfigWithContextFunc(context.Background(), patchLBConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("PatchLBConfig")
+}
+
+// PatchLBConfigWithContext calls PatchLBConfigWithContextFunc.
+func (m *API) PatchLBConfigWithContext(ctx context.Context, patchLBConfigOptions *kubernetesserviceapiv1.PatchLBConfigOptions) (*core.DetailedResponse, error) {
+	m.record("PatchLBConfigWithContext", ctx, patchLBConfigOptions)
+	if m.PatchLBConfigWithContextFunc != nil {
+		return m.PatchLBConfigWithContextFunc(ctx, patchLBConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("PatchLBConfigWithContext")
+}
+
+// CreateSecret calls CreateSecretFunc.
+func (m *API) CreateSecret(createSecretOptions *kubernetesserviceapiv1.CreateSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error) {
+	m.record("CreateSecret", createSecretOptions)
+	if m.CreateSecretFunc != nil {
+		return m.CreateSecretFunc(createSecretOptions)
+	}
+	if m.CreateSecretWithContextFunc != nil {
+		return m.CreateSecretWithContextFunc(context.Background(), createSecretOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Secret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSecret")
+}
+
+// CreateSecretWithContext calls CreateSecretWithContextFunc.
+func (m *API) CreateSecretWithContext(ctx context.Context, createSecretOptions *kubernetesserviceapiv1.CreateSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error) {
+	m.record("CreateSecretWithContext", ctx, createSecretOptions)
+	if m.CreateSecretWithContextFunc != nil {
+		return m.CreateSecretWithContextFunc(ctx, createSecretOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Secret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSecretWithContext")
+}
+
+// DeleteIngressSecret calls DeleteIngressSecretFunc.
+func (m *API) DeleteIngressSecret(deleteIngressSecretOptions *kubernetesserviceapiv1.DeleteIngressSecretOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteIngressSecret", deleteIngressSecretOptions)
+	if m.DeleteIngressSecretFunc != nil {
+		return m.DeleteIngressSecretFunc(deleteIngressSecretOptions)
+	}
+	if m.DeleteIngressSecretWithContextFunc != nil {
+		return m.DeleteIngressSecretWithContextFunc(context.Background(), deleteIngressSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteIngressSecret")
+}
+
+// DeleteIngressSecretWithContext calls DeleteIngressSecretWithContextFunc.
+func (m *API) DeleteIngressSecretWithContext(ctx context.Context, deleteIngressSecretOptions *kubernetesserviceapiv1.DeleteIngressSecretOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteIngressSecretWithContext", ctx, deleteIngressSecretOptions)
+	if m.DeleteIngressSecretWithContextFunc != nil {
+		return m.DeleteIngressSecretWithContextFunc(ctx, deleteIngressSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteIngressSecretWithContext")
+}
+
+// GetSecret calls GetSecretFunc.
+func (m *API) GetSecret(getSecretOptions *kubernetesserviceapiv1.GetSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error) {
+	m.record("GetSecret", getSecretOptions)
+	if m.GetSecretFunc != nil {
+		return m.GetSecretFunc(getSecretOptions)
+	}
+	if m.GetSecretWithContextFunc != nil {
+		return m.GetSecretWithContextFunc(context.Background(), getSecretOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Secret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSecret")
+}
+
+// GetSecretWithContext calls GetSecretWithContextFunc.
+func (m *API) GetSecretWithContext(ctx context.Context, getSecretOptions *kubernetesserviceapiv1.GetSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error) {
+	m.record("GetSecretWithContext", ctx, getSecretOptions)
+	if m.GetSecretWithContextFunc != nil {
+		return m.GetSecretWithContextFunc(ctx, getSecretOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Secret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSecretWithContext")
+}
+
+// GetSecrets calls GetSecretsFunc.
+func (m *API) GetSecrets(getSecretsOptions *kubernetesserviceapiv1.GetSecretsOptions) ([]kubernetesserviceapiv1.Secret, *core.DetailedResponse, error) {
+	m.record("GetSecrets", getSecretsOptions)
+	if m.GetSecretsFunc != nil {
+		return m.GetSecretsFunc(getSecretsOptions)
+	}
+	if m.GetSecretsWithContextFunc != nil {
+		return m.GetSecretsWithContextFunc(context.Background(), getSecretsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Secret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSecrets")
+}
+
+// GetSecretsWithContext calls GetSecretsWithContextFunc.
+func (m *API) GetSecretsWithContext(ctx context.Context, getSecretsOptions *kubernetesserviceapiv1.GetSecretsOptions) ([]kubernetesserviceapiv1.Secret, *core.DetailedResponse, error) {
+	m.record("GetSecretsWithContext", ctx, getSecretsOptions)
+	if m.GetSecretsWithContextFunc != nil {
+		return m.GetSecretsWithContextFunc(ctx, getSecretsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Secret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSecretsWithContext")
+}
+
+// UpdateSecret calls UpdateSecretFunc.
+func (m *API) UpdateSecret(updateSecretOptions *kubernetesserviceapiv1.UpdateSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error) {
+	m.record("UpdateSecret", updateSecretOptions)
+	if m.UpdateSecretFunc != nil {
+		return m.UpdateSecretFunc(updateSecretOptions)
+	}
+	if m.UpdateSecretWithContextFunc != nil {
+		return m.UpdateSecretWithContextFunc(context.Background(), updateSecretOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Secret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateSecret")
+}
+
+// UpdateSecretWithContext calls UpdateSecretWithContextFunc.
+func (m *API) UpdateSecretWithContext(ctx context.Context, updateSecretOptions *kubernetesserviceapiv1.UpdateSecretOptions) (*kubernetesserviceapiv1.Secret, *core.DetailedResponse, error) {
+	m.record("UpdateSecretWithContext", ctx, updateSecretOptions)
+	if m.UpdateSecretWithContextFunc != nil {
+		return m.UpdateSecretWithContextFunc(ctx, updateSecretOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Secret
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateSecretWithContext")
+}
+
+// CleanupMigration calls CleanupMigrationFunc.
+func (m *API) CleanupMigration(cleanupMigrationOptions *kubernetesserviceapiv1.CleanupMigrationOptions) (*core.DetailedResponse, error) {
+	m.record("CleanupMigration", cleanupMigrationOptions)
+	if m.CleanupMigrationFunc != nil {
+		return m.CleanupMigrationFunc(cleanupMigrationOptions)
+	}
+	if m.CleanupMigrationWithContextFunc != nil {
+		return m.CleanupMigrationWithContextFunc(context.Background(), cleanupMigrationOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CleanupMigration")
+}
+
+// CleanupMigrationWithContext calls CleanupMigrationWithContextFunc.
+func (m *API) CleanupMigrationWithContext(ctx context.Context, cleanupMigrationOptions *kubernetesserviceapiv1.CleanupMigrationOptions) (*core.DetailedResponse, error) {
+	m.record("CleanupMigrationWithContext", ctx, cleanupMigrationOptions)
+	if m.CleanupMigrationWithContextFunc != nil {
+		return m.CleanupMigrationWithContextFunc(ctx, cleanupMigrationOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CleanupMigrationWithContext")
+}
+
+// V2GetClusterALB calls V2GetClusterALBFunc.
+func (m *API) V2GetClusterALB(v2GetClusterALBOptions *kubernetesserviceapiv1.V2GetClusterALBOptions) (*kubernetesserviceapiv1.VpcALBConfig, *core.DetailedResponse, error) {
+	m.record("V2GetClusterALB", v2GetClusterALBOptions)
+	if m.V2GetClusterALBFunc != nil {
+		return m.V2GetClusterALBFunc(v2GetClusterALBOptions)
+	}
+	if m.V2GetClusterALBWithContextFunc != nil {
+		return m.V2GetClusterALBWithContextFunc(context.Background(), v2GetClusterALBOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VpcALBConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetClusterALB")
+}
+
+// V2GetClusterALBWithContext calls V2GetClusterALBWithContextFunc.
+func (m *API) V2GetClusterALBWithContext(ctx context.Context, v2GetClusterALBOptions *kubernetesserviceapiv1.V2GetClusterALBOptions) (*kubernetesserviceapiv1.VpcALBConfig, *core.DetailedResponse, error) {
+	m.record("V2GetClusterALBWithContext", ctx, v2GetClusterALBOptions)
+	if m.V2GetClusterALBWithContextFunc != nil {
+		return m.V2GetClusterALBWithContextFunc(ctx, v2GetClusterALBOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VpcALBConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetClusterALBWithContext")
+}
+
+// GetSupportedImages calls GetSupportedImagesFunc.
+func (m *API) GetSupportedImages(getSupportedImagesOptions *kubernetesserviceapiv1.GetSupportedImagesOptions) ([]string, *core.DetailedResponse, error) {
+	m.record("GetSupportedImages", getSupportedImagesOptions)
+	if m.GetSupportedImagesFunc != nil {
+		return m.GetSupportedImagesFunc(getSupportedImagesOptions)
+	}
+	if m.GetSupportedImagesWithContextFunc != nil {
+		return m.GetSupportedImagesWithContextFunc(context.Background(), getSupportedImagesOptions)
+	}
+	var r0 []string
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSupportedImages")
+}
+
+// GetSupportedImagesWithContext calls GetSupportedImagesWithContextFunc.
+func (m *API) GetSupportedImagesWithContext(ctx context.Context, getSupportedImagesOptions *kubernetesserviceapiv1.GetSupportedImagesOptions) ([]string, *core.DetailedResponse, error) {
+	m.record("GetSupportedImagesWithContext", ctx, getSupportedImagesOptions)
+	if m.GetSupportedImagesWithContextFunc != nil {
+		return m.GetSupportedImagesWithContextFunc(ctx, getSupportedImagesOptions)
+	}
+	var r0 []string
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSupportedImagesWithContext")
+}
+
+// V2GetClusterALBs calls V2GetClusterALBsFunc.
+func (m *API) V2GetClusterALBs(v2GetClusterALBsOptions *kubernetesserviceapiv1.V2GetClusterALBsOptions) (*kubernetesserviceapiv1.VpcClusterALB, *core.DetailedResponse, error) {
+	m.record("V2GetClusterALBs", v2GetClusterALBsOptions)
+	if m.V2GetClusterALBsFunc != nil {
+		return m.V2GetClusterALBsFunc(v2GetClusterALBsOptions)
+	}
+	if m.V2GetClusterALBsWithContextFunc != nil {
+		return m.V2GetClusterALBsWithContextFunc(context.Background(), v2GetClusterALBsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VpcClusterALB
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetClusterALBs")
+}
+
+// V2GetClusterALBsWithContext calls V2GetClusterALBsWithContextFunc.
+func (m *API) V2GetClusterALBsWithContext(ctx context.Context, v2GetClusterALBsOptions *kubernetesserviceapiv1.V2GetClusterALBsOptions) (*kubernetesserviceapiv1.VpcClusterALB, *core.DetailedResponse, error) {
+	m.record("V2GetClusterALBsWithContext", ctx, v2GetClusterALBsOptions)
+	if m.V2GetClusterALBsWithContextFunc != nil {
+		return m.V2GetClusterALBsWithContextFunc(ctx, v2GetClusterALBsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VpcClusterALB
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetClusterALBsWithContext")
+}
+
+// GetMigrationStatus calls GetMigrationStatusFunc.
+func (m *API) GetMigrationStatus(getMigrationStatusOptions *kubernetesserviceapiv1.GetMigrationStatusOptions) (*kubernetesserviceapiv1.MigrationStatus, *core.DetailedResponse, error) {
+	m.record("GetMigrationStatus", getMigrationStatusOptions)
+	if m.GetMigrationStatusFunc != nil {
+		return m.GetMigrationStatusFunc(getMigrationStatusOptions)
+	}
+	if m.GetMigrationStatusWithContextFunc != nil {
+		return m.GetMigrationStatusWithContextFunc(context.Background(), getMigrationStatusOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MigrationStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMigrationStatus")
+}
+
+// GetMigrationStatusWithContext calls GetMigrationStatusWithContextFunc.
+func (m *API) GetMigrationStatusWithContext(ctx context.Context, getMigrationStatusOptions *kubernetesserviceapiv1.GetMigrationStatusOptions) (*kubernetesserviceapiv1.MigrationStatus, *core.DetailedResponse, error) {
+	m.record("GetMigrationStatusWithContext", ctx, getMigrationStatusOptions)
+	if m.GetMigrationStatusWithContextFunc != nil {
+		return m.GetMigrationStatusWithContextFunc(ctx, getMigrationStatusOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MigrationStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMigrationStatusWithContext")
+}
+
+// GetStatus calls GetStatusFunc.
+func (m *API) GetStatus(getStatusOptions *kubernetesserviceapiv1.GetStatusOptions) (*kubernetesserviceapiv1.IngressStatus, *core.DetailedResponse, error) {
+	m.record("GetStatus", getStatusOptions)
+	if m.GetStatusFunc != nil {
+		return m.GetStatusFunc(getStatusOptions)
+	}
+	if m.GetStatusWithContextFunc != nil {
+		return m.GetStatusWithContextFunc(context.Background(), getStatusOptions)
+	}
+	var r0 *kubernetesserviceapiv1.IngressStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStatus")
+}
+
+// GetStatusWithContext calls GetStatusWithContextFunc.
+func (m *API) GetStatusWithContext(ctx context.Context, getStatusOptions *kubernetesserviceapiv1.GetStatusOptions) (*kubernetesserviceapiv1.IngressStatus, *core.DetailedResponse, error) {
+	m.record("GetStatusWithContext", ctx, getStatusOptions)
+	if m.GetStatusWithContextFunc != nil {
+		return m.GetStatusWithContextFunc(ctx, getStatusOptions)
+	}
+	var r0 *kubernetesserviceapiv1.IngressStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStatusWithContext")
+}
+
+// StartMigration calls StartMigrationFunc.
+func (m *API) StartMigration(startMigrationOptions *kubernetesserviceapiv1.StartMigrationOptions) (*core.DetailedResponse, error) {
+	m.record("StartMigration", startMigrationOptions)
+	if m.StartMigrationFunc != nil {
+		return m.StartMigrationFunc(startMigrationOptions)
+	}
+	if m.StartMigrationWithContextFunc != nil {
+		return m.StartMigrationWithContextFunc(context.Background(), startMigrationOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("StartMigration")
+}
+
+// StartMigrationWithContext calls StartMigrationWithContextFunc.
+func (m *API) StartMigrationWithContext(ctx context.Context, startMigrationOptions *kubernetesserviceapiv1.StartMigrationOptions) (*core.DetailedResponse, error) {
+	m.record("StartMigrationWithContext", ctx, startMigrationOptions)
+	if m.StartMigrationWithContextFunc != nil {
+		return m.StartMigrationWithContextFunc(ctx, startMigrationOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("StartMigrationWithContext")
+}
+
+// V2UpdateALB calls V2UpdateALBFunc.
+func (m *API) V2UpdateALB(v2UpdateALBOptions *kubernetesserviceapiv1.V2UpdateALBOptions) (*core.DetailedResponse, error) {
+	m.record("V2UpdateALB", v2UpdateALBOptions)
+	if m.V2UpdateALBFunc != nil {
+		return m.V2UpdateALBFunc(v2UpdateALBOptions)
+	}
+	if m.V2UpdateALBWithContextFunc != nil {
+		return m.V2UpdateALBWithContextFunc(context.Background(), v2UpdateALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2UpdateALB")
+}
+
+// V2UpdateALBWithContext calls V2UpdateALBWithContextFunc.
+func (m *API) V2UpdateALBWithContext(ctx context.Context, v2UpdateALBOptions *kubernetesserviceapiv1.V2UpdateALBOptions) (*core.DetailedResponse, error) {
+	m.record("V2UpdateALBWithContext", ctx, v2UpdateALBOptions)
+	if m.V2UpdateALBWithContextFunc != nil {
+		return m.V2UpdateALBWithContextFunc(ctx, v2UpdateALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2UpdateALBWithContext")
+}
+
+// VpcCreateALB calls VpcCreateALBFunc.
+func (m *API) VpcCreateALB(vpcCreateALBOptions *kubernetesserviceapiv1.VpcCreateALBOptions) (*kubernetesserviceapiv1.AlbCreateResp, *core.DetailedResponse, error) {
+	m.record("VpcCreateALB", vpcCreateALBOptions)
+	if m.VpcCreateALBFunc != nil {
+		return m.VpcCreateALBFunc(vpcCreateALBOptions)
+	}
+	if m.VpcCreateALBWithContextFunc != nil {
+		return m.VpcCreateALBWithContextFunc(context.Background(), vpcCreateALBOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AlbCreateResp
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcCreateALB")
+}
+
+// VpcCreateALBWithContext calls VpcCreateALBWithContextFunc.
+func (m *API) VpcCreateALBWithContext(ctx context.Context, vpcCreateALBOptions *kubernetesserviceapiv1.VpcCreateALBOptions) (*kubernetesserviceapiv1.AlbCreateResp, *core.DetailedResponse, error) {
+	m.record("VpcCreateALBWithContext", ctx, vpcCreateALBOptions)
+	if m.VpcCreateALBWithContextFunc != nil {
+		return m.VpcCreateALBWithContextFunc(ctx, vpcCreateALBOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AlbCreateResp
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcCreateALBWithContext")
+}
+
+// VpcDisableALB calls VpcDisableALBFunc.
+func (m *API) VpcDisableALB(vpcDisableALBOptions *kubernetesserviceapiv1.VpcDisableALBOptions) (*core.DetailedResponse, error) {
+	m.record("VpcDisableALB", vpcDisableALBOptions)
+	if m.VpcDisableALBFunc != nil {
+		return m.VpcDisableALBFunc(vpcDisableALBOptions)
+	}
+	if m.VpcDisableALBWithContextFunc != nil {
+		return m.VpcDisableALBWithContextFunc(context.Background(), vpcDisableALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcDisableALB")
+}
+
+// VpcDisableALBWithContext calls VpcDisableALBWithContextFunc.
+func (m *API) VpcDisableALBWithContext(ctx context.Context, vpcDisableALBOptions *kubernetesserviceapiv1.VpcDisableALBOptions) (*core.DetailedResponse, error) {
+	m.record("VpcDisableALBWithContext", ctx, vpcDisableALBOptions)
+	if m.VpcDisableALBWithContextFunc != nil {
+		return m.VpcDisableALBWithContextFunc(ctx, vpcDisableALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcDisableALBWithContext")
+}
+
+// VpcEnableALB calls VpcEnableALBFunc.
+func (m *API) VpcEnableALB(vpcEnableALBOptions *kubernetesserviceapiv1.VpcEnableALBOptions) (*core.DetailedResponse, error) {
+	m.record("VpcEnableALB", vpcEnableALBOptions)
+	if m.VpcEnableALBFunc != nil {
+		return m.VpcEnableALBFunc(vpcEnableALBOptions)
+	}
+	if m.VpcEnableALBWithContextFunc != nil {
+		return m.VpcEnableALBWithContextFunc(context.Background(), vpcEnableALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcEnableALB")
+}
+
+// VpcEnableALBWithContext calls VpcEnableALBWithContextFunc.
+func (m *API) VpcEnableALBWithContext(ctx context.Context, vpcEnableALBOptions *kubernetesserviceapiv1.VpcEnableALBOptions) (*core.DetailedResponse, error) {
+	m.record("VpcEnableALBWithContext", ctx, vpcEnableALBOptions)
+	if m.VpcEnableALBWithContextFunc != nil {
+		return m.VpcEnableALBWithContextFunc(ctx, vpcEnableALBOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcEnableALBWithContext")
+}
+
+// V2DisablePrivateServiceEndpoint calls V2DisablePrivateServiceEndpointFunc.
+func (m *API) V2DisablePrivateServiceEndpoint(v2DisablePrivateServiceEndpointOptions *kubernetesserviceapiv1.V2DisablePrivateServiceEndpointOptions) (*core.DetailedResponse, error) {
+	m.record("V2DisablePrivateServiceEndpoint", v2DisablePrivateServiceEndpointOptions)
+	if m.V2DisablePrivateServiceEndpointFunc != nil {
+		return m.V2DisablePrivateServiceEndpointFunc(v2DisablePrivateServiceEndpointOptions)
+	}
+	if m.V2DisablePrivateServiceEndpointWithContextFunc != nil {
+		return m.V2DisablePrivateServiceEndpointWithContextFunc(context.Background(), v2DisablePrivateServiceEndpointOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2DisablePrivateServiceEndpoint")
+}
+
+// V2DisablePrivateServiceEndpointWithContext calls V2DisablePrivateServiceEndpointWithContextFunc.
+func (m *API) V2DisablePrivateServiceEndpointWithContext(ctx context.Context, v2DisablePrivateServiceEndpointOptions *kubernetesserviceapiv1.V2DisablePrivateServiceEndpointOptions) (*core.DetailedResponse, error) {
+	m.record("V2DisablePrivateServiceEndpointWithContext", ctx, v2DisablePrivateServiceEndpointOptions)
+	if m.V2DisablePrivateServiceEndpointWithContextFunc != nil {
+		return m.V2DisablePrivateServiceEndpointWithContextFunc(ctx, v2DisablePrivateServiceEndpointOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2DisablePrivateServiceEndpointWithContext")
+}
+
+// V2DisablePublicServiceEndpoint calls V2DisablePublicServiceEndpointFunc.
+func (m *API) V2DisablePublicServiceEndpoint(v2DisablePublicServiceEndpointOptions *kubernetesserviceapiv1.V2DisablePublicServiceEndpointOptions) (*core.DetailedResponse, error) {
+	m.record("V2DisablePublicServiceEndpoint", v2DisablePublicServiceEndpointOptions)
+	if m.V2DisablePublicServiceEndpointFunc != nil {
+		return m.V2DisablePublicServiceEndpointFunc(v2DisablePublicServiceEndpointOptions)
+	}
+	if m.V2DisablePublicServiceEndpointWithContextFunc != nil {
+		return m.V2DisablePublicServiceEndpointWithContextFunc(context.Background(), v2DisablePublicServiceEndpointOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2DisablePublicServiceEndpoint")
+}
+
+// V2DisablePublicServiceEndpointWithContext calls V2DisablePublicServiceEndpointWithContextFunc.
+func (m *API) V2DisablePublicServiceEndpointWithContext(ctx context.Context, v2DisablePublicServiceEndpointOptions *kubernetesserviceapiv1.V2DisablePublicServiceEndpointOptions) (*core.DetailedResponse, error) {
+	m.record("V2DisablePublicServiceEndpointWithContext", ctx, v2DisablePublicServiceEndpointOptions)
+	if m.V2DisablePublicServiceEndpointWithContextFunc != nil {
+		return m.V2DisablePublicServiceEndpointWithContextFunc(ctx, v2DisablePublicServiceEndpointOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2DisablePublicServiceEndpointWithContext")
+}
+
+// V2EnablePrivateServiceEndpoint calls V2EnablePrivateServiceEndpointFunc.
+func (m *API) V2EnablePrivateServiceEndpoint(v2EnablePrivateServiceEndpointOptions *kubernetesserviceapiv1.V2EnablePrivateServiceEndpointOptions) (*core.DetailedResponse, error) {
+	m.record("V2EnablePrivateServiceEndpoint", v2EnablePrivateServiceEndpointOptions)
+	if m.V2EnablePrivateServiceEndpointFunc != nil {
+		return m.V2EnablePrivateServiceEndpointFunc(v2EnablePrivateServiceEndpointOptions)
+	}
+	if m.V2EnablePrivateServiceEndpointWithContextFunc != nil {
+		return m.V2EnablePrivateServiceEndpointWithContextFunc(context.Background(), v2EnablePrivateServiceEndpointOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2EnablePrivateServiceEndpoint")
+}
+
+// V2EnablePrivateServiceEndpointWithContext calls V2EnablePrivateServiceEndpointWithContextFunc.
+func (m *API) V2EnablePrivateServiceEndpointWithContext(ctx context.Context, v2EnablePrivateServiceEndpointOptions *kubernetesserviceapiv1.V2EnablePrivateServiceEndpointOptions) (*core.DetailedResponse, error) {
+	m.record("V2EnablePrivateServiceEndpointWithContext", ctx, v2EnablePrivateServiceEndpointOptions)
+	if m.V2EnablePrivateServiceEndpointWithContextFunc != nil {
+		return m.V2EnablePrivateServiceEndpointWithContextFunc(ctx, v2EnablePrivateServiceEndpointOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2EnablePrivateServiceEndpointWithContext")
+}
+
+// V2EnablePublicServiceEndpoint calls V2EnablePublicServiceEndpointFunc.
+func (m *API) V2EnablePublicServiceEndpoint(v2EnablePublicServiceEndpointOptions *kubernetesserviceapiv1.V2EnablePublicServiceEndpointOptions) (*core.DetailedResponse, error) {
+	m.record("V2EnablePublicServiceEndpoint", v2EnablePublicServiceEndpointOptions)
+	if m.V2EnablePublicServiceEndpointFunc != nil {
+		return m.V2EnablePublicServiceEndpointFunc(v2EnablePublicServiceEndpointOptions)
+	}
+	if m.V2EnablePublicServiceEndpointWithContextFunc != nil {
+		return m.V2EnablePublicServiceEndpointWithContextFunc(context.Background(), v2EnablePublicServiceEndpointOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2EnablePublicServiceEndpoint")
+}
+
+// V2EnablePublicServiceEndpointWithContext calls V2EnablePublicServiceEndpointWithContextFunc.
+func (m *API) V2EnablePublicServiceEndpointWithContext(ctx context.Context, v2EnablePublicServiceEndpointOptions *kubernetesserviceapiv1.V2EnablePublicServiceEndpointOptions) (*core.DetailedResponse, error) {
+	m.record("V2EnablePublicServiceEndpointWithContext", ctx, v2EnablePublicServiceEndpointOptions)
+	if m.V2EnablePublicServiceEndpointWithContextFunc != nil {
+		return m.V2EnablePublicServiceEndpointWithContextFunc(ctx, v2EnablePublicServiceEndpointOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2EnablePublicServiceEndpointWithContext")
+}
+
+// V2EnablePullSecret calls V2EnablePullSecretFunc.
+func (m *API) V2EnablePullSecret(v2EnablePullSecretOptions *kubernetesserviceapiv1.V2EnablePullSecretOptions) (*core.DetailedResponse, error) {
+	m.record("V2EnablePullSecret", v2EnablePullSecretOptions)
+	if m.V2EnablePullSecretFunc != nil {
+		return m.V2EnablePullSecretFunc(v2EnablePullSecretOptions)
+	}
+	if m.V2EnablePullSecretWithContextFunc != nil {
+		return m.V2EnablePullSecretWithContextFunc(context.Background(), v2EnablePullSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2EnablePullSecret")
+}
+
+// V2EnablePullSecretWithContext calls V2EnablePullSecretWithContextFunc.
+func (m *API) V2EnablePullSecretWithContext(ctx context.Context, v2EnablePullSecretOptions *kubernetesserviceapiv1.V2EnablePullSecretOptions) (*core.DetailedResponse, error) {
+	m.record("V2EnablePullSecretWithContext", ctx, v2EnablePullSecretOptions)
+	if m.V2EnablePullSecretWithContextFunc != nil {
+		return m.V2EnablePullSecretWithContextFunc(ctx, v2EnablePullSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2EnablePullSecretWithContext")
+}
+
+// V2GetVersions calls V2GetVersionsFunc.
+func (m *API) V2GetVersions(v2GetVersionsOptions *kubernetesserviceapiv1.V2GetVersionsOptions) ([]kubernetesserviceapiv1.IKSVersion, *core.DetailedResponse, error) {
+	m.record("V2GetVersions", v2GetVersionsOptions)
+	if m.V2GetVersionsFunc != nil {
+		return m.V2GetVersionsFunc(v2GetVersionsOptions)
+	}
+	if m.V2GetVersionsWithContextFunc != nil {
+		return m.V2GetVersionsWithContextFunc(context.Background(), v2GetVersionsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.IKSVersion
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetVersions")
+}
+
+// V2GetVersionsWithContext calls V2GetVersionsWithContextFunc.
+func (m *API) V2GetVersionsWithContext(ctx context.Context, v2GetVersionsOptions *kubernetesserviceapiv1.V2GetVersionsOptions) ([]kubernetesserviceapiv1.IKSVersion, *core.DetailedResponse, error) {
+	m.record("V2GetVersionsWithContext", ctx, v2GetVersionsOptions)
+	if m.V2GetVersionsWithContextFunc != nil {
+		return m.V2GetVersionsWithContextFunc(ctx, v2GetVersionsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.IKSVersion
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetVersionsWithContext")
+}
+
+// DeleteSecret calls DeleteSecretFunc.
+func (m *API) DeleteSecret(deleteSecretOptions *kubernetesserviceapiv1.DeleteSecretOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteSecret", deleteSecretOptions)
+	if m.DeleteSecretFunc != nil {
+		return m.DeleteSecretFunc(deleteSecretOptions)
+	}
+	if m.DeleteSecretWithContextFunc != nil {
+		return m.DeleteSecretWithContextFunc(context.Background(), deleteSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteSecret")
+}
+
+// DeleteSecretWithContext calls DeleteSecretWithContextFunc.
+func (m *API) DeleteSecretWithContext(ctx context.Context, deleteSecretOptions *kubernetesserviceapiv1.DeleteSecretOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteSecretWithContext", ctx, deleteSecretOptions)
+	if m.DeleteSecretWithContextFunc != nil {
+		return m.DeleteSecretWithContextFunc(ctx, deleteSecretOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteSecretWithContext")
+}
+
+// GetNlbDNSList calls GetNlbDNSListFunc.
+func (m *API) GetNlbDNSList(getNlbDNSListOptions *kubernetesserviceapiv1.GetNlbDNSListOptions) ([]kubernetesserviceapiv1.NlbVPCListConfig, *core.DetailedResponse, error) {
+	m.record("GetNlbDNSList", getNlbDNSListOptions)
+	if m.GetNlbDNSListFunc != nil {
+		return m.GetNlbDNSListFunc(getNlbDNSListOptions)
+	}
+	if m.GetNlbDNSListWithContextFunc != nil {
+		return m.GetNlbDNSListWithContextFunc(context.Background(), getNlbDNSListOptions)
+	}
+	var r0 []kubernetesserviceapiv1.NlbVPCListConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetNlbDNSList")
+}
+
+// GetNlbDNSListWithContext calls GetNlbDNSListWithContextFunc.
+func (m *API) GetNlbDNSListWithContext(ctx context.Context, getNlbDNSListOptions *kubernetesserviceapiv1.GetNlbDNSListOptions) ([]kubernetesserviceapiv1.NlbVPCListConfig, *core.DetailedResponse, error) {
+	m.record("GetNlbDNSListWithContext", ctx, getNlbDNSListOptions)
+	if m.GetNlbDNSListWithContextFunc != nil {
+		return m.GetNlbDNSListWithContextFunc(ctx, getNlbDNSListOptions)
+	}
+	var r0 []kubernetesserviceapiv1.NlbVPCListConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetNlbDNSListWithContext")
+}
+
+// GetSatLocationNlbDNSList calls GetSatLocationNlbDNSListFunc.
+func (m *API) GetSatLocationNlbDNSList(getSatLocationNlbDNSListOptions *kubernetesserviceapiv1.GetSatLocationNlbDNSListOptions) ([]kubernetesserviceapiv1.NlbVPCListConfig, *core.DetailedResponse, error) {
+	m.record("GetSatLocationNlbDNSList", getSatLocationNlbDNSListOptions)
+	if m.GetSatLocationNlbDNSListFunc != nil {
+		return m.GetSatLocationNlbDNSListFunc(getSatLocationNlbDNSListOptions)
+	}
+	if m.GetSatLocationNlbDNSListWithContextFunc != nil {
+		return m.GetSatLocationNlbDNSListWithContextFunc(context.Background(), getSatLocationNlbDNSListOptions)
+	}
+	var r0 []kubernetesserviceapiv1.NlbVPCListConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatLocationNlbDNSList")
+}
+
+// GetSatLocationNlbDNSListWithContext calls GetSatLocationNlbDNSListWithContextFunc.
+func (m *API) GetSatLocationNlbDNSListWithContext(ctx context.Context, getSatLocationNlbDNSListOptions *kubernetesserviceapiv1.GetSatLocationNlbDNSListOptions) ([]kubernetesserviceapiv1.NlbVPCListConfig, *core.DetailedResponse, error) {
+	m.record("GetSatLocationNlbDNSListWithContext", ctx, getSatLocationNlbDNSListOptions)
+	if m.GetSatLocationNlbDNSListWithContextFunc != nil {
+		return m.GetSatLocationNlbDNSListWithContextFunc(ctx, getSatLocationNlbDNSListOptions)
+	}
+	var r0 []kubernetesserviceapiv1.NlbVPCListConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatLocationNlbDNSListWithContext")
+}
+
+// RegenerateCert calls RegenerateCertFunc.
+func (m *API) RegenerateCert(regenerateCertOptions *kubernetesserviceapiv1.RegenerateCertOptions) (*core.DetailedResponse, error) {
+	m.record("RegenerateCert", regenerateCertOptions)
+	if m.RegenerateCertFunc != nil {
+		return m.RegenerateCertFunc(regenerateCertOptions)
+	}
+	if m.RegenerateCertWithContextFunc != nil {
+		return m.RegenerateCertWithContextFunc(context.Background(), regenerateCertOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RegenerateCert")
+}
+
+// RegenerateCertWithContext calls RegenerateCertWithContextFunc.
+func (m *API) RegenerateCertWithContext(ctx context.Context, regenerateCertOptions *kubernetesserviceapiv1.RegenerateCertOptions) (*core.DetailedResponse, error) {
+	m.record("RegenerateCertWithContext", ctx, regenerateCertOptions)
+	if m.RegenerateCertWithContextFunc != nil {
+		return m.RegenerateCertWithContextFunc(ctx, regenerateCertOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RegenerateCertWithContext")
+}
+
+// RegisterMultishiftCluster calls RegisterMultishiftClusterFunc.
+func (m *API) RegisterMultishiftCluster(registerMultishiftClusterOptions *kubernetesserviceapiv1.RegisterMultishiftClusterOptions) (*kubernetesserviceapiv1.MSCRegisterResp, *core.DetailedResponse, error) {
+	m.record("RegisterMultishiftCluster", registerMultishiftClusterOptions)
+	if m.RegisterMultishiftClusterFunc != nil {
+		return m.RegisterMultishiftClusterFunc(registerMultishiftClusterOptions)
+	}
+	if m.RegisterMultishiftClusterWithContextFunc != nil {
+		return m.RegisterMultishiftClusterWithContextFunc(context.Background(), registerMultishiftClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MSCRegisterResp
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("RegisterMultishiftCluster")
+}
+
+// RegisterMultishiftClusterWithContext calls RegisterMultishiftClusterWithContextFunc.
+func (m *API) RegisterMultishiftClusterWithContext(ctx context.Context, registerMultishiftClusterOptions *kubernetesserviceapiv1.RegisterMultishiftClusterOptions) (*kubernetesserviceapiv1.MSCRegisterResp, *core.DetailedResponse, error) {
+	m.record("RegisterMultishiftClusterWithContext", ctx, registerMultishiftClusterOptions)
+	if m.RegisterMultishiftClusterWithContextFunc != nil {
+		return m.RegisterMultishiftClusterWithContextFunc(ctx, registerMultishiftClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MSCRegisterResp
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("RegisterMultishiftClusterWithContext")
+}
+
+// ReplaceLBHostname calls ReplaceLBHostnameFunc.
+func (m *API) ReplaceLBHostname(replaceLBHostnameOptions *kubernetesserviceapiv1.ReplaceLBHostnameOptions) (*kubernetesserviceapiv1.NlbVPCConfig, *core.DetailedResponse, error) {
+	m.record("ReplaceLBHostname", replaceLBHostnameOptions)
+	if m.ReplaceLBHostnameFunc != nil {
+		return m.ReplaceLBHostnameFunc(replaceLBHostnameOptions)
+	}
+	if m.ReplaceLBHostnameWithContextFunc != nil {
+		return m.ReplaceLBHostnameWithContextFunc(context.Background(), replaceLBHostnameOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbVPCConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ReplaceLBHostname")
+}
+
+// ReplaceLBHostnameWithContext calls ReplaceLBHostnameWithContextFunc.
+func (m *API) ReplaceLBHostnameWithContext(ctx context.Context, replaceLBHostnameOptions *kubernetesserviceapiv1.ReplaceLBHostnameOptions) (*kubernetesserviceapiv1.NlbVPCConfig, *core.DetailedResponse, error) {
+	m.record("ReplaceLBHostnameWithContext", ctx, replaceLBHostnameOptions)
+	if m.ReplaceLBHostnameWithContextFunc != nil {
+		return m.ReplaceLBHostnameWithContextFunc(ctx, replaceLBHostnameOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbVPCConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ReplaceLBHostnameWithContext")
+}
+
+// CreateNlbDNS calls CreateNlbDNSFunc.
+func (m *API) CreateNlbDNS(createNlbDNSOptions *kubernetesserviceapiv1.CreateNlbDNSOptions) (*kubernetesserviceapiv1.NlbVPCConfig, *core.DetailedResponse, error) {
+	m.record("CreateNlbDNS", createNlbDNSOptions)
+	if m.CreateNlbDNSFunc != nil {
+		return m.CreateNlbDNSFunc(createNlbDNSOptions)
+	}
+	if m.CreateNlbDNSWithContextFunc != nil {
+		return m.CreateNlbDNSWithContextFunc(context.Background(), createNlbDNSOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbVPCConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateNlbDNS")
+}
+
+// CreateNlbDNSWithContext calls CreateNlbDNSWithContextFunc.
+func (m *API) CreateNlbDNSWithContext(ctx context.Context, createNlbDNSOptions *kubernetesserviceapiv1.CreateNlbDNSOptions) (*kubernetesserviceapiv1.NlbVPCConfig, *core.DetailedResponse, error) {
+	m.record("CreateNlbDNSWithContext", ctx, createNlbDNSOptions)
+	if m.CreateNlbDNSWithContextFunc != nil {
+		return m.CreateNlbDNSWithContextFunc(ctx, createNlbDNSOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbVPCConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateNlbDNSWithContext")
+}
+
+// RemoveLBHostname calls RemoveLBHostnameFunc.
+func (m *API) RemoveLBHostname(removeLBHostnameOptions *kubernetesserviceapiv1.RemoveLBHostnameOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveLBHostname", removeLBHostnameOptions)
+	if m.RemoveLBHostnameFunc != nil {
+		return m.RemoveLBHostnameFunc(removeLBHostnameOptions)
+	}
+	if m.RemoveLBHostnameWithContextFunc != nil {
+		return m.RemoveLBHostnameWithContextFunc(context.Background(), removeLBHostnameOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveLBHostname")
+}
+
+// RemoveLBHostnameWithContext calls RemoveLBHostnameWithContextFunc.
+func (m *API) RemoveLBHostnameWithContext(ctx context.Context, removeLBHostnameOptions *kubernetesserviceapiv1.RemoveLBHostnameOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveLBHostnameWithContext", ctx, removeLBHostnameOptions)
+	if m.RemoveLBHostnameWithContextFunc != nil {
+		return m.RemoveLBHostnameWithContextFunc(ctx, removeLBHostnameOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveLBHostnameWithContext")
+}
+
+// ReplaceWorker calls ReplaceWorkerFunc.
+func (m *API) ReplaceWorker(replaceWorkerOptions *kubernetesserviceapiv1.ReplaceWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("ReplaceWorker", replaceWorkerOptions)
+	if m.ReplaceWorkerFunc != nil {
+		return m.ReplaceWorkerFunc(replaceWorkerOptions)
+	}
+	if m.ReplaceWorkerWithContextFunc != nil {
+		return m.ReplaceWorkerWithContextFunc(context.Background(), replaceWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ReplaceWorker")
+}
+
+// ReplaceWorkerWithContext calls ReplaceWorkerWithContextFunc.
+func (m *API) ReplaceWorkerWithContext(ctx context.Context, replaceWorkerOptions *kubernetesserviceapiv1.ReplaceWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("ReplaceWorkerWithContext", ctx, replaceWorkerOptions)
+	if m.ReplaceWorkerWithContextFunc != nil {
+		return m.ReplaceWorkerWithContextFunc(ctx, replaceWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ReplaceWorkerWithContext")
+}
+
+// V2UpdateMaster calls V2UpdateMasterFunc.
+func (m *API) V2UpdateMaster(v2UpdateMasterOptions *kubernetesserviceapiv1.V2UpdateMasterOptions) (*core.DetailedResponse, error) {
+	m.record("V2UpdateMaster", v2UpdateMasterOptions)
+	if m.V2UpdateMasterFunc != nil {
+		return m.V2UpdateMasterFunc(v2UpdateMasterOptions)
+	}
+	if m.V2UpdateMasterWithContextFunc != nil {
+		return m.V2UpdateMasterWithContextFunc(context.Background(), v2UpdateMasterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2UpdateMaster")
+}
+
+// V2UpdateMasterWithContext calls V2UpdateMasterWithContextFunc.
+func (m *API) V2UpdateMasterWithContext(ctx context.Context, v2UpdateMasterOptions *kubernetesserviceapiv1.V2UpdateMasterOptions) (*core.DetailedResponse, error) {
+	m.record("V2UpdateMasterWithContext", ctx, v2UpdateMasterOptions)
+	if m.V2UpdateMasterWithContextFunc != nil {
+		return m.V2UpdateMasterWithContextFunc(ctx, v2UpdateMasterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2UpdateMasterWithContext")
+}
+
+// GetClusters calls GetClustersFunc.
+func (m *API) GetClusters(getClustersOptions *kubernetesserviceapiv1.GetClustersOptions) ([]kubernetesserviceapiv1.Cluster, *core.DetailedResponse, error) {
+	m.record("GetClusters", getClustersOptions)
+	if m.GetClustersFunc != nil {
+		return m.GetClustersFunc(getClustersOptions)
+	}
+	if m.GetClustersWithContextFunc != nil {
+		return m.GetClustersWithContextFunc(context.Background(), getClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Cluster
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusters")
+}
+
+// GetClustersWithContext calls GetClustersWithContextFunc.
+func (m *API) GetClustersWithContext(ctx context.Context, getClustersOptions *kubernetesserviceapiv1.GetClustersOptions) ([]kubernetesserviceapiv1.Cluster, *core.DetailedResponse, error) {
+	m.record("GetClustersWithContext", ctx, getClustersOptions)
+	if m.GetClustersWithContextFunc != nil {
+		return m.GetClustersWithContextFunc(ctx, getClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Cluster
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClustersWithContext")
+}
+
+// CreateCluster calls CreateClusterFunc.
+func (m *API) CreateCluster(createClusterOptions *kubernetesserviceapiv1.CreateClusterOptions) (*kubernetesserviceapiv1.ClusterCreateResponse, *core.DetailedResponse, error) {
+	m.record("CreateCluster", createClusterOptions)
+	if m.CreateClusterFunc != nil {
+		return m.CreateClusterFunc(createClusterOptions)
+	}
+	if m.CreateClusterWithContextFunc != nil {
+		return m.CreateClusterWithContextFunc(context.Background(), createClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterCreateResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateCluster")
+}
+
+// CreateClusterWithContext calls CreateClusterWithContextFunc.
+func (m *API) CreateClusterWithContext(ctx context.Context, createClusterOptions *kubernetesserviceapiv1.CreateClusterOptions) (*kubernetesserviceapiv1.ClusterCreateResponse, *core.DetailedResponse, error) {
+	m.record("CreateClusterWithContext", ctx, createClusterOptions)
+	if m.CreateClusterWithContextFunc != nil {
+		return m.CreateClusterWithContextFunc(ctx, createClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterCreateResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateClusterWithContext")
+}
+
+// GetCluster1 calls GetCluster1Func.
+func (m *API) GetCluster1(getCluster1Options *kubernetesserviceapiv1.GetCluster1Options) (*kubernetesserviceapiv1.Cluster, *core.DetailedResponse, error) {
+	m.record("GetCluster1", getCluster1Options)
+	if m.GetCluster1Func != nil {
+		return m.GetCluster1Func(getCluster1Options)
+	}
+	if m.GetCluster1WithContextFunc != nil {
+		return m.GetCluster1WithContextFunc(context.Background(), getCluster1Options)
+	}
+	var r0 *kubernetesserviceapiv1.Cluster
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetCluster1")
+}
+
+// GetCluster1WithContext calls GetCluster1WithContextFunc.
+func (m *API) GetCluster1WithContext(ctx context.Context, getCluster1Options *kubernetesserviceapiv1.GetCluster1Options) (*kubernetesserviceapiv1.Cluster, *core.DetailedResponse, error) {
+	m.record("GetCluster1WithContext", ctx, getCluster1Options)
+	if m.GetCluster1WithContextFunc != nil {
+		return m.GetCluster1WithContextFunc(ctx, getCluster1Options)
+	}
+	var r0 *kubernetesserviceapiv1.Cluster
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetCluster1WithContext")
+}
+
+// UpdateCluster calls UpdateClusterFunc.
+func (m *API) UpdateCluster(updateClusterOptions *kubernetesserviceapiv1.UpdateClusterOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateCluster", updateClusterOptions)
+	if m.UpdateClusterFunc != nil {
+		return m.UpdateClusterFunc(updateClusterOptions)
+	}
+	if m.UpdateClusterWithContextFunc != nil {
+		return m.UpdateClusterWithContextFunc(context.Background(), updateClusterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateCluster")
+}
+
+// UpdateClusterWithContext calls UpdateClusterWithContextFunc.
+func (m *API) UpdateClusterWithContext(ctx context.Context, updateClusterOptions *kubernetesserviceapiv1.UpdateClusterOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateClusterWithContext", ctx, updateClusterOptions)
+	if m.UpdateClusterWithContextFunc != nil {
+		return m.UpdateClusterWithContextFunc(ctx, updateClusterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateClusterWithContext")
+}
+
+// RemoveCluster calls RemoveClusterFunc.
+func (m *API) RemoveCluster(removeClusterOptions *kubernetesserviceapiv1.RemoveClusterOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveCluster", removeClusterOptions)
+	if m.RemoveClusterFunc != nil {
+		return m.RemoveClusterFunc(removeClusterOptions)
+	}
+	if m.RemoveClusterWithContextFunc != nil {
+		return m.RemoveClusterWithContextFunc(context.Background(), removeClusterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveCluster")
+}
+
+// RemoveClusterWithContext calls RemoveClusterWithContextFunc.
+func (m *API) RemoveClusterWithContext(ctx context.Context, removeClusterOptions *kubernetesserviceapiv1.RemoveClusterOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveClusterWithContext", ctx, removeClusterOptions)
+	if m.RemoveClusterWithContextFunc != nil {
+		return m.RemoveClusterWithContextFunc(ctx, removeClusterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveClusterWithContext")
+}
+
+// GetClusterAddons calls GetClusterAddonsFunc.
+func (m *API) GetClusterAddons(getClusterAddonsOptions *kubernetesserviceapiv1.GetClusterAddonsOptions) ([]kubernetesserviceapiv1.ClusterAddon, *core.DetailedResponse, error) {
+	m.record("GetClusterAddons", getClusterAddonsOptions)
+	if m.GetClusterAddonsFunc != nil {
+		return m.GetClusterAddonsFunc(getClusterAddonsOptions)
+	}
+	if m.GetClusterAddonsWithContextFunc != nil {
+		return m.GetClusterAddonsWithContextFunc(context.Background(), getClusterAddonsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ClusterAddon
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterAddons")
+}
+
+// GetClusterAddonsWithContext calls GetClusterAddonsWithContextFunc.
+func (m *API) GetClusterAddonsWithContext(ctx context.Context, getClusterAddonsOptions *kubernetesserviceapiv1.GetClusterAddonsOptions) ([]kubernetesserviceapiv1.ClusterAddon, *core.DetailedResponse, error) {
+	m.record("GetClusterAddonsWithContext", ctx, getClusterAddonsOptions)
+	if m.GetClusterAddonsWithContextFunc != nil {
+		return m.GetClusterAddonsWithContextFunc(ctx, getClusterAddonsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ClusterAddon
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterAddonsWithContext")
+}
+
+// ManageClusterAddons calls ManageClusterAddonsFunc.
+func (m *API) ManageClusterAddons(manageClusterAddonsOptions *kubernetesserviceapiv1.ManageClusterAddonsOptions) (*kubernetesserviceapiv1.AddonResponse, *core.DetailedResponse, error) {
+	m.record("ManageClusterAddons", manageClusterAddonsOptions)
+	if m.ManageClusterAddonsFunc != nil {
+		return m.ManageClusterAddonsFunc(manageClusterAddonsOptions)
+	}
+	if m.ManageClusterAddonsWithContextFunc != nil {
+		return m.ManageClusterAddonsWithContextFunc(context.Background(), manageClusterAddonsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AddonResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ManageClusterAddons")
+}
+
+// ManageClusterAddonsWithContext calls ManageClusterAddonsWithContextFunc.
+func (m *API) ManageClusterAddonsWithContext(ctx context.Context, manageClusterAddonsOptions *kubernetesserviceapiv1.ManageClusterAddonsOptions) (*kubernetesserviceapiv1.AddonResponse, *core.DetailedResponse, error) {
+	m.record("ManageClusterAddonsWithContext", ctx, manageClusterAddonsOptions)
+	if m.ManageClusterAddonsWithContextFunc != nil {
+		return m.ManageClusterAddonsWithContextFunc(ctx, manageClusterAddonsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AddonResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ManageClusterAddonsWithContext")
+}
+
+// GetClusterConfig calls GetClusterConfigFunc.
+func (m *API) GetClusterConfig(getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions) (*core.DetailedResponse, error) {
+	m.record("GetClusterConfig", getClusterConfigOptions)
+	if m.GetClusterConfigFunc != nil {
+		return m.GetClusterConfigFunc(getClusterConfigOptions)
+	}
+	if m.GetClusterConfigWithContextFunc != nil {
+		return m.GetClusterConfigWithContextFunc(context.Background(), getClusterConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("GetClusterConfig")
+}
+
+// GetClusterConfigWithContext calls GetClusterConfigWithContextFunc.
+func (m *API) GetClusterConfigWithContext(ctx context.Context, getClusterConfigOptions *kubernetesserviceapiv1.GetClusterConfigOptions) (*core.DetailedResponse, error) {
+	m.record("GetClusterConfigWithContext", ctx, getClusterConfigOptions)
+	if m.GetClusterConfigWithContextFunc != nil {
+		return m.GetClusterConfigWithContextFunc(ctx, getClusterConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("GetClusterConfigWithContext")
+}
+
+// CreateKMSConfig calls CreateKMSConfigFunc.
+func (m *API) CreateKMSConfig(createKMSConfigOptions *kubernetesserviceapiv1.CreateKMSConfigOptions) (*core.DetailedResponse, error) {
+	m.record("CreateKMSConfig", createKMSConfigOptions)
+	if m.CreateKMSConfigFunc != nil {
+		return m.CreateKMSConfigFunc(createKMSConfigOptions)
+	}
+	if m.CreateKMSConfigWithContextFunc != nil {
+		return m.CreateKMSConfigWithContextFunc(context.Background(), createKMSConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateKMSConfig")
+}
+
+// CreateKMSConfigWithContext calls CreateKMSConfigWithContextFunc.
+func (m *API) CreateKMSConfigWithContext(ctx context.Context, createKMSConfigOptions *kubernetesserviceapiv1.CreateKMSConfigOptions) (*core.DetailedResponse, error) {
+	m.record("CreateKMSConfigWithContext", ctx, createKMSConfigOptions)
+	if m.CreateKMSConfigWithContextFunc != nil {
+		return m.CreateKMSConfigWithContextFunc(ctx, createKMSConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateKMSConfigWithContext")
+}
+
+// HandleMasterAPIServer calls HandleMasterAPIServerFunc.
+func (m *API) HandleMasterAPIServer(handleMasterAPIServerOptions *kubernetesserviceapiv1.HandleMasterAPIServerOptions) (*core.DetailedResponse, error) {
+	m.record("HandleMasterAPIServer", handleMasterAPIServerOptions)
+	if m.HandleMasterAPIServerFunc != nil {
+		return m.HandleMasterAPIServerFunc(handleMasterAPIServerOptions)
+	}
+	if m.HandleMasterAPIServerWithContextFunc != nil {
+		return m.HandleMasterAPIServerWithContextFunc(context.Background(), handleMasterAPIServerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("HandleMasterAPIServer")
+}
+
+// HandleMasterAPIServerWithContext calls HandleMasterAPIServerWithContextFunc.
+func (m *API) HandleMasterAPIServerWithContext(ctx context.Context, handleMasterAPIServerOptions *kubernetesserviceapiv1.HandleMasterAPIServerOptions) (*core.DetailedResponse, error) {
+	m.record("HandleMasterAPIServerWithContext", ctx, handleMasterAPIServerOptions)
+	if m.HandleMasterAPIServerWithContextFunc != nil {
+		return m.HandleMasterAPIServerWithContextFunc(ctx, handleMasterAPIServerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("HandleMasterAPIServerWithContext")
+}
+
+// ListServicesForAllNamespaces calls ListServicesForAllNamespacesFunc.
+func (m *API) ListServicesForAllNamespaces(listServicesForAllNamespacesOptions *kubernetesserviceapiv1.ListServicesForAllNamespacesOptions) ([]kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error) {
+	m.record("ListServicesForAllNamespaces", listServicesForAllNamespacesOptions)
+	if m.ListServicesForAllNamespacesFunc != nil {
+		return m.ListServicesForAllNamespacesFunc(listServicesForAllNamespacesOptions)
+	}
+	if m.ListServicesForAllNamespacesWithContextFunc != nil {
+		return m.ListServicesForAllNamespacesWithContextFunc(context.Background(), listServicesForAllNamespacesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.BoundService
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListServicesForAllNamespaces")
+}
+
+// ListServicesForAllNamespacesWithContext calls ListServicesForAllNamespacesWithContextFunc.
+func (m *API) ListServicesForAllNamespacesWithContext(ctx context.Context, listServicesForAllNamespacesOptions *kubernetesserviceapiv1.ListServicesForAllNamespacesOptions) ([]kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error) {
+	m.record("ListServicesForAllNamespacesWithContext", ctx, listServicesForAllNamespacesOptions)
+	if m.ListServicesForAllNamespacesWithContextFunc != nil {
+		return m.ListServicesForAllNamespacesWithContextFunc(ctx, listServicesForAllNamespacesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.BoundService
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListServicesForAllNamespacesWithContext")
+}
+
+// BindServiceToNamespace calls BindServiceToNamespaceFunc.
+func (m *API) BindServiceToNamespace(bindServiceToNamespaceOptions *kubernetesserviceapiv1.BindServiceToNamespaceOptions) (*kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error) {
+	m.record("BindServiceToNamespace", bindServiceToNamespaceOptions)
+	if m.BindServiceToNamespaceFunc != nil {
+		return m.BindServiceToNamespaceFunc(bindServiceToNamespaceOptions)
+	}
+	if m.BindServiceToNamespaceWithContextFunc != nil {
+		return m.BindServiceToNamespaceWithContextFunc(context.Background(), bindServiceToNamespaceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.BoundService
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("BindServiceToNamespace")
+}
+
+// BindServiceToNamespaceWithContext calls BindServiceToNamespaceWithContextFunc.
+func (m *API) BindServiceToNamespaceWithContext(ctx context.Context, bindServiceToNamespaceOptions *kubernetesserviceapiv1.BindServiceToNamespaceOptions) (*kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error) {
+	m.record("BindServiceToNamespaceWithContext", ctx, bindServiceToNamespaceOptions)
+	if m.BindServiceToNamespaceWithContextFunc != nil {
+		return m.BindServiceToNamespaceWithContextFunc(ctx, bindServiceToNamespaceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.BoundService
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("BindServiceToNamespaceWithContext")
+}
+
+// ListServicesInNamespace calls ListServicesInNamespaceFunc.
+func (m *API) ListServicesInNamespace(listServicesInNamespaceOptions *kubernetesserviceapiv1.ListServicesInNamespaceOptions) ([]kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error) {
+	m.record("ListServicesInNamespace", listServicesInNamespaceOptions)
+	if m.ListServicesInNamespaceFunc != nil {
+		return m.ListServicesInNamespaceFunc(listServicesInNamespaceOptions)
+	}
+	if m.ListServicesInNamespaceWithContextFunc != nil {
+		return m.ListServicesInNamespaceWithContextFunc(context.Background(), listServicesInNamespaceOptions)
+	}
+	var r0 []kubernetesserviceapiv1.BoundService
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListServicesInNamespace")
+}
+
+// ListServicesInNamespaceWithContext calls ListServicesInNamespaceWithContextFunc.
+func (m *API) ListServicesInNamespaceWithContext(ctx context.Context, listServicesInNamespaceOptions *kubernetesserviceapiv1.ListServicesInNamespaceOptions) ([]kubernetesserviceapiv1.BoundService, *core.DetailedResponse, error) {
+	m.record("ListServicesInNamespaceWithContext", ctx, listServicesInNamespaceOptions)
+	if m.ListServicesInNamespaceWithContextFunc != nil {
+		return m.ListServicesInNamespaceWithContextFunc(ctx, listServicesInNamespaceOptions)
+	}
+	var r0 []kubernetesserviceapiv1.BoundService
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListServicesInNamespaceWithContext")
+}
+
+// UnbindServiceFromNamespace calls UnbindServiceFromNamespaceFunc.
+func (m *API) UnbindServiceFromNamespace(unbindServiceFromNamespaceOptions *kubernetesserviceapiv1.UnbindServiceFromNamespaceOptions) (*core.DetailedResponse, error) {
+	m.record("UnbindServiceFromNamespace", unbindServiceFromNamespaceOptions)
+	if m.UnbindServiceFromNamespaceFunc != nil {
+		return m.UnbindServiceFromNamespaceFunc(unbindServiceFromNamespaceOptions)
+	}
+	if m.UnbindServiceFromNamespaceWithContextFunc != nil {
+		return m.UnbindServiceFromNamespaceWithContextFunc(context.Background(), unbindServiceFromNamespaceOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UnbindServiceFromNamespace")
+}
+
+// UnbindServiceFromNamespaceWithContext calls UnbindServiceFromNamespaceWithContextFunc.
+func (m *API) UnbindServiceFromNamespaceWithContext(ctx context.Context, unbindServiceFromNamespaceOptions *kubernetesserviceapiv1.UnbindServiceFromNamespaceOptions) (*core.DetailedResponse, error) {
+	m.record("UnbindServiceFromNamespaceWithContext", ctx, unbindServiceFromNamespaceOptions)
+	if m.UnbindServiceFromNamespaceWithContextFunc != nil {
+		return m.UnbindServiceFromNamespaceWithContextFunc(ctx, unbindServiceFromNamespaceOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UnbindServiceFromNamespaceWithContext")
+}
+
+// GetClusterSubnets calls GetClusterSubnetsFunc.
+func (m *API) GetClusterSubnets(getClusterSubnetsOptions *kubernetesserviceapiv1.GetClusterSubnetsOptions) ([]kubernetesserviceapiv1.VlanConfigField, *core.DetailedResponse, error) {
+	m.record("GetClusterSubnets", getClusterSubnetsOptions)
+	if m.GetClusterSubnetsFunc != nil {
+		return m.GetClusterSubnetsFunc(getClusterSubnetsOptions)
+	}
+	if m.GetClusterSubnetsWithContextFunc != nil {
+		return m.GetClusterSubnetsWithContextFunc(context.Background(), getClusterSubnetsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.VlanConfigField
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterSubnets")
+}
+
+// GetClusterSubnetsWithContext calls GetClusterSubnetsWithContextFunc.
+func (m *API) GetClusterSubnetsWithContext(ctx context.Context, getClusterSubnetsOptions *kubernetesserviceapiv1.GetClusterSubnetsOptions) ([]kubernetesserviceapiv1.VlanConfigField, *core.DetailedResponse, error) {
+	m.record("GetClusterSubnetsWithContext", ctx, getClusterSubnetsOptions)
+	if m.GetClusterSubnetsWithContextFunc != nil {
+		return m.GetClusterSubnetsWithContextFunc(ctx, getClusterSubnetsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.VlanConfigField
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterSubnetsWithContext")
+}
+
+// AddClusterSubnet calls AddClusterSubnetFunc.
+func (m *API) AddClusterSubnet(addClusterSubnetOptions *kubernetesserviceapiv1.AddClusterSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterSubnet", addClusterSubnetOptions)
+	if m.AddClusterSubnetFunc != nil {
+		return m.AddClusterSubnetFunc(addClusterSubnetOptions)
+	}
+	if m.AddClusterSubnetWithContextFunc != nil {
+		return m.AddClusterSubnetWithContextFunc(context.Background(), addClusterSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterSubnet")
+}
+
+// AddClusterSubnetWithContext calls AddClusterSubnetWithContextFunc.
+func (m *API) AddClusterSubnetWithContext(ctx context.Context, addClusterSubnetOptions *kubernetesserviceapiv1.AddClusterSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterSubnetWithContext", ctx, addClusterSubnetOptions)
+	if m.AddClusterSubnetWithContextFunc != nil {
+		return m.AddClusterSubnetWithContextFunc(ctx, addClusterSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterSubnetWithContext")
+}
+
+// DetachClusterSubnet calls DetachClusterSubnetFunc.
+func (m *API) DetachClusterSubnet(detachClusterSubnetOptions *kubernetesserviceapiv1.DetachClusterSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("DetachClusterSubnet", detachClusterSubnetOptions)
+	if m.DetachClusterSubnetFunc != nil {
+		return m.DetachClusterSubnetFunc(detachClusterSubnetOptions)
+	}
+	if m.DetachClusterSubnetWithContextFunc != nil {
+		return m.DetachClusterSubnetWithContextFunc(context.Background(), detachClusterSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DetachClusterSubnet")
+}
+
+// DetachClusterSubnetWithContext calls DetachClusterSubnetWithContextFunc.
+func (m *API) DetachClusterSubnetWithContext(ctx context.Context, detachClusterSubnetOptions *kubernetesserviceapiv1.DetachClusterSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("DetachClusterSubnetWithContext", ctx, detachClusterSubnetOptions)
+	if m.DetachClusterSubnetWithContextFunc != nil {
+		return m.DetachClusterSubnetWithContextFunc(ctx, detachClusterSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DetachClusterSubnetWithContext")
+}
+
+// GetClusterUserSubnet calls GetClusterUserSubnetFunc.
+func (m *API) GetClusterUserSubnet(getClusterUserSubnetOptions *kubernetesserviceapiv1.GetClusterUserSubnetOptions) ([]kubernetesserviceapiv1.VlanConfigField, *core.DetailedResponse, error) {
+	m.record("GetClusterUserSubnet", getClusterUserSubnetOptions)
+	if m.GetClusterUserSubnetFunc != nil {
+		return m.GetClusterUserSubnetFunc(getClusterUserSubnetOptions)
+	}
+	if m.GetClusterUserSubnetWithContextFunc != nil {
+		return m.GetClusterUserSubnetWithContextFunc(context.Background(), getClusterUserSubnetOptions)
+	}
+	var r0 []kubernetesserviceapiv1.VlanConfigField
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterUserSubnet")
+}
+
+// GetClusterUserSubnetWithContext calls GetClusterUserSubnetWithContextFunc.
+func (m *API) GetClusterUserSubnetWithContext(ctx context.Context, getClusterUserSubnetOptions *kubernetesserviceapiv1.GetClusterUserSubnetOptions) ([]kubernetesserviceapiv1.VlanConfigField, *core.DetailedResponse, error) {
+	m.record("GetClusterUserSubnetWithContext", ctx, getClusterUserSubnetOptions)
+	if m.GetClusterUserSubnetWithContextFunc != nil {
+		return m.GetClusterUserSubnetWithContextFunc(ctx, getClusterUserSubnetOptions)
+	}
+	var r0 []kubernetesserviceapiv1.VlanConfigField
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterUserSubnetWithContext")
+}
+
+// AddClusterUserSubnet calls AddClusterUserSubnetFunc.
+func (m *API) AddClusterUserSubnet(addClusterUserSubnetOptions *kubernetesserviceapiv1.AddClusterUserSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterUserSubnet", addClusterUserSubnetOptions)
+	if m.AddClusterUserSubnetFunc != nil {
+		return m.AddClusterUserSubnetFunc(addClusterUserSubnetOptions)
+	}
+	if m.AddClusterUserSubnetWithContextFunc != nil {
+		return m.AddClusterUserSubnetWithContextFunc(context.Background(), addClusterUserSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterUserSubnet")
+}
+
+// AddClusterUserSubnetWithContext calls AddClusterUserSubnetWithContextFunc.
+func (m *API) AddClusterUserSubnetWithContext(ctx context.Context, addClusterUserSubnetOptions *kubernetesserviceapiv1.AddClusterUserSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterUserSubnetWithContext", ctx, addClusterUserSubnetOptions)
+	if m.AddClusterUserSubnetWithContextFunc != nil {
+		return m.AddClusterUserSubnetWithContextFunc(ctx, addClusterUserSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterUserSubnetWithContext")
+}
+
+// RemoveClusterUserSubnet calls RemoveClusterUserSubnetFunc.
+func (m *API) RemoveClusterUserSubnet(removeClusterUserSubnetOptions *kubernetesserviceapiv1.RemoveClusterUserSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveClusterUserSubnet", removeClusterUserSubnetOptions)
+	if m.RemoveClusterUserSubnetFunc != nil {
+		return m.RemoveClusterUserSubnetFunc(removeClusterUserSubnetOptions)
+	}
+	if m.RemoveClusterUserSubnetWithContextFunc != nil {
+		return m.RemoveClusterUserSubnetWithContextFunc(context.Background(), removeClusterUserSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveClusterUserSubnet")
+}
+
+// RemoveClusterUserSubnetWithContext calls RemoveClusterUserSubnetWithContextFunc.
+func (m *API) RemoveClusterUserSubnetWithContext(ctx context.Context, removeClusterUserSubnetOptions *kubernetesserviceapiv1.RemoveClusterUserSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveClusterUserSubnetWithContext", ctx, removeClusterUserSubnetOptions)
+	if m.RemoveClusterUserSubnetWithContextFunc != nil {
+		return m.RemoveClusterUserSubnetWithContextFunc(ctx, removeClusterUserSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveClusterUserSubnetWithContext")
+}
+
+// CreateClusterSubnet calls CreateClusterSubnetFunc.
+func (m *API) CreateClusterSubnet(createClusterSubnetOptions *kubernetesserviceapiv1.CreateClusterSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("CreateClusterSubnet", createClusterSubnetOptions)
+	if m.CreateClusterSubnetFunc != nil {
+		return m.CreateClusterSubnetFunc(createClusterSubnetOptions)
+	}
+	if m.CreateClusterSubnetWithContextFunc != nil {
+		return m.CreateClusterSubnetWithContextFunc(context.Background(), createClusterSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateClusterSubnet")
+}
+
+// CreateClusterSubnetWithContext calls CreateClusterSubnetWithContextFunc.
+func (m *API) CreateClusterSubnetWithContext(ctx context.Context, createClusterSubnetOptions *kubernetesserviceapiv1.CreateClusterSubnetOptions) (*core.DetailedResponse, error) {
+	m.record("CreateClusterSubnetWithContext", ctx, createClusterSubnetOptions)
+	if m.CreateClusterSubnetWithContextFunc != nil {
+		return m.CreateClusterSubnetWithContextFunc(ctx, createClusterSubnetOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateClusterSubnetWithContext")
+}
+
+// GetClusterWebhooks calls GetClusterWebhooksFunc.
+func (m *API) GetClusterWebhooks(getClusterWebhooksOptions *kubernetesserviceapiv1.GetClusterWebhooksOptions) ([]kubernetesserviceapiv1.ClusterWebhook, *core.DetailedResponse, error) {
+	m.record("GetClusterWebhooks", getClusterWebhooksOptions)
+	if m.GetClusterWebhooksFunc != nil {
+		return m.GetClusterWebhooksFunc(getClusterWebhooksOptions)
+	}
+	if m.GetClusterWebhooksWithContextFunc != nil {
+		return m.GetClusterWebhooksWithContextFunc(context.Background(), getClusterWebhooksOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ClusterWebhook
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterWebhooks")
+}
+
+// GetClusterWebhooksWithContext calls GetClusterWebhooksWithContextFunc.
+func (m *API) GetClusterWebhooksWithContext(ctx context.Context, getClusterWebhooksOptions *kubernetesserviceapiv1.GetClusterWebhooksOptions) ([]kubernetesserviceapiv1.ClusterWebhook, *core.DetailedResponse, error) {
+	m.record("GetClusterWebhooksWithContext", ctx, getClusterWebhooksOptions)
+	if m.GetClusterWebhooksWithContextFunc != nil {
+		return m.GetClusterWebhooksWithContextFunc(ctx, getClusterWebhooksOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ClusterWebhook
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterWebhooksWithContext")
+}
+
+// AddClusterWebhooks calls AddClusterWebhooksFunc.
+func (m *API) AddClusterWebhooks(addClusterWebhooksOptions *kubernetesserviceapiv1.AddClusterWebhooksOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterWebhooks", addClusterWebhooksOptions)
+	if m.AddClusterWebhooksFunc != nil {
+		return m.AddClusterWebhooksFunc(addClusterWebhooksOptions)
+	}
+	if m.AddClusterWebhooksWithContextFunc != nil {
+		return m.AddClusterWebhooksWithContextFunc(context.Background(), addClusterWebhooksOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterWebhooks")
+}
+
+// AddClusterWebhooksWithContext calls AddClusterWebhooksWithContextFunc.
+func (m *API) AddClusterWebhooksWithContext(ctx context.Context, addClusterWebhooksOptions *kubernetesserviceapiv1.AddClusterWebhooksOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterWebhooksWithContext", ctx, addClusterWebhooksOptions)
+	if m.AddClusterWebhooksWithContextFunc != nil {
+		return m.AddClusterWebhooksWithContextFunc(ctx, addClusterWebhooksOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterWebhooksWithContext")
+}
+
+// GetWorkerPools calls GetWorkerPoolsFunc.
+func (m *API) GetWorkerPools(getWorkerPoolsOptions *kubernetesserviceapiv1.GetWorkerPoolsOptions) ([]kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkerPools", getWorkerPoolsOptions)
+	if m.GetWorkerPoolsFunc != nil {
+		return m.GetWorkerPoolsFunc(getWorkerPoolsOptions)
+	}
+	if m.GetWorkerPoolsWithContextFunc != nil {
+		return m.GetWorkerPoolsWithContextFunc(context.Background(), getWorkerPoolsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.WorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkerPools")
+}
+
+// GetWorkerPoolsWithContext calls GetWorkerPoolsWithContextFunc.
+func (m *API) GetWorkerPoolsWithContext(ctx context.Context, getWorkerPoolsOptions *kubernetesserviceapiv1.GetWorkerPoolsOptions) ([]kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkerPoolsWithContext", ctx, getWorkerPoolsOptions)
+	if m.GetWorkerPoolsWithContextFunc != nil {
+		return m.GetWorkerPoolsWithContextFunc(ctx, getWorkerPoolsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.WorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkerPoolsWithContext")
+}
+
+// CreateWorkerPool calls CreateWorkerPoolFunc.
+func (m *API) CreateWorkerPool(createWorkerPoolOptions *kubernetesserviceapiv1.CreateWorkerPoolOptions) (*kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("CreateWorkerPool", createWorkerPoolOptions)
+	if m.CreateWorkerPoolFunc != nil {
+		return m.CreateWorkerPoolFunc(createWorkerPoolOptions)
+	}
+	if m.CreateWorkerPoolWithContextFunc != nil {
+		return m.CreateWorkerPoolWithContextFunc(context.Background(), createWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.WorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateWorkerPool")
+}
+
+// CreateWorkerPoolWithContext calls CreateWorkerPoolWithContextFunc.
+func (m *API) CreateWorkerPoolWithContext(ctx context.Context, createWorkerPoolOptions *kubernetesserviceapiv1.CreateWorkerPoolOptions) (*kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("CreateWorkerPoolWithContext", ctx, createWorkerPoolOptions)
+	if m.CreateWorkerPoolWithContextFunc != nil {
+		return m.CreateWorkerPoolWithContextFunc(ctx, createWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.WorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateWorkerPoolWithContext")
+}
+
+// GetWorkerPool1 calls GetWorkerPool1Func.
+func (m *API) GetWorkerPool1(getWorkerPool1Options *kubernetesserviceapiv1.GetWorkerPool1Options) (*kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkerPool1", getWorkerPool1Options)
+	if m.GetWorkerPool1Func != nil {
+		return m.GetWorkerPool1Func(getWorkerPool1Options)
+	}
+	if m.GetWorkerPool1WithContextFunc != nil {
+		return m.GetWorkerPool1WithContextFunc(context.Background(), getWorkerPool1Options)
+	}
+	var r0 *kubernetesserviceapiv1.WorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkerPool1")
+}
+
+// GetWorkerPool1WithContext calls GetWorkerPool1WithContextFunc.
+func (m *API) GetWorkerPool1WithContext(ctx context.Context, getWorkerPool1Options *kubernetesserviceapiv1.GetWorkerPool1Options) (*kubernetesserviceapiv1.WorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkerPool1WithContext", ctx, getWorkerPool1Options)
+	if m.GetWorkerPool1WithContextFunc != nil {
+		return m.GetWorkerPool1WithContextFunc(ctx, getWorkerPool1Options)
+	}
+	var r0 *kubernetesserviceapiv1.WorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkerPool1WithContext")
+}
+
+// RemoveWorkerPool calls RemoveWorkerPoolFunc.
+func (m *API) RemoveWorkerPool(removeWorkerPoolOptions *kubernetesserviceapiv1.RemoveWorkerPoolOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveWorkerPool", removeWorkerPoolOptions)
+	if m.RemoveWorkerPoolFunc != nil {
+		return m.RemoveWorkerPoolFunc(removeWorkerPoolOptions)
+	}
+	if m.RemoveWorkerPoolWithContextFunc != nil {
+		return m.RemoveWorkerPoolWithContextFunc(context.Background(), removeWorkerPoolOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveWorkerPool")
+}
+
+// RemoveWorkerPoolWithContext calls RemoveWorkerPoolWithContextFunc.
+func (m *API) RemoveWorkerPoolWithContext(ctx context.Context, removeWorkerPoolOptions *kubernetesserviceapiv1.RemoveWorkerPoolOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveWorkerPoolWithContext", ctx, removeWorkerPoolOptions)
+	if m.RemoveWorkerPoolWithContextFunc != nil {
+		return m.RemoveWorkerPoolWithContextFunc(ctx, removeWorkerPoolOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveWorkerPoolWithContext")
+}
+
+// PatchWorkerPool calls PatchWorkerPoolFunc.
+func (m *API) PatchWorkerPool(patchWorkerPoolOptions *kubernetesserviceapiv1.PatchWorkerPoolOptions) (*core.DetailedResponse, error) {
+	m.record("PatchWorkerPool", patchWorkerPoolOptions)
+	if m.PatchWorkerPoolFunc != nil {
+		return m.PatchWorkerPoolFunc(patchWorkerPoolOptions)
+	}
+	if m.PatchWorkerPoolWithContextFunc != nil {
+		return m.PatchWorkerPoolWithContextFunc(context.Background(), patchWorkerPoolOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("PatchWorkerPool")
+}
+
+// PatchWorkerPoolWithContext calls PatchWorkerPoolWithContextFunc.
+func (m *API) PatchWorkerPoolWithContext(ctx context.Context, patchWorkerPoolOptions *kubernetesserviceapiv1.PatchWorkerPoolOptions) (*core.DetailedResponse, error) {
+	m.record("PatchWorkerPoolWithContext", ctx, patchWorkerPoolOptions)
+	if m.PatchWorkerPoolWithContextFunc != nil {
+		return m.PatchWorkerPoolWithContextFunc(ctx, patchWorkerPoolOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("PatchWorkerPoolWithContext")
+}
+
+// AddWorkerPoolZone calls AddWorkerPoolZoneFunc.
+func (m *API) AddWorkerPoolZone(addWorkerPoolZoneOptions *kubernetesserviceapiv1.AddWorkerPoolZoneOptions) (*core.DetailedResponse, error) {
+	m.record("AddWorkerPoolZone", addWorkerPoolZoneOptions)
+	if m.AddWorkerPoolZoneFunc != nil {
+		return m.AddWorkerPoolZoneFunc(addWorkerPoolZoneOptions)
+	}
+	if m.AddWorkerPoolZoneWithContextFunc != nil {
+		return m.AddWorkerPoolZoneWithContextFunc(context.Background(), addWorkerPoolZoneOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddWorkerPoolZone")
+}
+
+// AddWorkerPoolZoneWithContext calls AddWorkerPoolZoneWithContextFunc.
+func (m *API) AddWorkerPoolZoneWithContext(ctx context.Context, addWorkerPoolZoneOptions *kubernetesserviceapiv1.AddWorkerPoolZoneOptions) (*core.DetailedResponse, error) {
+	m.record("AddWorkerPoolZoneWithContext", ctx, addWorkerPoolZoneOptions)
+	if m.AddWorkerPoolZoneWithContextFunc != nil {
+		return m.AddWorkerPoolZoneWithContextFunc(ctx, addWorkerPoolZoneOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddWorkerPoolZoneWithContext")
+}
+
+// RemoveWorkerPoolZone calls RemoveWorkerPoolZoneFunc.
+func (m *API) RemoveWorkerPoolZone(removeWorkerPoolZoneOptions *kubernetesserviceapiv1.RemoveWorkerPoolZoneOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveWorkerPoolZone", removeWorkerPoolZoneOptions)
+	if m.RemoveWorkerPoolZoneFunc != nil {
+		return m.RemoveWorkerPoolZoneFunc(removeWorkerPoolZoneOptions)
+	}
+	if m.RemoveWorkerPoolZoneWithContextFunc != nil {
+		return m.RemoveWorkerPoolZoneWithContextFunc(context.Background(), removeWorkerPoolZoneOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveWorkerPoolZone")
+}
+
+// RemoveWorkerPoolZoneWithContext calls RemoveWorkerPoolZoneWithContextFunc.
+func (m *API) RemoveWorkerPoolZoneWithContext(ctx context.Context, removeWorkerPoolZoneOptions *kubernetesserviceapiv1.RemoveWorkerPoolZoneOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveWorkerPoolZoneWithContext", ctx, removeWorkerPoolZoneOptions)
+	if m.RemoveWorkerPoolZoneWithContextFunc != nil {
+		return m.RemoveWorkerPoolZoneWithContextFunc(ctx, removeWorkerPoolZoneOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveWorkerPoolZoneWithContext")
+}
+
+// AddWorkerPoolZoneNetwork calls AddWorkerPoolZoneNetworkFunc.
+func (m *API) AddWorkerPoolZoneNetwork(addWorkerPoolZoneNetworkOptions *kubernetesserviceapiv1.AddWorkerPoolZoneNetworkOptions) (*core.DetailedResponse, error) {
+	m.record("AddWorkerPoolZoneNetwork", addWorkerPoolZoneNetworkOptions)
+	if m.AddWorkerPoolZoneNetworkFunc != nil {
+		return m.AddWorkerPoolZoneNetworkFunc(addWorkerPoolZoneNetworkOptions)
+	}
+	if m.AddWorkerPoolZoneNetworkWithContextFunc != nil {
+		return m.AddWorkerPoolZoneNetworkWithContextFunc(context.Background(), addWorkerPoolZoneNetworkOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddWorkerPoolZoneNetwork")
+}
+
+// AddWorkerPoolZoneNetworkWithContext calls AddWorkerPoolZoneNetworkWithContextFunc.
+func (m *API) AddWorkerPoolZoneNetworkWithContext(ctx context.Context, addWorkerPoolZoneNetworkOptions *kubernetesserviceapiv1.AddWorkerPoolZoneNetworkOptions) (*core.DetailedResponse, error) {
+	m.record("AddWorkerPoolZoneNetworkWithContext", ctx, addWorkerPoolZoneNetworkOptions)
+	if m.AddWorkerPoolZoneNetworkWithContextFunc != nil {
+		return m.AddWorkerPoolZoneNetworkWithContextFunc(ctx, addWorkerPoolZoneNetworkOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddWorkerPoolZoneNetworkWithContext")
+}
+
+// GetClusterWorkers calls GetClusterWorkersFunc.
+func (m *API) GetClusterWorkers(getClusterWorkersOptions *kubernetesserviceapiv1.GetClusterWorkersOptions) ([]kubernetesserviceapiv1.Worker, *core.DetailedResponse, error) {
+	m.record("GetClusterWorkers", getClusterWorkersOptions)
+	if m.GetClusterWorkersFunc != nil {
+		return m.GetClusterWorkersFunc(getClusterWorkersOptions)
+	}
+	if m.GetClusterWorkersWithContextFunc != nil {
+		return m.GetClusterWorkersWithContextFunc(context.Background(), getClusterWorkersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Worker
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterWorkers")
+}
+
+// GetClusterWorkersWithContext calls GetClusterWorkersWithContextFunc.
+func (m *API) GetClusterWorkersWithContext(ctx context.Context, getClusterWorkersOptions *kubernetesserviceapiv1.GetClusterWorkersOptions) ([]kubernetesserviceapiv1.Worker, *core.DetailedResponse, error) {
+	m.record("GetClusterWorkersWithContext", ctx, getClusterWorkersOptions)
+	if m.GetClusterWorkersWithContextFunc != nil {
+		return m.GetClusterWorkersWithContextFunc(ctx, getClusterWorkersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Worker
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterWorkersWithContext")
+}
+
+// AddClusterWorkers calls AddClusterWorkersFunc.
+func (m *API) AddClusterWorkers(addClusterWorkersOptions *kubernetesserviceapiv1.AddClusterWorkersOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterWorkers", addClusterWorkersOptions)
+	if m.AddClusterWorkersFunc != nil {
+		return m.AddClusterWorkersFunc(addClusterWorkersOptions)
+	}
+	if m.AddClusterWorkersWithContextFunc != nil {
+		return m.AddClusterWorkersWithContextFunc(context.Background(), addClusterWorkersOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterWorkers")
+}
+
+// AddClusterWorkersWithContext calls AddClusterWorkersWithContextFunc.
+func (m *API) AddClusterWorkersWithContext(ctx context.Context, addClusterWorkersOptions *kubernetesserviceapiv1.AddClusterWorkersOptions) (*core.DetailedResponse, error) {
+	m.record("AddClusterWorkersWithContext", ctx, addClusterWorkersOptions)
+	if m.AddClusterWorkersWithContextFunc != nil {
+		return m.AddClusterWorkersWithContextFunc(ctx, addClusterWorkersOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AddClusterWorkersWithContext")
+}
+
+// GetWorkers calls GetWorkersFunc.
+func (m *API) GetWorkers(getWorkersOptions *kubernetesserviceapiv1.GetWorkersOptions) (*kubernetesserviceapiv1.Worker, *core.DetailedResponse, error) {
+	m.record("GetWorkers", getWorkersOptions)
+	if m.GetWorkersFunc != nil {
+		return m.GetWorkersFunc(getWorkersOptions)
+	}
+	if m.GetWorkersWithContextFunc != nil {
+		return m.GetWorkersWithContextFunc(context.Background(), getWorkersOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Worker
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkers")
+}
+
+// GetWorkersWithContext calls GetWorkersWithContextFunc.
+func (m *API) GetWorkersWithContext(ctx context.Context, getWorkersOptions *kubernetesserviceapiv1.GetWorkersOptions) (*kubernetesserviceapiv1.Worker, *core.DetailedResponse, error) {
+	m.record("GetWorkersWithContext", ctx, getWorkersOptions)
+	if m.GetWorkersWithContextFunc != nil {
+		return m.GetWorkersWithContextFunc(ctx, getWorkersOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Worker
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkersWithContext")
+}
+
+// UpdateClusterWorker calls UpdateClusterWorkerFunc.
+func (m *API) UpdateClusterWorker(updateClusterWorkerOptions *kubernetesserviceapiv1.UpdateClusterWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateClusterWorker", updateClusterWorkerOptions)
+	if m.UpdateClusterWorkerFunc != nil {
+		return m.UpdateClusterWorkerFunc(updateClusterWorkerOptions)
+	}
+	if m.UpdateClusterWorkerWithContextFunc != nil {
+		return m.UpdateClusterWorkerWithContextFunc(context.Background(), updateClusterWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateClusterWorker")
+}
+
+// UpdateClusterWorkerWithContext calls UpdateClusterWorkerWithContextFunc.
+func (m *API) UpdateClusterWorkerWithContext(ctx context.Context, updateClusterWorkerOptions *kubernetesserviceapiv1.UpdateClusterWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateClusterWorkerWithContext", ctx, updateClusterWorkerOptions)
+	if m.UpdateClusterWorkerWithContextFunc != nil {
+		return m.UpdateClusterWorkerWithContextFunc(ctx, updateClusterWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateClusterWorkerWithContext")
+}
+
+// RemoveClusterWorker calls RemoveClusterWorkerFunc.
+func (m *API) RemoveClusterWorker(removeClusterWorkerOptions *kubernetesserviceapiv1.RemoveClusterWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveClusterWorker", removeClusterWorkerOptions)
+	if m.RemoveClusterWorkerFunc != nil {
+		return m.RemoveClusterWorkerFunc(removeClusterWorkerOptions)
+	}
+	if m.RemoveClusterWorkerWithContextFunc != nil {
+		return m.RemoveClusterWorkerWithContextFunc(context.Background(), removeClusterWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveClusterWorker")
+}
+
+// RemoveClusterWorkerWithContext calls RemoveClusterWorkerWithContextFunc.
+func (m *API) RemoveClusterWorkerWithContext(ctx context.Context, removeClusterWorkerOptions *kubernetesserviceapiv1.RemoveClusterWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveClusterWorkerWithContext", ctx, removeClusterWorkerOptions)
+	if m.RemoveClusterWorkerWithContextFunc != nil {
+		return m.RemoveClusterWorkerWithContextFunc(ctx, removeClusterWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveClusterWorkerWithContext")
+}
+
+// GetUserConfig calls GetUserConfigFunc.
+func (m *API) GetUserConfig(getUserConfigOptions *kubernetesserviceapiv1.GetUserConfigOptions) (*kubernetesserviceapiv1.UserConfig, *core.DetailedResponse, error) {
+	m.record("GetUserConfig", getUserConfigOptions)
+	if m.GetUserConfigFunc != nil {
+		return m.GetUserConfigFunc(getUserConfigOptions)
+	}
+	if m.GetUserConfigWithContextFunc != nil {
+		return m.GetUserConfigWithContextFunc(context.Background(), getUserConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UserConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetUserConfig")
+}
+
+// GetUserConfigWithContext calls GetUserConfigWithContextFunc.
+func (m *API) GetUserConfigWithContext(ctx context.Context, getUserConfigOptions *kubernetesserviceapiv1.GetUserConfigOptions) (*kubernetesserviceapiv1.UserConfig, *core.DetailedResponse, error) {
+	m.record("GetUserConfigWithContext", ctx, getUserConfigOptions)
+	if m.GetUserConfigWithContextFunc != nil {
+		return m.GetUserConfigWithContextFunc(ctx, getUserConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UserConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetUserConfigWithContext")
+}
+
+// V2DisableImageSecurity calls V2DisableImageSecurityFunc.
+func (m *API) V2DisableImageSecurity(v2DisableImageSecurityOptions *kubernetesserviceapiv1.V2DisableImageSecurityOptions) (*core.DetailedResponse, error) {
+	m.record("V2DisableImageSecurity", v2DisableImageSecurityOptions)
+	if m.V2DisableImageSecurityFunc != nil {
+		return m.V2DisableImageSecurityFunc(v2DisableImageSecurityOptions)
+	}
+	if m.V2DisableImageSecurityWithContextFunc != nil {
+		return m.V2DisableImageSecurityWithContextFunc(context.Background(), v2DisableImageSecurityOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2DisableImageSecurity")
+}
+
+// V2DisableImageSecurityWithContext calls V2DisableImageSecurityWithContextFunc.
+func (m *API) V2DisableImageSecurityWithContext(ctx context.Context, v2DisableImageSecurityOptions *kubernetesserviceapiv1.V2DisableImageSecurityOptions) (*core.DetailedResponse, error) {
+	m.record("V2DisableImageSecurityWithContext", ctx, v2DisableImageSecurityOptions)
+	if m.V2DisableImageSecurityWithContextFunc != nil {
+		return m.V2DisableImageSecurityWithContextFunc(ctx, v2DisableImageSecurityOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2DisableImageSecurityWithContext")
+}
+
+// V2EnableImageSecurity calls V2EnableImageSecurityFunc.
+func (m *API) V2EnableImageSecurity(v2EnableImageSecurityOptions *kubernetesserviceapiv1.V2EnableImageSecurityOptions) (*core.DetailedResponse, error) {
+	m.record("V2EnableImageSecurity", v2EnableImageSecurityOptions)
+	if m.V2EnableImageSecurityFunc != nil {
+		return m.V2EnableImageSecurityFunc(v2EnableImageSecurityOptions)
+	}
+	if m.V2EnableImageSecurityWithContextFunc != nil {
+		return m.V2EnableImageSecurityWithContextFunc(context.Background(), v2EnableImageSecurityOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2EnableImageSecurity")
+}
+
+// V2EnableImageSecurityWithContext calls V2EnableImageSecurityWithContextFunc.
+func (m *API) V2EnableImageSecurityWithContext(ctx context.Context, v2EnableImageSecurityOptions *kubernetesserviceapiv1.V2EnableImageSecurityOptions) (*core.DetailedResponse, error) {
+	m.record("V2EnableImageSecurityWithContext", ctx, v2EnableImageSecurityOptions)
+	if m.V2EnableImageSecurityWithContextFunc != nil {
+		return m.V2EnableImageSecurityWithContextFunc(ctx, v2EnableImageSecurityOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2EnableImageSecurityWithContext")
+}
+
+// FetchFilterConfigs calls FetchFilterConfigsFunc.
+func (m *API) FetchFilterConfigs(fetchFilterConfigsOptions *kubernetesserviceapiv1.FetchFilterConfigsOptions) ([]kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error) {
+	m.record("FetchFilterConfigs", fetchFilterConfigsOptions)
+	if m.FetchFilterConfigsFunc != nil {
+		return m.FetchFilterConfigsFunc(fetchFilterConfigsOptions)
+	}
+	if m.FetchFilterConfigsWithContextFunc != nil {
+		return m.FetchFilterConfigsWithContextFunc(context.Background(), fetchFilterConfigsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.FilterConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("FetchFilterConfigs")
+}
+
+// FetchFilterConfigsWithContext calls FetchFilterConfigsWithContextFunc.
+func (m *API) FetchFilterConfigsWithContext(ctx context.Context, fetchFilterConfigsOptions *kubernetesserviceapiv1.FetchFilterConfigsOptions) ([]kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error) {
+	m.record("FetchFilterConfigsWithContext", ctx, fetchFilterConfigsOptions)
+	if m.FetchFilterConfigsWithContextFunc != nil {
+		return m.FetchFilterConfigsWithContextFunc(ctx, fetchFilterConfigsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.FilterConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("FetchFilterConfigsWithContext")
+}
+
+// CreateFilterConfig calls CreateFilterConfigFunc.
+func (m *API) CreateFilterConfig(createFilterConfigOptions *kubernetesserviceapiv1.CreateFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error) {
+	m.record("CreateFilterConfig", createFilterConfigOptions)
+	if m.CreateFilterConfigFunc != nil {
+		return m.CreateFilterConfigFunc(createFilterConfigOptions)
+	}
+	if m.CreateFilterConfigWithContextFunc != nil {
+		return m.CreateFilterConfigWithContextFunc(context.Background(), createFilterConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.FilterConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateFilterConfig")
+}
+
+// CreateFilterConfigWithContext calls CreateFilterConfigWithContextFunc.
+func (m *API) CreateFilterConfigWithContext(ctx context.Context, createFilterConfigOptions *kubernetesserviceapiv1.CreateFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error) {
+	m.record("CreateFilterConfigWithContext", ctx, createFilterConfigOptions)
+	if m.CreateFilterConfigWithContextFunc != nil {
+		return m.CreateFilterConfigWithContextFunc(ctx, createFilterConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.FilterConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateFilterConfigWithContext")
+}
+
+// DeleteFilterConfigs calls DeleteFilterConfigsFunc.
+func (m *API) DeleteFilterConfigs(deleteFilterConfigsOptions *kubernetesserviceapiv1.DeleteFilterConfigsOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteFilterConfigs", deleteFilterConfigsOptions)
+	if m.DeleteFilterConfigsFunc != nil {
+		return m.DeleteFilterConfigsFunc(deleteFilterConfigsOptions)
+	}
+	if m.DeleteFilterConfigsWithContextFunc != nil {
+		return m.DeleteFilterConfigsWithContextFunc(context.Background(), deleteFilterConfigsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteFilterConfigs")
+}
+
+// DeleteFilterConfigsWithContext calls DeleteFilterConfigsWithContextFunc.
+func (m *API) DeleteFilterConfigsWithContext(ctx context.Context, deleteFilterConfigsOptions *kubernetesserviceapiv1.DeleteFilterConfigsOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteFilterConfigsWithContext", ctx, deleteFilterConfigsOptions)
+	if m.DeleteFilterConfigsWithContextFunc != nil {
+		return m.DeleteFilterConfigsWithContextFunc(ctx, deleteFilterConfigsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteFilterConfigsWithContext")
+}
+
+// FetchFilterConfig calls FetchFilterConfigFunc.
+func (m *API) FetchFilterConfig(fetchFilterConfigOptions *kubernetesserviceapiv1.FetchFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error) {
+	m.record("FetchFilterConfig", fetchFilterConfigOptions)
+	if m.FetchFilterConfigFunc != nil {
+		return m.FetchFilterConfigFunc(fetchFilterConfigOptions)
+	}
+	if m.FetchFilterConfigWithContextFunc != nil {
+		return m.FetchFilterConfigWithContextFunc(context.Background(), fetchFilterConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.FilterConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("FetchFilterConfig")
+}
+
+// FetchFilterConfigWithContext calls FetchFilterConfigWithContextFunc.
+func (m *API) FetchFilterConfigWithContext(ctx context.Context, fetchFilterConfigOptions *kubernetesserviceapiv1.FetchFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error) {
+	m.record("FetchFilterConfigWithContext", ctx, fetchFilterConfigOptions)
+	if m.FetchFilterConfigWithContextFunc != nil {
+		return m.FetchFilterConfigWithContextFunc(ctx, fetchFilterConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.FilterConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("FetchFilterConfigWithContext")
+}
+
+// UpdateFilterConfig calls UpdateFilterConfigFunc.
+func (m *API) UpdateFilterConfig(updateFilterConfigOptions *kubernetesserviceapiv1.UpdateFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error) {
+	m.record("UpdateFilterConfig", updateFilterConfigOptions)
+	if m.UpdateFilterConfigFunc != nil {
+		return m.UpdateFilterConfigFunc(updateFilterConfigOptions)
+	}
+	if m.UpdateFilterConfigWithContextFunc != nil {
+		return m.UpdateFilterConfigWithContextFunc(context.Background(), updateFilterConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.FilterConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateFilterConfig")
+}
+
+// UpdateFilterConfigWithContext calls UpdateFilterConfigWithContextFunc.
+func (m *API) UpdateFilterConfigWithContext(ctx context.Context, updateFilterConfigOptions *kubernetesserviceapiv1.UpdateFilterConfigOptions) (*kubernetesserviceapiv1.FilterConfigResponse, *core.DetailedResponse, error) {
+	m.record("UpdateFilterConfigWithContext", ctx, updateFilterConfigOptions)
+	if m.UpdateFilterConfigWithContextFunc != nil {
+		return m.UpdateFilterConfigWithContextFunc(ctx, updateFilterConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.FilterConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateFilterConfigWithContext")
+}
+
+// DeleteFilterConfig calls DeleteFilterConfigFunc.
+func (m *API) DeleteFilterConfig(deleteFilterConfigOptions *kubernetesserviceapiv1.DeleteFilterConfigOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteFilterConfig", deleteFilterConfigOptions)
+	if m.DeleteFilterConfigFunc != nil {
+		return m.DeleteFilterConfigFunc(deleteFilterConfigOptions)
+	}
+	if m.DeleteFilterConfigWithContextFunc != nil {
+		return m.DeleteFilterConfigWithContextFunc(context.Background(), deleteFilterConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteFilterConfig")
+}
+
+// DeleteFilterConfigWithContext calls DeleteFilterConfigWithContextFunc.
+func (m *API) DeleteFilterConfigWithContext(ctx context.Context, deleteFilterConfigOptions *kubernetesserviceapiv1.DeleteFilterConfigOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteFilterConfigWithContext", ctx, deleteFilterConfigOptions)
+	if m.DeleteFilterConfigWithContextFunc != nil {
+		return m.DeleteFilterConfigWithContextFunc(ctx, deleteFilterConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteFilterConfigWithContext")
+}
+
+// GetMasterLogCollectionStatus calls GetMasterLogCollectionStatusFunc.
+func (m *API) GetMasterLogCollectionStatus(getMasterLogCollectionStatusOptions *kubernetesserviceapiv1.GetMasterLogCollectionStatusOptions) ([]kubernetesserviceapiv1.MasterLogCollectionStatusResponseBody, *core.DetailedResponse, error) {
+	m.record("GetMasterLogCollectionStatus", getMasterLogCollectionStatusOptions)
+	if m.GetMasterLogCollectionStatusFunc != nil {
+		return m.GetMasterLogCollectionStatusFunc(getMasterLogCollectionStatusOptions)
+	}
+	if m.GetMasterLogCollectionStatusWithContextFunc != nil {
+		return m.GetMasterLogCollectionStatusWithContextFunc(context.Background(), getMasterLogCollectionStatusOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MasterLogCollectionStatusResponseBody
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMasterLogCollectionStatus")
+}
+
+// GetMasterLogCollectionStatusWithContext calls GetMasterLogCollectionStatusWithContextFunc.
+func (m *API) GetMasterLogCollectionStatusWithContext(ctx context.Context, getMasterLogCollectionStatusOptions *kubernetesserviceapiv1.GetMasterLogCollectionStatusOptions) ([]kubernetesserviceapiv1.MasterLogCollectionStatusResponseBody, *core.DetailedResponse, error) {
+	m.record("GetMasterLogCollectionStatusWithContext", ctx, getMasterLogCollectionStatusOptions)
+	if m.GetMasterLogCollectionStatusWithContextFunc != nil {
+		return m.GetMasterLogCollectionStatusWithContextFunc(ctx, getMasterLogCollectionStatusOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MasterLogCollectionStatusResponseBody
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMasterLogCollectionStatusWithContext")
+}
+
+// CreateMasterLogCollection calls CreateMasterLogCollectionFunc.
+func (m *API) CreateMasterLogCollection(createMasterLogCollectionOptions *kubernetesserviceapiv1.CreateMasterLogCollectionOptions) (*core.DetailedResponse, error) {
+	m.record("CreateMasterLogCollection", createMasterLogCollectionOptions)
+	if m.CreateMasterLogCollectionFunc != nil {
+		return m.CreateMasterLogCollectionFunc(createMasterLogCollectionOptions)
+	}
+	if m.CreateMasterLogCollectionWithContextFunc != nil {
+		return m.CreateMasterLogCollectionWithContextFunc(context.Background(), createMasterLogCollectionOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateMasterLogCollection")
+}
+
+// CreateMasterLogCollectionWithContext calls CreateMasterLogCollectionWithContextFunc.
+func (m *API) CreateMasterLogCollectionWithContext(ctx context.Context, createMasterLogCollectionOptions *kubernetesserviceapiv1.CreateMasterLogCollectionOptions) (*core.DetailedResponse, error) {
+	m.record("CreateMasterLogCollectionWithContext", ctx, createMasterLogCollectionOptions)
+	if m.CreateMasterLogCollectionWithContextFunc != nil {
+		return m.CreateMasterLogCollectionWithContextFunc(ctx, createMasterLogCollectionOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateMasterLogCollectionWithContext")
+}
+
+// GetClusterKeyOwner calls GetClusterKeyOwnerFunc.
+func (m *API) GetClusterKeyOwner(getClusterKeyOwnerOptions *kubernetesserviceapiv1.GetClusterKeyOwnerOptions) (*kubernetesserviceapiv1.ClusterKeyOwnerInfo, *core.DetailedResponse, error) {
+	m.record("GetClusterKeyOwner", getClusterKeyOwnerOptions)
+	if m.GetClusterKeyOwnerFunc != nil {
+		return m.GetClusterKeyOwnerFunc(getClusterKeyOwnerOptions)
+	}
+	if m.GetClusterKeyOwnerWithContextFunc != nil {
+		return m.GetClusterKeyOwnerWithContextFunc(context.Background(), getClusterKeyOwnerOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterKeyOwnerInfo
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterKeyOwner")
+}
+
+// GetClusterKeyOwnerWithContext calls GetClusterKeyOwnerWithContextFunc.
+func (m *API) GetClusterKeyOwnerWithContext(ctx context.Context, getClusterKeyOwnerOptions *kubernetesserviceapiv1.GetClusterKeyOwnerOptions) (*kubernetesserviceapiv1.ClusterKeyOwnerInfo, *core.DetailedResponse, error) {
+	m.record("GetClusterKeyOwnerWithContext", ctx, getClusterKeyOwnerOptions)
+	if m.GetClusterKeyOwnerWithContextFunc != nil {
+		return m.GetClusterKeyOwnerWithContextFunc(ctx, getClusterKeyOwnerOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterKeyOwnerInfo
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterKeyOwnerWithContext")
+}
+
+// GetDefaultLoggingEndpoint calls GetDefaultLoggingEndpointFunc.
+func (m *API) GetDefaultLoggingEndpoint(getDefaultLoggingEndpointOptions *kubernetesserviceapiv1.GetDefaultLoggingEndpointOptions) (*kubernetesserviceapiv1.DefaultLoggingEndpointResponse, *core.DetailedResponse, error) {
+	m.record("GetDefaultLoggingEndpoint", getDefaultLoggingEndpointOptions)
+	if m.GetDefaultLoggingEndpointFunc != nil {
+		return m.GetDefaultLoggingEndpointFunc(getDefaultLoggingEndpointOptions)
+	}
+	if m.GetDefaultLoggingEndpointWithContextFunc != nil {
+		return m.GetDefaultLoggingEndpointWithContextFunc(context.Background(), getDefaultLoggingEndpointOptions)
+	}
+	var r0 *kubernetesserviceapiv1.DefaultLoggingEndpointResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetDefaultLoggingEndpoint")
+}
+
+// GetDefaultLoggingEndpointWithContext calls GetDefaultLoggingEndpointWithContextFunc.
+func (m *API) GetDefaultLoggingEndpointWithContext(ctx context.Context, getDefaultLoggingEndpointOptions *kubernetesserviceapiv1.GetDefaultLoggingEndpointOptions) (*kubernetesserviceapiv1.DefaultLoggingEndpointResponse, *core.DetailedResponse, error) {
+	m.record("GetDefaultLoggingEndpointWithContext", ctx, getDefaultLoggingEndpointOptions)
+	if m.GetDefaultLoggingEndpointWithContextFunc != nil {
+		return m.GetDefaultLoggingEndpointWithContextFunc(ctx, getDefaultLoggingEndpointOptions)
+	}
+	var r0 *kubernetesserviceapiv1.DefaultLoggingEndpointResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetDefaultLoggingEndpointWithContext")
+}
+
+// FetchLoggingConfigs calls FetchLoggingConfigsFunc.
+func (m *API) FetchLoggingConfigs(fetchLoggingConfigsOptions *kubernetesserviceapiv1.FetchLoggingConfigsOptions) ([]kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error) {
+	m.record("FetchLoggingConfigs", fetchLoggingConfigsOptions)
+	if m.FetchLoggingConfigsFunc != nil {
+		return m.FetchLoggingConfigsFunc(fetchLoggingConfigsOptions)
+	}
+	if m.FetchLoggingConfigsWithContextFunc != nil {
+		return m.FetchLoggingConfigsWithContextFunc(context.Background(), fetchLoggingConfigsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.LogConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("FetchLoggingConfigs")
+}
+
+// FetchLoggingConfigsWithContext calls FetchLoggingConfigsWithContextFunc.
+func (m *API) FetchLoggingConfigsWithContext(ctx context.Context, fetchLoggingConfigsOptions *kubernetesserviceapiv1.FetchLoggingConfigsOptions) ([]kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error) {
+	m.record("FetchLoggingConfigsWithContext", ctx, fetchLoggingConfigsOptions)
+	if m.FetchLoggingConfigsWithContextFunc != nil {
+		return m.FetchLoggingConfigsWithContextFunc(ctx, fetchLoggingConfigsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.LogConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("FetchLoggingConfigsWithContext")
+}
+
+// DeleteLoggingConfigs calls DeleteLoggingConfigsFunc.
+func (m *API) DeleteLoggingConfigs(deleteLoggingConfigsOptions *kubernetesserviceapiv1.DeleteLoggingConfigsOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteLoggingConfigs", deleteLoggingConfigsOptions)
+	if m.DeleteLoggingConfigsFunc != nil {
+		return m.DeleteLoggingConfigsFunc(deleteLoggingConfigsOptions)
+	}
+	if m.DeleteLoggingConfigsWithContextFunc != nil {
+		return m.DeleteLoggingConfigsWithContextFunc(context.Background(), deleteLoggingConfigsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteLoggingConfigs")
+}
+
+// DeleteLoggingConfigsWithContext calls DeleteLoggingConfigsWithContextFunc.
+func (m *API) DeleteLoggingConfigsWithContext(ctx context.Context, deleteLoggingConfigsOptions *kubernetesserviceapiv1.DeleteLoggingConfigsOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteLoggingConfigsWithContext", ctx, deleteLoggingConfigsOptions)
+	if m.DeleteLoggingConfigsWithContextFunc != nil {
+		return m.DeleteLoggingConfigsWithContextFunc(ctx, deleteLoggingConfigsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteLoggingConfigsWithContext")
+}
+
+// FetchLoggingConfigsForSource calls FetchLoggingConfigsForSourceFunc.
+func (m *API) FetchLoggingConfigsForSource(fetchLoggingConfigsForSourceOptions *kubernetesserviceapiv1.FetchLoggingConfigsForSourceOptions) ([]kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error) {
+	m.record("FetchLoggingConfigsForSource", fetchLoggingConfigsForSourceOptions)
+	if m.FetchLoggingConfigsForSourceFunc != nil {
+		return m.FetchLoggingConfigsForSourceFunc(fetchLoggingConfigsForSourceOptions)
+	}
+	if m.FetchLoggingConfigsForSourceWithContextFunc != nil {
+		return m.FetchLoggingConfigsForSourceWithContextFunc(context.Background(), fetchLoggingConfigsForSourceOptions)
+	}
+	var r0 []kubernetesserviceapiv1.LogConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("FetchLoggingConfigsForSource")
+}
+
+// FetchLoggingConfigsForSourceWithContext calls FetchLoggingConfigsForSourceWithContextFunc.
+func (m *API) FetchLoggingConfigsForSourceWithContext(ctx context.Context, fetchLoggingConfigsForSourceOptions *kubernetesserviceapiv1.FetchLoggingConfigsForSourceOptions) ([]kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error) {
+	m.record("FetchLoggingConfigsForSourceWithContext", ctx, fetchLoggingConfigsForSourceOptions)
+	if m.FetchLoggingConfigsForSourceWithContextFunc != nil {
+		return m.FetchLoggingConfigsForSourceWithContextFunc(ctx, fetchLoggingConfigsForSourceOptions)
+	}
+	var r0 []kubernetesserviceapiv1.LogConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("FetchLoggingConfigsForSourceWithContext")
+}
+
+// CreateLoggingConfig calls CreateLoggingConfigFunc.
+func (m *API) CreateLoggingConfig(createLoggingConfigOptions *kubernetesserviceapiv1.CreateLoggingConfigOptions) (*kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error) {
+	m.record("CreateLoggingConfig", createLoggingConfigOptions)
+	if m.CreateLoggingConfigFunc != nil {
+		return m.CreateLoggingConfigFunc(createLoggingConfigOptions)
+	}
+	if m.CreateLoggingConfigWithContextFunc != nil {
+		return m.CreateLoggingConfigWithContextFunc(context.Background(), createLoggingConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.LogConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateLoggingConfig")
+}
+
+// CreateLoggingConfigWithContext calls CreateLoggingConfigWithContextFunc.
+func (m *API) CreateLoggingConfigWithContext(ctx context.Context, createLoggingConfigOptions *kubernetesserviceapiv1.CreateLoggingConfigOptions) (*kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error) {
+	m.record("CreateLoggingConfigWithContext", ctx, createLoggingConfigOptions)
+	if m.CreateLoggingConfigWithContextFunc != nil {
+		return m.CreateLoggingConfigWithContextFunc(ctx, createLoggingConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.LogConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateLoggingConfigWithContext")
+}
+
+// UpdateLoggingConfig calls UpdateLoggingConfigFunc.
+func (m *API) UpdateLoggingConfig(updateLoggingConfigOptions *kubernetesserviceapiv1.UpdateLoggingConfigOptions) (*kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error) {
+	m.record("UpdateLoggingConfig", updateLoggingConfigOptions)
+	if m.UpdateLoggingConfigFunc != nil {
+		return m.UpdateLoggingConfigFunc(updateLoggingConfigOptions)
+	}
+	if m.UpdateLoggingConfigWithContextFunc != nil {
+		return m.UpdateLoggingConfigWithContextFunc(context.Background(), updateLoggingConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.LogConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateLoggingConfig")
+}
+
+// UpdateLoggingConfigWithContext calls UpdateLoggingConfigWithContextFunc.
+func (m *API) UpdateLoggingConfigWithContext(ctx context.Context, updateLoggingConfigOptions *kubernetesserviceapiv1.UpdateLoggingConfigOptions) (*kubernetesserviceapiv1.LogConfigResponse, *core.DetailedResponse, error) {
+	m.record("UpdateLoggingConfigWithContext", ctx, updateLoggingConfigOptions)
+	if m.UpdateLoggingConfigWithContextFunc != nil {
+		return m.UpdateLoggingConfigWithContextFunc(ctx, updateLoggingConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.LogConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateLoggingConfigWithContext")
+}
+
+// DeleteLoggingConfig calls DeleteLoggingConfigFunc.
+func (m *API) DeleteLoggingConfig(deleteLoggingConfigOptions *kubernetesserviceapiv1.DeleteLoggingConfigOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteLoggingConfig", deleteLoggingConfigOptions)
+	if m.DeleteLoggingConfigFunc != nil {
+		return m.DeleteLoggingConfigFunc(deleteLoggingConfigOptions)
+	}
+	if m.DeleteLoggingConfigWithContextFunc != nil {
+		return m.DeleteLoggingConfigWithContextFunc(context.Background(), deleteLoggingConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteLoggingConfig")
+}
+
+// DeleteLoggingConfigWithContext calls DeleteLoggingConfigWithContextFunc.
+func (m *API) DeleteLoggingConfigWithContext(ctx context.Context, deleteLoggingConfigOptions *kubernetesserviceapiv1.DeleteLoggingConfigOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteLoggingConfigWithContext", ctx, deleteLoggingConfigOptions)
+	if m.DeleteLoggingConfigWithContextFunc != nil {
+		return m.DeleteLoggingConfigWithContextFunc(ctx, deleteLoggingConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteLoggingConfigWithContext")
+}
+
+// RefreshLoggingConfig calls RefreshLoggingConfigFunc.
+func (m *API) RefreshLoggingConfig(refreshLoggingConfigOptions *kubernetesserviceapiv1.RefreshLoggingConfigOptions) (*core.DetailedResponse, error) {
+	m.record("RefreshLoggingConfig", refreshLoggingConfigOptions)
+	if m.RefreshLoggingConfigFunc != nil {
+		return m.RefreshLoggingConfigFunc(refreshLoggingConfigOptions)
+	}
+	if m.RefreshLoggingConfigWithContextFunc != nil {
+		return m.RefreshLoggingConfigWithContextFunc(context.Background(), refreshLoggingConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RefreshLoggingConfig")
+}
+
+// RefreshLoggingConfigWithContext calls RefreshLoggingConfigWithContextFunc.
+func (m *API) RefreshLoggingConfigWithContext(ctx context.Context, refreshLoggingConfigOptions *kubernetesserviceapiv1.RefreshLoggingConfigOptions) (*core.DetailedResponse, error) {
+	m.record("RefreshLoggingConfigWithContext", ctx, refreshLoggingConfigOptions)
+	if m.RefreshLoggingConfigWithContextFunc != nil {
+		return m.RefreshLoggingConfigWithContextFunc(ctx, refreshLoggingConfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RefreshLoggingConfigWithContext")
+}
+
+// GetFluentdUpdatePolicy calls GetFluentdUpdatePolicyFunc.
+func (m *API) GetFluentdUpdatePolicy(getFluentdUpdatePolicyOptions *kubernetesserviceapiv1.GetFluentdUpdatePolicyOptions) (*kubernetesserviceapiv1.UpdatePolicy, *core.DetailedResponse, error) {
+	m.record("GetFluentdUpdatePolicy", getFluentdUpdatePolicyOptions)
+	if m.GetFluentdUpdatePolicyFunc != nil {
+		return m.GetFluentdUpdatePolicyFunc(getFluentdUpdatePolicyOptions)
+	}
+	if m.GetFluentdUpdatePolicyWithContextFunc != nil {
+		return m.GetFluentdUpdatePolicyWithContextFunc(context.Background(), getFluentdUpdatePolicyOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UpdatePolicy
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetFluentdUpdatePolicy")
+}
+
+// GetFluentdUpdatePolicyWithContext calls GetFluentdUpdatePolicyWithContextFunc.
+func (m *API) GetFluentdUpdatePolicyWithContext(ctx context.Context, getFluentdUpdatePolicyOptions *kubernetesserviceapiv1.GetFluentdUpdatePolicyOptions) (*kubernetesserviceapiv1.UpdatePolicy, *core.DetailedResponse, error) {
+	m.record("GetFluentdUpdatePolicyWithContext", ctx, getFluentdUpdatePolicyOptions)
+	if m.GetFluentdUpdatePolicyWithContextFunc != nil {
+		return m.GetFluentdUpdatePolicyWithContextFunc(ctx, getFluentdUpdatePolicyOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UpdatePolicy
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetFluentdUpdatePolicyWithContext")
+}
+
+// ChangeFluentdUpdatePolicy calls ChangeFluentdUpdatePolicyFunc.
+func (m *API) ChangeFluentdUpdatePolicy(changeFluentdUpdatePolicyOptions *kubernetesserviceapiv1.ChangeFluentdUpdatePolicyOptions) (*core.DetailedResponse, error) {
+	m.record("ChangeFluentdUpdatePolicy", changeFluentdUpdatePolicyOptions)
+	if m.ChangeFluentdUpdatePolicyFunc != nil {
+		return m.ChangeFluentdUpdatePolicyFunc(changeFluentdUpdatePolicyOptions)
+	}
+	if m.ChangeFluentdUpdatePolicyWithContextFunc != nil {
+		return m.ChangeFluentdUpdatePolicyWithContextFunc(context.Background(), changeFluentdUpdatePolicyOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ChangeFluentdUpdatePolicy")
+}
+
+// ChangeFluentdUpdatePolicyWithContext calls ChangeFluentdUpdatePolicyWithContextFunc.
+func (m *API) ChangeFluentdUpdatePolicyWithContext(ctx context.Context, changeFluentdUpdatePolicyOptions *kubernetesserviceapiv1.ChangeFluentdUpdatePolicyOptions) (*core.DetailedResponse, error) {
+	m.record("ChangeFluentdUpdatePolicyWithContext", ctx, changeFluentdUpdatePolicyOptions)
+	if m.ChangeFluentdUpdatePolicyWithContextFunc != nil {
+		return m.ChangeFluentdUpdatePolicyWithContextFunc(ctx, changeFluentdUpdatePolicyOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ChangeFluentdUpdatePolicyWithContext")
+}
+
+// CreateLoggingInstance calls CreateLoggingInstanceFunc.
+func (m *API) CreateLoggingInstance(createLoggingInstanceOptions *kubernetesserviceapiv1.CreateLoggingInstanceOptions) (*kubernetesserviceapiv1.ConfigResponse, *core.DetailedResponse, error) {
+	m.record("CreateLoggingInstance", createLoggingInstanceOptions)
+	if m.CreateLoggingInstanceFunc != nil {
+		return m.CreateLoggingInstanceFunc(createLoggingInstanceOptions)
+	}
+	if m.CreateLoggingInstanceWithContextFunc != nil {
+		return m.CreateLoggingInstanceWithContextFunc(context.Background(), createLoggingInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateLoggingInstance")
+}
+
+// CreateLoggingInstanceWithContext calls CreateLoggingInstanceWithContextFunc.
+func (m *API) CreateLoggingInstanceWithContext(ctx context.Context, createLoggingInstanceOptions *kubernetesserviceapiv1.CreateLoggingInstanceOptions) (*kubernetesserviceapiv1.ConfigResponse, *core.DetailedResponse, error) {
+	m.record("CreateLoggingInstanceWithContext", ctx, createLoggingInstanceOptions)
+	if m.CreateLoggingInstanceWithContextFunc != nil {
+		return m.CreateLoggingInstanceWithContextFunc(ctx, createLoggingInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateLoggingInstanceWithContext")
+}
+
+// DiscoverLoggingInstance calls DiscoverLoggingInstanceFunc.
+func (m *API) DiscoverLoggingInstance(discoverLoggingInstanceOptions *kubernetesserviceapiv1.DiscoverLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("DiscoverLoggingInstance", discoverLoggingInstanceOptions)
+	if m.DiscoverLoggingInstanceFunc != nil {
+		return m.DiscoverLoggingInstanceFunc(discoverLoggingInstanceOptions)
+	}
+	if m.DiscoverLoggingInstanceWithContextFunc != nil {
+		return m.DiscoverLoggingInstanceWithContextFunc(context.Background(), discoverLoggingInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DiscoverLoggingInstance")
+}
+
+// DiscoverLoggingInstanceWithContext calls DiscoverLoggingInstanceWithContextFunc.
+func (m *API) DiscoverLoggingInstanceWithContext(ctx context.Context, discoverLoggingInstanceOptions *kubernetesserviceapiv1.DiscoverLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("DiscoverLoggingInstanceWithContext", ctx, discoverLoggingInstanceOptions)
+	if m.DiscoverLoggingInstanceWithContextFunc != nil {
+		return m.DiscoverLoggingInstanceWithContextFunc(ctx, discoverLoggingInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DiscoverLoggingInstanceWithContext")
+}
+
+// GetLoggingInstance calls GetLoggingInstanceFunc.
+func (m *API) GetLoggingInstance(getLoggingInstanceOptions *kubernetesserviceapiv1.GetLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("GetLoggingInstance", getLoggingInstanceOptions)
+	if m.GetLoggingInstanceFunc != nil {
+		return m.GetLoggingInstanceFunc(getLoggingInstanceOptions)
+	}
+	if m.GetLoggingInstanceWithContextFunc != nil {
+		return m.GetLoggingInstanceWithContextFunc(context.Background(), getLoggingInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetLoggingInstance")
+}
+
+// GetLoggingInstanceWithContext calls GetLoggingInstanceWithContextFunc.
+func (m *API) GetLoggingInstanceWithContext(ctx context.Context, getLoggingInstanceOptions *kubernetesserviceapiv1.GetLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("GetLoggingInstanceWithContext", ctx, getLoggingInstanceOptions)
+	if m.GetLoggingInstanceWithContextFunc != nil {
+		return m.GetLoggingInstanceWithContextFunc(ctx, getLoggingInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetLoggingInstanceWithContext")
+}
+
+// GetLoggingInstances calls GetLoggingInstancesFunc.
+func (m *API) GetLoggingInstances(getLoggingInstancesOptions *kubernetesserviceapiv1.GetLoggingInstancesOptions) ([]kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("GetLoggingInstances", getLoggingInstancesOptions)
+	if m.GetLoggingInstancesFunc != nil {
+		return m.GetLoggingInstancesFunc(getLoggingInstancesOptions)
+	}
+	if m.GetLoggingInstancesWithContextFunc != nil {
+		return m.GetLoggingInstancesWithContextFunc(context.Background(), getLoggingInstancesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetLoggingInstances")
+}
+
+// GetLoggingInstancesWithContext calls GetLoggingInstancesWithContextFunc.
+func (m *API) GetLoggingInstancesWithContext(ctx context.Context, getLoggingInstancesOptions *kubernetesserviceapiv1.GetLoggingInstancesOptions) ([]kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("GetLoggingInstancesWithContext", ctx, getLoggingInstancesOptions)
+	if m.GetLoggingInstancesWithContextFunc != nil {
+		return m.GetLoggingInstancesWithContextFunc(ctx, getLoggingInstancesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetLoggingInstancesWithContext")
+}
+
+// ModifyLoggingInstance calls ModifyLoggingInstanceFunc.
+func (m *API) ModifyLoggingInstance(modifyLoggingInstanceOptions *kubernetesserviceapiv1.ModifyLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("ModifyLoggingInstance", modifyLoggingInstanceOptions)
+	if m.ModifyLoggingInstanceFunc != nil {
+		return m.ModifyLoggingInstanceFunc(modifyLoggingInstanceOptions)
+	}
+	if m.ModifyLoggingInstanceWithContextFunc != nil {
+		return m.ModifyLoggingInstanceWithContextFunc(context.Background(), modifyLoggingInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ModifyLoggingInstance")
+}
+
+// ModifyLoggingInstanceWithContext calls ModifyLoggingInstanceWithContextFunc.
+func (m *API) ModifyLoggingInstanceWithContext(ctx context.Context, modifyLoggingInstanceOptions *kubernetesserviceapiv1.ModifyLoggingInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("ModifyLoggingInstanceWithContext", ctx, modifyLoggingInstanceOptions)
+	if m.ModifyLoggingInstanceWithContextFunc != nil {
+		return m.ModifyLoggingInstanceWithContextFunc(ctx, modifyLoggingInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ModifyLoggingInstanceWithContext")
+}
+
+// RemoveLoggingInstance calls RemoveLoggingInstanceFunc.
+func (m *API) RemoveLoggingInstance(removeLoggingInstanceOptions *kubernetesserviceapiv1.RemoveLoggingInstanceOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveLoggingInstance", removeLoggingInstanceOptions)
+	if m.RemoveLoggingInstanceFunc != nil {
+		return m.RemoveLoggingInstanceFunc(removeLoggingInstanceOptions)
+	}
+	if m.RemoveLoggingInstanceWithContextFunc != nil {
+		return m.RemoveLoggingInstanceWithContextFunc(context.Background(), removeLoggingInstanceOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveLoggingInstance")
+}
+
+// RemoveLoggingInstanceWithContext calls RemoveLoggingInstanceWithContextFunc.
+func (m *API) RemoveLoggingInstanceWithContext(ctx context.Context, removeLoggingInstanceOptions *kubernetesserviceapiv1.RemoveLoggingInstanceOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveLoggingInstanceWithContext", ctx, removeLoggingInstanceOptions)
+	if m.RemoveLoggingInstanceWithContextFunc != nil {
+		return m.RemoveLoggingInstanceWithContextFunc(ctx, removeLoggingInstanceOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveLoggingInstanceWithContext")
+}
+
+// CreateMonitoringInstance calls CreateMonitoringInstanceFunc.
+func (m *API) CreateMonitoringInstance(createMonitoringInstanceOptions *kubernetesserviceapiv1.CreateMonitoringInstanceOptions) (*kubernetesserviceapiv1.ConfigResponse, *core.DetailedResponse, error) {
+	m.record("CreateMonitoringInstance", createMonitoringInstanceOptions)
+	if m.CreateMonitoringInstanceFunc != nil {
+		return m.CreateMonitoringInstanceFunc(createMonitoringInstanceOptions)
+	}
+	if m.CreateMonitoringInstanceWithContextFunc != nil {
+		return m.CreateMonitoringInstanceWithContextFunc(context.Background(), createMonitoringInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateMonitoringInstance")
+}
+
+// CreateMonitoringInstanceWithContext calls CreateMonitoringInstanceWithContextFunc.
+func (m *API) CreateMonitoringInstanceWithContext(ctx context.Context, createMonitoringInstanceOptions *kubernetesserviceapiv1.CreateMonitoringInstanceOptions) (*kubernetesserviceapiv1.ConfigResponse, *core.DetailedResponse, error) {
+	m.record("CreateMonitoringInstanceWithContext", ctx, createMonitoringInstanceOptions)
+	if m.CreateMonitoringInstanceWithContextFunc != nil {
+		return m.CreateMonitoringInstanceWithContextFunc(ctx, createMonitoringInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ConfigResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateMonitoringInstanceWithContext")
+}
+
+// DiscoverMonitoringInstance calls DiscoverMonitoringInstanceFunc.
+func (m *API) DiscoverMonitoringInstance(discoverMonitoringInstanceOptions *kubernetesserviceapiv1.DiscoverMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("DiscoverMonitoringInstance", discoverMonitoringInstanceOptions)
+	if m.DiscoverMonitoringInstanceFunc != nil {
+		return m.DiscoverMonitoringInstanceFunc(discoverMonitoringInstanceOptions)
+	}
+	if m.DiscoverMonitoringInstanceWithContextFunc != nil {
+		return m.DiscoverMonitoringInstanceWithContextFunc(context.Background(), discoverMonitoringInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DiscoverMonitoringInstance")
+}
+
+// DiscoverMonitoringInstanceWithContext calls DiscoverMonitoringInstanceWithContextFunc.
+func (m *API) DiscoverMonitoringInstanceWithContext(ctx context.Context, discoverMonitoringInstanceOptions *kubernetesserviceapiv1.DiscoverMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("DiscoverMonitoringInstanceWithContext", ctx, discoverMonitoringInstanceOptions)
+	if m.DiscoverMonitoringInstanceWithContextFunc != nil {
+		return m.DiscoverMonitoringInstanceWithContextFunc(ctx, discoverMonitoringInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DiscoverMonitoringInstanceWithContext")
+}
+
+// GetMonitoringInstance calls GetMonitoringInstanceFunc.
+func (m *API) GetMonitoringInstance(getMonitoringInstanceOptions *kubernetesserviceapiv1.GetMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("GetMonitoringInstance", getMonitoringInstanceOptions)
+	if m.GetMonitoringInstanceFunc != nil {
+		return m.GetMonitoringInstanceFunc(getMonitoringInstanceOptions)
+	}
+	if m.GetMonitoringInstanceWithContextFunc != nil {
+		return m.GetMonitoringInstanceWithContextFunc(context.Background(), getMonitoringInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMonitoringInstance")
+}
+
+// GetMonitoringInstanceWithContext calls GetMonitoringInstanceWithContextFunc.
+func (m *API) GetMonitoringInstanceWithContext(ctx context.Context, getMonitoringInstanceOptions *kubernetesserviceapiv1.GetMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("GetMonitoringInstanceWithContext", ctx, getMonitoringInstanceOptions)
+	if m.GetMonitoringInstanceWithContextFunc != nil {
+		return m.GetMonitoringInstanceWithContextFunc(ctx, getMonitoringInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMonitoringInstanceWithContext")
+}
+
+// GetMonitoringInstances calls GetMonitoringInstancesFunc.
+func (m *API) GetMonitoringInstances(getMonitoringInstancesOptions *kubernetesserviceapiv1.GetMonitoringInstancesOptions) ([]kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("GetMonitoringInstances", getMonitoringInstancesOptions)
+	if m.GetMonitoringInstancesFunc != nil {
+		return m.GetMonitoringInstancesFunc(getMonitoringInstancesOptions)
+	}
+	if m.GetMonitoringInstancesWithContextFunc != nil {
+		return m.GetMonitoringInstancesWithContextFunc(context.Background(), getMonitoringInstancesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMonitoringInstances")
+}
+
+// GetMonitoringInstancesWithContext calls GetMonitoringInstancesWithContextFunc.
+func (m *API) GetMonitoringInstancesWithContext(ctx context.Context, getMonitoringInstancesOptions *kubernetesserviceapiv1.GetMonitoringInstancesOptions) ([]kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("GetMonitoringInstancesWithContext", ctx, getMonitoringInstancesOptions)
+	if m.GetMonitoringInstancesWithContextFunc != nil {
+		return m.GetMonitoringInstancesWithContextFunc(ctx, getMonitoringInstancesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMonitoringInstancesWithContext")
+}
+
+// ModifyMonitoringInstance calls ModifyMonitoringInstanceFunc.
+func (m *API) ModifyMonitoringInstance(modifyMonitoringInstanceOptions *kubernetesserviceapiv1.ModifyMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("ModifyMonitoringInstance", modifyMonitoringInstanceOptions)
+	if m.ModifyMonitoringInstanceFunc != nil {
+		return m.ModifyMonitoringInstanceFunc(modifyMonitoringInstanceOptions)
+	}
+	if m.ModifyMonitoringInstanceWithContextFunc != nil {
+		return m.ModifyMonitoringInstanceWithContextFunc(context.Background(), modifyMonitoringInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ModifyMonitoringInstance")
+}
+
+// ModifyMonitoringInstanceWithContext calls ModifyMonitoringInstanceWithContextFunc.
+func (m *API) ModifyMonitoringInstanceWithContext(ctx context.Context, modifyMonitoringInstanceOptions *kubernetesserviceapiv1.ModifyMonitoringInstanceOptions) (*kubernetesserviceapiv1.ObsConfig, *core.DetailedResponse, error) {
+	m.record("ModifyMonitoringInstanceWithContext", ctx, modifyMonitoringInstanceOptions)
+	if m.ModifyMonitoringInstanceWithContextFunc != nil {
+		return m.ModifyMonitoringInstanceWithContextFunc(ctx, modifyMonitoringInstanceOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ObsConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ModifyMonitoringInstanceWithContext")
+}
+
+// RemoveMonitoringInstance calls RemoveMonitoringInstanceFunc.
+func (m *API) RemoveMonitoringInstance(removeMonitoringInstanceOptions *kubernetesserviceapiv1.RemoveMonitoringInstanceOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveMonitoringInstance", removeMonitoringInstanceOptions)
+	if m.RemoveMonitoringInstanceFunc != nil {
+		return m.RemoveMonitoringInstanceFunc(removeMonitoringInstanceOptions)
+	}
+	if m.RemoveMonitoringInstanceWithContextFunc != nil {
+		return m.RemoveMonitoringInstanceWithContextFunc(context.Background(), removeMonitoringInstanceOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveMonitoringInstance")
+}
+
+// RemoveMonitoringInstanceWithContext calls RemoveMonitoringInstanceWithContextFunc.
+func (m *API) RemoveMonitoringInstanceWithContext(ctx context.Context, removeMonitoringInstanceOptions *kubernetesserviceapiv1.RemoveMonitoringInstanceOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveMonitoringInstanceWithContext", ctx, removeMonitoringInstanceOptions)
+	if m.RemoveMonitoringInstanceWithContextFunc != nil {
+		return m.RemoveMonitoringInstanceWithContextFunc(ctx, removeMonitoringInstanceOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveMonitoringInstanceWithContext")
+}
+
+// UpdateDNSWithIP calls UpdateDNSWithIPFunc.
+func (m *API) UpdateDNSWithIP(updateDNSWithIPOptions *kubernetesserviceapiv1.UpdateDNSWithIPOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateDNSWithIP", updateDNSWithIPOptions)
+	if m.UpdateDNSWithIPFunc != nil {
+		return m.UpdateDNSWithIPFunc(updateDNSWithIPOptions)
+	}
+	if m.UpdateDNSWithIPWithContextFunc != nil {
+		return m.UpdateDNSWithIPWithContextFunc(context.Background(), updateDNSWithIPOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateDNSWithIP")
+}
+
+// UpdateDNSWithIPWithContext calls UpdateDNSWithIPWithContextFunc.
+func (m *API) UpdateDNSWithIPWithContext(ctx context.Context, updateDNSWithIPOptions *kubernetesserviceapiv1.UpdateDNSWithIPOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateDNSWithIPWithContext", ctx, updateDNSWithIPOptions)
+	if m.UpdateDNSWithIPWithContextFunc != nil {
+		return m.UpdateDNSWithIPWithContextFunc(ctx, updateDNSWithIPOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateDNSWithIPWithContext")
+}
+
+// UnregisterDNSWithIP calls UnregisterDNSWithIPFunc.
+func (m *API) UnregisterDNSWithIP(unregisterDNSWithIPOptions *kubernetesserviceapiv1.UnregisterDNSWithIPOptions) (*core.DetailedResponse, error) {
+	m.record("UnregisterDNSWithIP", unregisterDNSWithIPOptions)
+	if m.UnregisterDNSWithIPFunc != nil {
+		return m.UnregisterDNSWithIPFunc(unregisterDNSWithIPOptions)
+	}
+	if m.UnregisterDNSWithIPWithContextFunc != nil {
+		return m.UnregisterDNSWithIPWithContextFunc(context.Background(), unregisterDNSWithIPOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UnregisterDNSWithIP")
+}
+
+// UnregisterDNSWithIPWithContext calls UnregisterDNSWithIPWithContextFunc.
+func (m *API) UnregisterDNSWithIPWithContext(ctx context.Context, unregisterDNSWithIPOptions *kubernetesserviceapiv1.UnregisterDNSWithIPOptions) (*core.DetailedResponse, error) {
+	m.record("UnregisterDNSWithIPWithContext", ctx, unregisterDNSWithIPOptions)
+	if m.UnregisterDNSWithIPWithContextFunc != nil {
+		return m.UnregisterDNSWithIPWithContextFunc(ctx, unregisterDNSWithIPOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UnregisterDNSWithIPWithContext")
+}
+
+// ListNLBIPsForSubdomain calls ListNLBIPsForSubdomainFunc.
+func (m *API) ListNLBIPsForSubdomain(listNLBIPsForSubdomainOptions *kubernetesserviceapiv1.ListNLBIPsForSubdomainOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error) {
+	m.record("ListNLBIPsForSubdomain", listNLBIPsForSubdomainOptions)
+	if m.ListNLBIPsForSubdomainFunc != nil {
+		return m.ListNLBIPsForSubdomainFunc(listNLBIPsForSubdomainOptions)
+	}
+	if m.ListNLBIPsForSubdomainWithContextFunc != nil {
+		return m.ListNLBIPsForSubdomainWithContextFunc(context.Background(), listNLBIPsForSubdomainOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterNlbHostsList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListNLBIPsForSubdomain")
+}
+
+// ListNLBIPsForSubdomainWithContext calls ListNLBIPsForSubdomainWithContextFunc.
+func (m *API) ListNLBIPsForSubdomainWithContext(ctx context.Context, listNLBIPsForSubdomainOptions *kubernetesserviceapiv1.ListNLBIPsForSubdomainOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error) {
+	m.record("ListNLBIPsForSubdomainWithContext", ctx, listNLBIPsForSubdomainOptions)
+	if m.ListNLBIPsForSubdomainWithContextFunc != nil {
+		return m.ListNLBIPsForSubdomainWithContextFunc(ctx, listNLBIPsForSubdomainOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterNlbHostsList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListNLBIPsForSubdomainWithContext")
+}
+
+// RegisterDNSWithIP calls RegisterDNSWithIPFunc.
+func (m *API) RegisterDNSWithIP(registerDNSWithIPOptions *kubernetesserviceapiv1.RegisterDNSWithIPOptions) (*kubernetesserviceapiv1.NlbConfig, *core.DetailedResponse, error) {
+	m.record("RegisterDNSWithIP", registerDNSWithIPOptions)
+	if m.RegisterDNSWithIPFunc != nil {
+		return m.RegisterDNSWithIPFunc(registerDNSWithIPOptions)
+	}
+	if m.RegisterDNSWithIPWithContextFunc != nil {
+		return m.RegisterDNSWithIPWithContextFunc(context.Background(), registerDNSWithIPOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("RegisterDNSWithIP")
+}
+
+// RegisterDNSWithIPWithContext calls RegisterDNSWithIPWithContextFunc.
+func (m *API) RegisterDNSWithIPWithContext(ctx context.Context, registerDNSWithIPOptions *kubernetesserviceapiv1.RegisterDNSWithIPOptions) (*kubernetesserviceapiv1.NlbConfig, *core.DetailedResponse, error) {
+	m.record("RegisterDNSWithIPWithContext", ctx, registerDNSWithIPOptions)
+	if m.RegisterDNSWithIPWithContextFunc != nil {
+		return m.RegisterDNSWithIPWithContextFunc(ctx, registerDNSWithIPOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("RegisterDNSWithIPWithContext")
+}
+
+// UpdateNlbDNSHealthMonitor calls UpdateNlbDNSHealthMonitorFunc.
+func (m *API) UpdateNlbDNSHealthMonitor(updateNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.UpdateNlbDNSHealthMonitorOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateNlbDNSHealthMonitor", updateNlbDNSHealthMonitorOptions)
+	if m.UpdateNlbDNSHealthMonitorFunc != nil {
+		return m.UpdateNlbDNSHealthMonitorFunc(updateNlbDNSHealthMonitorOptions)
+	}
+	if m.UpdateNlbDNSHealthMonitorWithContextFunc != nil {
+		return m.UpdateNlbDNSHealthMonitorWithContextFunc(context.Background(), updateNlbDNSHealthMonitorOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateNlbDNSHealthMonitor")
+}
+
+// UpdateNlbDNSHealthMonitorWithContext calls UpdateNlbDNSHealthMonitorWithContextFunc.
+func (m *API) UpdateNlbDNSHealthMonitorWithContext(ctx context.Context, updateNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.UpdateNlbDNSHealthMonitorOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateNlbDNSHealthMonitorWithContext", ctx, updateNlbDNSHealthMonitorOptions)
+	if m.UpdateNlbDNSHealthMonitorWithContextFunc != nil {
+		return m.UpdateNlbDNSHealthMonitorWithContextFunc(ctx, updateNlbDNSHealthMonitorOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateNlbDNSHealthMonitorWithContext")
+}
+
+// AddNlbDNSHealthMonitor calls AddNlbDNSHealthMonitorFunc.
+func (m *API) AddNlbDNSHealthMonitor(addNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.AddNlbDNSHealthMonitorOptions) (*kubernetesserviceapiv1.NlbHealthConfig, *core.DetailedResponse, error) {
+	m.record("AddNlbDNSHealthMonitor", addNlbDNSHealthMonitorOptions)
+	if m.AddNlbDNSHealthMonitorFunc != nil {
+		return m.AddNlbDNSHealthMonitorFunc(addNlbDNSHealthMonitorOptions)
+	}
+	if m.AddNlbDNSHealthMonitorWithContextFunc != nil {
+		return m.AddNlbDNSHealthMonitorWithContextFunc(context.Background(), addNlbDNSHealthMonitorOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbHealthConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("AddNlbDNSHealthMonitor")
+}
+
+// AddNlbDNSHealthMonitorWithContext calls AddNlbDNSHealthMonitorWithContextFunc.
+func (m *API) AddNlbDNSHealthMonitorWithContext(ctx context.Context, addNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.AddNlbDNSHealthMonitorOptions) (*kubernetesserviceapiv1.NlbHealthConfig, *core.DetailedResponse, error) {
+	m.record("AddNlbDNSHealthMonitorWithContext", ctx, addNlbDNSHealthMonitorOptions)
+	if m.AddNlbDNSHealthMonitorWithContextFunc != nil {
+		return m.AddNlbDNSHealthMonitorWithContextFunc(ctx, addNlbDNSHealthMonitorOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbHealthConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("AddNlbDNSHealthMonitorWithContext")
+}
+
+// GetNlbDNSHealthMonitor calls GetNlbDNSHealthMonitorFunc.
+func (m *API) GetNlbDNSHealthMonitor(getNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.GetNlbDNSHealthMonitorOptions) (*kubernetesserviceapiv1.NlbHealthConfig, *core.DetailedResponse, error) {
+	m.record("GetNlbDNSHealthMonitor", getNlbDNSHealthMonitorOptions)
+	if m.GetNlbDNSHealthMonitorFunc != nil {
+		return m.GetNlbDNSHealthMonitorFunc(getNlbDNSHealthMonitorOptions)
+	}
+	if m.GetNlbDNSHealthMonitorWithContextFunc != nil {
+		return m.GetNlbDNSHealthMonitorWithContextFunc(context.Background(), getNlbDNSHealthMonitorOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbHealthConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetNlbDNSHealthMonitor")
+}
+
+// GetNlbDNSHealthMonitorWithContext calls GetNlbDNSHealthMonitorWithContextFunc.
+func (m *API) GetNlbDNSHealthMonitorWithContext(ctx context.Context, getNlbDNSHealthMonitorOptions *kubernetesserviceapiv1.GetNlbDNSHealthMonitorOptions) (*kubernetesserviceapiv1.NlbHealthConfig, *core.DetailedResponse, error) {
+	m.record("GetNlbDNSHealthMonitorWithContext", ctx, getNlbDNSHealthMonitorOptions)
+	if m.GetNlbDNSHealthMonitorWithContextFunc != nil {
+		return m.GetNlbDNSHealthMonitorWithContextFunc(ctx, getNlbDNSHealthMonitorOptions)
+	}
+	var r0 *kubernetesserviceapiv1.NlbHealthConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetNlbDNSHealthMonitorWithContext")
+}
+
+// ListNlbDNSHealthMonitors calls ListNlbDNSHealthMonitorsFunc.
+func (m *API) ListNlbDNSHealthMonitors(listNlbDNSHealthMonitorsOptions *kubernetesserviceapiv1.ListNlbDNSHealthMonitorsOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error) {
+	m.record("ListNlbDNSHealthMonitors", listNlbDNSHealthMonitorsOptions)
+	if m.ListNlbDNSHealthMonitorsFunc != nil {
+		return m.ListNlbDNSHealthMonitorsFunc(listNlbDNSHealthMonitorsOptions)
+	}
+	if m.ListNlbDNSHealthMonitorsWithContextFunc != nil {
+		return m.ListNlbDNSHealthMonitorsWithContextFunc(context.Background(), listNlbDNSHealthMonitorsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterNlbHostsList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListNlbDNSHealthMonitors")
+}
+
+// ListNlbDNSHealthMonitorsWithContext calls ListNlbDNSHealthMonitorsWithContextFunc.
+func (m *API) ListNlbDNSHealthMonitorsWithContext(ctx context.Context, listNlbDNSHealthMonitorsOptions *kubernetesserviceapiv1.ListNlbDNSHealthMonitorsOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error) {
+	m.record("ListNlbDNSHealthMonitorsWithContext", ctx, listNlbDNSHealthMonitorsOptions)
+	if m.ListNlbDNSHealthMonitorsWithContextFunc != nil {
+		return m.ListNlbDNSHealthMonitorsWithContextFunc(ctx, listNlbDNSHealthMonitorsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterNlbHostsList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListNlbDNSHealthMonitorsWithContext")
+}
+
+// ListNlbDNSHealthMonitorStatus calls ListNlbDNSHealthMonitorStatusFunc.
+func (m *API) ListNlbDNSHealthMonitorStatus(listNlbDNSHealthMonitorStatusOptions *kubernetesserviceapiv1.ListNlbDNSHealthMonitorStatusOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error) {
+	m.record("ListNlbDNSHealthMonitorStatus", listNlbDNSHealthMonitorStatusOptions)
+	if m.ListNlbDNSHealthMonitorStatusFunc != nil {
+		return m.ListNlbDNSHealthMonitorStatusFunc(listNlbDNSHealthMonitorStatusOptions)
+	}
+	if m.ListNlbDNSHealthMonitorStatusWithContextFunc != nil {
+		return m.ListNlbDNSHealthMonitorStatusWithContextFunc(context.Background(), listNlbDNSHealthMonitorStatusOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterNlbHostsList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListNlbDNSHealthMonitorStatus")
+}
+
+// ListNlbDNSHealthMonitorStatusWithContext calls ListNlbDNSHealthMonitorStatusWithContextFunc.
+func (m *API) ListNlbDNSHealthMonitorStatusWithContext(ctx context.Context, listNlbDNSHealthMonitorStatusOptions *kubernetesserviceapiv1.ListNlbDNSHealthMonitorStatusOptions) (*kubernetesserviceapiv1.ClusterNlbHostsList, *core.DetailedResponse, error) {
+	m.record("ListNlbDNSHealthMonitorStatusWithContext", ctx, listNlbDNSHealthMonitorStatusOptions)
+	if m.ListNlbDNSHealthMonitorStatusWithContextFunc != nil {
+		return m.ListNlbDNSHealthMonitorStatusWithContextFunc(ctx, listNlbDNSHealthMonitorStatusOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterNlbHostsList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListNlbDNSHealthMonitorStatusWithContext")
+}
+
+// GetDatacenterVLANs calls GetDatacenterVLANsFunc.
+func (m *API) GetDatacenterVLANs(getDatacenterVLANsOptions *kubernetesserviceapiv1.GetDatacenterVLANsOptions) ([]kubernetesserviceapiv1.VLAN, *core.DetailedResponse, error) {
+	m.record("GetDatacenterVLANs", getDatacenterVLANsOptions)
+	if m.GetDatacenterVLANsFunc != nil {
+		return m.GetDatacenterVLANsFunc(getDatacenterVLANsOptions)
+	}
+	if m.GetDatacenterVLANsWithContextFunc != nil {
+		return m.GetDatacenterVLANsWithContextFunc(context.Background(), getDatacenterVLANsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.VLAN
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetDatacenterVLANs")
+}
+
+// GetDatacenterVLANsWithContext calls GetDatacenterVLANsWithContextFunc.
+func (m *API) GetDatacenterVLANsWithContext(ctx context.Context, getDatacenterVLANsOptions *kubernetesserviceapiv1.GetDatacenterVLANsOptions) ([]kubernetesserviceapiv1.VLAN, *core.DetailedResponse, error) {
+	m.record("GetDatacenterVLANsWithContext", ctx, getDatacenterVLANsOptions)
+	if m.GetDatacenterVLANsWithContextFunc != nil {
+		return m.GetDatacenterVLANsWithContextFunc(ctx, getDatacenterVLANsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.VLAN
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetDatacenterVLANsWithContext")
+}
+
+// ListSubnets calls ListSubnetsFunc.
+func (m *API) ListSubnets(listSubnetsOptions *kubernetesserviceapiv1.ListSubnetsOptions) ([]kubernetesserviceapiv1.Subnet, *core.DetailedResponse, error) {
+	m.record("ListSubnets", listSubnetsOptions)
+	if m.ListSubnetsFunc != nil {
+		return m.ListSubnetsFunc(listSubnetsOptions)
+	}
+	if m.ListSubnetsWithContextFunc != nil {
+		return m.ListSubnetsWithContextFunc(context.Background(), listSubnetsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Subnet
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListSubnets")
+}
+
+// ListSubnetsWithContext calls ListSubnetsWithContextFunc.
+func (m *API) ListSubnetsWithContext(ctx context.Context, listSubnetsOptions *kubernetesserviceapiv1.ListSubnetsOptions) ([]kubernetesserviceapiv1.Subnet, *core.DetailedResponse, error) {
+	m.record("ListSubnetsWithContext", ctx, listSubnetsOptions)
+	if m.ListSubnetsWithContextFunc != nil {
+		return m.ListSubnetsWithContextFunc(ctx, listSubnetsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Subnet
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListSubnetsWithContext")
+}
+
+// CreateSatelliteCluster calls CreateSatelliteClusterFunc.
+func (m *API) CreateSatelliteCluster(createSatelliteClusterOptions *kubernetesserviceapiv1.CreateSatelliteClusterOptions) (*kubernetesserviceapiv1.MultishiftCreateClusterResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteCluster", createSatelliteClusterOptions)
+	if m.CreateSatelliteClusterFunc != nil {
+		return m.CreateSatelliteClusterFunc(createSatelliteClusterOptions)
+	}
+	if m.CreateSatelliteClusterWithContextFunc != nil {
+		return m.CreateSatelliteClusterWithContextFunc(context.Background(), createSatelliteClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftCreateClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteCluster")
+}
+
+// CreateSatelliteClusterWithContext calls CreateSatelliteClusterWithContextFunc.
+func (m *API) CreateSatelliteClusterWithContext(ctx context.Context, createSatelliteClusterOptions *kubernetesserviceapiv1.CreateSatelliteClusterOptions) (*kubernetesserviceapiv1.MultishiftCreateClusterResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteClusterWithContext", ctx, createSatelliteClusterOptions)
+	if m.CreateSatelliteClusterWithContextFunc != nil {
+		return m.CreateSatelliteClusterWithContextFunc(ctx, createSatelliteClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftCreateClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteClusterWithContext")
+}
+
+// CreateSatelliteWorkerPool calls CreateSatelliteWorkerPoolFunc.
+func (m *API) CreateSatelliteWorkerPool(createSatelliteWorkerPoolOptions *kubernetesserviceapiv1.CreateSatelliteWorkerPoolOptions) (*kubernetesserviceapiv1.CreateWorkerpoolResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteWorkerPool", createSatelliteWorkerPoolOptions)
+	if m.CreateSatelliteWorkerPoolFunc != nil {
+		return m.CreateSatelliteWorkerPoolFunc(createSatelliteWorkerPoolOptions)
+	}
+	if m.CreateSatelliteWorkerPoolWithContextFunc != nil {
+		return m.CreateSatelliteWorkerPoolWithContextFunc(context.Background(), createSatelliteWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateWorkerpoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteWorkerPool")
+}
+
+// CreateSatelliteWorkerPoolWithContext calls CreateSatelliteWorkerPoolWithContextFunc.
+func (m *API) CreateSatelliteWorkerPoolWithContext(ctx context.Context, createSatelliteWorkerPoolOptions *kubernetesserviceapiv1.CreateSatelliteWorkerPoolOptions) (*kubernetesserviceapiv1.CreateWorkerpoolResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteWorkerPoolWithContext", ctx, createSatelliteWorkerPoolOptions)
+	if m.CreateSatelliteWorkerPoolWithContextFunc != nil {
+		return m.CreateSatelliteWorkerPoolWithContextFunc(ctx, createSatelliteWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateWorkerpoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteWorkerPoolWithContext")
+}
+
+// CreateSatelliteWorkerPoolZone calls CreateSatelliteWorkerPoolZoneFunc.
+func (m *API) CreateSatelliteWorkerPoolZone(createSatelliteWorkerPoolZoneOptions *kubernetesserviceapiv1.CreateSatelliteWorkerPoolZoneOptions) (*core.DetailedResponse, error) {
+	m.record("CreateSatelliteWorkerPoolZone", createSatelliteWorkerPoolZoneOptions)
+	if m.CreateSatelliteWorkerPoolZoneFunc != nil {
+		return m.CreateSatelliteWorkerPoolZoneFunc(createSatelliteWorkerPoolZoneOptions)
+	}
+	if m.CreateSatelliteWorkerPoolZoneWithContextFunc != nil {
+		return m.CreateSatelliteWorkerPoolZoneWithContextFunc(context.Background(), createSatelliteWorkerPoolZoneOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateSatelliteWorkerPoolZone")
+}
+
+// CreateSatelliteWorkerPoolZoneWithContext calls CreateSatelliteWorkerPoolZoneWithContextFunc.
+func (m *API) CreateSatelliteWorkerPoolZoneWithContext(ctx context.Context, createSatelliteWorkerPoolZoneOptions *kubernetesserviceapiv1.CreateSatelliteWorkerPoolZoneOptions) (*core.DetailedResponse, error) {
+	m.record("CreateSatelliteWorkerPoolZoneWithContext", ctx, createSatelliteWorkerPoolZoneOptions)
+	if m.CreateSatelliteWorkerPoolZoneWithContextFunc != nil {
+		return m.CreateSatelliteWorkerPoolZoneWithContextFunc(ctx, createSatelliteWorkerPoolZoneOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("CreateSatelliteWorkerPoolZoneWithContext")
+}
+
+// GetSatelliteClusters calls GetSatelliteClustersFunc.
+func (m *API) GetSatelliteClusters(getSatelliteClustersOptions *kubernetesserviceapiv1.GetSatelliteClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error) {
+	m.record("GetSatelliteClusters", getSatelliteClustersOptions)
+	if m.GetSatelliteClustersFunc != nil {
+		return m.GetSatelliteClustersFunc(getSatelliteClustersOptions)
+	}
+	if m.GetSatelliteClustersWithContextFunc != nil {
+		return m.GetSatelliteClustersWithContextFunc(context.Background(), getSatelliteClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetClustersResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteClusters")
+}
+
+// GetSatelliteClustersWithContext calls GetSatelliteClustersWithContextFunc.
+func (m *API) GetSatelliteClustersWithContext(ctx context.Context, getSatelliteClustersOptions *kubernetesserviceapiv1.GetSatelliteClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error) {
+	m.record("GetSatelliteClustersWithContext", ctx, getSatelliteClustersOptions)
+	if m.GetSatelliteClustersWithContextFunc != nil {
+		return m.GetSatelliteClustersWithContextFunc(ctx, getSatelliteClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetClustersResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteClustersWithContext")
+}
+
+// CreateSatelliteAssignment calls CreateSatelliteAssignmentFunc.
+func (m *API) CreateSatelliteAssignment(createSatelliteAssignmentOptions *kubernetesserviceapiv1.CreateSatelliteAssignmentOptions) (*kubernetesserviceapiv1.MultishiftCreateAssignmentResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteAssignment", createSatelliteAssignmentOptions)
+	if m.CreateSatelliteAssignmentFunc != nil {
+		return m.CreateSatelliteAssignmentFunc(createSatelliteAssignmentOptions)
+	}
+	if m.CreateSatelliteAssignmentWithContextFunc != nil {
+		return m.CreateSatelliteAssignmentWithContextFunc(context.Background(), createSatelliteAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftCreateAssignmentResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteAssignment")
+}
+
+// CreateSatelliteAssignmentWithContext calls CreateSatelliteAssignmentWithContextFunc.
+func (m *API) CreateSatelliteAssignmentWithContext(ctx context.Context, createSatelliteAssignmentOptions *kubernetesserviceapiv1.CreateSatelliteAssignmentOptions) (*kubernetesserviceapiv1.MultishiftCreateAssignmentResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteAssignmentWithContext", ctx, createSatelliteAssignmentOptions)
+	if m.CreateSatelliteAssignmentWithContextFunc != nil {
+		return m.CreateSatelliteAssignmentWithContextFunc(ctx, createSatelliteAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftCreateAssignmentResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteAssignmentWithContext")
+}
+
+// AttachSatelliteHost calls AttachSatelliteHostFunc.
+func (m *API) AttachSatelliteHost(attachSatelliteHostOptions *kubernetesserviceapiv1.AttachSatelliteHostOptions) ([]byte, error) {
+	m.record("AttachSatelliteHost", attachSatelliteHostOptions)
+	if m.AttachSatelliteHostFunc != nil {
+		return m.AttachSatelliteHostFunc(attachSatelliteHostOptions)
+	}
+	if m.AttachSatelliteHostWithContextFunc != nil {
+		return m.AttachSatelliteHostWithContextFunc(context.Background(), attachSatelliteHostOptions)
+	}
+	var r0 []byte
+	return r0, notStubbed("AttachSatelliteHost")
+}
+
+// AttachSatelliteHostWithContext calls AttachSatelliteHostWithContextFunc.
+func (m *API) AttachSatelliteHostWithContext(ctx context.Context, attachSatelliteHostOptions *kubernetesserviceapiv1.AttachSatelliteHostOptions) ([]byte, error) {
+	m.record("AttachSatelliteHostWithContext", ctx, attachSatelliteHostOptions)
+	if m.AttachSatelliteHostWithContextFunc != nil {
+		return m.AttachSatelliteHostWithContextFunc(ctx, attachSatelliteHostOptions)
+	}
+	var r0 []byte
+	return r0, notStubbed("AttachSatelliteHostWithContext")
+}
+
+// GetSatelliteHosts calls GetSatelliteHostsFunc.
+func (m *API) GetSatelliteHosts(getSatelliteHostsOptions *kubernetesserviceapiv1.GetSatelliteHostsOptions) ([]kubernetesserviceapiv1.MultishiftQueueNode, *core.DetailedResponse, error) {
+	m.record("GetSatelliteHosts", getSatelliteHostsOptions)
+	if m.GetSatelliteHostsFunc != nil {
+		return m.GetSatelliteHostsFunc(getSatelliteHostsOptions)
+	}
+	if m.GetSatelliteHostsWithContextFunc != nil {
+		return m.GetSatelliteHostsWithContextFunc(context.Background(), getSatelliteHostsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MultishiftQueueNode
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteHosts")
+}
+
+// GetSatelliteHostsWithContext calls GetSatelliteHostsWithContextFunc.
+func (m *API) GetSatelliteHostsWithContext(ctx context.Context, getSatelliteHostsOptions *kubernetesserviceapiv1.GetSatelliteHostsOptions) ([]kubernetesserviceapiv1.MultishiftQueueNode, *core.DetailedResponse, error) {
+	m.record("GetSatelliteHostsWithContext", ctx, getSatelliteHostsOptions)
+	if m.GetSatelliteHostsWithContextFunc != nil {
+		return m.GetSatelliteHostsWithContextFunc(ctx, getSatelliteHostsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MultishiftQueueNode
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteHostsWithContext")
+}
+
+// RemoveSatelliteHost calls RemoveSatelliteHostFunc.
+func (m *API) RemoveSatelliteHost(removeSatelliteHostOptions *kubernetesserviceapiv1.RemoveSatelliteHostOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveSatelliteHost", removeSatelliteHostOptions)
+	if m.RemoveSatelliteHostFunc != nil {
+		return m.RemoveSatelliteHostFunc(removeSatelliteHostOptions)
+	}
+	if m.RemoveSatelliteHostWithContextFunc != nil {
+		return m.RemoveSatelliteHostWithContextFunc(context.Background(), removeSatelliteHostOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveSatelliteHost")
+}
+
+// RemoveSatelliteHostWithContext calls RemoveSatelliteHostWithContextFunc.
+func (m *API) RemoveSatelliteHostWithContext(ctx context.Context, removeSatelliteHostOptions *kubernetesserviceapiv1.RemoveSatelliteHostOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveSatelliteHostWithContext", ctx, removeSatelliteHostOptions)
+	if m.RemoveSatelliteHostWithContextFunc != nil {
+		return m.RemoveSatelliteHostWithContextFunc(ctx, removeSatelliteHostOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveSatelliteHostWithContext")
+}
+
+// UpdateSatelliteHost calls UpdateSatelliteHostFunc.
+func (m *API) UpdateSatelliteHost(updateSatelliteHostOptions *kubernetesserviceapiv1.UpdateSatelliteHostOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateSatelliteHost", updateSatelliteHostOptions)
+	if m.UpdateSatelliteHostFunc != nil {
+		return m.UpdateSatelliteHostFunc(updateSatelliteHostOptions)
+	}
+	if m.UpdateSatelliteHostWithContextFunc != nil {
+		return m.UpdateSatelliteHostWithContextFunc(context.Background(), updateSatelliteHostOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateSatelliteHost")
+}
+
+// UpdateSatelliteHostWithContext calls UpdateSatelliteHostWithContextFunc.
+func (m *API) UpdateSatelliteHostWithContext(ctx context.Context, updateSatelliteHostOptions *kubernetesserviceapiv1.UpdateSatelliteHostOptions) (*core.DetailedResponse, error) {
+	m.record("UpdateSatelliteHostWithContext", ctx, updateSatelliteHostOptions)
+	if m.UpdateSatelliteHostWithContextFunc != nil {
+		return m.UpdateSatelliteHostWithContextFunc(ctx, updateSatelliteHostOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("UpdateSatelliteHostWithContext")
+}
+
+// CreateSatelliteLocation calls CreateSatelliteLocationFunc.
+func (m *API) CreateSatelliteLocation(createSatelliteLocationOptions *kubernetesserviceapiv1.CreateSatelliteLocationOptions) (*kubernetesserviceapiv1.MultishiftCreateControllerResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteLocation", createSatelliteLocationOptions)
+	if m.CreateSatelliteLocationFunc != nil {
+		return m.CreateSatelliteLocationFunc(createSatelliteLocationOptions)
+	}
+	if m.CreateSatelliteLocationWithContextFunc != nil {
+		return m.CreateSatelliteLocationWithContextFunc(context.Background(), createSatelliteLocationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftCreateControllerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteLocation")
+}
+
+// CreateSatelliteLocationWithContext calls CreateSatelliteLocationWithContextFunc.
+func (m *API) CreateSatelliteLocationWithContext(ctx context.Context, createSatelliteLocationOptions *kubernetesserviceapiv1.CreateSatelliteLocationOptions) (*kubernetesserviceapiv1.MultishiftCreateControllerResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteLocationWithContext", ctx, createSatelliteLocationOptions)
+	if m.CreateSatelliteLocationWithContextFunc != nil {
+		return m.CreateSatelliteLocationWithContextFunc(ctx, createSatelliteLocationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftCreateControllerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteLocationWithContext")
+}
+
+// GetSatelliteLocation calls GetSatelliteLocationFunc.
+func (m *API) GetSatelliteLocation(getSatelliteLocationOptions *kubernetesserviceapiv1.GetSatelliteLocationOptions) (*kubernetesserviceapiv1.MultishiftGetController, *core.DetailedResponse, error) {
+	m.record("GetSatelliteLocation", getSatelliteLocationOptions)
+	if m.GetSatelliteLocationFunc != nil {
+		return m.GetSatelliteLocationFunc(getSatelliteLocationOptions)
+	}
+	if m.GetSatelliteLocationWithContextFunc != nil {
+		return m.GetSatelliteLocationWithContextFunc(context.Background(), getSatelliteLocationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftGetController
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteLocation")
+}
+
+// GetSatelliteLocationWithContext calls GetSatelliteLocationWithContextFunc.
+func (m *API) GetSatelliteLocationWithContext(ctx context.Context, getSatelliteLocationOptions *kubernetesserviceapiv1.GetSatelliteLocationOptions) (*kubernetesserviceapiv1.MultishiftGetController, *core.DetailedResponse, error) {
+	m.record("GetSatelliteLocationWithContext", ctx, getSatelliteLocationOptions)
+	if m.GetSatelliteLocationWithContextFunc != nil {
+		return m.GetSatelliteLocationWithContextFunc(ctx, getSatelliteLocationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftGetController
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteLocationWithContext")
+}
+
+// GetSatelliteLocations calls GetSatelliteLocationsFunc.
+func (m *API) GetSatelliteLocations(getSatelliteLocationsOptions *kubernetesserviceapiv1.GetSatelliteLocationsOptions) ([]kubernetesserviceapiv1.MultishiftController, *core.DetailedResponse, error) {
+	m.record("GetSatelliteLocations", getSatelliteLocationsOptions)
+	if m.GetSatelliteLocationsFunc != nil {
+		return m.GetSatelliteLocationsFunc(getSatelliteLocationsOptions)
+	}
+	if m.GetSatelliteLocationsWithContextFunc != nil {
+		return m.GetSatelliteLocationsWithContextFunc(context.Background(), getSatelliteLocationsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MultishiftController
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteLocations")
+}
+
+// GetSatelliteLocationsWithContext calls GetSatelliteLocationsWithContextFunc.
+func (m *API) GetSatelliteLocationsWithContext(ctx context.Context, getSatelliteLocationsOptions *kubernetesserviceapiv1.GetSatelliteLocationsOptions) ([]kubernetesserviceapiv1.MultishiftController, *core.DetailedResponse, error) {
+	m.record("GetSatelliteLocationsWithContext", ctx, getSatelliteLocationsOptions)
+	if m.GetSatelliteLocationsWithContextFunc != nil {
+		return m.GetSatelliteLocationsWithContextFunc(ctx, getSatelliteLocationsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MultishiftController
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteLocationsWithContext")
+}
+
+// RemoveSatelliteLocation calls RemoveSatelliteLocationFunc.
+func (m *API) RemoveSatelliteLocation(removeSatelliteLocationOptions *kubernetesserviceapiv1.RemoveSatelliteLocationOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveSatelliteLocation", removeSatelliteLocationOptions)
+	if m.RemoveSatelliteLocationFunc != nil {
+		return m.RemoveSatelliteLocationFunc(removeSatelliteLocationOptions)
+	}
+	if m.RemoveSatelliteLocationWithContextFunc != nil {
+		return m.RemoveSatelliteLocationWithContextFunc(context.Background(), removeSatelliteLocationOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveSatelliteLocation")
+}
+
+// RemoveSatelliteLocationWithContext calls RemoveSatelliteLocationWithContextFunc.
+func (m *API) RemoveSatelliteLocationWithContext(ctx context.Context, removeSatelliteLocationOptions *kubernetesserviceapiv1.RemoveSatelliteLocationOptions) (*core.DetailedResponse, error) {
+	m.record("RemoveSatelliteLocationWithContext", ctx, removeSatelliteLocationOptions)
+	if m.RemoveSatelliteLocationWithContextFunc != nil {
+		return m.RemoveSatelliteLocationWithContextFunc(ctx, removeSatelliteLocationOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveSatelliteLocationWithContext")
+}
+
+// CreateSatelliteClusterRemote calls CreateSatelliteClusterRemoteFunc.
+func (m *API) CreateSatelliteClusterRemote(createSatelliteClusterRemoteOptions *kubernetesserviceapiv1.CreateSatelliteClusterRemoteOptions) (*kubernetesserviceapiv1.MultishiftCreateClusterResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteClusterRemote", createSatelliteClusterRemoteOptions)
+	if m.CreateSatelliteClusterRemoteFunc != nil {
+		return m.CreateSatelliteClusterRemoteFunc(createSatelliteClusterRemoteOptions)
+	}
+	if m.CreateSatelliteClusterRemoteWithContextFunc != nil {
+		return m.CreateSatelliteClusterRemoteWithContextFunc(context.Background(), createSatelliteClusterRemoteOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftCreateClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteClusterRemote")
+}
+
+// CreateSatelliteClusterRemoteWithContext calls CreateSatelliteClusterRemoteWithContextFunc.
+func (m *API) CreateSatelliteClusterRemoteWithContext(ctx context.Context, createSatelliteClusterRemoteOptions *kubernetesserviceapiv1.CreateSatelliteClusterRemoteOptions) (*kubernetesserviceapiv1.MultishiftCreateClusterResponse, *core.DetailedResponse, error) {
+	m.record("CreateSatelliteClusterRemoteWithContext", ctx, createSatelliteClusterRemoteOptions)
+	if m.CreateSatelliteClusterRemoteWithContextFunc != nil {
+		return m.CreateSatelliteClusterRemoteWithContextFunc(ctx, createSatelliteClusterRemoteOptions)
+	}
+	var r0 *kubernetesserviceapiv1.MultishiftCreateClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSatelliteClusterRemoteWithContext")
+}
+
+// GetSatelliteServiceClusters calls GetSatelliteServiceClustersFunc.
+func (m *API) GetSatelliteServiceClusters(getSatelliteServiceClustersOptions *kubernetesserviceapiv1.GetSatelliteServiceClustersOptions) ([]kubernetesserviceapiv1.MultishiftClustersResponse, *core.DetailedResponse, error) {
+	m.record("GetSatelliteServiceClusters", getSatelliteServiceClustersOptions)
+	if m.GetSatelliteServiceClustersFunc != nil {
+		return m.GetSatelliteServiceClustersFunc(getSatelliteServiceClustersOptions)
+	}
+	if m.GetSatelliteServiceClustersWithContextFunc != nil {
+		return m.GetSatelliteServiceClustersWithContextFunc(context.Background(), getSatelliteServiceClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MultishiftClustersResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteServiceClusters")
+}
+
+// GetSatelliteServiceClustersWithContext calls GetSatelliteServiceClustersWithContextFunc.
+func (m *API) GetSatelliteServiceClustersWithContext(ctx context.Context, getSatelliteServiceClustersOptions *kubernetesserviceapiv1.GetSatelliteServiceClustersOptions) ([]kubernetesserviceapiv1.MultishiftClustersResponse, *core.DetailedResponse, error) {
+	m.record("GetSatelliteServiceClustersWithContext", ctx, getSatelliteServiceClustersOptions)
+	if m.GetSatelliteServiceClustersWithContextFunc != nil {
+		return m.GetSatelliteServiceClustersWithContextFunc(ctx, getSatelliteServiceClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MultishiftClustersResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSatelliteServiceClustersWithContext")
+}
+
+// CreateAttachment calls CreateAttachmentFunc.
+func (m *API) CreateAttachment(createAttachmentOptions *kubernetesserviceapiv1.CreateAttachmentOptions) (*kubernetesserviceapiv1.VolumeAttachment, *core.DetailedResponse, error) {
+	m.record("CreateAttachment", createAttachmentOptions)
+	if m.CreateAttachmentFunc != nil {
+		return m.CreateAttachmentFunc(createAttachmentOptions)
+	}
+	if m.CreateAttachmentWithContextFunc != nil {
+		return m.CreateAttachmentWithContextFunc(context.Background(), createAttachmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VolumeAttachment
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateAttachment")
+}
+
+// CreateAttachmentWithContext calls CreateAttachmentWithContextFunc.
+func (m *API) CreateAttachmentWithContext(ctx context.Context, createAttachmentOptions *kubernetesserviceapiv1.CreateAttachmentOptions) (*kubernetesserviceapiv1.VolumeAttachment, *core.DetailedResponse, error) {
+	m.record("CreateAttachmentWithContext", ctx, createAttachmentOptions)
+	if m.CreateAttachmentWithContextFunc != nil {
+		return m.CreateAttachmentWithContextFunc(ctx, createAttachmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VolumeAttachment
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateAttachmentWithContext")
+}
+
+// DeleteAttachment calls DeleteAttachmentFunc.
+func (m *API) DeleteAttachment(deleteAttachmentOptions *kubernetesserviceapiv1.DeleteAttachmentOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteAttachment", deleteAttachmentOptions)
+	if m.DeleteAttachmentFunc != nil {
+		return m.DeleteAttachmentFunc(deleteAttachmentOptions)
+	}
+	if m.DeleteAttachmentWithContextFunc != nil {
+		return m.DeleteAttachmentWithContextFunc(context.Background(), deleteAttachmentOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteAttachment")
+}
+
+// DeleteAttachmentWithContext calls DeleteAttachmentWithContextFunc.
+func (m *API) DeleteAttachmentWithContext(ctx context.Context, deleteAttachmentOptions *kubernetesserviceapiv1.DeleteAttachmentOptions) (*core.DetailedResponse, error) {
+	m.record("DeleteAttachmentWithContext", ctx, deleteAttachmentOptions)
+	if m.DeleteAttachmentWithContextFunc != nil {
+		return m.DeleteAttachmentWithContextFunc(ctx, deleteAttachmentOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("DeleteAttachmentWithContext")
+}
+
+// GetAttachment calls GetAttachmentFunc.
+func (m *API) GetAttachment(getAttachmentOptions *kubernetesserviceapiv1.GetAttachmentOptions) (*kubernetesserviceapiv1.VolumeAttachment, *core.DetailedResponse, error) {
+	m.record("GetAttachment", getAttachmentOptions)
+	if m.GetAttachmentFunc != nil {
+		return m.GetAttachmentFunc(getAttachmentOptions)
+	}
+	if m.GetAttachmentWithContextFunc != nil {
+		return m.GetAttachmentWithContextFunc(context.Background(), getAttachmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VolumeAttachment
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAttachment")
+}
+
+// GetAttachmentWithContext calls GetAttachmentWithContextFunc.
+func (m *API) GetAttachmentWithContext(ctx context.Context, getAttachmentOptions *kubernetesserviceapiv1.GetAttachmentOptions) (*kubernetesserviceapiv1.VolumeAttachment, *core.DetailedResponse, error) {
+	m.record("GetAttachmentWithContext", ctx, getAttachmentOptions)
+	if m.GetAttachmentWithContextFunc != nil {
+		return m.GetAttachmentWithContextFunc(ctx, getAttachmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VolumeAttachment
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAttachmentWithContext")
+}
+
+// GetAttachments calls GetAttachmentsFunc.
+func (m *API) GetAttachments(getAttachmentsOptions *kubernetesserviceapiv1.GetAttachmentsOptions) (*kubernetesserviceapiv1.VolumeAttachmentList, *core.DetailedResponse, error) {
+	m.record("GetAttachments", getAttachmentsOptions)
+	if m.GetAttachmentsFunc != nil {
+		return m.GetAttachmentsFunc(getAttachmentsOptions)
+	}
+	if m.GetAttachmentsWithContextFunc != nil {
+		return m.GetAttachmentsWithContextFunc(context.Background(), getAttachmentsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VolumeAttachmentList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAttachments")
+}
+
+// GetAttachmentsWithContext calls GetAttachmentsWithContextFunc.
+func (m *API) GetAttachmentsWithContext(ctx context.Context, getAttachmentsOptions *kubernetesserviceapiv1.GetAttachmentsOptions) (*kubernetesserviceapiv1.VolumeAttachmentList, *core.DetailedResponse, error) {
+	m.record("GetAttachmentsWithContext", ctx, getAttachmentsOptions)
+	if m.GetAttachmentsWithContextFunc != nil {
+		return m.GetAttachmentsWithContextFunc(ctx, getAttachmentsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VolumeAttachmentList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAttachmentsWithContext")
+}
+
+// GetVolume calls GetVolumeFunc.
+func (m *API) GetVolume(getVolumeOptions *kubernetesserviceapiv1.GetVolumeOptions) (*kubernetesserviceapiv1.Volume, *core.DetailedResponse, error) {
+	m.record("GetVolume", getVolumeOptions)
+	if m.GetVolumeFunc != nil {
+		return m.GetVolumeFunc(getVolumeOptions)
+	}
+	if m.GetVolumeWithContextFunc != nil {
+		return m.GetVolumeWithContextFunc(context.Background(), getVolumeOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Volume
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVolume")
+}
+
+// GetVolumeWithContext calls GetVolumeWithContextFunc.
+func (m *API) GetVolumeWithContext(ctx context.Context, getVolumeOptions *kubernetesserviceapiv1.GetVolumeOptions) (*kubernetesserviceapiv1.Volume, *core.DetailedResponse, error) {
+	m.record("GetVolumeWithContext", ctx, getVolumeOptions)
+	if m.GetVolumeWithContextFunc != nil {
+		return m.GetVolumeWithContextFunc(ctx, getVolumeOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Volume
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVolumeWithContext")
+}
+
+// GetVolumes calls GetVolumesFunc.
+func (m *API) GetVolumes(getVolumesOptions *kubernetesserviceapiv1.GetVolumesOptions) (*kubernetesserviceapiv1.VolumesList, *core.DetailedResponse, error) {
+	m.record("GetVolumes", getVolumesOptions)
+	if m.GetVolumesFunc != nil {
+		return m.GetVolumesFunc(getVolumesOptions)
+	}
+	if m.GetVolumesWithContextFunc != nil {
+		return m.GetVolumesWithContextFunc(context.Background(), getVolumesOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VolumesList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVolumes")
+}
+
+// GetVolumesWithContext calls GetVolumesWithContextFunc.
+func (m *API) GetVolumesWithContext(ctx context.Context, getVolumesOptions *kubernetesserviceapiv1.GetVolumesOptions) (*kubernetesserviceapiv1.VolumesList, *core.DetailedResponse, error) {
+	m.record("GetVolumesWithContext", ctx, getVolumesOptions)
+	if m.GetVolumesWithContextFunc != nil {
+		return m.GetVolumesWithContextFunc(ctx, getVolumesOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VolumesList
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVolumesWithContext")
+}
+
+// CreateAssignment calls CreateAssignmentFunc.
+func (m *API) CreateAssignment(createAssignmentOptions *kubernetesserviceapiv1.CreateAssignmentOptions) (*kubernetesserviceapiv1.CreateSubscriptionData, *core.DetailedResponse, error) {
+	m.record("CreateAssignment", createAssignmentOptions)
+	if m.CreateAssignmentFunc != nil {
+		return m.CreateAssignmentFunc(createAssignmentOptions)
+	}
+	if m.CreateAssignmentWithContextFunc != nil {
+		return m.CreateAssignmentWithContextFunc(context.Background(), createAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateSubscriptionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateAssignment")
+}
+
+// CreateAssignmentWithContext calls CreateAssignmentWithContextFunc.
+func (m *API) CreateAssignmentWithContext(ctx context.Context, createAssignmentOptions *kubernetesserviceapiv1.CreateAssignmentOptions) (*kubernetesserviceapiv1.CreateSubscriptionData, *core.DetailedResponse, error) {
+	m.record("CreateAssignmentWithContext", ctx, createAssignmentOptions)
+	if m.CreateAssignmentWithContextFunc != nil {
+		return m.CreateAssignmentWithContextFunc(ctx, createAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateSubscriptionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateAssignmentWithContext")
+}
+
+// CreateAssignmentByCluster calls CreateAssignmentByClusterFunc.
+func (m *API) CreateAssignmentByCluster(createAssignmentOptions *kubernetesserviceapiv1.CreateAssignmentOptions) (*kubernetesserviceapiv1.CreateSubscriptionData, *core.DetailedResponse, error) {
+	m.record("CreateAssignmentByCluster", createAssignmentOptions)
+	if m.CreateAssignmentByClusterFunc != nil {
+		return m.CreateAssignmentByClusterFunc(createAssignmentOptions)
+	}
+	if m.CreateAssignmentByClusterWithContextFunc != nil {
+		return m.CreateAssignmentByClusterWithContextFunc(context.Background(), createAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateSubscriptionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateAssignmentByCluster")
+}
+
+// CreateAssignmentByClusterWithContext calls CreateAssignmentByClusterWithContextFunc.
+func (m *API) CreateAssignmentByClusterWithContext(ctx context.Context, createAssignmentOptions *kubernetesserviceapiv1.CreateAssignmentOptions) (*kubernetesserviceapiv1.CreateSubscriptionData, *core.DetailedResponse, error) {
+	m.record("CreateAssignmentByClusterWithContext", ctx, createAssignmentOptions)
+	if m.CreateAssignmentByClusterWithContextFunc != nil {
+		return m.CreateAssignmentByClusterWithContextFunc(ctx, createAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateSubscriptionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateAssignmentByClusterWithContext")
+}
+
+// CreateStorageConfiguration calls CreateStorageConfigurationFunc.
+func (m *API) CreateStorageConfiguration(createStorageConfigurationOptions *kubernetesserviceapiv1.CreateStorageConfigurationOptions) (*kubernetesserviceapiv1.CreateConfigurationData, *core.DetailedResponse, error) {
+	m.record("CreateStorageConfiguration", createStorageConfigurationOptions)
+	if m.CreateStorageConfigurationFunc != nil {
+		return m.CreateStorageConfigurationFunc(createStorageConfigurationOptions)
+	}
+	if m.CreateStorageConfigurationWithContextFunc != nil {
+		return m.CreateStorageConfigurationWithContextFunc(context.Background(), createStorageConfigurationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateConfigurationData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateStorageConfiguration")
+}
+
+// CreateStorageConfigurationWithContext calls CreateStorageConfigurationWithContextFunc.
+func (m *API) CreateStorageConfigurationWithContext(ctx context.Context, createStorageConfigurationOptions *kubernetesserviceapiv1.CreateStorageConfigurationOptions) (*kubernetesserviceapiv1.CreateConfigurationData, *core.DetailedResponse, error) {
+	m.record("CreateStorageConfigurationWithContext", ctx, createStorageConfigurationOptions)
+	if m.CreateStorageConfigurationWithContextFunc != nil {
+		return m.CreateStorageConfigurationWithContextFunc(ctx, createStorageConfigurationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateConfigurationData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateStorageConfigurationWithContext")
+}
+
+// GetAssignedStorageConfigs calls GetAssignedStorageConfigsFunc.
+func (m *API) GetAssignedStorageConfigs(getAssignedStorageConfigsOptions *kubernetesserviceapiv1.GetAssignedStorageConfigsOptions) ([]kubernetesserviceapiv1.AppliedStorageConfig, *core.DetailedResponse, error) {
+	m.record("GetAssignedStorageConfigs", getAssignedStorageConfigsOptions)
+	if m.GetAssignedStorageConfigsFunc != nil {
+		return m.GetAssignedStorageConfigsFunc(getAssignedStorageConfigsOptions)
+	}
+	if m.GetAssignedStorageConfigsWithContextFunc != nil {
+		return m.GetAssignedStorageConfigsWithContextFunc(context.Background(), getAssignedStorageConfigsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.AppliedStorageConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignedStorageConfigs")
+}
+
+// GetAssignedStorageConfigsWithContext calls GetAssignedStorageConfigsWithContextFunc.
+func (m *API) GetAssignedStorageConfigsWithContext(ctx context.Context, getAssignedStorageConfigsOptions *kubernetesserviceapiv1.GetAssignedStorageConfigsOptions) ([]kubernetesserviceapiv1.AppliedStorageConfig, *core.DetailedResponse, error) {
+	m.record("GetAssignedStorageConfigsWithContext", ctx, getAssignedStorageConfigsOptions)
+	if m.GetAssignedStorageConfigsWithContextFunc != nil {
+		return m.GetAssignedStorageConfigsWithContextFunc(ctx, getAssignedStorageConfigsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.AppliedStorageConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignedStorageConfigsWithContext")
+}
+
+// GetAssignment calls GetAssignmentFunc.
+func (m *API) GetAssignment(getAssignmentOptions *kubernetesserviceapiv1.GetAssignmentOptions) (*kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error) {
+	m.record("GetAssignment", getAssignmentOptions)
+	if m.GetAssignmentFunc != nil {
+		return m.GetAssignmentFunc(getAssignmentOptions)
+	}
+	if m.GetAssignmentWithContextFunc != nil {
+		return m.GetAssignmentWithContextFunc(context.Background(), getAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Subscription
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignment")
+}
+
+// GetAssignmentWithContext calls GetAssignmentWithContextFunc.
+func (m *API) GetAssignmentWithContext(ctx context.Context, getAssignmentOptions *kubernetesserviceapiv1.GetAssignmentOptions) (*kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error) {
+	m.record("GetAssignmentWithContext", ctx, getAssignmentOptions)
+	if m.GetAssignmentWithContextFunc != nil {
+		return m.GetAssignmentWithContextFunc(ctx, getAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Subscription
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignmentWithContext")
+}
+
+// GetAssignmentByName calls GetAssignmentByNameFunc.
+func (m *API) GetAssignmentByName(getAssignmentByNameOptions *kubernetesserviceapiv1.GetAssignmentByNameOptions) (*kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error) {
+	m.record("GetAssignmentByName", getAssignmentByNameOptions)
+	if m.GetAssignmentByNameFunc != nil {
+		return m.GetAssignmentByNameFunc(getAssignmentByNameOptions)
+	}
+	if m.GetAssignmentByNameWithContextFunc != nil {
+		return m.GetAssignmentByNameWithContextFunc(context.Background(), getAssignmentByNameOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Subscription
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignmentByName")
+}
+
+// GetAssignmentByNameWithContext calls GetAssignmentByNameWithContextFunc.
+func (m *API) GetAssignmentByNameWithContext(ctx context.Context, getAssignmentByNameOptions *kubernetesserviceapiv1.GetAssignmentByNameOptions) (*kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error) {
+	m.record("GetAssignmentByNameWithContext", ctx, getAssignmentByNameOptions)
+	if m.GetAssignmentByNameWithContextFunc != nil {
+		return m.GetAssignmentByNameWithContextFunc(ctx, getAssignmentByNameOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Subscription
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignmentByNameWithContext")
+}
+
+// GetAssignments calls GetAssignmentsFunc.
+func (m *API) GetAssignments(getAssignmentsOptions *kubernetesserviceapiv1.GetAssignmentsOptions) ([]kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error) {
+	m.record("GetAssignments", getAssignmentsOptions)
+	if m.GetAssignmentsFunc != nil {
+		return m.GetAssignmentsFunc(getAssignmentsOptions)
+	}
+	if m.GetAssignmentsWithContextFunc != nil {
+		return m.GetAssignmentsWithContextFunc(context.Background(), getAssignmentsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Subscription
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignments")
+}
+
+// GetAssignmentsWithContext calls GetAssignmentsWithContextFunc.
+func (m *API) GetAssignmentsWithContext(ctx context.Context, getAssignmentsOptions *kubernetesserviceapiv1.GetAssignmentsOptions) ([]kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error) {
+	m.record("GetAssignmentsWithContext", ctx, getAssignmentsOptions)
+	if m.GetAssignmentsWithContextFunc != nil {
+		return m.GetAssignmentsWithContextFunc(ctx, getAssignmentsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Subscription
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignmentsWithContext")
+}
+
+// GetAssignmentsByConfig calls GetAssignmentsByConfigFunc.
+func (m *API) GetAssignmentsByConfig(getAssignmentsByConfigOptions *kubernetesserviceapiv1.GetAssignmentsByConfigOptions) ([]kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error) {
+	m.record("GetAssignmentsByConfig", getAssignmentsByConfigOptions)
+	if m.GetAssignmentsByConfigFunc != nil {
+		return m.GetAssignmentsByConfigFunc(getAssignmentsByConfigOptions)
+	}
+	if m.GetAssignmentsByConfigWithContextFunc != nil {
+		return m.GetAssignmentsByConfigWithContextFunc(context.Background(), getAssignmentsByConfigOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Subscription
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignmentsByConfig")
+}
+
+// GetAssignmentsByConfigWithContext calls GetAssignmentsByConfigWithContextFunc.
+func (m *API) GetAssignmentsByConfigWithContext(ctx context.Context, getAssignmentsByConfigOptions *kubernetesserviceapiv1.GetAssignmentsByConfigOptions) ([]kubernetesserviceapiv1.Subscription, *core.DetailedResponse, error) {
+	m.record("GetAssignmentsByConfigWithContext", ctx, getAssignmentsByConfigOptions)
+	if m.GetAssignmentsByConfigWithContextFunc != nil {
+		return m.GetAssignmentsByConfigWithContextFunc(ctx, getAssignmentsByConfigOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Subscription
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAssignmentsByConfigWithContext")
+}
+
+// GetAvailableStorageClasses calls GetAvailableStorageClassesFunc.
+func (m *API) GetAvailableStorageClasses(getAvailableStorageClassesOptions *kubernetesserviceapiv1.GetAvailableStorageClassesOptions) (*kubernetesserviceapiv1.AvailableStorageClasses, *core.DetailedResponse, error) {
+	m.record("GetAvailableStorageClasses", getAvailableStorageClassesOptions)
+	if m.GetAvailableStorageClassesFunc != nil {
+		return m.GetAvailableStorageClassesFunc(getAvailableStorageClassesOptions)
+	}
+	if m.GetAvailableStorageClassesWithContextFunc != nil {
+		return m.GetAvailableStorageClassesWithContextFunc(context.Background(), getAvailableStorageClassesOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AvailableStorageClasses
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAvailableStorageClasses")
+}
+
+// GetAvailableStorageClassesWithContext calls GetAvailableStorageClassesWithContextFunc.
+func (m *API) GetAvailableStorageClassesWithContext(ctx context.Context, getAvailableStorageClassesOptions *kubernetesserviceapiv1.GetAvailableStorageClassesOptions) (*kubernetesserviceapiv1.AvailableStorageClasses, *core.DetailedResponse, error) {
+	m.record("GetAvailableStorageClassesWithContext", ctx, getAvailableStorageClassesOptions)
+	if m.GetAvailableStorageClassesWithContextFunc != nil {
+		return m.GetAvailableStorageClassesWithContextFunc(ctx, getAvailableStorageClassesOptions)
+	}
+	var r0 *kubernetesserviceapiv1.AvailableStorageClasses
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAvailableStorageClassesWithContext")
+}
+
+// GetStorageConfiguration calls GetStorageConfigurationFunc.
+func (m *API) GetStorageConfiguration(getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions) (*kubernetesserviceapiv1.StorageConfig, *core.DetailedResponse, error) {
+	m.record("GetStorageConfiguration", getStorageConfigurationOptions)
+	if m.GetStorageConfigurationFunc != nil {
+		return m.GetStorageConfigurationFunc(getStorageConfigurationOptions)
+	}
+	if m.GetStorageConfigurationWithContextFunc != nil {
+		return m.GetStorageConfigurationWithContextFunc(context.Background(), getStorageConfigurationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.StorageConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStorageConfiguration")
+}
+
+// GetStorageConfigurationWithContext calls GetStorageConfigurationWithContextFunc.
+func (m *API) GetStorageConfigurationWithContext(ctx context.Context, getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions) (*kubernetesserviceapiv1.StorageConfig, *core.DetailedResponse, error) {
+	m.record("GetStorageConfigurationWithContext", ctx, getStorageConfigurationOptions)
+	if m.GetStorageConfigurationWithContextFunc != nil {
+		return m.GetStorageConfigurationWithContextFunc(ctx, getStorageConfigurationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.StorageConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStorageConfigurationWithContext")
+}
+
+// GetStorageConfigurations calls GetStorageConfigurationsFunc.
+func (m *API) GetStorageConfigurations(getStorageConfigurationsOptions *kubernetesserviceapiv1.GetStorageConfigurationsOptions) ([]kubernetesserviceapiv1.StorageConfig, *core.DetailedResponse, error) {
+	m.record("GetStorageConfigurations", getStorageConfigurationsOptions)
+	if m.GetStorageConfigurationsFunc != nil {
+		return m.GetStorageConfigurationsFunc(getStorageConfigurationsOptions)
+	}
+	if m.GetStorageConfigurationsWithContextFunc != nil {
+		return m.GetStorageConfigurationsWithContextFunc(context.Background(), getStorageConfigurationsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.StorageConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStorageConfigurations")
+}
+
+// GetStorageConfigurationsWithContext calls GetStorageConfigurationsWithContextFunc.
+func (m *API) GetStorageConfigurationsWithContext(ctx context.Context, getStorageConfigurationsOptions *kubernetesserviceapiv1.GetStorageConfigurationsOptions) ([]kubernetesserviceapiv1.StorageConfig, *core.DetailedResponse, error) {
+	m.record("GetStorageConfigurationsWithContext", ctx, getStorageConfigurationsOptions)
+	if m.GetStorageConfigurationsWithContextFunc != nil {
+		return m.GetStorageConfigurationsWithContextFunc(ctx, getStorageConfigurationsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.StorageConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStorageConfigurationsWithContext")
+}
+
+// GetStorageTemplate calls GetStorageTemplateFunc.
+func (m *API) GetStorageTemplate(getStorageTemplateOptions *kubernetesserviceapiv1.GetStorageTemplateOptions) (*kubernetesserviceapiv1.RegisteredStorageVersion, *core.DetailedResponse, error) {
+	m.record("GetStorageTemplate", getStorageTemplateOptions)
+	if m.GetStorageTemplateFunc != nil {
+		return m.GetStorageTemplateFunc(getStorageTemplateOptions)
+	}
+	if m.GetStorageTemplateWithContextFunc != nil {
+		return m.GetStorageTemplateWithContextFunc(context.Background(), getStorageTemplateOptions)
+	}
+	var r0 *kubernetesserviceapiv1.RegisteredStorageVersion
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStorageTemplate")
+}
+
+// GetStorageTemplateWithContext calls GetStorageTemplateWithContextFunc.
+func (m *API) GetStorageTemplateWithContext(ctx context.Context, getStorageTemplateOptions *kubernetesserviceapiv1.GetStorageTemplateOptions) (*kubernetesserviceapiv1.RegisteredStorageVersion, *core.DetailedResponse, error) {
+	m.record("GetStorageTemplateWithContext", ctx, getStorageTemplateOptions)
+	if m.GetStorageTemplateWithContextFunc != nil {
+		return m.GetStorageTemplateWithContextFunc(ctx, getStorageTemplateOptions)
+	}
+	var r0 *kubernetesserviceapiv1.RegisteredStorageVersion
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStorageTemplateWithContext")
+}
+
+// GetStorageTemplates calls GetStorageTemplatesFunc.
+func (m *API) GetStorageTemplates(getStorageTemplatesOptions *kubernetesserviceapiv1.GetStorageTemplatesOptions) ([]kubernetesserviceapiv1.RegisteredStorage, *core.DetailedResponse, error) {
+	m.record("GetStorageTemplates", getStorageTemplatesOptions)
+	if m.GetStorageTemplatesFunc != nil {
+		return m.GetStorageTemplatesFunc(getStorageTemplatesOptions)
+	}
+	if m.GetStorageTemplatesWithContextFunc != nil {
+		return m.GetStorageTemplatesWithContextFunc(context.Background(), getStorageTemplatesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.RegisteredStorage
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStorageTemplates")
+}
+
+// GetStorageTemplatesWithContext calls GetStorageTemplatesWithContextFunc.
+func (m *API) GetStorageTemplatesWithContext(ctx context.Context, getStorageTemplatesOptions *kubernetesserviceapiv1.GetStorageTemplatesOptions) ([]kubernetesserviceapiv1.RegisteredStorage, *core.DetailedResponse, error) {
+	m.record("GetStorageTemplatesWithContext", ctx, getStorageTemplatesOptions)
+	if m.GetStorageTemplatesWithContextFunc != nil {
+		return m.GetStorageTemplatesWithContextFunc(ctx, getStorageTemplatesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.RegisteredStorage
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetStorageTemplatesWithContext")
+}
+
+// RemoveAssignment calls RemoveAssignmentFunc.
+func (m *API) RemoveAssignment(removeAssignmentOptions *kubernetesserviceapiv1.RemoveAssignmentOptions) (*kubernetesserviceapiv1.RemoveSubscriptionData, *core.DetailedResponse, error) {
+	m.record("RemoveAssignment", removeAssignmentOptions)
+	if m.RemoveAssignmentFunc != nil {
+		return m.RemoveAssignmentFunc(removeAssignmentOptions)
+	}
+	if m.RemoveAssignmentWithContextFunc != nil {
+		return m.RemoveAssignmentWithContextFunc(context.Background(), removeAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.RemoveSubscriptionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("RemoveAssignment")
+}
+
+// RemoveAssignmentWithContext calls RemoveAssignmentWithContextFunc.
+func (m *API) RemoveAssignmentWithContext(ctx context.Context, removeAssignmentOptions *kubernetesserviceapiv1.RemoveAssignmentOptions) (*kubernetesserviceapiv1.RemoveSubscriptionData, *core.DetailedResponse, error) {
+	m.record("RemoveAssignmentWithContext", ctx, removeAssignmentOptions)
+	if m.RemoveAssignmentWithContextFunc != nil {
+		return m.RemoveAssignmentWithContextFunc(ctx, removeAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.RemoveSubscriptionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("RemoveAssignmentWithContext")
+}
+
+// RemoveStorageConfiguration calls RemoveStorageConfigurationFunc.
+func (m *API) RemoveStorageConfiguration(removeStorageConfigurationOptions *kubernetesserviceapiv1.RemoveStorageConfigurationOptions) (*kubernetesserviceapiv1.ErrorResponse, *core.DetailedResponse, error) {
+	m.record("RemoveStorageConfiguration", removeStorageConfigurationOptions)
+	if m.RemoveStorageConfigurationFunc != nil {
+		return m.RemoveStorageConfigurationFunc(removeStorageConfigurationOptions)
+	}
+	if m.RemoveStorageConfigurationWithContextFunc != nil {
+		return m.RemoveStorageConfigurationWithContextFunc(context.Background(), removeStorageConfigurationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ErrorResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("RemoveStorageConfiguration")
+}
+
+// RemoveStorageConfigurationWithContext calls RemoveStorageConfigurationWithContextFunc.
+func (m *API) RemoveStorageConfigurationWithContext(ctx context.Context, removeStorageConfigurationOptions *kubernetesserviceapiv1.RemoveStorageConfigurationOptions) (*kubernetesserviceapiv1.ErrorResponse, *core.DetailedResponse, error) {
+	m.record("RemoveStorageConfigurationWithContext", ctx, removeStorageConfigurationOptions)
+	if m.RemoveStorageConfigurationWithContextFunc != nil {
+		return m.RemoveStorageConfigurationWithContextFunc(ctx, removeStorageConfigurationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ErrorResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("RemoveStorageConfigurationWithContext")
+}
+
+// UpdateAssignment calls UpdateAssignmentFunc.
+func (m *API) UpdateAssignment(updateAssignmentOptions *kubernetesserviceapiv1.UpdateAssignmentOptions) (*kubernetesserviceapiv1.UpdateSubscriptionData, *core.DetailedResponse, error) {
+	m.record("UpdateAssignment", updateAssignmentOptions)
+	if m.UpdateAssignmentFunc != nil {
+		return m.UpdateAssignmentFunc(updateAssignmentOptions)
+	}
+	if m.UpdateAssignmentWithContextFunc != nil {
+		return m.UpdateAssignmentWithContextFunc(context.Background(), updateAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UpdateSubscriptionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateAssignment")
+}
+
+// UpdateAssignmentWithContext calls UpdateAssignmentWithContextFunc.
+func (m *API) UpdateAssignmentWithContext(ctx context.Context, updateAssignmentOptions *kubernetesserviceapiv1.UpdateAssignmentOptions) (*kubernetesserviceapiv1.UpdateSubscriptionData, *core.DetailedResponse, error) {
+	m.record("UpdateAssignmentWithContext", ctx, updateAssignmentOptions)
+	if m.UpdateAssignmentWithContextFunc != nil {
+		return m.UpdateAssignmentWithContextFunc(ctx, updateAssignmentOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UpdateSubscriptionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateAssignmentWithContext")
+}
+
+// UpdateAssignmentVersion calls UpdateAssignmentVersionFunc.
+func (m *API) UpdateAssignmentVersion(updateAssignmentVersionOptions *kubernetesserviceapiv1.UpdateAssignmentVersionOptions) (*kubernetesserviceapiv1.UpdateSubscriptionVersionData, *core.DetailedResponse, error) {
+	m.record("UpdateAssignmentVersion", updateAssignmentVersionOptions)
+	if m.UpdateAssignmentVersionFunc != nil {
+		return m.UpdateAssignmentVersionFunc(updateAssignmentVersionOptions)
+	}
+	if m.UpdateAssignmentVersionWithContextFunc != nil {
+		return m.UpdateAssignmentVersionWithContextFunc(context.Background(), updateAssignmentVersionOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UpdateSubscriptionVersionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateAssignmentVersion")
+}
+
+// UpdateAssignmentVersionWithContext calls UpdateAssignmentVersionWithContextFunc.
+func (m *API) UpdateAssignmentVersionWithContext(ctx context.Context, updateAssignmentVersionOptions *kubernetesserviceapiv1.UpdateAssignmentVersionOptions) (*kubernetesserviceapiv1.UpdateSubscriptionVersionData, *core.DetailedResponse, error) {
+	m.record("UpdateAssignmentVersionWithContext", ctx, updateAssignmentVersionOptions)
+	if m.UpdateAssignmentVersionWithContextFunc != nil {
+		return m.UpdateAssignmentVersionWithContextFunc(ctx, updateAssignmentVersionOptions)
+	}
+	var r0 *kubernetesserviceapiv1.UpdateSubscriptionVersionData
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateAssignmentVersionWithContext")
+}
+
+// UpdateStorageConfiguration calls UpdateStorageConfigurationFunc.
+func (m *API) UpdateStorageConfiguration(updateStorageConfigurationOptions *kubernetesserviceapiv1.UpdateStorageConfigurationOptions) (*kubernetesserviceapiv1.ErrorResponse, *core.DetailedResponse, error) {
+	m.record("UpdateStorageConfiguration", updateStorageConfigurationOptions)
+	if m.UpdateStorageConfigurationFunc != nil {
+		return m.UpdateStorageConfigurationFunc(updateStorageConfigurationOptions)
+	}
+	if m.UpdateStorageConfigurationWithContextFunc != nil {
+		return m.UpdateStorageConfigurationWithContextFunc(context.Background(), updateStorageConfigurationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ErrorResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateStorageConfiguration")
+}
+
+// UpdateStorageConfigurationWithContext calls UpdateStorageConfigurationWithContextFunc.
+func (m *API) UpdateStorageConfigurationWithContext(ctx context.Context, updateStorageConfigurationOptions *kubernetesserviceapiv1.UpdateStorageConfigurationOptions) (*kubernetesserviceapiv1.ErrorResponse, *core.DetailedResponse, error) {
+	m.record("UpdateStorageConfigurationWithContext", ctx, updateStorageConfigurationOptions)
+	if m.UpdateStorageConfigurationWithContextFunc != nil {
+		return m.UpdateStorageConfigurationWithContextFunc(ctx, updateStorageConfigurationOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ErrorResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateStorageConfigurationWithContext")
+}
+
+// GetAddons calls GetAddonsFunc.
+func (m *API) GetAddons(getAddonsOptions *kubernetesserviceapiv1.GetAddonsOptions) ([]kubernetesserviceapiv1.AddonCommon, *core.DetailedResponse, error) {
+	m.record("GetAddons", getAddonsOptions)
+	if m.GetAddonsFunc != nil {
+		return m.GetAddonsFunc(getAddonsOptions)
+	}
+	if m.GetAddonsWithContextFunc != nil {
+		return m.GetAddonsWithContextFunc(context.Background(), getAddonsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.AddonCommon
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAddons")
+}
+
+// GetAddonsWithContext calls GetAddonsWithContextFunc.
+func (m *API) GetAddonsWithContext(ctx context.Context, getAddonsOptions *kubernetesserviceapiv1.GetAddonsOptions) ([]kubernetesserviceapiv1.AddonCommon, *core.DetailedResponse, error) {
+	m.record("GetAddonsWithContext", ctx, getAddonsOptions)
+	if m.GetAddonsWithContextFunc != nil {
+		return m.GetAddonsWithContextFunc(ctx, getAddonsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.AddonCommon
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetAddonsWithContext")
+}
+
+// GetBluemixConfig calls GetBluemixConfigFunc.
+func (m *API) GetBluemixConfig(getBluemixConfigOptions *kubernetesserviceapiv1.GetBluemixConfigOptions) (*kubernetesserviceapiv1.BluemixConfig, *core.DetailedResponse, error) {
+	m.record("GetBluemixConfig", getBluemixConfigOptions)
+	if m.GetBluemixConfigFunc != nil {
+		return m.GetBluemixConfigFunc(getBluemixConfigOptions)
+	}
+	if m.GetBluemixConfigWithContextFunc != nil {
+		return m.GetBluemixConfigWithContextFunc(context.Background(), getBluemixConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.BluemixConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetBluemixConfig")
+}
+
+// GetBluemixConfigWithContext calls GetBluemixConfigWithContextFunc.
+func (m *API) GetBluemixConfigWithContext(ctx context.Context, getBluemixConfigOptions *kubernetesserviceapiv1.GetBluemixConfigOptions) (*kubernetesserviceapiv1.BluemixConfig, *core.DetailedResponse, error) {
+	m.record("GetBluemixConfigWithContext", ctx, getBluemixConfigOptions)
+	if m.GetBluemixConfigWithContextFunc != nil {
+		return m.GetBluemixConfigWithContextFunc(ctx, getBluemixConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.BluemixConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetBluemixConfigWithContext")
+}
+
+// GetDatacenterMachineTypes calls GetDatacenterMachineTypesFunc.
+func (m *API) GetDatacenterMachineTypes(getDatacenterMachineTypesOptions *kubernetesserviceapiv1.GetDatacenterMachineTypesOptions) ([]kubernetesserviceapiv1.MachineType, *core.DetailedResponse, error) {
+	m.record("GetDatacenterMachineTypes", getDatacenterMachineTypesOptions)
+	if m.GetDatacenterMachineTypesFunc != nil {
+		return m.GetDatacenterMachineTypesFunc(getDatacenterMachineTypesOptions)
+	}
+	if m.GetDatacenterMachineTypesWithContextFunc != nil {
+		return m.GetDatacenterMachineTypesWithContextFunc(context.Background(), getDatacenterMachineTypesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MachineType
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetDatacenterMachineTypes")
+}
+
+// GetDatacenterMachineTypesWithContext calls GetDatacenterMachineTypesWithContextFunc.
+func (m *API) GetDatacenterMachineTypesWithContext(ctx context.Context, getDatacenterMachineTypesOptions *kubernetesserviceapiv1.GetDatacenterMachineTypesOptions) ([]kubernetesserviceapiv1.MachineType, *core.DetailedResponse, error) {
+	m.record("GetDatacenterMachineTypesWithContext", ctx, getDatacenterMachineTypesOptions)
+	if m.GetDatacenterMachineTypesWithContextFunc != nil {
+		return m.GetDatacenterMachineTypesWithContextFunc(ctx, getDatacenterMachineTypesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MachineType
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetDatacenterMachineTypesWithContext")
+}
+
+// GetKubeVersions calls GetKubeVersionsFunc.
+func (m *API) GetKubeVersions(getKubeVersionsOptions *kubernetesserviceapiv1.GetKubeVersionsOptions) ([]kubernetesserviceapiv1.KubeVersion, *core.DetailedResponse, error) {
+	m.record("GetKubeVersions", getKubeVersionsOptions)
+	if m.GetKubeVersionsFunc != nil {
+		return m.GetKubeVersionsFunc(getKubeVersionsOptions)
+	}
+	if m.GetKubeVersionsWithContextFunc != nil {
+		return m.GetKubeVersionsWithContextFunc(context.Background(), getKubeVersionsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.KubeVersion
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetKubeVersions")
+}
+
+// GetKubeVersionsWithContext calls GetKubeVersionsWithContextFunc.
+func (m *API) GetKubeVersionsWithContext(ctx context.Context, getKubeVersionsOptions *kubernetesserviceapiv1.GetKubeVersionsOptions) ([]kubernetesserviceapiv1.KubeVersion, *core.DetailedResponse, error) {
+	m.record("GetKubeVersionsWithContext", ctx, getKubeVersionsOptions)
+	if m.GetKubeVersionsWithContextFunc != nil {
+		return m.GetKubeVersionsWithContextFunc(ctx, getKubeVersionsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.KubeVersion
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetKubeVersionsWithContext")
+}
+
+// ListLocations calls ListLocationsFunc.
+func (m *API) ListLocations(listLocationsOptions *kubernetesserviceapiv1.ListLocationsOptions) ([]kubernetesserviceapiv1.IKSLocation, *core.DetailedResponse, error) {
+	m.record("ListLocations", listLocationsOptions)
+	if m.ListLocationsFunc != nil {
+		return m.ListLocationsFunc(listLocationsOptions)
+	}
+	if m.ListLocationsWithContextFunc != nil {
+		return m.ListLocationsWithContextFunc(context.Background(), listLocationsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.IKSLocation
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListLocations")
+}
+
+// ListLocationsWithContext calls ListLocationsWithContextFunc.
+func (m *API) ListLocationsWithContext(ctx context.Context, listLocationsOptions *kubernetesserviceapiv1.ListLocationsOptions) ([]kubernetesserviceapiv1.IKSLocation, *core.DetailedResponse, error) {
+	m.record("ListLocationsWithContext", ctx, listLocationsOptions)
+	if m.ListLocationsWithContextFunc != nil {
+		return m.ListLocationsWithContextFunc(ctx, listLocationsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.IKSLocation
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListLocationsWithContext")
+}
+
+// GetMessages calls GetMessagesFunc.
+func (m *API) GetMessages(getMessagesOptions *kubernetesserviceapiv1.GetMessagesOptions) ([]kubernetesserviceapiv1.MessageResponse, *core.DetailedResponse, error) {
+	m.record("GetMessages", getMessagesOptions)
+	if m.GetMessagesFunc != nil {
+		return m.GetMessagesFunc(getMessagesOptions)
+	}
+	if m.GetMessagesWithContextFunc != nil {
+		return m.GetMessagesWithContextFunc(context.Background(), getMessagesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MessageResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMessages")
+}
+
+// GetMessagesWithContext calls GetMessagesWithContextFunc.
+func (m *API) GetMessagesWithContext(ctx context.Context, getMessagesOptions *kubernetesserviceapiv1.GetMessagesOptions) ([]kubernetesserviceapiv1.MessageResponse, *core.DetailedResponse, error) {
+	m.record("GetMessagesWithContext", ctx, getMessagesOptions)
+	if m.GetMessagesWithContextFunc != nil {
+		return m.GetMessagesWithContextFunc(ctx, getMessagesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MessageResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetMessagesWithContext")
+}
+
+// GetProductConfig calls GetProductConfigFunc.
+func (m *API) GetProductConfig(getProductConfigOptions *kubernetesserviceapiv1.GetProductConfigOptions) (*kubernetesserviceapiv1.ProductConfig, *core.DetailedResponse, error) {
+	m.record("GetProductConfig", getProductConfigOptions)
+	if m.GetProductConfigFunc != nil {
+		return m.GetProductConfigFunc(getProductConfigOptions)
+	}
+	if m.GetProductConfigWithContextFunc != nil {
+		return m.GetProductConfigWithContextFunc(context.Background(), getProductConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ProductConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetProductConfig")
+}
+
+// GetProductConfigWithContext calls GetProductConfigWithContextFunc.
+func (m *API) GetProductConfigWithContext(ctx context.Context, getProductConfigOptions *kubernetesserviceapiv1.GetProductConfigOptions) (*kubernetesserviceapiv1.ProductConfig, *core.DetailedResponse, error) {
+	m.record("GetProductConfigWithContext", ctx, getProductConfigOptions)
+	if m.GetProductConfigWithContextFunc != nil {
+		return m.GetProductConfigWithContextFunc(ctx, getProductConfigOptions)
+	}
+	var r0 *kubernetesserviceapiv1.ProductConfig
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetProductConfigWithContext")
+}
+
+// GetRegions calls GetRegionsFunc.
+func (m *API) GetRegions(getRegionsOptions *kubernetesserviceapiv1.GetRegionsOptions) (*kubernetesserviceapiv1.Regions, *core.DetailedResponse, error) {
+	m.record("GetRegions", getRegionsOptions)
+	if m.GetRegionsFunc != nil {
+		return m.GetRegionsFunc(getRegionsOptions)
+	}
+	if m.GetRegionsWithContextFunc != nil {
+		return m.GetRegionsWithContextFunc(context.Background(), getRegionsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Regions
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetRegions")
+}
+
+// GetRegionsWithContext calls GetRegionsWithContextFunc.
+func (m *API) GetRegionsWithContext(ctx context.Context, getRegionsOptions *kubernetesserviceapiv1.GetRegionsOptions) (*kubernetesserviceapiv1.Regions, *core.DetailedResponse, error) {
+	m.record("GetRegionsWithContext", ctx, getRegionsOptions)
+	if m.GetRegionsWithContextFunc != nil {
+		return m.GetRegionsWithContextFunc(ctx, getRegionsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.Regions
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetRegionsWithContext")
+}
+
+// GetVersions calls GetVersionsFunc.
+func (m *API) GetVersions(getVersionsOptions *kubernetesserviceapiv1.GetVersionsOptions) (map[string][]kubernetesserviceapiv1.KubeVersion, *core.DetailedResponse, error) {
+	m.record("GetVersions", getVersionsOptions)
+	if m.GetVersionsFunc != nil {
+		return m.GetVersionsFunc(getVersionsOptions)
+	}
+	if m.GetVersionsWithContextFunc != nil {
+		return m.GetVersionsWithContextFunc(context.Background(), getVersionsOptions)
+	}
+	var r0 map[string][]kubernetesserviceapiv1.KubeVersion
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVersions")
+}
+
+// GetVersionsWithContext calls GetVersionsWithContextFunc.
+func (m *API) GetVersionsWithContext(ctx context.Context, getVersionsOptions *kubernetesserviceapiv1.GetVersionsOptions) (map[string][]kubernetesserviceapiv1.KubeVersion, *core.DetailedResponse, error) {
+	m.record("GetVersionsWithContext", ctx, getVersionsOptions)
+	if m.GetVersionsWithContextFunc != nil {
+		return m.GetVersionsWithContextFunc(ctx, getVersionsOptions)
+	}
+	var r0 map[string][]kubernetesserviceapiv1.KubeVersion
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVersionsWithContext")
+}
+
+// GetZones calls GetZonesFunc.
+func (m *API) GetZones(getZonesOptions *kubernetesserviceapiv1.GetZonesOptions) ([]kubernetesserviceapiv1.ZoneResponse, *core.DetailedResponse, error) {
+	m.record("GetZones", getZonesOptions)
+	if m.GetZonesFunc != nil {
+		return m.GetZonesFunc(getZonesOptions)
+	}
+	if m.GetZonesWithContextFunc != nil {
+		return m.GetZonesWithContextFunc(context.Background(), getZonesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ZoneResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetZones")
+}
+
+// GetZonesWithContext calls GetZonesWithContextFunc.
+func (m *API) GetZonesWithContext(ctx context.Context, getZonesOptions *kubernetesserviceapiv1.GetZonesOptions) ([]kubernetesserviceapiv1.ZoneResponse, *core.DetailedResponse, error) {
+	m.record("GetZonesWithContext", ctx, getZonesOptions)
+	if m.GetZonesWithContextFunc != nil {
+		return m.GetZonesWithContextFunc(ctx, getZonesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ZoneResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetZonesWithContext")
+}
+
+// V2GetMessages calls V2GetMessagesFunc.
+func (m *API) V2GetMessages(v2GetMessagesOptions *kubernetesserviceapiv1.V2GetMessagesOptions) ([]kubernetesserviceapiv1.MessageResponse, *core.DetailedResponse, error) {
+	m.record("V2GetMessages", v2GetMessagesOptions)
+	if m.V2GetMessagesFunc != nil {
+		return m.V2GetMessagesFunc(v2GetMessagesOptions)
+	}
+	if m.V2GetMessagesWithContextFunc != nil {
+		return m.V2GetMessagesWithContextFunc(context.Background(), v2GetMessagesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MessageResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetMessages")
+}
+
+// V2GetMessagesWithContext calls V2GetMessagesWithContextFunc.
+func (m *API) V2GetMessagesWithContext(ctx context.Context, v2GetMessagesOptions *kubernetesserviceapiv1.V2GetMessagesOptions) ([]kubernetesserviceapiv1.MessageResponse, *core.DetailedResponse, error) {
+	m.record("V2GetMessagesWithContext", ctx, v2GetMessagesOptions)
+	if m.V2GetMessagesWithContextFunc != nil {
+		return m.V2GetMessagesWithContextFunc(ctx, v2GetMessagesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.MessageResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetMessagesWithContext")
+}
+
+// ApplyRBACAndGetKubeconfig calls ApplyRBACAndGetKubeconfigFunc.
+func (m *API) ApplyRBACAndGetKubeconfig(applyRBACAndGetKubeconfigOptions *kubernetesserviceapiv1.ApplyRBACAndGetKubeconfigOptions) (*core.DetailedResponse, error) {
+	m.record("ApplyRBACAndGetKubeconfig", applyRBACAndGetKubeconfigOptions)
+	if m.ApplyRBACAndGetKubeconfigFunc != nil {
+		return m.ApplyRBACAndGetKubeconfigFunc(applyRBACAndGetKubeconfigOptions)
+	}
+	if m.ApplyRBACAndGetKubeconfigWithContextFunc != nil {
+		return m.ApplyRBACAndGetKubeconfigWithContextFunc(context.Background(), applyRBACAndGetKubeconfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ApplyRBACAndGetKubeconfig")
+}
+
+// ApplyRBACAndGetKubeconfigWithContext calls ApplyRBACAndGetKubeconfigWithContextFunc.
+func (m *API) ApplyRBACAndGetKubeconfigWithContext(ctx context.Context, applyRBACAndGetKubeconfigOptions *kubernetesserviceapiv1.ApplyRBACAndGetKubeconfigOptions) (*core.DetailedResponse, error) {
+	m.record("ApplyRBACAndGetKubeconfigWithContext", ctx, applyRBACAndGetKubeconfigOptions)
+	if m.ApplyRBACAndGetKubeconfigWithContextFunc != nil {
+		return m.ApplyRBACAndGetKubeconfigWithContextFunc(ctx, applyRBACAndGetKubeconfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("ApplyRBACAndGetKubeconfigWithContext")
+}
+
+// AutoUpdateMaster calls AutoUpdateMasterFunc.
+func (m *API) AutoUpdateMaster(autoUpdateMasterOptions *kubernetesserviceapiv1.AutoUpdateMasterOptions) (*core.DetailedResponse, error) {
+	m.record("AutoUpdateMaster", autoUpdateMasterOptions)
+	if m.AutoUpdateMasterFunc != nil {
+		return m.AutoUpdateMasterFunc(autoUpdateMasterOptions)
+	}
+	if m.AutoUpdateMasterWithContextFunc != nil {
+		return m.AutoUpdateMasterWithContextFunc(context.Background(), autoUpdateMasterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AutoUpdateMaster")
+}
+
+// AutoUpdateMasterWithContext calls AutoUpdateMasterWithContextFunc.
+func (m *API) AutoUpdateMasterWithContext(ctx context.Context, autoUpdateMasterOptions *kubernetesserviceapiv1.AutoUpdateMasterOptions) (*core.DetailedResponse, error) {
+	m.record("AutoUpdateMasterWithContext", ctx, autoUpdateMasterOptions)
+	if m.AutoUpdateMasterWithContextFunc != nil {
+		return m.AutoUpdateMasterWithContextFunc(ctx, autoUpdateMasterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("AutoUpdateMasterWithContext")
+}
+
+// ClassicGetCluster calls ClassicGetClusterFunc.
+func (m *API) ClassicGetCluster(classicGetClusterOptions *kubernetesserviceapiv1.ClassicGetClusterOptions) ([]kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetCluster", classicGetClusterOptions)
+	if m.ClassicGetClusterFunc != nil {
+		return m.ClassicGetClusterFunc(classicGetClusterOptions)
+	}
+	if m.ClassicGetClusterWithContextFunc != nil {
+		return m.ClassicGetClusterWithContextFunc(context.Background(), classicGetClusterOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetCluster")
+}
+
+// ClassicGetClusterWithContext calls ClassicGetClusterWithContextFunc.
+func (m *API) ClassicGetClusterWithContext(ctx context.Context, classicGetClusterOptions *kubernetesserviceapiv1.ClassicGetClusterOptions) ([]kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetClusterWithContext", ctx, classicGetClusterOptions)
+	if m.ClassicGetClusterWithContextFunc != nil {
+		return m.ClassicGetClusterWithContextFunc(ctx, classicGetClusterOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetClusterWithContext")
+}
+
+// ClassicGetClusters calls ClassicGetClustersFunc.
+func (m *API) ClassicGetClusters(classicGetClustersOptions *kubernetesserviceapiv1.ClassicGetClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetClusters", classicGetClustersOptions)
+	if m.ClassicGetClustersFunc != nil {
+		return m.ClassicGetClustersFunc(classicGetClustersOptions)
+	}
+	if m.ClassicGetClustersWithContextFunc != nil {
+		return m.ClassicGetClustersWithContextFunc(context.Background(), classicGetClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetClustersResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetClusters")
+}
+
+// ClassicGetClustersWithContext calls ClassicGetClustersWithContextFunc.
+func (m *API) ClassicGetClustersWithContext(ctx context.Context, classicGetClustersOptions *kubernetesserviceapiv1.ClassicGetClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetClustersWithContext", ctx, classicGetClustersOptions)
+	if m.ClassicGetClustersWithContextFunc != nil {
+		return m.ClassicGetClustersWithContextFunc(ctx, classicGetClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetClustersResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetClustersWithContext")
+}
+
+// GetVLANs calls GetVLANsFunc.
+func (m *API) GetVLANs(getVLANsOptions *kubernetesserviceapiv1.GetVLANsOptions) (*kubernetesserviceapiv1.VLAN, *core.DetailedResponse, error) {
+	m.record("GetVLANs", getVLANsOptions)
+	if m.GetVLANsFunc != nil {
+		return m.GetVLANsFunc(getVLANsOptions)
+	}
+	if m.GetVLANsWithContextFunc != nil {
+		return m.GetVLANsWithContextFunc(context.Background(), getVLANsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VLAN
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVLANs")
+}
+
+// GetVLANsWithContext calls GetVLANsWithContextFunc.
+func (m *API) GetVLANsWithContext(ctx context.Context, getVLANsOptions *kubernetesserviceapiv1.GetVLANsOptions) (*kubernetesserviceapiv1.VLAN, *core.DetailedResponse, error) {
+	m.record("GetVLANsWithContext", ctx, getVLANsOptions)
+	if m.GetVLANsWithContextFunc != nil {
+		return m.GetVLANsWithContextFunc(ctx, getVLANsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.VLAN
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVLANsWithContext")
+}
+
+// ClassicGetWorker calls ClassicGetWorkerFunc.
+func (m *API) ClassicGetWorker(classicGetWorkerOptions *kubernetesserviceapiv1.ClassicGetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetWorker", classicGetWorkerOptions)
+	if m.ClassicGetWorkerFunc != nil {
+		return m.ClassicGetWorkerFunc(classicGetWorkerOptions)
+	}
+	if m.ClassicGetWorkerWithContextFunc != nil {
+		return m.ClassicGetWorkerWithContextFunc(context.Background(), classicGetWorkerOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetWorker")
+}
+
+// ClassicGetWorkerWithContext calls ClassicGetWorkerWithContextFunc.
+func (m *API) ClassicGetWorkerWithContext(ctx context.Context, classicGetWorkerOptions *kubernetesserviceapiv1.ClassicGetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetWorkerWithContext", ctx, classicGetWorkerOptions)
+	if m.ClassicGetWorkerWithContextFunc != nil {
+		return m.ClassicGetWorkerWithContextFunc(ctx, classicGetWorkerOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetWorkerWithContext")
+}
+
+// ClassicGetWorkerPool calls ClassicGetWorkerPoolFunc.
+func (m *API) ClassicGetWorkerPool(classicGetWorkerPoolOptions *kubernetesserviceapiv1.ClassicGetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetWorkerPool", classicGetWorkerPoolOptions)
+	if m.ClassicGetWorkerPoolFunc != nil {
+		return m.ClassicGetWorkerPoolFunc(classicGetWorkerPoolOptions)
+	}
+	if m.ClassicGetWorkerPoolWithContextFunc != nil {
+		return m.ClassicGetWorkerPoolWithContextFunc(context.Background(), classicGetWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetWorkerPool")
+}
+
+// ClassicGetWorkerPoolWithContext calls ClassicGetWorkerPoolWithContextFunc.
+func (m *API) ClassicGetWorkerPoolWithContext(ctx context.Context, classicGetWorkerPoolOptions *kubernetesserviceapiv1.ClassicGetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetWorkerPoolWithContext", ctx, classicGetWorkerPoolOptions)
+	if m.ClassicGetWorkerPoolWithContextFunc != nil {
+		return m.ClassicGetWorkerPoolWithContextFunc(ctx, classicGetWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetWorkerPoolWithContext")
+}
+
+// ClassicGetWorkerPools calls ClassicGetWorkerPoolsFunc.
+func (m *API) ClassicGetWorkerPools(classicGetWorkerPoolsOptions *kubernetesserviceapiv1.ClassicGetWorkerPoolsOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetWorkerPools", classicGetWorkerPoolsOptions)
+	if m.ClassicGetWorkerPoolsFunc != nil {
+		return m.ClassicGetWorkerPoolsFunc(classicGetWorkerPoolsOptions)
+	}
+	if m.ClassicGetWorkerPoolsWithContextFunc != nil {
+		return m.ClassicGetWorkerPoolsWithContextFunc(context.Background(), classicGetWorkerPoolsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetWorkerPools")
+}
+
+// ClassicGetWorkerPoolsWithContext calls ClassicGetWorkerPoolsWithContextFunc.
+func (m *API) ClassicGetWorkerPoolsWithContext(ctx context.Context, classicGetWorkerPoolsOptions *kubernetesserviceapiv1.ClassicGetWorkerPoolsOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetWorkerPoolsWithContext", ctx, classicGetWorkerPoolsOptions)
+	if m.ClassicGetWorkerPoolsWithContextFunc != nil {
+		return m.ClassicGetWorkerPoolsWithContextFunc(ctx, classicGetWorkerPoolsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetWorkerPoolsWithContext")
+}
+
+// ClassicGetWorkers calls ClassicGetWorkersFunc.
+func (m *API) ClassicGetWorkers(classicGetWorkersOptions *kubernetesserviceapiv1.ClassicGetWorkersOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetWorkers", classicGetWorkersOptions)
+	if m.ClassicGetWorkersFunc != nil {
+		return m.ClassicGetWorkersFunc(classicGetWorkersOptions)
+	}
+	if m.ClassicGetWorkersWithContextFunc != nil {
+		return m.ClassicGetWorkersWithContextFunc(context.Background(), classicGetWorkersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetWorkers")
+}
+
+// ClassicGetWorkersWithContext calls ClassicGetWorkersWithContextFunc.
+func (m *API) ClassicGetWorkersWithContext(ctx context.Context, classicGetWorkersOptions *kubernetesserviceapiv1.ClassicGetWorkersOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("ClassicGetWorkersWithContext", ctx, classicGetWorkersOptions)
+	if m.ClassicGetWorkersWithContextFunc != nil {
+		return m.ClassicGetWorkersWithContextFunc(ctx, classicGetWorkersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ClassicGetWorkersWithContext")
+}
+
+// KmsEnableCluster calls KmsEnableClusterFunc.
+func (m *API) KmsEnableCluster(kmsEnableClusterOptions *kubernetesserviceapiv1.KmsEnableClusterOptions) (*core.DetailedResponse, error) {
+	m.record("KmsEnableCluster", kmsEnableClusterOptions)
+	if m.KmsEnableClusterFunc != nil {
+		return m.KmsEnableClusterFunc(kmsEnableClusterOptions)
+	}
+	if m.KmsEnableClusterWithContextFunc != nil {
+		return m.KmsEnableClusterWithContextFunc(context.Background(), kmsEnableClusterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("KmsEnableCluster")
+}
+
+// KmsEnableClusterWithContext calls KmsEnableClusterWithContextFunc.
+func (m *API) KmsEnableClusterWithContext(ctx context.Context, kmsEnableClusterOptions *kubernetesserviceapiv1.KmsEnableClusterOptions) (*core.DetailedResponse, error) {
+	m.record("KmsEnableClusterWithContext", ctx, kmsEnableClusterOptions)
+	if m.KmsEnableClusterWithContextFunc != nil {
+		return m.KmsEnableClusterWithContextFunc(ctx, kmsEnableClusterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("KmsEnableClusterWithContext")
+}
+
+// KmsGetCRKs calls KmsGetCRKsFunc.
+func (m *API) KmsGetCRKs(kmsGetCRKsOptions *kubernetesserviceapiv1.KmsGetCRKsOptions) ([]kubernetesserviceapiv1.GetCRKResponse, *core.DetailedResponse, error) {
+	m.record("KmsGetCRKs", kmsGetCRKsOptions)
+	if m.KmsGetCRKsFunc != nil {
+		return m.KmsGetCRKsFunc(kmsGetCRKsOptions)
+	}
+	if m.KmsGetCRKsWithContextFunc != nil {
+		return m.KmsGetCRKsWithContextFunc(context.Background(), kmsGetCRKsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetCRKResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("KmsGetCRKs")
+}
+
+// KmsGetCRKsWithContext calls KmsGetCRKsWithContextFunc.
+func (m *API) KmsGetCRKsWithContext(ctx context.Context, kmsGetCRKsOptions *kubernetesserviceapiv1.KmsGetCRKsOptions) ([]kubernetesserviceapiv1.GetCRKResponse, *core.DetailedResponse, error) {
+	m.record("KmsGetCRKsWithContext", ctx, kmsGetCRKsOptions)
+	if m.KmsGetCRKsWithContextFunc != nil {
+		return m.KmsGetCRKsWithContextFunc(ctx, kmsGetCRKsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetCRKResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("KmsGetCRKsWithContext")
+}
+
+// GetCluster calls GetClusterFunc.
+func (m *API) GetCluster(getClusterOptions *kubernetesserviceapiv1.GetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error) {
+	m.record("GetCluster", getClusterOptions)
+	if m.GetClusterFunc != nil {
+		return m.GetClusterFunc(getClusterOptions)
+	}
+	if m.GetClusterWithContextFunc != nil {
+		return m.GetClusterWithContextFunc(context.Background(), getClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetCluster")
+}
+
+// GetClusterWithContext calls GetClusterWithContextFunc.
+func (m *API) GetClusterWithContext(ctx context.Context, getClusterOptions *kubernetesserviceapiv1.GetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error) {
+	m.record("GetClusterWithContext", ctx, getClusterOptions)
+	if m.GetClusterWithContextFunc != nil {
+		return m.GetClusterWithContextFunc(ctx, getClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetClusterWithContext")
+}
+
+// V2GetClusterAddons calls V2GetClusterAddonsFunc.
+func (m *API) V2GetClusterAddons(v2GetClusterAddonsOptions *kubernetesserviceapiv1.V2GetClusterAddonsOptions) ([]kubernetesserviceapiv1.ClusterAddon, *core.DetailedResponse, error) {
+	m.record("V2GetClusterAddons", v2GetClusterAddonsOptions)
+	if m.V2GetClusterAddonsFunc != nil {
+		return m.V2GetClusterAddonsFunc(v2GetClusterAddonsOptions)
+	}
+	if m.V2GetClusterAddonsWithContextFunc != nil {
+		return m.V2GetClusterAddonsWithContextFunc(context.Background(), v2GetClusterAddonsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ClusterAddon
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetClusterAddons")
+}
+
+// V2GetClusterAddonsWithContext calls V2GetClusterAddonsWithContextFunc.
+func (m *API) V2GetClusterAddonsWithContext(ctx context.Context, v2GetClusterAddonsOptions *kubernetesserviceapiv1.V2GetClusterAddonsOptions) ([]kubernetesserviceapiv1.ClusterAddon, *core.DetailedResponse, error) {
+	m.record("V2GetClusterAddonsWithContext", ctx, v2GetClusterAddonsOptions)
+	if m.V2GetClusterAddonsWithContextFunc != nil {
+		return m.V2GetClusterAddonsWithContextFunc(ctx, v2GetClusterAddonsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.ClusterAddon
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetClusterAddonsWithContext")
+}
+
+// V2GetFlavors calls V2GetFlavorsFunc.
+func (m *API) V2GetFlavors(v2GetFlavorsOptions *kubernetesserviceapiv1.V2GetFlavorsOptions) ([]kubernetesserviceapiv1.Flavor, *core.DetailedResponse, error) {
+	m.record("V2GetFlavors", v2GetFlavorsOptions)
+	if m.V2GetFlavorsFunc != nil {
+		return m.V2GetFlavorsFunc(v2GetFlavorsOptions)
+	}
+	if m.V2GetFlavorsWithContextFunc != nil {
+		return m.V2GetFlavorsWithContextFunc(context.Background(), v2GetFlavorsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Flavor
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetFlavors")
+}
+
+// V2GetFlavorsWithContext calls V2GetFlavorsWithContextFunc.
+func (m *API) V2GetFlavorsWithContext(ctx context.Context, v2GetFlavorsOptions *kubernetesserviceapiv1.V2GetFlavorsOptions) ([]kubernetesserviceapiv1.Flavor, *core.DetailedResponse, error) {
+	m.record("V2GetFlavorsWithContext", ctx, v2GetFlavorsOptions)
+	if m.V2GetFlavorsWithContextFunc != nil {
+		return m.V2GetFlavorsWithContextFunc(ctx, v2GetFlavorsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Flavor
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("V2GetFlavorsWithContext")
+}
+
+// KmsGetInstances calls KmsGetInstancesFunc.
+func (m *API) KmsGetInstances(kmsGetInstancesOptions *kubernetesserviceapiv1.KmsGetInstancesOptions) ([]kubernetesserviceapiv1.GetKMSInstanceResponse, *core.DetailedResponse, error) {
+	m.record("KmsGetInstances", kmsGetInstancesOptions)
+	if m.KmsGetInstancesFunc != nil {
+		return m.KmsGetInstancesFunc(kmsGetInstancesOptions)
+	}
+	if m.KmsGetInstancesWithContextFunc != nil {
+		return m.KmsGetInstancesWithContextFunc(context.Background(), kmsGetInstancesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetKMSInstanceResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("KmsGetInstances")
+}
+
+// KmsGetInstancesWithContext calls KmsGetInstancesWithContextFunc.
+func (m *API) KmsGetInstancesWithContext(ctx context.Context, kmsGetInstancesOptions *kubernetesserviceapiv1.KmsGetInstancesOptions) ([]kubernetesserviceapiv1.GetKMSInstanceResponse, *core.DetailedResponse, error) {
+	m.record("KmsGetInstancesWithContext", ctx, kmsGetInstancesOptions)
+	if m.KmsGetInstancesWithContextFunc != nil {
+		return m.KmsGetInstancesWithContextFunc(ctx, kmsGetInstancesOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetKMSInstanceResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("KmsGetInstancesWithContext")
+}
+
+// GetKubeconfig calls GetKubeconfigFunc.
+func (m *API) GetKubeconfig(getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*core.DetailedResponse, error) {
+	m.record("GetKubeconfig", getKubeconfigOptions)
+	if m.GetKubeconfigFunc != nil {
+		return m.GetKubeconfigFunc(getKubeconfigOptions)
+	}
+	if m.GetKubeconfigWithContextFunc != nil {
+		return m.GetKubeconfigWithContextFunc(context.Background(), getKubeconfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("GetKubeconfig")
+}
+
+// GetKubeconfigWithContext calls GetKubeconfigWithContextFunc.
+func (m *API) GetKubeconfigWithContext(ctx context.Context, getKubeconfigOptions *kubernetesserviceapiv1.GetKubeconfigOptions) (*core.DetailedResponse, error) {
+	m.record("GetKubeconfigWithContext", ctx, getKubeconfigOptions)
+	if m.GetKubeconfigWithContextFunc != nil {
+		return m.GetKubeconfigWithContextFunc(ctx, getKubeconfigOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("GetKubeconfigWithContext")
+}
+
+// GetQuota calls GetQuotaFunc.
+func (m *API) GetQuota(getQuotaOptions *kubernetesserviceapiv1.GetQuotaOptions) ([]kubernetesserviceapiv1.GetQuotaResource, *core.DetailedResponse, error) {
+	m.record("GetQuota", getQuotaOptions)
+	if m.GetQuotaFunc != nil {
+		return m.GetQuotaFunc(getQuotaOptions)
+	}
+	if m.GetQuotaWithContextFunc != nil {
+		return m.GetQuotaWithContextFunc(context.Background(), getQuotaOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetQuotaResource
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetQuota")
+}
+
+// GetQuotaWithContext calls GetQuotaWithContextFunc.
+func (m *API) GetQuotaWithContext(ctx context.Context, getQuotaOptions *kubernetesserviceapiv1.GetQuotaOptions) ([]kubernetesserviceapiv1.GetQuotaResource, *core.DetailedResponse, error) {
+	m.record("GetQuotaWithContext", ctx, getQuotaOptions)
+	if m.GetQuotaWithContextFunc != nil {
+		return m.GetQuotaWithContextFunc(ctx, getQuotaOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetQuotaResource
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetQuotaWithContext")
+}
+
+// GetWorker calls GetWorkerFunc.
+func (m *API) GetWorker(getWorkerOptions *kubernetesserviceapiv1.GetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("GetWorker", getWorkerOptions)
+	if m.GetWorkerFunc != nil {
+		return m.GetWorkerFunc(getWorkerOptions)
+	}
+	if m.GetWorkerWithContextFunc != nil {
+		return m.GetWorkerWithContextFunc(context.Background(), getWorkerOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorker")
+}
+
+// GetWorkerWithContext calls GetWorkerWithContextFunc.
+func (m *API) GetWorkerWithContext(ctx context.Context, getWorkerOptions *kubernetesserviceapiv1.GetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkerWithContext", ctx, getWorkerOptions)
+	if m.GetWorkerWithContextFunc != nil {
+		return m.GetWorkerWithContextFunc(ctx, getWorkerOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkerWithContext")
+}
+
+// GetWorkerPool calls GetWorkerPoolFunc.
+func (m *API) GetWorkerPool(getWorkerPoolOptions *kubernetesserviceapiv1.GetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkerPool", getWorkerPoolOptions)
+	if m.GetWorkerPoolFunc != nil {
+		return m.GetWorkerPoolFunc(getWorkerPoolOptions)
+	}
+	if m.GetWorkerPoolWithContextFunc != nil {
+		return m.GetWorkerPoolWithContextFunc(context.Background(), getWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkerPool")
+}
+
+// GetWorkerPoolWithContext calls GetWorkerPoolWithContextFunc.
+func (m *API) GetWorkerPoolWithContext(ctx context.Context, getWorkerPoolOptions *kubernetesserviceapiv1.GetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkerPoolWithContext", ctx, getWorkerPoolOptions)
+	if m.GetWorkerPoolWithContextFunc != nil {
+		return m.GetWorkerPoolWithContextFunc(ctx, getWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkerPoolWithContext")
+}
+
+// GetWorkerPools1 calls GetWorkerPools1Func.
+func (m *API) GetWorkerPools1(getWorkerPools1Options *kubernetesserviceapiv1.GetWorkerPools1Options) ([]kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkerPools1", getWorkerPools1Options)
+	if m.GetWorkerPools1Func != nil {
+		return m.GetWorkerPools1Func(getWorkerPools1Options)
+	}
+	if m.GetWorkerPools1WithContextFunc != nil {
+		return m.GetWorkerPools1WithContextFunc(context.Background(), getWorkerPools1Options)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkerPools1")
+}
+
+// GetWorkerPools1WithContext calls GetWorkerPools1WithContextFunc.
+func (m *API) GetWorkerPools1WithContext(ctx context.Context, getWorkerPools1Options *kubernetesserviceapiv1.GetWorkerPools1Options) ([]kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkerPools1WithContext", ctx, getWorkerPools1Options)
+	if m.GetWorkerPools1WithContextFunc != nil {
+		return m.GetWorkerPools1WithContextFunc(ctx, getWorkerPools1Options)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkerPools1WithContext")
+}
+
+// GetWorkers1 calls GetWorkers1Func.
+func (m *API) GetWorkers1(getWorkers1Options *kubernetesserviceapiv1.GetWorkers1Options) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkers1", getWorkers1Options)
+	if m.GetWorkers1Func != nil {
+		return m.GetWorkers1Func(getWorkers1Options)
+	}
+	if m.GetWorkers1WithContextFunc != nil {
+		return m.GetWorkers1WithContextFunc(context.Background(), getWorkers1Options)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkers1")
+}
+
+// GetWorkers1WithContext calls GetWorkers1WithContextFunc.
+func (m *API) GetWorkers1WithContext(ctx context.Context, getWorkers1Options *kubernetesserviceapiv1.GetWorkers1Options) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("GetWorkers1WithContext", ctx, getWorkers1Options)
+	if m.GetWorkers1WithContextFunc != nil {
+		return m.GetWorkers1WithContextFunc(ctx, getWorkers1Options)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetWorkers1WithContext")
+}
+
+// RebalanceWorkerPool calls RebalanceWorkerPoolFunc.
+func (m *API) RebalanceWorkerPool(rebalanceWorkerPoolOptions *kubernetesserviceapiv1.RebalanceWorkerPoolOptions) (*core.DetailedResponse, error) {
+	m.record("RebalanceWorkerPool", rebalanceWorkerPoolOptions)
+	if m.RebalanceWorkerPoolFunc != nil {
+		return m.RebalanceWorkerPoolFunc(rebalanceWorkerPoolOptions)
+	}
+	if m.RebalanceWorkerPoolWithContextFunc != nil {
+		return m.RebalanceWorkerPoolWithContextFunc(context.Background(), rebalanceWorkerPoolOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RebalanceWorkerPool")
+}
+
+// RebalanceWorkerPoolWithContext calls RebalanceWorkerPoolWithContextFunc.
+func (m *API) RebalanceWorkerPoolWithContext(ctx context.Context, rebalanceWorkerPoolOptions *kubernetesserviceapiv1.RebalanceWorkerPoolOptions) (*core.DetailedResponse, error) {
+	m.record("RebalanceWorkerPoolWithContext", ctx, rebalanceWorkerPoolOptions)
+	if m.RebalanceWorkerPoolWithContextFunc != nil {
+		return m.RebalanceWorkerPoolWithContextFunc(ctx, rebalanceWorkerPoolOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RebalanceWorkerPoolWithContext")
+}
+
+// VpcRefreshMaster calls VpcRefreshMasterFunc.
+func (m *API) VpcRefreshMaster(vpcRefreshMasterOptions *kubernetesserviceapiv1.VpcRefreshMasterOptions) (*core.DetailedResponse, error) {
+	m.record("VpcRefreshMaster", vpcRefreshMasterOptions)
+	if m.VpcRefreshMasterFunc != nil {
+		return m.VpcRefreshMasterFunc(vpcRefreshMasterOptions)
+	}
+	if m.VpcRefreshMasterWithContextFunc != nil {
+		return m.VpcRefreshMasterWithContextFunc(context.Background(), vpcRefreshMasterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcRefreshMaster")
+}
+
+// VpcRefreshMasterWithContext calls VpcRefreshMasterWithContextFunc.
+func (m *API) VpcRefreshMasterWithContext(ctx context.Context, vpcRefreshMasterOptions *kubernetesserviceapiv1.VpcRefreshMasterOptions) (*core.DetailedResponse, error) {
+	m.record("VpcRefreshMasterWithContext", ctx, vpcRefreshMasterOptions)
+	if m.VpcRefreshMasterWithContextFunc != nil {
+		return m.VpcRefreshMasterWithContextFunc(ctx, vpcRefreshMasterOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcRefreshMasterWithContext")
+}
+
+// V2RemoveWorker calls V2RemoveWorkerFunc.
+func (m *API) V2RemoveWorker(v2RemoveWorkerOptions *kubernetesserviceapiv1.V2RemoveWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("V2RemoveWorker", v2RemoveWorkerOptions)
+	if m.V2RemoveWorkerFunc != nil {
+		return m.V2RemoveWorkerFunc(v2RemoveWorkerOptions)
+	}
+	if m.V2RemoveWorkerWithContextFunc != nil {
+		return m.V2RemoveWorkerWithContextFunc(context.Background(), v2RemoveWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2RemoveWorker")
+}
+
+// V2RemoveWorkerWithContext calls V2RemoveWorkerWithContextFunc.
+func (m *API) V2RemoveWorkerWithContext(ctx context.Context, v2RemoveWorkerOptions *kubernetesserviceapiv1.V2RemoveWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("V2RemoveWorkerWithContext", ctx, v2RemoveWorkerOptions)
+	if m.V2RemoveWorkerWithContextFunc != nil {
+		return m.V2RemoveWorkerWithContextFunc(ctx, v2RemoveWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2RemoveWorkerWithContext")
+}
+
+// RemoveWorkerPool1 calls RemoveWorkerPool1Func.
+func (m *API) RemoveWorkerPool1(removeWorkerPool1Options *kubernetesserviceapiv1.RemoveWorkerPool1Options) (*core.DetailedResponse, error) {
+	m.record("RemoveWorkerPool1", removeWorkerPool1Options)
+	if m.RemoveWorkerPool1Func != nil {
+		return m.RemoveWorkerPool1Func(removeWorkerPool1Options)
+	}
+	if m.RemoveWorkerPool1WithContextFunc != nil {
+		return m.RemoveWorkerPool1WithContextFunc(context.Background(), removeWorkerPool1Options)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveWorkerPool1")
+}
+
+// RemoveWorkerPool1WithContext calls RemoveWorkerPool1WithContextFunc.
+func (m *API) RemoveWorkerPool1WithContext(ctx context.Context, removeWorkerPool1Options *kubernetesserviceapiv1.RemoveWorkerPool1Options) (*core.DetailedResponse, error) {
+	m.record("RemoveWorkerPool1WithContext", ctx, removeWorkerPool1Options)
+	if m.RemoveWorkerPool1WithContextFunc != nil {
+		return m.RemoveWorkerPool1WithContextFunc(ctx, removeWorkerPool1Options)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveWorkerPool1WithContext")
+}
+
+// RemoveWorkerPoolZone1 calls RemoveWorkerPoolZone1Func.
+func (m *API) RemoveWorkerPoolZone1(removeWorkerPoolZone1Options *kubernetesserviceapiv1.RemoveWorkerPoolZone1Options) (*core.DetailedResponse, error) {
+	m.record("RemoveWorkerPoolZone1", removeWorkerPoolZone1Options)
+	if m.RemoveWorkerPoolZone1Func != nil {
+		return m.RemoveWorkerPoolZone1Func(removeWorkerPoolZone1Options)
+	}
+	if m.RemoveWorkerPoolZone1WithContextFunc != nil {
+		return m.RemoveWorkerPoolZone1WithContextFunc(context.Background(), removeWorkerPoolZone1Options)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveWorkerPoolZone1")
+}
+
+// RemoveWorkerPoolZone1WithContext calls RemoveWorkerPoolZone1WithContextFunc.
+func (m *API) RemoveWorkerPoolZone1WithContext(ctx context.Context, removeWorkerPoolZone1Options *kubernetesserviceapiv1.RemoveWorkerPoolZone1Options) (*core.DetailedResponse, error) {
+	m.record("RemoveWorkerPoolZone1WithContext", ctx, removeWorkerPoolZone1Options)
+	if m.RemoveWorkerPoolZone1WithContextFunc != nil {
+		return m.RemoveWorkerPoolZone1WithContextFunc(ctx, removeWorkerPoolZone1Options)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("RemoveWorkerPoolZone1WithContext")
+}
+
+// V2ResizeWorkerPool calls V2ResizeWorkerPoolFunc.
+func (m *API) V2ResizeWorkerPool(v2ResizeWorkerPoolOptions *kubernetesserviceapiv1.V2ResizeWorkerPoolOptions) (*core.DetailedResponse, error) {
+	m.record("V2ResizeWorkerPool", v2ResizeWorkerPoolOptions)
+	if m.V2ResizeWorkerPoolFunc != nil {
+		return m.V2ResizeWorkerPoolFunc(v2ResizeWorkerPoolOptions)
+	}
+	if m.V2ResizeWorkerPoolWithContextFunc != nil {
+		return m.V2ResizeWorkerPoolWithContextFunc(context.Background(), v2ResizeWorkerPoolOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2ResizeWorkerPool")
+}
+
+// V2ResizeWorkerPoolWithContext calls V2ResizeWorkerPoolWithContextFunc.
+func (m *API) V2ResizeWorkerPoolWithContext(ctx context.Context, v2ResizeWorkerPoolOptions *kubernetesserviceapiv1.V2ResizeWorkerPoolOptions) (*core.DetailedResponse, error) {
+	m.record("V2ResizeWorkerPoolWithContext", ctx, v2ResizeWorkerPoolOptions)
+	if m.V2ResizeWorkerPoolWithContextFunc != nil {
+		return m.V2ResizeWorkerPoolWithContextFunc(ctx, v2ResizeWorkerPoolOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2ResizeWorkerPoolWithContext")
+}
+
+// V2SetWorkerPoolLabels calls V2SetWorkerPoolLabelsFunc.
+func (m *API) V2SetWorkerPoolLabels(v2SetWorkerPoolLabelsOptions *kubernetesserviceapiv1.V2SetWorkerPoolLabelsOptions) (*core.DetailedResponse, error) {
+	m.record("V2SetWorkerPoolLabels", v2SetWorkerPoolLabelsOptions)
+	if m.V2SetWorkerPoolLabelsFunc != nil {
+		return m.V2SetWorkerPoolLabelsFunc(v2SetWorkerPoolLabelsOptions)
+	}
+	if m.V2SetWorkerPoolLabelsWithContextFunc != nil {
+		return m.V2SetWorkerPoolLabelsWithContextFunc(context.Background(), v2SetWorkerPoolLabelsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2SetWorkerPoolLabels")
+}
+
+// V2SetWorkerPoolLabelsWithContext calls V2SetWorkerPoolLabelsWithContextFunc.
+func (m *API) V2SetWorkerPoolLabelsWithContext(ctx context.Context, v2SetWorkerPoolLabelsOptions *kubernetesserviceapiv1.V2SetWorkerPoolLabelsOptions) (*core.DetailedResponse, error) {
+	m.record("V2SetWorkerPoolLabelsWithContext", ctx, v2SetWorkerPoolLabelsOptions)
+	if m.V2SetWorkerPoolLabelsWithContextFunc != nil {
+		return m.V2SetWorkerPoolLabelsWithContextFunc(ctx, v2SetWorkerPoolLabelsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2SetWorkerPoolLabelsWithContext")
+}
+
+// V2SetWorkerPoolTaints calls V2SetWorkerPoolTaintsFunc.
+func (m *API) V2SetWorkerPoolTaints(v2SetWorkerPoolTaintsOptions *kubernetesserviceapiv1.V2SetWorkerPoolTaintsOptions) (*core.DetailedResponse, error) {
+	m.record("V2SetWorkerPoolTaints", v2SetWorkerPoolTaintsOptions)
+	if m.V2SetWorkerPoolTaintsFunc != nil {
+		return m.V2SetWorkerPoolTaintsFunc(v2SetWorkerPoolTaintsOptions)
+	}
+	if m.V2SetWorkerPoolTaintsWithContextFunc != nil {
+		return m.V2SetWorkerPoolTaintsWithContextFunc(context.Background(), v2SetWorkerPoolTaintsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2SetWorkerPoolTaints")
+}
+
+// V2SetWorkerPoolTaintsWithContext calls V2SetWorkerPoolTaintsWithContextFunc.
+func (m *API) V2SetWorkerPoolTaintsWithContext(ctx context.Context, v2SetWorkerPoolTaintsOptions *kubernetesserviceapiv1.V2SetWorkerPoolTaintsOptions) (*core.DetailedResponse, error) {
+	m.record("V2SetWorkerPoolTaintsWithContext", ctx, v2SetWorkerPoolTaintsOptions)
+	if m.V2SetWorkerPoolTaintsWithContextFunc != nil {
+		return m.V2SetWorkerPoolTaintsWithContextFunc(ctx, v2SetWorkerPoolTaintsOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("V2SetWorkerPoolTaintsWithContext")
+}
+
+// VpcCreateCluster calls VpcCreateClusterFunc.
+func (m *API) VpcCreateCluster(vpcCreateClusterOptions *kubernetesserviceapiv1.VpcCreateClusterOptions) (*kubernetesserviceapiv1.CreateClusterResponse, *core.DetailedResponse, error) {
+	m.record("VpcCreateCluster", vpcCreateClusterOptions)
+	if m.VpcCreateClusterFunc != nil {
+		return m.VpcCreateClusterFunc(vpcCreateClusterOptions)
+	}
+	if m.VpcCreateClusterWithContextFunc != nil {
+		return m.VpcCreateClusterWithContextFunc(context.Background(), vpcCreateClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcCreateCluster")
+}
+
+// VpcCreateClusterWithContext calls VpcCreateClusterWithContextFunc.
+func (m *API) VpcCreateClusterWithContext(ctx context.Context, vpcCreateClusterOptions *kubernetesserviceapiv1.VpcCreateClusterOptions) (*kubernetesserviceapiv1.CreateClusterResponse, *core.DetailedResponse, error) {
+	m.record("VpcCreateClusterWithContext", ctx, vpcCreateClusterOptions)
+	if m.VpcCreateClusterWithContextFunc != nil {
+		return m.VpcCreateClusterWithContextFunc(ctx, vpcCreateClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcCreateClusterWithContext")
+}
+
+// VpcCreateWorkerPool calls VpcCreateWorkerPoolFunc.
+func (m *API) VpcCreateWorkerPool(vpcCreateWorkerPoolOptions *kubernetesserviceapiv1.VpcCreateWorkerPoolOptions) (*kubernetesserviceapiv1.CreateWorkerpoolResponse, *core.DetailedResponse, error) {
+	m.record("VpcCreateWorkerPool", vpcCreateWorkerPoolOptions)
+	if m.VpcCreateWorkerPoolFunc != nil {
+		return m.VpcCreateWorkerPoolFunc(vpcCreateWorkerPoolOptions)
+	}
+	if m.VpcCreateWorkerPoolWithContextFunc != nil {
+		return m.VpcCreateWorkerPoolWithContextFunc(context.Background(), vpcCreateWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateWorkerpoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcCreateWorkerPool")
+}
+
+// VpcCreateWorkerPoolWithContext calls VpcCreateWorkerPoolWithContextFunc.
+func (m *API) VpcCreateWorkerPoolWithContext(ctx context.Context, vpcCreateWorkerPoolOptions *kubernetesserviceapiv1.VpcCreateWorkerPoolOptions) (*kubernetesserviceapiv1.CreateWorkerpoolResponse, *core.DetailedResponse, error) {
+	m.record("VpcCreateWorkerPoolWithContext", ctx, vpcCreateWorkerPoolOptions)
+	if m.VpcCreateWorkerPoolWithContextFunc != nil {
+		return m.VpcCreateWorkerPoolWithContextFunc(ctx, vpcCreateWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.CreateWorkerpoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcCreateWorkerPoolWithContext")
+}
+
+// VpcCreateWorkerPoolZone calls VpcCreateWorkerPoolZoneFunc.
+func (m *API) VpcCreateWorkerPoolZone(vpcCreateWorkerPoolZoneOptions *kubernetesserviceapiv1.VpcCreateWorkerPoolZoneOptions) (*core.DetailedResponse, error) {
+	m.record("VpcCreateWorkerPoolZone", vpcCreateWorkerPoolZoneOptions)
+	if m.VpcCreateWorkerPoolZoneFunc != nil {
+		return m.VpcCreateWorkerPoolZoneFunc(vpcCreateWorkerPoolZoneOptions)
+	}
+	if m.VpcCreateWorkerPoolZoneWithContextFunc != nil {
+		return m.VpcCreateWorkerPoolZoneWithContextFunc(context.Background(), vpcCreateWorkerPoolZoneOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcCreateWorkerPoolZone")
+}
+
+// VpcCreateWorkerPoolZoneWithContext calls VpcCreateWorkerPoolZoneWithContextFunc.
+func (m *API) VpcCreateWorkerPoolZoneWithContext(ctx context.Context, vpcCreateWorkerPoolZoneOptions *kubernetesserviceapiv1.VpcCreateWorkerPoolZoneOptions) (*core.DetailedResponse, error) {
+	m.record("VpcCreateWorkerPoolZoneWithContext", ctx, vpcCreateWorkerPoolZoneOptions)
+	if m.VpcCreateWorkerPoolZoneWithContextFunc != nil {
+		return m.VpcCreateWorkerPoolZoneWithContextFunc(ctx, vpcCreateWorkerPoolZoneOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcCreateWorkerPoolZoneWithContext")
+}
+
+// VpcGetCluster calls VpcGetClusterFunc.
+func (m *API) VpcGetCluster(vpcGetClusterOptions *kubernetesserviceapiv1.VpcGetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetCluster", vpcGetClusterOptions)
+	if m.VpcGetClusterFunc != nil {
+		return m.VpcGetClusterFunc(vpcGetClusterOptions)
+	}
+	if m.VpcGetClusterWithContextFunc != nil {
+		return m.VpcGetClusterWithContextFunc(context.Background(), vpcGetClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetCluster")
+}
+
+// VpcGetClusterWithContext calls VpcGetClusterWithContextFunc.
+func (m *API) VpcGetClusterWithContext(ctx context.Context, vpcGetClusterOptions *kubernetesserviceapiv1.VpcGetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetClusterWithContext", ctx, vpcGetClusterOptions)
+	if m.VpcGetClusterWithContextFunc != nil {
+		return m.VpcGetClusterWithContextFunc(ctx, vpcGetClusterOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetClusterResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetClusterWithContext")
+}
+
+// VpcGetClusters calls VpcGetClustersFunc.
+func (m *API) VpcGetClusters(vpcGetClustersOptions *kubernetesserviceapiv1.VpcGetClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetClusters", vpcGetClustersOptions)
+	if m.VpcGetClustersFunc != nil {
+		return m.VpcGetClustersFunc(vpcGetClustersOptions)
+	}
+	if m.VpcGetClustersWithContextFunc != nil {
+		return m.VpcGetClustersWithContextFunc(context.Background(), vpcGetClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetClustersResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetClusters")
+}
+
+// VpcGetClustersWithContext calls VpcGetClustersWithContextFunc.
+func (m *API) VpcGetClustersWithContext(ctx context.Context, vpcGetClustersOptions *kubernetesserviceapiv1.VpcGetClustersOptions) ([]kubernetesserviceapiv1.GetClustersResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetClustersWithContext", ctx, vpcGetClustersOptions)
+	if m.VpcGetClustersWithContextFunc != nil {
+		return m.VpcGetClustersWithContextFunc(ctx, vpcGetClustersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetClustersResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetClustersWithContext")
+}
+
+// GetSubnets calls GetSubnetsFunc.
+func (m *API) GetSubnets(getSubnetsOptions *kubernetesserviceapiv1.GetSubnetsOptions) ([]kubernetesserviceapiv1.Subnet, *core.DetailedResponse, error) {
+	m.record("GetSubnets", getSubnetsOptions)
+	if m.GetSubnetsFunc != nil {
+		return m.GetSubnetsFunc(getSubnetsOptions)
+	}
+	if m.GetSubnetsWithContextFunc != nil {
+		return m.GetSubnetsWithContextFunc(context.Background(), getSubnetsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Subnet
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSubnets")
+}
+
+// GetSubnetsWithContext calls GetSubnetsWithContextFunc.
+func (m *API) GetSubnetsWithContext(ctx context.Context, getSubnetsOptions *kubernetesserviceapiv1.GetSubnetsOptions) ([]kubernetesserviceapiv1.Subnet, *core.DetailedResponse, error) {
+	m.record("GetSubnetsWithContext", ctx, getSubnetsOptions)
+	if m.GetSubnetsWithContextFunc != nil {
+		return m.GetSubnetsWithContextFunc(ctx, getSubnetsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.Subnet
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetSubnetsWithContext")
+}
+
+// GetVPC calls GetVPCFunc.
+func (m *API) GetVPC(getVPCOptions *kubernetesserviceapiv1.GetVPCOptions) (*kubernetesserviceapiv1.GetVPCResponse, *core.DetailedResponse, error) {
+	m.record("GetVPC", getVPCOptions)
+	if m.GetVPCFunc != nil {
+		return m.GetVPCFunc(getVPCOptions)
+	}
+	if m.GetVPCWithContextFunc != nil {
+		return m.GetVPCWithContextFunc(context.Background(), getVPCOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetVPCResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVPC")
+}
+
+// GetVPCWithContext calls GetVPCWithContextFunc.
+func (m *API) GetVPCWithContext(ctx context.Context, getVPCOptions *kubernetesserviceapiv1.GetVPCOptions) (*kubernetesserviceapiv1.GetVPCResponse, *core.DetailedResponse, error) {
+	m.record("GetVPCWithContext", ctx, getVPCOptions)
+	if m.GetVPCWithContextFunc != nil {
+		return m.GetVPCWithContextFunc(ctx, getVPCOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetVPCResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVPCWithContext")
+}
+
+// GetVPCs calls GetVPCsFunc.
+func (m *API) GetVPCs(getVPCsOptions *kubernetesserviceapiv1.GetVPCsOptions) ([]kubernetesserviceapiv1.VirtualPrivateCloudResponse, *core.DetailedResponse, error) {
+	m.record("GetVPCs", getVPCsOptions)
+	if m.GetVPCsFunc != nil {
+		return m.GetVPCsFunc(getVPCsOptions)
+	}
+	if m.GetVPCsWithContextFunc != nil {
+		return m.GetVPCsWithContextFunc(context.Background(), getVPCsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.VirtualPrivateCloudResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVPCs")
+}
+
+// GetVPCsWithContext calls GetVPCsWithContextFunc.
+func (m *API) GetVPCsWithContext(ctx context.Context, getVPCsOptions *kubernetesserviceapiv1.GetVPCsOptions) ([]kubernetesserviceapiv1.VirtualPrivateCloudResponse, *core.DetailedResponse, error) {
+	m.record("GetVPCsWithContext", ctx, getVPCsOptions)
+	if m.GetVPCsWithContextFunc != nil {
+		return m.GetVPCsWithContextFunc(ctx, getVPCsOptions)
+	}
+	var r0 []kubernetesserviceapiv1.VirtualPrivateCloudResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetVPCsWithContext")
+}
+
+// VpcGetWorker calls VpcGetWorkerFunc.
+func (m *API) VpcGetWorker(vpcGetWorkerOptions *kubernetesserviceapiv1.VpcGetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetWorker", vpcGetWorkerOptions)
+	if m.VpcGetWorkerFunc != nil {
+		return m.VpcGetWorkerFunc(vpcGetWorkerOptions)
+	}
+	if m.VpcGetWorkerWithContextFunc != nil {
+		return m.VpcGetWorkerWithContextFunc(context.Background(), vpcGetWorkerOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetWorker")
+}
+
+// VpcGetWorkerWithContext calls VpcGetWorkerWithContextFunc.
+func (m *API) VpcGetWorkerWithContext(ctx context.Context, vpcGetWorkerOptions *kubernetesserviceapiv1.VpcGetWorkerOptions) (*kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetWorkerWithContext", ctx, vpcGetWorkerOptions)
+	if m.VpcGetWorkerWithContextFunc != nil {
+		return m.VpcGetWorkerWithContextFunc(ctx, vpcGetWorkerOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetWorkerWithContext")
+}
+
+// VpcGetWorkerPool calls VpcGetWorkerPoolFunc.
+func (m *API) VpcGetWorkerPool(vpcGetWorkerPoolOptions *kubernetesserviceapiv1.VpcGetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetWorkerPool", vpcGetWorkerPoolOptions)
+	if m.VpcGetWorkerPoolFunc != nil {
+		return m.VpcGetWorkerPoolFunc(vpcGetWorkerPoolOptions)
+	}
+	if m.VpcGetWorkerPoolWithContextFunc != nil {
+		return m.VpcGetWorkerPoolWithContextFunc(context.Background(), vpcGetWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetWorkerPool")
+}
+
+// VpcGetWorkerPoolWithContext calls VpcGetWorkerPoolWithContextFunc.
+func (m *API) VpcGetWorkerPoolWithContext(ctx context.Context, vpcGetWorkerPoolOptions *kubernetesserviceapiv1.VpcGetWorkerPoolOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetWorkerPoolWithContext", ctx, vpcGetWorkerPoolOptions)
+	if m.VpcGetWorkerPoolWithContextFunc != nil {
+		return m.VpcGetWorkerPoolWithContextFunc(ctx, vpcGetWorkerPoolOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetWorkerPoolWithContext")
+}
+
+// VpcGetWorkerPools calls VpcGetWorkerPoolsFunc.
+func (m *API) VpcGetWorkerPools(vpcGetWorkerPoolsOptions *kubernetesserviceapiv1.VpcGetWorkerPoolsOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetWorkerPools", vpcGetWorkerPoolsOptions)
+	if m.VpcGetWorkerPoolsFunc != nil {
+		return m.VpcGetWorkerPoolsFunc(vpcGetWorkerPoolsOptions)
+	}
+	if m.VpcGetWorkerPoolsWithContextFunc != nil {
+		return m.VpcGetWorkerPoolsWithContextFunc(context.Background(), vpcGetWorkerPoolsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetWorkerPools")
+}
+
+// VpcGetWorkerPoolsWithContext calls VpcGetWorkerPoolsWithContextFunc.
+func (m *API) VpcGetWorkerPoolsWithContext(ctx context.Context, vpcGetWorkerPoolsOptions *kubernetesserviceapiv1.VpcGetWorkerPoolsOptions) (*kubernetesserviceapiv1.GetWorkerPoolResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetWorkerPoolsWithContext", ctx, vpcGetWorkerPoolsOptions)
+	if m.VpcGetWorkerPoolsWithContextFunc != nil {
+		return m.VpcGetWorkerPoolsWithContextFunc(ctx, vpcGetWorkerPoolsOptions)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerPoolResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetWorkerPoolsWithContext")
+}
+
+// VpcGetWorkers calls VpcGetWorkersFunc.
+func (m *API) VpcGetWorkers(vpcGetWorkersOptions *kubernetesserviceapiv1.VpcGetWorkersOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetWorkers", vpcGetWorkersOptions)
+	if m.VpcGetWorkersFunc != nil {
+		return m.VpcGetWorkersFunc(vpcGetWorkersOptions)
+	}
+	if m.VpcGetWorkersWithContextFunc != nil {
+		return m.VpcGetWorkersWithContextFunc(context.Background(), vpcGetWorkersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetWorkers")
+}
+
+// VpcGetWorkersWithContext calls VpcGetWorkersWithContextFunc.
+func (m *API) VpcGetWorkersWithContext(ctx context.Context, vpcGetWorkersOptions *kubernetesserviceapiv1.VpcGetWorkersOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetWorkersWithContext", ctx, vpcGetWorkersOptions)
+	if m.VpcGetWorkersWithContextFunc != nil {
+		return m.VpcGetWorkersWithContextFunc(ctx, vpcGetWorkersOptions)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetWorkersWithContext")
+}
+
+// VpcGetZones calls VpcGetZonesFunc.
+func (m *API) VpcGetZones(vpcGetZonesOptions *kubernetesserviceapiv1.VpcGetZonesOptions) ([][]kubernetesserviceapiv1.ZoneResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetZones", vpcGetZonesOptions)
+	if m.VpcGetZonesFunc != nil {
+		return m.VpcGetZonesFunc(vpcGetZonesOptions)
+	}
+	if m.VpcGetZonesWithContextFunc != nil {
+		return m.VpcGetZonesWithContextFunc(context.Background(), vpcGetZonesOptions)
+	}
+	var r0 [][]kubernetesserviceapiv1.ZoneResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetZones")
+}
+
+// VpcGetZonesWithContext calls VpcGetZonesWithContextFunc.
+func (m *API) VpcGetZonesWithContext(ctx context.Context, vpcGetZonesOptions *kubernetesserviceapiv1.VpcGetZonesOptions) ([][]kubernetesserviceapiv1.ZoneResponse, *core.DetailedResponse, error) {
+	m.record("VpcGetZonesWithContext", ctx, vpcGetZonesOptions)
+	if m.VpcGetZonesWithContextFunc != nil {
+		return m.VpcGetZonesWithContextFunc(ctx, vpcGetZonesOptions)
+	}
+	var r0 [][]kubernetesserviceapiv1.ZoneResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("VpcGetZonesWithContext")
+}
+
+// VpcReplaceWorker calls VpcReplaceWorkerFunc.
+func (m *API) VpcReplaceWorker(vpcReplaceWorkerOptions *kubernetesserviceapiv1.VpcReplaceWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("VpcReplaceWorker", vpcReplaceWorkerOptions)
+	if m.VpcReplaceWorkerFunc != nil {
+		return m.VpcReplaceWorkerFunc(vpcReplaceWorkerOptions)
+	}
+	if m.VpcReplaceWorkerWithContextFunc != nil {
+		return m.VpcReplaceWorkerWithContextFunc(context.Background(), vpcReplaceWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcReplaceWorker")
+}
+
+// VpcReplaceWorkerWithContext calls VpcReplaceWorkerWithContextFunc.
+func (m *API) VpcReplaceWorkerWithContext(ctx context.Context, vpcReplaceWorkerOptions *kubernetesserviceapiv1.VpcReplaceWorkerOptions) (*core.DetailedResponse, error) {
+	m.record("VpcReplaceWorkerWithContext", ctx, vpcReplaceWorkerOptions)
+	if m.VpcReplaceWorkerWithContextFunc != nil {
+		return m.VpcReplaceWorkerWithContextFunc(ctx, vpcReplaceWorkerOptions)
+	}
+	var r0 *core.DetailedResponse
+	return r0, notStubbed("VpcReplaceWorkerWithContext")
+}
+
+// NewClusterAddon calls NewClusterAddonFunc.
+func (m *API) NewClusterAddon(name string) (*kubernetesserviceapiv1.ClusterAddon, error) {
+	m.record("NewClusterAddon", name)
+	if m.NewClusterAddonFunc != nil {
+		return m.NewClusterAddonFunc(name)
+	}
+	var r0 *kubernetesserviceapiv1.ClusterAddon
+	return r0, notStubbed("NewClusterAddon")
+}
+
+// WaitForWorkerState calls WaitForWorkerStateFunc.
+func (m *API) WaitForWorkerState(ctx context.Context, cluster string, worker string, predicate kubernetesserviceapiv1.WorkerPredicate, options *kubernetesserviceapiv1.WorkerWaitOptions) (*kubernetesserviceapiv1.GetWorkerResponse, error) {
+	m.record("WaitForWorkerState", ctx, cluster, worker, predicate, options)
+	if m.WaitForWorkerStateFunc != nil {
+		return m.WaitForWorkerStateFunc(ctx, cluster, worker, predicate, options)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerResponse
+	return r0, notStubbed("WaitForWorkerState")
+}
+
+// WaitForWorkerReady calls WaitForWorkerReadyFunc.
+func (m *API) WaitForWorkerReady(ctx context.Context, cluster string, worker string, options *kubernetesserviceapiv1.WorkerWaitOptions) (*kubernetesserviceapiv1.GetWorkerResponse, error) {
+	m.record("WaitForWorkerReady", ctx, cluster, worker, options)
+	if m.WaitForWorkerReadyFunc != nil {
+		return m.WaitForWorkerReadyFunc(ctx, cluster, worker, options)
+	}
+	var r0 *kubernetesserviceapiv1.GetWorkerResponse
+	return r0, notStubbed("WaitForWorkerReady")
+}
+
+// WaitForWorkers calls WaitForWorkersFunc.
+func (m *API) WaitForWorkers(ctx context.Context, cluster string, predicate kubernetesserviceapiv1.WorkerPredicate, options *kubernetesserviceapiv1.WorkersWaitOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, error) {
+	m.record("WaitForWorkers", ctx, cluster, predicate, options)
+	if m.WaitForWorkersFunc != nil {
+		return m.WaitForWorkersFunc(ctx, cluster, predicate, options)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerResponse
+	return r0, notStubbed("WaitForWorkers")
+}
+
+// WaitForWorkerPoolReady calls WaitForWorkerPoolReadyFunc.
+func (m *API) WaitForWorkerPoolReady(ctx context.Context, cluster string, pool string, version string, options *kubernetesserviceapiv1.WorkersWaitOptions) ([]kubernetesserviceapiv1.GetWorkerResponse, error) {
+	m.record("WaitForWorkerPoolReady", ctx, cluster, pool, version, options)
+	if m.WaitForWorkerPoolReadyFunc != nil {
+		return m.WaitForWorkerPoolReadyFunc(ctx, cluster, pool, version, options)
+	}
+	var r0 []kubernetesserviceapiv1.GetWorkerResponse
+	return r0, notStubbed("WaitForWorkerPoolReady")
+}
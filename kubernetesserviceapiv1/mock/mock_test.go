@@ -0,0 +1,98 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/mock"
+)
+
+var _ = Describe(`Mock API`, func() {
+	var api *mock.API
+
+	// clusterName reads the name of the cluster through the API interface, as code under test would.
+	var clusterName = func(client kubernetesserviceapiv1.API, cluster string) (string, error) {
+		result, _, err := client.GetCluster(&kubernetesserviceapiv1.GetClusterOptions{Cluster: core.StringPtr(cluster)})
+		if err != nil {
+			return "", err
+		}
+		return *result.Name, nil
+	}
+
+	BeforeEach(func() {
+		api = &mock.API{}
+	})
+
+	It(`Calls the function of the method`, func() {
+		api.GetClusterFunc = func(options *kubernetesserviceapiv1.GetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error) {
+			return &kubernetesserviceapiv1.GetClusterResponse{Name: core.StringPtr("name-" + *options.Cluster)}, &core.DetailedResponse{StatusCode: 200}, nil
+		}
+		name, err := clusterName(api, "c1")
+		Expect(err).To(BeNil())
+		Expect(name).To(Equal("name-c1"))
+
+		calls := api.CallsTo("GetCluster")
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].Args[0].(*kubernetesserviceapiv1.GetClusterOptions).Cluster).To(Equal(core.StringPtr("c1")))
+	})
+
+	It(`Falls back to the function of the WithContext form`, func() {
+		api.GetClusterWithContextFunc = func(ctx context.Context, options *kubernetesserviceapiv1.GetClusterOptions) (*kubernetesserviceapiv1.GetClusterResponse, *core.DetailedResponse, error) {
+			Expect(ctx).To(Equal(context.Background()))
+			return &kubernetesserviceapiv1.GetClusterResponse{Name: core.StringPtr("from-context")}, nil, nil
+		}
+		name, err := clusterName(api, "c1")
+		Expect(err).To(BeNil())
+		Expect(name).To(Equal("from-context"))
+		Expect(api.CallsTo("GetCluster")).To(HaveLen(1))
+		Expect(api.CallsTo("GetClusterWithContext")).To(BeEmpty())
+	})
+
+	It(`Returns ErrNotStubbed for the methods without a function`, func() {
+		result, response, err := api.VpcCreateCluster(&kubernetesserviceapiv1.VpcCreateClusterOptions{})
+		Expect(result).To(BeNil())
+		Expect(response).To(BeNil())
+		Expect(errors.Is(err, mock.ErrNotStubbed)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("VpcCreateCluster"))
+
+		err = api.WaitForClusterDeleted(context.Background(), "c1", nil)
+		Expect(errors.Is(err, mock.ErrNotStubbed)).To(BeTrue())
+	})
+
+	It(`Records the calls in order until reset`, func() {
+		ctx := context.Background()
+		api.WaitForClusterDeleted(ctx, "c1", nil)
+		api.GetWorkerWithContext(ctx, &kubernetesserviceapiv1.GetWorkerOptions{})
+		api.WaitForClusterDeleted(ctx, "c2", nil)
+
+		calls := api.Calls()
+		Expect(calls).To(HaveLen(3))
+		Expect(calls[0]).To(Equal(mock.Call{Method: "WaitForClusterDeleted", Args: []interface{}{ctx, "c1", (*kubernetesserviceapiv1.ClusterWaitOptions)(nil)}}))
+		Expect(calls[1].Method).To(Equal("GetWorkerWithContext"))
+		Expect(api.CallsTo("WaitForClusterDeleted")[1].Args[1]).To(Equal("c2"))
+
+		api.Reset()
+		Expect(api.Calls()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,263 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+package kubernetesserviceapiv1
+
+import (
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// operations are the operations of the service by ID, passed to the interceptors in the request context.
+var operations = map[string]common.Operation{
+	"GetUserCredentials":              {ServiceName: "kubernetes_service_api", ID: "GetUserCredentials", Method: "GET", PathTemplate: "/v1/credentials"},
+	"StoreUserCredentials":            {ServiceName: "kubernetes_service_api", ID: "StoreUserCredentials", Method: "POST", PathTemplate: "/v1/credentials"},
+	"RemoveUserCredentials":           {ServiceName: "kubernetes_service_api", ID: "RemoveUserCredentials", Method: "DELETE", PathTemplate: "/v1/credentials"},
+	"GetInfraPermissions":             {ServiceName: "kubernetes_service_api", ID: "GetInfraPermissions", Method: "GET", PathTemplate: "/v1/infra-permissions"},
+	"ResetUserAPIKey":                 {ServiceName: "kubernetes_service_api", ID: "ResetUserAPIKey", Method: "POST", PathTemplate: "/v1/keys"},
+	"GetVlanSpanning":                 {ServiceName: "kubernetes_service_api", ID: "GetVlanSpanning", Method: "GET", PathTemplate: "/v1/subnets/vlan-spanning"},
+	"GetClusterACLs":                  {ServiceName: "kubernetes_service_api", ID: "GetClusterACLs", Method: "GET", PathTemplate: "/v1/acl/{idOrName}"},
+	"DisableClusterACLs":              {ServiceName: "kubernetes_service_api", ID: "DisableClusterACLs", Method: "DELETE", PathTemplate: "/v1/acl/{idOrName}"},
+	"AddClusterACLs":                  {ServiceName: "kubernetes_service_api", ID: "AddClusterACLs", Method: "PATCH", PathTemplate: "/v1/acl/{idOrName}/add"},
+	"EnableClusterACLs":               {ServiceName: "kubernetes_service_api", ID: "EnableClusterACLs", Method: "POST", PathTemplate: "/v1/acl/{idOrName}/enable"},
+	"RemoveClusterACLs":               {ServiceName: "kubernetes_service_api", ID: "RemoveClusterACLs", Method: "PATCH", PathTemplate: "/v1/acl/{idOrName}/rm"},
+	"EnableALB":                       {ServiceName: "kubernetes_service_api", ID: "EnableALB", Method: "POST", PathTemplate: "/v1/alb/albs"},
+	"GetClusterALB":                   {ServiceName: "kubernetes_service_api", ID: "GetClusterALB", Method: "GET", PathTemplate: "/v1/alb/albs/{albID}"},
+	"DisableALB":                      {ServiceName: "kubernetes_service_api", ID: "DisableALB", Method: "DELETE", PathTemplate: "/v1/alb/albs/{albID}"},
+	"GetAvailableALBTypes":            {ServiceName: "kubernetes_service_api", ID: "GetAvailableALBTypes", Method: "GET", PathTemplate: "/v1/alb/albtypes"},
+	"GetClusterALBs":                  {ServiceName: "kubernetes_service_api", ID: "GetClusterALBs", Method: "GET", PathTemplate: "/v1/alb/clusters/{idOrName}"},
+	"UpdateALBs":                      {ServiceName: "kubernetes_service_api", ID: "UpdateALBs", Method: "PUT", PathTemplate: "/v1/alb/clusters/{idOrName}/update"},
+	"GetUpdatePolicy":                 {ServiceName: "kubernetes_service_api", ID: "GetUpdatePolicy", Method: "GET", PathTemplate: "/v1/alb/clusters/{idOrName}/updatepolicy"},
+	"ChangeUpdatePolicy":              {ServiceName: "kubernetes_service_api", ID: "ChangeUpdatePolicy", Method: "PUT", PathTemplate: "/v1/alb/clusters/{idOrName}/updatepolicy"},
+	"RollbackUpdate":                  {ServiceName: "kubernetes_service_api", ID: "RollbackUpdate", Method: "PUT", PathTemplate: "/v1/alb/clusters/{idOrName}/updaterollback"},
+	"CreateALB":                       {ServiceName: "kubernetes_service_api", ID: "CreateALB", Method: "POST", PathTemplate: "/v1/alb/clusters/{idOrName}/zone/{zoneId}"},
+	"UpdateALBSecret":                 {ServiceName: "kubernetes_service_api", ID: "UpdateALBSecret", Method: "PUT", PathTemplate: "/v1/alb/albsecrets"},
+	"CreateALBSecret":                 {ServiceName: "kubernetes_service_api", ID: "CreateALBSecret", Method: "POST", PathTemplate: "/v1/alb/albsecrets"},
+	"ViewClusterALBSecrets":           {ServiceName: "kubernetes_service_api", ID: "ViewClusterALBSecrets", Method: "GET", PathTemplate: "/v1/alb/clusters/{idOrName}/albsecrets"},
+	"DeleteClusterALBSecrets":         {ServiceName: "kubernetes_service_api", ID: "DeleteClusterALBSecrets", Method: "DELETE", PathTemplate: "/v1/alb/clusters/{idOrName}/albsecrets"},
+	"GetAuditWebhook":                 {ServiceName: "kubernetes_service_api", ID: "GetAuditWebhook", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/apiserverconfigs/auditwebhook"},
+	"UpdateAuditWebhook":              {ServiceName: "kubernetes_service_api", ID: "UpdateAuditWebhook", Method: "PUT", PathTemplate: "/v1/clusters/{idOrName}/apiserverconfigs/auditwebhook"},
+	"DeleteAuditWebhook":              {ServiceName: "kubernetes_service_api", ID: "DeleteAuditWebhook", Method: "DELETE", PathTemplate: "/v1/clusters/{idOrName}/apiserverconfigs/auditwebhook"},
+	"GetLBConfig":                     {ServiceName: "kubernetes_service_api", ID: "GetLBConfig", Method: "GET", PathTemplate: "/ingress/v2/load-balancer/configuration"},
+	"PatchLBConfig":                   {ServiceName: "kubernetes_service_api", ID: "PatchLBConfig", Method: "PATCH", PathTemplate: "/ingress/v2/load-balancer/configuration"},
+	"CreateSecret":                    {ServiceName: "kubernetes_service_api", ID: "CreateSecret", Method: "POST", PathTemplate: "/ingress/v2/secret/createSecret"},
+	"DeleteIngressSecret":             {ServiceName: "kubernetes_service_api", ID: "DeleteIngressSecret", Method: "POST", PathTemplate: "/ingress/v2/secret/deleteSecret"},
+	"GetSecret":                       {ServiceName: "kubernetes_service_api", ID: "GetSecret", Method: "GET", PathTemplate: "/ingress/v2/secret/getSecret"},
+	"GetSecrets":                      {ServiceName: "kubernetes_service_api", ID: "GetSecrets", Method: "GET", PathTemplate: "/ingress/v2/secret/getSecrets"},
+	"UpdateSecret":                    {ServiceName: "kubernetes_service_api", ID: "UpdateSecret", Method: "POST", PathTemplate: "/ingress/v2/secret/updateSecret"},
+	"CleanupMigration":                {ServiceName: "kubernetes_service_api", ID: "CleanupMigration", Method: "POST", PathTemplate: "/v2/alb/cleanupMigration"},
+	"V2GetClusterALB":                 {ServiceName: "kubernetes_service_api", ID: "V2GetClusterALB", Method: "GET", PathTemplate: "/v2/alb/getAlb"},
+	"GetSupportedImages":              {ServiceName: "kubernetes_service_api", ID: "GetSupportedImages", Method: "GET", PathTemplate: "/v2/alb/getAlbImages"},
+	"V2GetClusterALBs":                {ServiceName: "kubernetes_service_api", ID: "V2GetClusterALBs", Method: "GET", PathTemplate: "/v2/alb/getClusterAlbs"},
+	"GetMigrationStatus":              {ServiceName: "kubernetes_service_api", ID: "GetMigrationStatus", Method: "GET", PathTemplate: "/v2/alb/getMigrationStatus"},
+	"GetStatus":                       {ServiceName: "kubernetes_service_api", ID: "GetStatus", Method: "GET", PathTemplate: "/v2/alb/getStatus"},
+	"StartMigration":                  {ServiceName: "kubernetes_service_api", ID: "StartMigration", Method: "POST", PathTemplate: "/v2/alb/startMigration"},
+	"V2UpdateALB":                     {ServiceName: "kubernetes_service_api", ID: "V2UpdateALB", Method: "POST", PathTemplate: "/v2/alb/updateAlb"},
+	"VpcCreateALB":                    {ServiceName: "kubernetes_service_api", ID: "VpcCreateALB", Method: "POST", PathTemplate: "/v2/alb/vpc/createAlb"},
+	"VpcDisableALB":                   {ServiceName: "kubernetes_service_api", ID: "VpcDisableALB", Method: "POST", PathTemplate: "/v2/alb/vpc/disableAlb"},
+	"VpcEnableALB":                    {ServiceName: "kubernetes_service_api", ID: "VpcEnableALB", Method: "POST", PathTemplate: "/v2/alb/vpc/enableAlb"},
+	"V2DisablePrivateServiceEndpoint": {ServiceName: "kubernetes_service_api", ID: "V2DisablePrivateServiceEndpoint", Method: "POST", PathTemplate: "/v2/disablePrivateServiceEndpoint"},
+	"V2DisablePublicServiceEndpoint":  {ServiceName: "kubernetes_service_api", ID: "V2DisablePublicServiceEndpoint", Method: "POST", PathTemplate: "/v2/disablePublicServiceEndpoint"},
+	"V2EnablePrivateServiceEndpoint":  {ServiceName: "kubernetes_service_api", ID: "V2EnablePrivateServiceEndpoint", Method: "POST", PathTemplate: "/v2/enablePrivateServiceEndpoint"},
+	"V2EnablePublicServiceEndpoint":   {ServiceName: "kubernetes_service_api", ID: "V2EnablePublicServiceEndpoint", Method: "POST", PathTemplate: "/v2/enablePublicServiceEndpoint"},
+	"V2EnablePullSecret":              {ServiceName: "kubernetes_service_api", ID: "V2EnablePullSecret", Method: "POST", PathTemplate: "/v2/enablePullSecret"},
+	"V2GetVersions":                   {ServiceName: "kubernetes_service_api", ID: "V2GetVersions", Method: "GET", PathTemplate: "/v2/getVersions"},
+	"DeleteSecret":                    {ServiceName: "kubernetes_service_api", ID: "DeleteSecret", Method: "POST", PathTemplate: "/v2/nlb-dns/deleteSecret"},
+	"GetNlbDNSList":                   {ServiceName: "kubernetes_service_api", ID: "GetNlbDNSList", Method: "GET", PathTemplate: "/v2/nlb-dns/getNlbDNSList"},
+	"GetSatLocationNlbDNSList":        {ServiceName: "kubernetes_service_api", ID: "GetSatLocationNlbDNSList", Method: "GET", PathTemplate: "/v2/nlb-dns/getSatLocationSubdomains"},
+	"RegenerateCert":                  {ServiceName: "kubernetes_service_api", ID: "RegenerateCert", Method: "POST", PathTemplate: "/v2/nlb-dns/regenerateCert"},
+	"RegisterMultishiftCluster":       {ServiceName: "kubernetes_service_api", ID: "RegisterMultishiftCluster", Method: "POST", PathTemplate: "/v2/nlb-dns/registerMSCDomains"},
+	"ReplaceLBHostname":               {ServiceName: "kubernetes_service_api", ID: "ReplaceLBHostname", Method: "POST", PathTemplate: "/v2/nlb-dns/vpc/ReplaceLBHostname"},
+	"CreateNlbDNS":                    {ServiceName: "kubernetes_service_api", ID: "CreateNlbDNS", Method: "POST", PathTemplate: "/v2/nlb-dns/vpc/createNlbDNS"},
+	"RemoveLBHostname":                {ServiceName: "kubernetes_service_api", ID: "RemoveLBHostname", Method: "POST", PathTemplate: "/v2/nlb-dns/vpc/removeLBHostname"},
+	"ReplaceWorker":                   {ServiceName: "kubernetes_service_api", ID: "ReplaceWorker", Method: "POST", PathTemplate: "/v2/replaceWorker"},
+	"V2UpdateMaster":                  {ServiceName: "kubernetes_service_api", ID: "V2UpdateMaster", Method: "POST", PathTemplate: "/v2/updateMaster"},
+	"GetClusters":                     {ServiceName: "kubernetes_service_api", ID: "GetClusters", Method: "GET", PathTemplate: "/v1/clusters"},
+	"CreateCluster":                   {ServiceName: "kubernetes_service_api", ID: "CreateCluster", Method: "POST", PathTemplate: "/v1/clusters"},
+	"GetCluster1":                     {ServiceName: "kubernetes_service_api", ID: "GetCluster1", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}"},
+	"UpdateCluster":                   {ServiceName: "kubernetes_service_api", ID: "UpdateCluster", Method: "PUT", PathTemplate: "/v1/clusters/{idOrName}"},
+	"RemoveCluster":                   {ServiceName: "kubernetes_service_api", ID: "RemoveCluster", Method: "DELETE", PathTemplate: "/v1/clusters/{idOrName}"},
+	"GetClusterAddons":                {ServiceName: "kubernetes_service_api", ID: "GetClusterAddons", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/addons"},
+	"ManageClusterAddons":             {ServiceName: "kubernetes_service_api", ID: "ManageClusterAddons", Method: "PATCH", PathTemplate: "/v1/clusters/{idOrName}/addons"},
+	"GetClusterConfig":                {ServiceName: "kubernetes_service_api", ID: "GetClusterConfig", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/config"},
+	"CreateKMSConfig":                 {ServiceName: "kubernetes_service_api", ID: "CreateKMSConfig", Method: "POST", PathTemplate: "/v1/clusters/{idOrName}/kms"},
+	"HandleMasterAPIServer":           {ServiceName: "kubernetes_service_api", ID: "HandleMasterAPIServer", Method: "PUT", PathTemplate: "/v1/clusters/{idOrName}/masters"},
+	"ListServicesForAllNamespaces":    {ServiceName: "kubernetes_service_api", ID: "ListServicesForAllNamespaces", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/services"},
+	"BindServiceToNamespace":          {ServiceName: "kubernetes_service_api", ID: "BindServiceToNamespace", Method: "POST", PathTemplate: "/v1/clusters/{idOrName}/services"},
+	"ListServicesInNamespace":         {ServiceName: "kubernetes_service_api", ID: "ListServicesInNamespace", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/services/{namespace}"},
+	"UnbindServiceFromNamespace":      {ServiceName: "kubernetes_service_api", ID: "UnbindServiceFromNamespace", Method: "DELETE", PathTemplate: "/v1/clusters/{idOrName}/services/{namespace}/{serviceInstanceId}"},
+	"GetClusterSubnets":               {ServiceName: "kubernetes_service_api", ID: "GetClusterSubnets", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/subnets"},
+	"AddClusterSubnet":                {ServiceName: "kubernetes_service_api", ID: "AddClusterSubnet", Method: "PUT", PathTemplate: "/v1/clusters/{idOrName}/subnets/{subnetId}"},
+	"DetachClusterSubnet":             {ServiceName: "kubernetes_service_api", ID: "DetachClusterSubnet", Method: "PATCH", PathTemplate: "/v1/clusters/{idOrName}/subnets/{subnetId}"},
+	"GetClusterUserSubnet":            {ServiceName: "kubernetes_service_api", ID: "GetClusterUserSubnet", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/usersubnets"},
+	"AddClusterUserSubnet":            {ServiceName: "kubernetes_service_api", ID: "AddClusterUserSubnet", Method: "POST", PathTemplate: "/v1/clusters/{idOrName}/usersubnets"},
+	"RemoveClusterUserSubnet":         {ServiceName: "kubernetes_service_api", ID: "RemoveClusterUserSubnet", Method: "DELETE", PathTemplate: "/v1/clusters/{idOrName}/usersubnets/{subnetId}/vlans/{vlanId}"},
+	"CreateClusterSubnet":             {ServiceName: "kubernetes_service_api", ID: "CreateClusterSubnet", Method: "POST", PathTemplate: "/v1/clusters/{idOrName}/vlans/{vlanId}"},
+	"GetClusterWebhooks":              {ServiceName: "kubernetes_service_api", ID: "GetClusterWebhooks", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/webhooks"},
+	"AddClusterWebhooks":              {ServiceName: "kubernetes_service_api", ID: "AddClusterWebhooks", Method: "POST", PathTemplate: "/v1/clusters/{idOrName}/webhooks"},
+	"GetWorkerPools":                  {ServiceName: "kubernetes_service_api", ID: "GetWorkerPools", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/workerpools"},
+	"CreateWorkerPool":                {ServiceName: "kubernetes_service_api", ID: "CreateWorkerPool", Method: "POST", PathTemplate: "/v1/clusters/{idOrName}/workerpools"},
+	"GetWorkerPool1":                  {ServiceName: "kubernetes_service_api", ID: "GetWorkerPool1", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/workerpools/{poolidOrName}"},
+	"RemoveWorkerPool":                {ServiceName: "kubernetes_service_api", ID: "RemoveWorkerPool", Method: "DELETE", PathTemplate: "/v1/clusters/{idOrName}/workerpools/{poolidOrName}"},
+	"PatchWorkerPool":                 {ServiceName: "kubernetes_service_api", ID: "PatchWorkerPool", Method: "PATCH", PathTemplate: "/v1/clusters/{idOrName}/workerpools/{poolidOrName}"},
+	"AddWorkerPoolZone":               {ServiceName: "kubernetes_service_api", ID: "AddWorkerPoolZone", Method: "POST", PathTemplate: "/v1/clusters/{idOrName}/workerpools/{poolidOrName}/zones"},
+	"RemoveWorkerPoolZone":            {ServiceName: "kubernetes_service_api", ID: "RemoveWorkerPoolZone", Method: "DELETE", PathTemplate: "/v1/clusters/{idOrName}/workerpools/{poolidOrName}/zones/{zoneid}"},
+	"AddWorkerPoolZoneNetwork":        {ServiceName: "kubernetes_service_api", ID: "AddWorkerPoolZoneNetwork", Method: "PATCH", PathTemplate: "/v1/clusters/{idOrName}/workerpools/{poolidOrName}/zones/{zoneid}"},
+	"GetClusterWorkers":               {ServiceName: "kubernetes_service_api", ID: "GetClusterWorkers", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/workers"},
+	"AddClusterWorkers":               {ServiceName: "kubernetes_service_api", ID: "AddClusterWorkers", Method: "POST", PathTemplate: "/v1/clusters/{idOrName}/workers"},
+	"GetWorkers":                      {ServiceName: "kubernetes_service_api", ID: "GetWorkers", Method: "GET", PathTemplate: "/v1/clusters/{idOrName}/workers/{workerId}"},
+	"UpdateClusterWorker":             {ServiceName: "kubernetes_service_api", ID: "UpdateClusterWorker", Method: "PUT", PathTemplate: "/v1/clusters/{idOrName}/workers/{workerId}"},
+	"RemoveClusterWorker":             {ServiceName: "kubernetes_service_api", ID: "RemoveClusterWorker", Method: "DELETE", PathTemplate: "/v1/clusters/{idOrName}/workers/{workerId}"},
+	"GetUserConfig":                   {ServiceName: "kubernetes_service_api", ID: "GetUserConfig", Method: "GET", PathTemplate: "/v1/user-config"},
+	"V2DisableImageSecurity":          {ServiceName: "kubernetes_service_api", ID: "V2DisableImageSecurity", Method: "POST", PathTemplate: "/v2/disableImageSecurity"},
+	"V2EnableImageSecurity":           {ServiceName: "kubernetes_service_api", ID: "V2EnableImageSecurity", Method: "POST", PathTemplate: "/v2/enableImageSecurity"},
+	"FetchFilterConfigs":              {ServiceName: "kubernetes_service_api", ID: "FetchFilterConfigs", Method: "GET", PathTemplate: "/v1/logging/{idOrName}/filterconfigs"},
+	"CreateFilterConfig":              {ServiceName: "kubernetes_service_api", ID: "CreateFilterConfig", Method: "POST", PathTemplate: "/v1/logging/{idOrName}/filterconfigs"},
+	"DeleteFilterConfigs":             {ServiceName: "kubernetes_service_api", ID: "DeleteFilterConfigs", Method: "DELETE", PathTemplate: "/v1/logging/{idOrName}/filterconfigs"},
+	"FetchFilterConfig":               {ServiceName: "kubernetes_service_api", ID: "FetchFilterConfig", Method: "GET", PathTemplate: "/v1/logging/{idOrName}/filterconfigs/{id}"},
+	"UpdateFilterConfig":              {ServiceName: "kubernetes_service_api", ID: "UpdateFilterConfig", Method: "PUT", PathTemplate: "/v1/logging/{idOrName}/filterconfigs/{id}"},
+	"DeleteFilterConfig":              {ServiceName: "kubernetes_service_api", ID: "DeleteFilterConfig", Method: "DELETE", PathTemplate: "/v1/logging/{idOrName}/filterconfigs/{id}"},
+	"GetMasterLogCollectionStatus":    {ServiceName: "kubernetes_service_api", ID: "GetMasterLogCollectionStatus", Method: "GET", PathTemplate: "/v1/log-collector/{idOrName}/masterlogs"},
+	"CreateMasterLogCollection":       {ServiceName: "kubernetes_service_api", ID: "CreateMasterLogCollection", Method: "POST", PathTemplate: "/v1/log-collector/{idOrName}/masterlogs"},
+	"GetClusterKeyOwner":              {ServiceName: "kubernetes_service_api", ID: "GetClusterKeyOwner", Method: "GET", PathTemplate: "/v1/logging/{idOrName}/clusterkeyowner"},
+	"GetDefaultLoggingEndpoint":       {ServiceName: "kubernetes_service_api", ID: "GetDefaultLoggingEndpoint", Method: "GET", PathTemplate: "/v1/logging/{idOrName}/default"},
+	"FetchLoggingConfigs":             {ServiceName: "kubernetes_service_api", ID: "FetchLoggingConfigs", Method: "GET", PathTemplate: "/v1/logging/{idOrName}/loggingconfig"},
+	"DeleteLoggingConfigs":            {ServiceName: "kubernetes_service_api", ID: "DeleteLoggingConfigs", Method: "DELETE", PathTemplate: "/v1/logging/{idOrName}/loggingconfig"},
+	"FetchLoggingConfigsForSource":    {ServiceName: "kubernetes_service_api", ID: "FetchLoggingConfigsForSource", Method: "GET", PathTemplate: "/v1/logging/{idOrName}/loggingconfig/{logSource}"},
+	"CreateLoggingConfig":             {ServiceName: "kubernetes_service_api", ID: "CreateLoggingConfig", Method: "POST", PathTemplate: "/v1/logging/{idOrName}/loggingconfig/{logSource}"},
+	"UpdateLoggingConfig":             {ServiceName: "kubernetes_service_api", ID: "UpdateLoggingConfig", Method: "PUT", PathTemplate: "/v1/logging/{idOrName}/loggingconfig/{logSource}/{id}"},
+	"DeleteLoggingConfig":             {ServiceName: "kubernetes_service_api", ID: "DeleteLoggingConfig", Method: "DELETE", PathTemplate: "/v1/logging/{idOrName}/loggingconfig/{logSource}/{id}"},
+	"RefreshLoggingConfig":            {ServiceName: "kubernetes_service_api", ID: "RefreshLoggingConfig", Method: "PUT", PathTemplate: "/v1/logging/{idOrName}/refresh"},
+	"GetFluentdUpdatePolicy":          {ServiceName: "kubernetes_service_api", ID: "GetFluentdUpdatePolicy", Method: "GET", PathTemplate: "/v1/logging/{idOrName}/updatepolicy"},
+	"ChangeFluentdUpdatePolicy":       {ServiceName: "kubernetes_service_api", ID: "ChangeFluentdUpdatePolicy", Method: "PUT", PathTemplate: "/v1/logging/{idOrName}/updatepolicy"},
+	"CreateLoggingInstance":           {ServiceName: "kubernetes_service_api", ID: "CreateLoggingInstance", Method: "POST", PathTemplate: "/v2/observe/logging/createConfig"},
+	"DiscoverLoggingInstance":         {ServiceName: "kubernetes_service_api", ID: "DiscoverLoggingInstance", Method: "POST", PathTemplate: "/v2/observe/logging/discoverAgent"},
+	"GetLoggingInstance":              {ServiceName: "kubernetes_service_api", ID: "GetLoggingInstance", Method: "GET", PathTemplate: "/v2/observe/logging/getConfig"},
+	"GetLoggingInstances":             {ServiceName: "kubernetes_service_api", ID: "GetLoggingInstances", Method: "GET", PathTemplate: "/v2/observe/logging/getConfigs"},
+	"ModifyLoggingInstance":           {ServiceName: "kubernetes_service_api", ID: "ModifyLoggingInstance", Method: "POST", PathTemplate: "/v2/observe/logging/modifyConfig"},
+	"RemoveLoggingInstance":           {ServiceName: "kubernetes_service_api", ID: "RemoveLoggingInstance", Method: "POST", PathTemplate: "/v2/observe/logging/removeConfig"},
+	"CreateMonitoringInstance":        {ServiceName: "kubernetes_service_api", ID: "CreateMonitoringInstance", Method: "POST", PathTemplate: "/v2/observe/monitoring/createConfig"},
+	"DiscoverMonitoringInstance":      {ServiceName: "kubernetes_service_api", ID: "DiscoverMonitoringInstance", Method: "POST", PathTemplate: "/v2/observe/monitoring/discoverAgent"},
+	"GetMonitoringInstance":           {ServiceName: "kubernetes_service_api", ID: "GetMonitoringInstance", Method: "GET", PathTemplate: "/v2/observe/monitoring/getConfig"},
+	"GetMonitoringInstances":          {ServiceName: "kubernetes_service_api", ID: "GetMonitoringInstances", Method: "GET", PathTemplate: "/v2/observe/monitoring/getConfigs"},
+	"ModifyMonitoringInstance":        {ServiceName: "kubernetes_service_api", ID: "ModifyMonitoringInstance", Method: "POST", PathTemplate: "/v2/observe/monitoring/modifyConfig"},
+	"RemoveMonitoringInstance":        {ServiceName: "kubernetes_service_api", ID: "RemoveMonitoringInstance", Method: "POST", PathTemplate: "/v2/observe/monitoring/removeConfig"},
+	"UpdateDNSWithIP":                 {ServiceName: "kubernetes_service_api", ID: "UpdateDNSWithIP", Method: "PUT", PathTemplate: "/v1/nlb-dns/clusters/{idOrName}/add"},
+	"UnregisterDNSWithIP":             {ServiceName: "kubernetes_service_api", ID: "UnregisterDNSWithIP", Method: "DELETE", PathTemplate: "/v1/nlb-dns/clusters/{idOrName}/host/{nlbHost}/ip/{nlbIP}/remove"},
+	"ListNLBIPsForSubdomain":          {ServiceName: "kubernetes_service_api", ID: "ListNLBIPsForSubdomain", Method: "GET", PathTemplate: "/v1/nlb-dns/clusters/{idOrName}/list"},
+	"RegisterDNSWithIP":               {ServiceName: "kubernetes_service_api", ID: "RegisterDNSWithIP", Method: "POST", PathTemplate: "/v1/nlb-dns/clusters/{idOrName}/register"},
+	"UpdateNlbDNSHealthMonitor":       {ServiceName: "kubernetes_service_api", ID: "UpdateNlbDNSHealthMonitor", Method: "PUT", PathTemplate: "/v1/nlb-dns/clusters/{idOrName}/health"},
+	"AddNlbDNSHealthMonitor":          {ServiceName: "kubernetes_service_api", ID: "AddNlbDNSHealthMonitor", Method: "PATCH", PathTemplate: "/v1/nlb-dns/health/clusters/{idOrName}/config"},
+	"GetNlbDNSHealthMonitor":          {ServiceName: "kubernetes_service_api", ID: "GetNlbDNSHealthMonitor", Method: "GET", PathTemplate: "/v1/nlb-dns/health/clusters/{idOrName}/host/{nlbHost}/config"},
+	"ListNlbDNSHealthMonitors":        {ServiceName: "kubernetes_service_api", ID: "ListNlbDNSHealthMonitors", Method: "GET", PathTemplate: "/v1/nlb-dns/health/clusters/{idOrName}/list"},
+	"ListNlbDNSHealthMonitorStatus":   {ServiceName: "kubernetes_service_api", ID: "ListNlbDNSHealthMonitorStatus", Method: "GET", PathTemplate: "/v1/nlb-dns/health/clusters/{idOrName}/status"},
+	"GetDatacenterVLANs":              {ServiceName: "kubernetes_service_api", ID: "GetDatacenterVLANs", Method: "GET", PathTemplate: "/v1/datacenters/{datacenter}/vlans"},
+	"ListSubnets":                     {ServiceName: "kubernetes_service_api", ID: "ListSubnets", Method: "GET", PathTemplate: "/v1/subnets"},
+	"CreateSatelliteCluster":          {ServiceName: "kubernetes_service_api", ID: "CreateSatelliteCluster", Method: "POST", PathTemplate: "/v2/satellite/createCluster"},
+	"CreateSatelliteWorkerPool":       {ServiceName: "kubernetes_service_api", ID: "CreateSatelliteWorkerPool", Method: "POST", PathTemplate: "/v2/satellite/createWorkerPool"},
+	"CreateSatelliteWorkerPoolZone":   {ServiceName: "kubernetes_service_api", ID: "CreateSatelliteWorkerPoolZone", Method: "POST", PathTemplate: "/v2/satellite/createWorkerPoolZone"},
+	"GetSatelliteClusters":            {ServiceName: "kubernetes_service_api", ID: "GetSatelliteClusters", Method: "GET", PathTemplate: "/v2/satellite/getClusters"},
+	"CreateSatelliteAssignment":       {ServiceName: "kubernetes_service_api", ID: "CreateSatelliteAssignment", Method: "POST", PathTemplate: "/v2/satellite/hostqueue/createAssignment"},
+	"AttachSatelliteHost":             {ServiceName: "kubernetes_service_api", ID: "AttachSatelliteHost", Method: "POST", PathTemplate: "/v2/satellite/hostqueue/createRegistrationScript"},
+	"GetSatelliteHosts":               {ServiceName: "kubernetes_service_api", ID: "GetSatelliteHosts", Method: "GET", PathTemplate: "/v2/satellite/hostqueue/getHosts"},
+	"RemoveSatelliteHost":             {ServiceName: "kubernetes_service_api", ID: "RemoveSatelliteHost", Method: "POST", PathTemplate: "/v2/satellite/hostqueue/removeHost"},
+	"UpdateSatelliteHost":             {ServiceName: "kubernetes_service_api", ID: "UpdateSatelliteHost", Method: "POST", PathTemplate: "/v2/satellite/hostqueue/updateHost"},
+	"CreateSatelliteLocation":         {ServiceName: "kubernetes_service_api", ID: "CreateSatelliteLocation", Method: "POST", PathTemplate: "/v2/satellite/createController"},
+	"GetSatelliteLocation":            {ServiceName: "kubernetes_service_api", ID: "GetSatelliteLocation", Method: "GET", PathTemplate: "/v2/satellite/getController"},
+	"GetSatelliteLocations":           {ServiceName: "kubernetes_service_api", ID: "GetSatelliteLocations", Method: "GET", PathTemplate: "/v2/satellite/getControllers"},
+	"RemoveSatelliteLocation":         {ServiceName: "kubernetes_service_api", ID: "RemoveSatelliteLocation", Method: "POST", PathTemplate: "/v2/satellite/removeController"},
+	"CreateSatelliteClusterRemote":    {ServiceName: "kubernetes_service_api", ID: "CreateSatelliteClusterRemote", Method: "POST", PathTemplate: "/v2/satellite/createClusterRemoteLocation"},
+	"GetSatelliteServiceClusters":     {ServiceName: "kubernetes_service_api", ID: "GetSatelliteServiceClusters", Method: "GET", PathTemplate: "/v2/satellite/getServiceClusters"},
+	"CreateAttachment":                {ServiceName: "kubernetes_service_api", ID: "CreateAttachment", Method: "POST", PathTemplate: "/v2/storage/createAttachment"},
+	"DeleteAttachment":                {ServiceName: "kubernetes_service_api", ID: "DeleteAttachment", Method: "POST", PathTemplate: "/v2/storage/deleteAttachment"},
+	"GetAttachment":                   {ServiceName: "kubernetes_service_api", ID: "GetAttachment", Method: "GET", PathTemplate: "/v2/storage/getAttachment"},
+	"GetAttachments":                  {ServiceName: "kubernetes_service_api", ID: "GetAttachments", Method: "GET", PathTemplate: "/v2/storage/getAttachments"},
+	"GetVolume":                       {ServiceName: "kubernetes_service_api", ID: "GetVolume", Method: "GET", PathTemplate: "/v2/storage/getVolume"},
+	"GetVolumes":                      {ServiceName: "kubernetes_service_api", ID: "GetVolumes", Method: "GET", PathTemplate: "/v2/storage/getVolumes"},
+	"CreateAssignment":                {ServiceName: "kubernetes_service_api", ID: "CreateAssignment", Method: "POST", PathTemplate: "/v2/storage/satellite/createAssignment"},
+	"CreateAssignmentByCluster":       {ServiceName: "kubernetes_service_api", ID: "CreateAssignmentByCluster", Method: "POST", PathTemplate: "/v2/storage/satellite/createAssignmentByCluster"},
+	"CreateStorageConfiguration":      {ServiceName: "kubernetes_service_api", ID: "CreateStorageConfiguration", Method: "POST", PathTemplate: "/v2/storage/satellite/createStorageConfigurationByController"},
+	"GetAssignedStorageConfigs":       {ServiceName: "kubernetes_service_api", ID: "GetAssignedStorageConfigs", Method: "GET", PathTemplate: "/v2/storage/satellite/getAssignedStorageConfigs"},
+	"GetAssignment":                   {ServiceName: "kubernetes_service_api", ID: "GetAssignment", Method: "GET", PathTemplate: "/v2/storage/satellite/getAssignment"},
+	"GetAssignmentByName":             {ServiceName: "kubernetes_service_api", ID: "GetAssignmentByName", Method: "GET", PathTemplate: "/v2/storage/satellite/getAssignmentByName"},
+	"GetAssignments":                  {ServiceName: "kubernetes_service_api", ID: "GetAssignments", Method: "GET", PathTemplate: "/v2/storage/satellite/getAssignments"},
+	"GetAssignmentsByConfig":          {ServiceName: "kubernetes_service_api", ID: "GetAssignmentsByConfig", Method: "GET", PathTemplate: "/v2/storage/satellite/getAssignmentsByConfig"},
+	"GetAvailableStorageClasses":      {ServiceName: "kubernetes_service_api", ID: "GetAvailableStorageClasses", Method: "GET", PathTemplate: "/v2/storage/satellite/getAvailableStorageClasses"},
+	"GetStorageConfiguration":         {ServiceName: "kubernetes_service_api", ID: "GetStorageConfiguration", Method: "GET", PathTemplate: "/v2/storage/satellite/getStorageConfiguration"},
+	"GetStorageConfigurations":        {ServiceName: "kubernetes_service_api", ID: "GetStorageConfigurations", Method: "GET", PathTemplate: "/v2/storage/satellite/getStorageConfigurations"},
+	"GetStorageTemplate":              {ServiceName: "kubernetes_service_api", ID: "GetStorageTemplate", Method: "GET", PathTemplate: "/v2/storage/satellite/getStorageTemplate"},
+	"GetStorageTemplates":             {ServiceName: "kubernetes_service_api", ID: "GetStorageTemplates", Method: "GET", PathTemplate: "/v2/storage/satellite/getStorageTemplates"},
+	"RemoveAssignment":                {ServiceName: "kubernetes_service_api", ID: "RemoveAssignment", Method: "DELETE", PathTemplate: "/v2/storage/satellite/removeAssignment"},
+	"RemoveStorageConfiguration":      {ServiceName: "kubernetes_service_api", ID: "RemoveStorageConfiguration", Method: "DELETE", PathTemplate: "/v2/storage/satellite/removeStorageConfiguration"},
+	"UpdateAssignment":                {ServiceName: "kubernetes_service_api", ID: "UpdateAssignment", Method: "PATCH", PathTemplate: "/v2/storage/satellite/updateAssignment"},
+	"UpdateAssignmentVersion":         {ServiceName: "kubernetes_service_api", ID: "UpdateAssignmentVersion", Method: "PATCH", PathTemplate: "/v2/storage/satellite/updateAssignmentVersion"},
+	"UpdateStorageConfiguration":      {ServiceName: "kubernetes_service_api", ID: "UpdateStorageConfiguration", Method: "POST", PathTemplate: "/v2/storage/satellite/updateStorageConfigurationByController"},
+	"GetAddons":                       {ServiceName: "kubernetes_service_api", ID: "GetAddons", Method: "GET", PathTemplate: "/v1/addons"},
+	"GetBluemixConfig":                {ServiceName: "kubernetes_service_api", ID: "GetBluemixConfig", Method: "GET", PathTemplate: "/v1/config"},
+	"GetDatacenterMachineTypes":       {ServiceName: "kubernetes_service_api", ID: "GetDatacenterMachineTypes", Method: "GET", PathTemplate: "/v1/datacenters/{datacenter}/machine-types"},
+	"GetKubeVersions":                 {ServiceName: "kubernetes_service_api", ID: "GetKubeVersions", Method: "GET", PathTemplate: "/v1/kube-versions"},
+	"ListLocations":                   {ServiceName: "kubernetes_service_api", ID: "ListLocations", Method: "GET", PathTemplate: "/v1/locations"},
+	"GetMessages":                     {ServiceName: "kubernetes_service_api", ID: "GetMessages", Method: "GET", PathTemplate: "/v1/messages"},
+	"GetProductConfig":                {ServiceName: "kubernetes_service_api", ID: "GetProductConfig", Method: "GET", PathTemplate: "/v1/prodconfig"},
+	"GetRegions":                      {ServiceName: "kubernetes_service_api", ID: "GetRegions", Method: "GET", PathTemplate: "/v1/regions"},
+	"GetVersions":                     {ServiceName: "kubernetes_service_api", ID: "GetVersions", Method: "GET", PathTemplate: "/v1/versions"},
+	"GetZones":                        {ServiceName: "kubernetes_service_api", ID: "GetZones", Method: "GET", PathTemplate: "/v1/zones"},
+	"V2GetMessages":                   {ServiceName: "kubernetes_service_api", ID: "V2GetMessages", Method: "GET", PathTemplate: "/v2/getMessages"},
+	"ApplyRBACAndGetKubeconfig":       {ServiceName: "kubernetes_service_api", ID: "ApplyRBACAndGetKubeconfig", Method: "POST", PathTemplate: "/v2/applyRBACAndGetKubeconfig"},
+	"AutoUpdateMaster":                {ServiceName: "kubernetes_service_api", ID: "AutoUpdateMaster", Method: "POST", PathTemplate: "/v2/autoUpdateMaster"},
+	"ClassicGetCluster":               {ServiceName: "kubernetes_service_api", ID: "ClassicGetCluster", Method: "GET", PathTemplate: "/v2/classic/getCluster"},
+	"ClassicGetClusters":              {ServiceName: "kubernetes_service_api", ID: "ClassicGetClusters", Method: "GET", PathTemplate: "/v2/classic/getClusters"},
+	"GetVLANs":                        {ServiceName: "kubernetes_service_api", ID: "GetVLANs", Method: "GET", PathTemplate: "/v2/classic/getVLANs"},
+	"ClassicGetWorker":                {ServiceName: "kubernetes_service_api", ID: "ClassicGetWorker", Method: "GET", PathTemplate: "/v2/classic/getWorker"},
+	"ClassicGetWorkerPool":            {ServiceName: "kubernetes_service_api", ID: "ClassicGetWorkerPool", Method: "GET", PathTemplate: "/v2/classic/getWorkerPool"},
+	"ClassicGetWorkerPools":           {ServiceName: "kubernetes_service_api", ID: "ClassicGetWorkerPools", Method: "GET", PathTemplate: "/v2/classic/getWorkerPools"},
+	"ClassicGetWorkers":               {ServiceName: "kubernetes_service_api", ID: "ClassicGetWorkers", Method: "GET", PathTemplate: "/v2/classic/getWorkers"},
+	"KmsEnableCluster":                {ServiceName: "kubernetes_service_api", ID: "KmsEnableCluster", Method: "POST", PathTemplate: "/v2/enableKMS"},
+	"KmsGetCRKs":                      {ServiceName: "kubernetes_service_api", ID: "KmsGetCRKs", Method: "GET", PathTemplate: "/v2/getCRKs"},
+	"GetCluster":                      {ServiceName: "kubernetes_service_api", ID: "GetCluster", Method: "GET", PathTemplate: "/v2/getCluster"},
+	"V2GetClusterAddons":              {ServiceName: "kubernetes_service_api", ID: "V2GetClusterAddons", Method: "GET", PathTemplate: "/v2/getClusterAddons"},
+	"V2GetFlavors":                    {ServiceName: "kubernetes_service_api", ID: "V2GetFlavors", Method: "GET", PathTemplate: "/v2/getFlavors"},
+	"KmsGetInstances":                 {ServiceName: "kubernetes_service_api", ID: "KmsGetInstances", Method: "GET", PathTemplate: "/v2/getKMSInstances"},
+	"GetKubeconfig":                   {ServiceName: "kubernetes_service_api", ID: "GetKubeconfig", Method: "GET", PathTemplate: "/v2/getKubeconfig"},
+	"GetQuota":                        {ServiceName: "kubernetes_service_api", ID: "GetQuota", Method: "GET", PathTemplate: "/v2/getQuota"},
+	"GetWorker":                       {ServiceName: "kubernetes_service_api", ID: "GetWorker", Method: "GET", PathTemplate: "/v2/getWorker"},
+	"GetWorkerPool":                   {ServiceName: "kubernetes_service_api", ID: "GetWorkerPool", Method: "GET", PathTemplate: "/v2/getWorkerPool"},
+	"GetWorkerPools1":                 {ServiceName: "kubernetes_service_api", ID: "GetWorkerPools1", Method: "GET", PathTemplate: "/v2/getWorkerPools"},
+	"GetWorkers1":                     {ServiceName: "kubernetes_service_api", ID: "GetWorkers1", Method: "GET", PathTemplate: "/v2/getWorkers"},
+	"RebalanceWorkerPool":             {ServiceName: "kubernetes_service_api", ID: "RebalanceWorkerPool", Method: "POST", PathTemplate: "/v2/rebalanceWorkerPool"},
+	"VpcRefreshMaster":                {ServiceName: "kubernetes_service_api", ID: "VpcRefreshMaster", Method: "POST", PathTemplate: "/v2/refreshMaster"},
+	"V2RemoveWorker":                  {ServiceName: "kubernetes_service_api", ID: "V2RemoveWorker", Method: "POST", PathTemplate: "/v2/removeWorker"},
+	"RemoveWorkerPool1":               {ServiceName: "kubernetes_service_api", ID: "RemoveWorkerPool1", Method: "POST", PathTemplate: "/v2/removeWorkerPool"},
+	"RemoveWorkerPoolZone1":           {ServiceName: "kubernetes_service_api", ID: "RemoveWorkerPoolZone1", Method: "POST", PathTemplate: "/v2/removeWorkerPoolZone"},
+	"V2ResizeWorkerPool":              {ServiceName: "kubernetes_service_api", ID: "V2ResizeWorkerPool", Method: "POST", PathTemplate: "/v2/resizeWorkerPool"},
+	"V2SetWorkerPoolLabels":           {ServiceName: "kubernetes_service_api", ID: "V2SetWorkerPoolLabels", Method: "POST", PathTemplate: "/v2/setWorkerPoolLabels"},
+	"V2SetWorkerPoolTaints":           {ServiceName: "kubernetes_service_api", ID: "V2SetWorkerPoolTaints", Method: "POST", PathTemplate: "/v2/setWorkerPoolTaints"},
+	"VpcCreateCluster":                {ServiceName: "kubernetes_service_api", ID: "VpcCreateCluster", Method: "POST", PathTemplate: "/v2/vpc/createCluster"},
+	"VpcCreateWorkerPool":             {ServiceName: "kubernetes_service_api", ID: "VpcCreateWorkerPool", Method: "POST", PathTemplate: "/v2/vpc/createWorkerPool"},
+	"VpcCreateWorkerPoolZone":         {ServiceName: "kubernetes_service_api", ID: "VpcCreateWorkerPoolZone", Method: "POST", PathTemplate: "/v2/vpc/createWorkerPoolZone"},
+	"VpcGetCluster":                   {ServiceName: "kubernetes_service_api", ID: "VpcGetCluster", Method: "GET", PathTemplate: "/v2/vpc/getCluster"},
+	"VpcGetClusters":                  {ServiceName: "kubernetes_service_api", ID: "VpcGetClusters", Method: "GET", PathTemplate: "/v2/vpc/getClusters"},
+	"GetSubnets":                      {ServiceName: "kubernetes_service_api", ID: "GetSubnets", Method: "GET", PathTemplate: "/v2/vpc/getSubnets"},
+	"GetVPC":                          {ServiceName: "kubernetes_service_api", ID: "GetVPC", Method: "GET", PathTemplate: "/v2/vpc/getVPC"},
+	"GetVPCs":                         {ServiceName: "kubernetes_service_api", ID: "GetVPCs", Method: "GET", PathTemplate: "/v2/vpc/getVPCs"},
+	"VpcGetWorker":                    {ServiceName: "kubernetes_service_api", ID: "VpcGetWorker", Method: "GET", PathTemplate: "/v2/vpc/getWorker"},
+	"VpcGetWorkerPool":                {ServiceName: "kubernetes_service_api", ID: "VpcGetWorkerPool", Method: "GET", PathTemplate: "/v2/vpc/getWorkerPool"},
+	"VpcGetWorkerPools":               {ServiceName: "kubernetes_service_api", ID: "VpcGetWorkerPools", Method: "GET", PathTemplate: "/v2/vpc/getWorkerPools"},
+	"VpcGetWorkers":                   {ServiceName: "kubernetes_service_api", ID: "VpcGetWorkers", Method: "GET", PathTemplate: "/v2/vpc/getWorkers"},
+	"VpcGetZones":                     {ServiceName: "kubernetes_service_api", ID: "VpcGetZones", Method: "GET", PathTemplate: "/v2/vpc/getZones"},
+	"VpcReplaceWorker":                {ServiceName: "kubernetes_service_api", ID: "VpcReplaceWorker", Method: "POST", PathTemplate: "/v2/vpc/replaceWorker"},
+}
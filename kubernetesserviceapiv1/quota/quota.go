@@ -0,0 +1,431 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package quota checks the operations that create clusters and workers against the quotas of the account before
+// they are sent.
+//
+// The quotas returned by GetQuota limit the number of clusters and of workers per region, separately for the
+// classic and the VPC infrastructure. A Checker computes the clusters and workers an operation would add, reads the
+// clusters and workers already in use from ClassicGetClusters and VpcGetClusters, and returns a Report of the
+// resulting usage, with an *ExceededError if the operation would exceed a quota:
+//
+//	checker := quota.NewChecker(kubernetesServiceApi)
+//	if _, err := checker.CheckVpcCreateCluster(ctx, vpcCreateClusterOptions); err != nil {
+//		return err
+//	}
+//	_, _, err := kubernetesServiceApi.VpcCreateClusterWithContext(ctx, vpcCreateClusterOptions)
+//
+// Check answers the same question for resources that are not tied to an operation, such as 30 more VPC workers in
+// eu-de:
+//
+//	report, err := checker.Check(ctx, quota.Demand{Region: "eu-de", Infrastructure: quota.InfrastructureVPC, Workers: 30})
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// Infrastructures the quotas apply to.
+const (
+	InfrastructureClassic = "classic"
+	InfrastructureVPC     = "vpc"
+)
+
+// Resources limited by the quotas.
+const (
+	ResourceClusters = "cluster"
+	ResourceWorkers  = "worker"
+)
+
+// Demand : The clusters and workers an operation adds in a region.
+type Demand struct {
+	// The region, such as "eu-de".
+	Region string
+
+	// The infrastructure, InfrastructureClassic or InfrastructureVPC.
+	Infrastructure string
+
+	// The number of clusters added.
+	Clusters int64
+
+	// The number of workers added.
+	Workers int64
+}
+
+// Usage : The quota and the usage of a resource in a region.
+type Usage struct {
+	// The region, such as "eu-de".
+	Region string
+
+	// The infrastructure, InfrastructureClassic or InfrastructureVPC.
+	Infrastructure string
+
+	// The resource, ResourceClusters or ResourceWorkers.
+	Resource string
+
+	// Whether GetQuota returned a quota for the resource. The resource is not limited otherwise.
+	Limited bool
+
+	// The quota of the resource, if Limited.
+	Quota int64
+
+	// The number of resources in use.
+	Used int64
+
+	// The number of resources the operation adds.
+	Requested int64
+}
+
+// Available returns the number of resources that can still be added, or -1 if the resource is not limited.
+func (usage Usage) Available() int64 {
+	if !usage.Limited {
+		return -1
+	}
+	if available := usage.Quota - usage.Used; available > 0 {
+		return available
+	}
+	return 0
+}
+
+// Exceeded reports whether adding the requested resources would exceed the quota.
+func (usage Usage) Exceeded() bool {
+	return usage.Limited && usage.Requested > 0 && usage.Used+usage.Requested > usage.Quota
+}
+
+// String describes the usage, such as "eu-de vpc workers: 10 used + 5 requested of 12".
+func (usage Usage) String() string {
+	quota := "unlimited"
+	if usage.Limited {
+		quota = fmt.Sprintf("of %d", usage.Quota)
+	}
+	return fmt.Sprintf("%s %s %ss: %d used + %d requested %s", usage.Region, usage.Infrastructure, usage.Resource,
+		usage.Used, usage.Requested, quota)
+}
+
+// Report : The usage of the resources an operation adds.
+type Report struct {
+	// The ID of the operation, such as "VpcCreateCluster", or empty for Check.
+	Operation string
+
+	// The usage of the resources the operation adds, by region, infrastructure and resource, in the order of the
+	// demands.
+	Usages []Usage
+}
+
+// Exceeded returns the usages whose quota the operation would exceed.
+func (report *Report) Exceeded() []Usage {
+	var exceeded []Usage
+	for _, usage := range report.Usages {
+		if usage.Exceeded() {
+			exceeded = append(exceeded, usage)
+		}
+	}
+	return exceeded
+}
+
+// String lists the usages, the exceeded ones marked with "!".
+func (report *Report) String() string {
+	var b strings.Builder
+	operation := report.Operation
+	if operation == "" {
+		operation = "the demand"
+	}
+	fmt.Fprintf(&b, "Quota usage of %s:\n", operation)
+	if len(report.Usages) == 0 {
+		b.WriteString("  no resources requested\n")
+	}
+	for _, usage := range report.Usages {
+		marker := " "
+		if usage.Exceeded() {
+			marker = "!"
+		}
+		fmt.Fprintf(&b, "%s %s\n", marker, usage)
+	}
+	return b.String()
+}
+
+// ExceededError : The error returned when an operation would exceed a quota.
+type ExceededError struct {
+	// The report of the operation.
+	Report *Report
+}
+
+// Error lists the exceeded quotas.
+func (err *ExceededError) Error() string {
+	operation := err.Report.Operation
+	if operation == "" {
+		operation = "the demand"
+	}
+	var quotas []string
+	for _, usage := range err.Report.Exceeded() {
+		quotas = append(quotas, fmt.Sprintf("the quota of %d %s %ss in %s (%d used, %d requested)",
+			usage.Quota, usage.Infrastructure, usage.Resource, usage.Region, usage.Used, usage.Requested))
+	}
+	return fmt.Sprintf("quota: %s would exceed %s", operation, strings.Join(quotas, " and "))
+}
+
+// Checker : Checks operations against the quotas of the account of a client.
+type Checker struct {
+	service *kubernetesserviceapiv1.KubernetesServiceApiV1
+}
+
+// NewChecker returns a Checker of the operations of service.
+func NewChecker(service *kubernetesserviceapiv1.KubernetesServiceApiV1) *Checker {
+	return &Checker{service: service}
+}
+
+// Check returns the report of adding the demands, and an *ExceededError if they would exceed a quota.
+func (checker *Checker) Check(ctx context.Context, demands ...Demand) (*Report, error) {
+	account, err := checker.readAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return account.check("", demands)
+}
+
+// CheckCreateCluster checks the classic cluster and the workers of its default worker pool created by CreateCluster.
+// The region is the one of the data center, or the default region of the client if the data center is not known.
+func (checker *Checker) CheckCreateCluster(ctx context.Context, options *kubernetesserviceapiv1.CreateClusterOptions) (*Report, error) {
+	region, err := checker.zoneRegion(core.StringNilMapper(options.DataCenter))
+	if err != nil {
+		return nil, err
+	}
+	account, err := checker.readAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return account.check("CreateCluster", []Demand{{
+		Region:         region,
+		Infrastructure: InfrastructureClassic,
+		Clusters:       1,
+		Workers:        int64Value(options.WorkerNum, 1),
+	}})
+}
+
+// CheckVpcCreateCluster checks the VPC cluster and the workers of its default worker pool created by
+// VpcCreateCluster. The region is the one of the zones of the worker pool.
+func (checker *Checker) CheckVpcCreateCluster(ctx context.Context, options *kubernetesserviceapiv1.VpcCreateClusterOptions) (*Report, error) {
+	demand := Demand{Infrastructure: InfrastructureVPC, Clusters: 1}
+	if pool := options.WorkerPool; pool != nil {
+		demand.Workers = int64Value(pool.WorkerCount, 0) * int64(len(pool.Zones))
+		if len(pool.Zones) > 0 {
+			region, err := checker.zoneRegion(core.StringNilMapper(pool.Zones[0].ID))
+			if err != nil {
+				return nil, err
+			}
+			demand.Region = region
+		}
+	}
+	if demand.Region == "" {
+		demand.Region = checker.service.GetRegion()
+	}
+	account, err := checker.readAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return account.check("VpcCreateCluster", []Demand{demand})
+}
+
+// CheckAddClusterWorkers checks the workers added to a classic cluster by AddClusterWorkers.
+func (checker *Checker) CheckAddClusterWorkers(ctx context.Context, options *kubernetesserviceapiv1.AddClusterWorkersOptions) (*Report, error) {
+	return checker.checkClusterWorkers(ctx, "AddClusterWorkers", core.StringNilMapper(options.IdOrName), int64Value(options.WorkerNum, 1))
+}
+
+// CheckCreateWorkerPool checks the workers of the worker pool created by CreateWorkerPool.
+func (checker *Checker) CheckCreateWorkerPool(ctx context.Context, options *kubernetesserviceapiv1.CreateWorkerPoolOptions) (*Report, error) {
+	workers := int64Value(options.SizePerZone, 0) * int64(len(options.Zones))
+	return checker.checkClusterWorkers(ctx, "CreateWorkerPool", core.StringNilMapper(options.IdOrName), workers)
+}
+
+// CheckVpcCreateWorkerPool checks the workers of the worker pool created by VpcCreateWorkerPool.
+func (checker *Checker) CheckVpcCreateWorkerPool(ctx context.Context, options *kubernetesserviceapiv1.VpcCreateWorkerPoolOptions) (*Report, error) {
+	workers := int64Value(options.WorkerCount, 0) * int64(len(options.Zones))
+	return checker.checkClusterWorkers(ctx, "VpcCreateWorkerPool", core.StringNilMapper(options.Cluster), workers)
+}
+
+// CheckPatchWorkerPool checks the workers added by PatchWorkerPool when it resizes a worker pool to more workers per
+// zone. Other patches add no workers.
+func (checker *Checker) CheckPatchWorkerPool(ctx context.Context, options *kubernetesserviceapiv1.PatchWorkerPoolOptions) (*Report, error) {
+	cluster := core.StringNilMapper(options.IdOrName)
+	if core.StringNilMapper(options.State) != "resizing" || options.SizePerZone == nil {
+		return checker.checkClusterWorkers(ctx, "PatchWorkerPool", cluster, 0)
+	}
+	pool, _, err := checker.service.GetWorkerPoolWithContext(ctx, &kubernetesserviceapiv1.GetWorkerPoolOptions{
+		Cluster:            options.IdOrName,
+		Workerpool:         options.PoolidOrName,
+		XAuthResourceGroup: options.XAuthResourceGroup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("quota: reading worker pool %s of cluster %s: %w", core.StringNilMapper(options.PoolidOrName), cluster, err)
+	}
+	workers := (*options.SizePerZone - int64Value(pool.WorkerCount, 0)) * int64(len(pool.Zones))
+	if workers < 0 {
+		workers = 0
+	}
+	return checker.checkClusterWorkers(ctx, "PatchWorkerPool", cluster, workers)
+}
+
+// checkClusterWorkers checks the workers added to the cluster idOrName by operation.
+func (checker *Checker) checkClusterWorkers(ctx context.Context, operation string, idOrName string, workers int64) (*Report, error) {
+	account, err := checker.readAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cluster, ok := account.findCluster(idOrName)
+	if !ok {
+		return nil, fmt.Errorf("quota: cluster %s not found", idOrName)
+	}
+	return account.check(operation, []Demand{{Region: cluster.region, Infrastructure: cluster.infrastructure, Workers: workers}})
+}
+
+// zoneRegion returns the region of the VPC zone or classic data center zone, or the default region of the client if
+// zone is not known.
+func (checker *Checker) zoneRegion(zone string) (string, error) {
+	region, err := kubernetesserviceapiv1.GetRegionForZone(zone)
+	if err == nil {
+		return region, nil
+	}
+	if region = checker.service.GetRegion(); region != "" {
+		return region, nil
+	}
+	return "", fmt.Errorf("quota: %w", err)
+}
+
+// account : The quotas and the clusters of an account.
+type account struct {
+	quotas   map[key]int64
+	used     map[key]int64
+	clusters []cluster
+}
+
+// key : A resource of an infrastructure in a region.
+type key struct {
+	region         string
+	infrastructure string
+	resource       string
+}
+
+// cluster : A cluster of an account.
+type cluster struct {
+	id             string
+	name           string
+	region         string
+	infrastructure string
+}
+
+// readAccount reads the quotas and the classic and VPC clusters of the account.
+func (checker *Checker) readAccount(ctx context.Context) (*account, error) {
+	quotas, _, err := checker.service.GetQuotaWithContext(ctx, &kubernetesserviceapiv1.GetQuotaOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("quota: reading the quotas: %w", err)
+	}
+	account := &account{quotas: map[key]int64{}, used: map[key]int64{}}
+	for _, quota := range quotas {
+		for _, region := range quota.Regions {
+			if region.ID != nil && region.Quota != nil {
+				account.quotas[key{*region.ID, core.StringNilMapper(quota.Infrastructure), core.StringNilMapper(quota.Type)}] = *region.Quota
+			}
+		}
+	}
+
+	classic, _, err := checker.service.ClassicGetClustersWithContext(ctx, &kubernetesserviceapiv1.ClassicGetClustersOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("quota: listing the classic clusters: %w", err)
+	}
+	account.addClusters(InfrastructureClassic, classic)
+	vpc, _, err := checker.service.VpcGetClustersWithContext(ctx, &kubernetesserviceapiv1.VpcGetClustersOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("quota: listing the VPC clusters: %w", err)
+	}
+	account.addClusters(InfrastructureVPC, vpc)
+	return account, nil
+}
+
+// addClusters counts the clusters of infrastructure and their workers as used.
+func (account *account) addClusters(infrastructure string, clusters []kubernetesserviceapiv1.GetClustersResponse) {
+	for _, c := range clusters {
+		region := core.StringNilMapper(c.Region)
+		account.clusters = append(account.clusters, cluster{
+			id:             core.StringNilMapper(c.ID),
+			name:           core.StringNilMapper(c.Name),
+			region:         region,
+			infrastructure: infrastructure,
+		})
+		account.used[key{region, infrastructure, ResourceClusters}]++
+		account.used[key{region, infrastructure, ResourceWorkers}] += int64Value(c.WorkerCount, 0)
+	}
+}
+
+// findCluster returns the cluster with the ID or name idOrName.
+func (account *account) findCluster(idOrName string) (cluster, bool) {
+	for _, c := range account.clusters {
+		if c.id == idOrName || c.name == idOrName {
+			return c, true
+		}
+	}
+	return cluster{}, false
+}
+
+// check returns the report of adding the demands of operation, and an *ExceededError if they would exceed a quota.
+// The demands in the same region and infrastructure are added up.
+func (account *account) check(operation string, demands []Demand) (*Report, error) {
+	report := &Report{Operation: operation, Usages: []Usage{}}
+	index := map[key]int{}
+	for _, demand := range demands {
+		for _, requested := range []struct {
+			resource string
+			count    int64
+		}{{ResourceClusters, demand.Clusters}, {ResourceWorkers, demand.Workers}} {
+			if requested.count <= 0 {
+				continue
+			}
+			k := key{demand.Region, demand.Infrastructure, requested.resource}
+			if i, ok := index[k]; ok {
+				report.Usages[i].Requested += requested.count
+				continue
+			}
+			quota, limited := account.quotas[k]
+			index[k] = len(report.Usages)
+			report.Usages = append(report.Usages, Usage{
+				Region:         demand.Region,
+				Infrastructure: demand.Infrastructure,
+				Resource:       requested.resource,
+				Limited:        limited,
+				Quota:          quota,
+				Used:           account.used[k],
+				Requested:      requested.count,
+			})
+		}
+	}
+	if len(report.Exceeded()) > 0 {
+		return report, &ExceededError{Report: report}
+	}
+	return report, nil
+}
+
+// int64Value returns the value of i, or def if i is nil.
+func int64Value(i *int64, def int64) int64 {
+	if i == nil {
+		return def
+	}
+	return *i
+}
@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quota_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/quota"
+)
+
+// quotaResource returns the quota of resource on infrastructure in region.
+func quotaResource(infrastructure string, resource string, region string, limit int64) kubernetesserviceapiv1.GetQuotaResource {
+	return kubernetesserviceapiv1.GetQuotaResource{
+		Infrastructure: core.StringPtr(infrastructure),
+		Type:           core.StringPtr(resource),
+		Regions:        []kubernetesserviceapiv1.GetQuotaResourceRegion{{ID: core.StringPtr(region), Quota: core.Int64Ptr(limit)}},
+	}
+}
+
+var _ = Describe(`Checker`, func() {
+	var (
+		server  *fake.Server
+		service *kubernetesserviceapiv1.KubernetesServiceApiV1
+		checker *quota.Checker
+		ctx     = context.Background()
+	)
+
+	// vpcCreateClusterOptions returns the options creating the VPC cluster name in eu-de with workers per zone in
+	// two zones.
+	var vpcCreateClusterOptions = func(name string, workers int64) *kubernetesserviceapiv1.VpcCreateClusterOptions {
+		options := service.NewVpcCreateClusterOptions("rg1")
+		options.Name = core.StringPtr(name)
+		options.WorkerPool = &kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+			Flavor:      core.StringPtr("bx2.4x16"),
+			WorkerCount: core.Int64Ptr(workers),
+			Zones: []kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{
+				{ID: core.StringPtr("eu-de-1"), SubnetID: core.StringPtr("subnet-1")},
+				{ID: core.StringPtr("eu-de-2"), SubnetID: core.StringPtr("subnet-2")},
+			},
+		}
+		return options
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(&fake.Options{Quotas: []kubernetesserviceapiv1.GetQuotaResource{
+			quotaResource(quota.InfrastructureVPC, quota.ResourceClusters, "eu-de", 2),
+			quotaResource(quota.InfrastructureVPC, quota.ResourceWorkers, "eu-de", 20),
+			quotaResource(quota.InfrastructureClassic, quota.ResourceWorkers, "us-south", 4),
+		}})
+		var err error
+		service, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		checker = quota.NewChecker(service)
+
+		_, _, err = service.VpcCreateCluster(vpcCreateClusterOptions("c1", 3))
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Checks demands against the quotas and the usage of their region`, func() {
+		report, err := checker.Check(ctx, quota.Demand{Region: "eu-de", Infrastructure: quota.InfrastructureVPC, Workers: 14})
+		Expect(err).To(BeNil())
+		Expect(report.Usages).To(Equal([]quota.Usage{{
+			Region:         "eu-de",
+			Infrastructure: quota.InfrastructureVPC,
+			Resource:       quota.ResourceWorkers,
+			Limited:        true,
+			Quota:          20,
+			Used:           6,
+			Requested:      14,
+		}}))
+		Expect(report.Usages[0].Available()).To(Equal(int64(14)))
+		Expect(report.Exceeded()).To(BeEmpty())
+
+		report, err = checker.Check(ctx,
+			quota.Demand{Region: "eu-de", Infrastructure: quota.InfrastructureVPC, Workers: 10},
+			quota.Demand{Region: "eu-de", Infrastructure: quota.InfrastructureVPC, Clusters: 1, Workers: 5},
+			quota.Demand{Region: "jp-tok", Infrastructure: quota.InfrastructureVPC, Workers: 30},
+		)
+		var exceeded *quota.ExceededError
+		Expect(errors.As(err, &exceeded)).To(BeTrue())
+		Expect(exceeded.Report).To(Equal(report))
+		Expect(report.Usages).To(HaveLen(3))
+		Expect(report.Usages[0].Requested).To(Equal(int64(15)))
+		Expect(report.Usages[1].Resource).To(Equal(quota.ResourceClusters))
+		Expect(report.Usages[2].Limited).To(BeFalse())
+		Expect(report.Usages[2].Available()).To(Equal(int64(-1)))
+		Expect(report.Exceeded()).To(Equal(report.Usages[:1]))
+		Expect(err.Error()).To(Equal("quota: the demand would exceed the quota of 20 vpc workers in eu-de (6 used, 15 requested)"))
+		Expect(report.String()).To(Equal("Quota usage of the demand:\n" +
+			"! eu-de vpc workers: 6 used + 15 requested of 20\n" +
+			"  eu-de vpc clusters: 1 used + 1 requested of 2\n" +
+			"  jp-tok vpc workers: 0 used + 30 requested unlimited\n"))
+	})
+
+	It(`Checks the clusters and workers created by operations before they are sent`, func() {
+		report, err := checker.CheckVpcCreateCluster(ctx, vpcCreateClusterOptions("c2", 7))
+		Expect(err).To(BeNil())
+		Expect(report.Operation).To(Equal("VpcCreateCluster"))
+		Expect(report.Usages).To(HaveLen(2))
+		Expect(report.Usages[1].Requested).To(Equal(int64(14)))
+		_, _, err = service.VpcCreateCluster(vpcCreateClusterOptions("c2", 7))
+		Expect(err).To(BeNil())
+
+		_, err = checker.CheckVpcCreateCluster(ctx, vpcCreateClusterOptions("c3", 1))
+		Expect(err).To(MatchError("quota: VpcCreateCluster would exceed the quota of 2 vpc clusters in eu-de (2 used, 1 requested) and " +
+			"the quota of 20 vpc workers in eu-de (20 used, 2 requested)"))
+
+		vpcCreateWorkerPoolOptions := service.NewVpcCreateWorkerPoolOptions()
+		vpcCreateWorkerPoolOptions.SetCluster("c1")
+		vpcCreateWorkerPoolOptions.SetName("pool2")
+		vpcCreateWorkerPoolOptions.SetWorkerCount(1)
+		vpcCreateWorkerPoolOptions.SetZones([]kubernetesserviceapiv1.Zone{{ID: core.StringPtr("eu-de-3")}})
+		report, err = checker.CheckVpcCreateWorkerPool(ctx, vpcCreateWorkerPoolOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(report.Exceeded()).To(HaveLen(1))
+
+		patchWorkerPoolOptions := service.NewPatchWorkerPoolOptions("c1", "default")
+		patchWorkerPoolOptions.SetState("resizing")
+		patchWorkerPoolOptions.SetSizePerZone(1)
+		report, err = checker.CheckPatchWorkerPool(ctx, patchWorkerPoolOptions)
+		Expect(err).To(BeNil())
+		Expect(report.Usages).To(BeEmpty())
+		patchWorkerPoolOptions.SetSizePerZone(4)
+		report, err = checker.CheckPatchWorkerPool(ctx, patchWorkerPoolOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(report.Usages[0].Requested).To(Equal(int64(2)))
+
+		_, err = checker.CheckAddClusterWorkers(ctx, service.NewAddClusterWorkersOptions("rg1", "c9"))
+		Expect(err).To(MatchError("quota: cluster c9 not found"))
+	})
+
+	It(`Checks classic clusters in the region of their data center`, func() {
+		createClusterOptions := service.NewCreateClusterOptions("rg1")
+		createClusterOptions.SetName("classic1")
+		createClusterOptions.SetDataCenter("dal10")
+		createClusterOptions.SetWorkerNum(3)
+		report, err := checker.CheckCreateCluster(ctx, createClusterOptions)
+		Expect(err).To(BeNil())
+		Expect(report.Usages[0].Region).To(Equal("us-south"))
+		Expect(report.Usages[0].Limited).To(BeFalse())
+		_, _, err = service.CreateCluster(createClusterOptions)
+		Expect(err).To(BeNil())
+
+		addClusterWorkersOptions := service.NewAddClusterWorkersOptions("rg1", "classic1")
+		addClusterWorkersOptions.SetWorkerNum(2)
+		report, err = checker.CheckAddClusterWorkers(ctx, addClusterWorkersOptions)
+		Expect(err).To(MatchError("quota: AddClusterWorkers would exceed the quota of 4 classic workers in us-south (3 used, 2 requested)"))
+		Expect(report.Usages[0].Infrastructure).To(Equal(quota.InfrastructureClassic))
+
+		createWorkerPoolOptions := service.NewCreateWorkerPoolOptions("classic1")
+		createWorkerPoolOptions.SetName("pool2")
+		createWorkerPoolOptions.SetSizePerZone(1)
+		createWorkerPoolOptions.SetZones([]kubernetesserviceapiv1.WorkerPoolZone{{ID: core.StringPtr("dal12")}})
+		_, err = checker.CheckCreateWorkerPool(ctx, createWorkerPoolOptions)
+		Expect(err).To(BeNil())
+
+		createClusterOptions.SetDataCenter("unknown01")
+		_, err = checker.CheckCreateCluster(ctx, createClusterOptions)
+		Expect(err).To(MatchError("quota: region of zone 'unknown01' not found"))
+		service.SetRegion("us-south")
+		_, err = checker.CheckCreateCluster(ctx, createClusterOptions)
+		Expect(errors.As(err, new(*quota.ExceededError))).To(BeTrue())
+	})
+})
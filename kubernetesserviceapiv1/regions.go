@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultPrivateServiceURL is the default URL to make service requests to over the IBM Cloud private network.
+const DefaultPrivateServiceURL = "https://private.containers.cloud.ibm.com/global"
+
+// Kubernetes Service regions.
+const (
+	RegionAuSyd   = "au-syd"
+	RegionBrSao   = "br-sao"
+	RegionCaTor   = "ca-tor"
+	RegionEuDe    = "eu-de"
+	RegionEuEs    = "eu-es"
+	RegionEuGb    = "eu-gb"
+	RegionJpOsa   = "jp-osa"
+	RegionJpTok   = "jp-tok"
+	RegionUsEast  = "us-east"
+	RegionUsSouth = "us-south"
+)
+
+// regionalEndpoint holds the public and private service URLs of a region.
+type regionalEndpoint struct {
+	publicURL  string
+	privateURL string
+}
+
+// regionalEndpoints is the catalog of regions served by the Kubernetes Service API.
+var regionalEndpoints = map[string]regionalEndpoint{
+	RegionAuSyd:   newRegionalEndpoint(RegionAuSyd),
+	RegionBrSao:   newRegionalEndpoint(RegionBrSao),
+	RegionCaTor:   newRegionalEndpoint(RegionCaTor),
+	RegionEuDe:    newRegionalEndpoint(RegionEuDe),
+	RegionEuEs:    newRegionalEndpoint(RegionEuEs),
+	RegionEuGb:    newRegionalEndpoint(RegionEuGb),
+	RegionJpOsa:   newRegionalEndpoint(RegionJpOsa),
+	RegionJpTok:   newRegionalEndpoint(RegionJpTok),
+	RegionUsEast:  newRegionalEndpoint(RegionUsEast),
+	RegionUsSouth: newRegionalEndpoint(RegionUsSouth),
+}
+
+// classicZoneRegions is the region of the classic infrastructure data centers.
+var classicZoneRegions = map[string]string{
+	"syd01": RegionAuSyd, "syd04": RegionAuSyd, "syd05": RegionAuSyd,
+	"sao01": RegionBrSao, "sao04": RegionBrSao, "sao05": RegionBrSao,
+	"tor01": RegionCaTor, "tor04": RegionCaTor, "tor05": RegionCaTor,
+	"ams03": RegionEuDe, "fra02": RegionEuDe, "fra04": RegionEuDe, "fra05": RegionEuDe, "mil01": RegionEuDe,
+	"osl01": RegionEuDe, "par01": RegionEuDe,
+	"mad02": RegionEuEs, "mad04": RegionEuEs, "mad05": RegionEuEs,
+	"lon02": RegionEuGb, "lon04": RegionEuGb, "lon05": RegionEuGb, "lon06": RegionEuGb,
+	"osa21": RegionJpOsa, "osa22": RegionJpOsa, "osa23": RegionJpOsa,
+	"che01": RegionJpTok, "hkg02": RegionJpTok, "seo01": RegionJpTok, "sng01": RegionJpTok,
+	"tok02": RegionJpTok, "tok04": RegionJpTok, "tok05": RegionJpTok,
+	"mon01": RegionUsEast, "wdc04": RegionUsEast, "wdc06": RegionUsEast, "wdc07": RegionUsEast,
+	"dal10": RegionUsSouth, "dal12": RegionUsSouth, "dal13": RegionUsSouth, "mex01": RegionUsSouth,
+	"sjc03": RegionUsSouth, "sjc04": RegionUsSouth,
+}
+
+func newRegionalEndpoint(region string) regionalEndpoint {
+	return regionalEndpoint{
+		publicURL:  fmt.Sprintf("https://%s.containers.cloud.ibm.com/global", region),
+		privateURL: fmt.Sprintf("https://private.%s.containers.cloud.ibm.com/global", region),
+	}
+}
+
+// GetServiceURLForRegion returns the service URL to be used for the specified region
+func GetServiceURLForRegion(region string) (string, error) {
+	endpoint, ok := regionalEndpoints[region]
+	if !ok {
+		return "", fmt.Errorf("service URL for region '%s' not found", region)
+	}
+	return endpoint.publicURL, nil
+}
+
+// GetPrivateServiceURLForRegion returns the private network service URL to be used for the specified region
+func GetPrivateServiceURLForRegion(region string) (string, error) {
+	endpoint, ok := regionalEndpoints[region]
+	if !ok {
+		return "", fmt.Errorf("private service URL for region '%s' not found", region)
+	}
+	return endpoint.privateURL, nil
+}
+
+// GetRegions returns the names of the regions with a known service URL, in alphabetical order
+func GetRegions() []string {
+	regions := make([]string, 0, len(regionalEndpoints))
+	for region := range regionalEndpoints {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// GetRegionForZone returns the region of a VPC zone, such as "eu-de-1", or of a classic data center, such as "fra02"
+func GetRegionForZone(zone string) (string, error) {
+	if region, ok := classicZoneRegions[zone]; ok {
+		return region, nil
+	}
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		if _, ok := regionalEndpoints[zone[:i]]; ok {
+			return zone[:i], nil
+		}
+	}
+	return "", fmt.Errorf("region of zone '%s' not found", zone)
+}
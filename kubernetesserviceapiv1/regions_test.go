@@ -0,0 +1,121 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+var _ = Describe(`Regions`, func() {
+	Describe(`Regional service URLs`, func() {
+		It(`GetServiceURLForRegion(region string)`, func() {
+			url, err := kubernetesserviceapiv1.GetServiceURLForRegion(kubernetesserviceapiv1.RegionUsSouth)
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://us-south.containers.cloud.ibm.com/global"))
+		})
+		It(`GetPrivateServiceURLForRegion(region string)`, func() {
+			url, err := kubernetesserviceapiv1.GetPrivateServiceURLForRegion(kubernetesserviceapiv1.RegionEuDe)
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://private.eu-de.containers.cloud.ibm.com/global"))
+
+			url, err = kubernetesserviceapiv1.GetPrivateServiceURLForRegion("INVALID_REGION")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+		})
+		It(`GetRegionForZone(zone string)`, func() {
+			region, err := kubernetesserviceapiv1.GetRegionForZone("eu-de-2")
+			Expect(err).To(BeNil())
+			Expect(region).To(Equal(kubernetesserviceapiv1.RegionEuDe))
+
+			region, err = kubernetesserviceapiv1.GetRegionForZone("dal10")
+			Expect(err).To(BeNil())
+			Expect(region).To(Equal(kubernetesserviceapiv1.RegionUsSouth))
+
+			region, err = kubernetesserviceapiv1.GetRegionForZone("xx-north-1")
+			Expect(region).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+		})
+		It(`GetRegions()`, func() {
+			regions := kubernetesserviceapiv1.GetRegions()
+			Expect(regions).To(ContainElement(kubernetesserviceapiv1.RegionJpTok))
+			Expect(regions[0]).To(Equal(kubernetesserviceapiv1.RegionAuSyd))
+			for _, region := range regions {
+				_, err := kubernetesserviceapiv1.GetServiceURLForRegion(region)
+				Expect(err).To(BeNil())
+			}
+		})
+	})
+	Describe(`Default region`, func() {
+		var testServer *httptest.Server
+		var regionHeader string
+		BeforeEach(func() {
+			regionHeader = ""
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				regionHeader = req.Header.Get("X-Region")
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"apiUser": "ApiUser"}`)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Sends the client region when XRegion is not set`, func() {
+			kubernetesServiceApiService, serviceErr := kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        kubernetesserviceapiv1.RegionEuGb,
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(kubernetesServiceApiService.GetRegion()).To(Equal(kubernetesserviceapiv1.RegionEuGb))
+
+			getUserCredentialsOptionsModel := new(kubernetesserviceapiv1.GetUserCredentialsOptions)
+			_, _, operationErr := kubernetesServiceApiService.GetUserCredentials(getUserCredentialsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(regionHeader).To(Equal(kubernetesserviceapiv1.RegionEuGb))
+			Expect(getUserCredentialsOptionsModel.XRegion).To(BeNil())
+
+			getUserCredentialsOptionsModel.SetXRegion(kubernetesserviceapiv1.RegionJpOsa)
+			_, _, operationErr = kubernetesServiceApiService.GetUserCredentials(getUserCredentialsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(regionHeader).To(Equal(kubernetesserviceapiv1.RegionJpOsa))
+		})
+		It(`Requires XRegion when no client region is set`, func() {
+			kubernetesServiceApiService, serviceErr := kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			_, _, operationErr := kubernetesServiceApiService.GetUserCredentials(new(kubernetesserviceapiv1.GetUserCredentialsOptions))
+			Expect(operationErr).ToNot(BeNil())
+
+			kubernetesServiceApiService.SetRegion(kubernetesserviceapiv1.RegionCaTor)
+			_, _, operationErr = kubernetesServiceApiService.GetUserCredentials(new(kubernetesserviceapiv1.GetUserCredentialsOptions))
+			Expect(operationErr).To(BeNil())
+			Expect(regionHeader).To(Equal(kubernetesserviceapiv1.RegionCaTor))
+		})
+	})
+})
@@ -0,0 +1,585 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package upgrade upgrades the Kubernetes version of a cluster: its master first, then its workers, worker pool by
+// worker pool, in batches.
+//
+// An Upgrade selects its target version from GetVersions, updates the master with UpdateCluster and waits for it to
+// run the target version. It then updates the workers that run an older version, at most Options.MaxUnavailable at
+// a time in each worker pool: the workers of classic clusters are updated in place with UpdateClusterWorker, and
+// those of VPC clusters are replaced with VpcReplaceWorker, by a workerops.Runner calling the optional hooks around
+// each of them. Every batch waits for the workers of its pool to be ready and passes the optional health gate before
+// the next one starts.
+//
+// An upgrade can be paused, resumed and aborted from another goroutine, and reports its progress to a callback:
+//
+//	u := upgrade.NewUpgrade(kubernetesServiceApi, "my-cluster", &upgrade.Options{
+//		MaxUnavailable: 2,
+//		OnProgress:     func(event upgrade.Event) { log.Printf("%+v", event) },
+//	})
+//	err := u.Run(ctx)
+//
+// Running an upgrade again after it failed or was aborted resumes it: when the workers run an older minor version
+// than the master and Options.Version is not set, the target is the version of the master.
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/workerops"
+)
+
+// ErrAborted is returned by Run when the upgrade is aborted.
+var ErrAborted = errors.New("upgrade: aborted")
+
+// EventType : The step of an upgrade an Event reports.
+type EventType string
+
+// Types of the events of an upgrade.
+const (
+	EventTargetSelected      EventType = "TargetSelected"
+	EventMasterUpdateStarted EventType = "MasterUpdateStarted"
+	EventMasterUpdated       EventType = "MasterUpdated"
+	EventBatchStarted        EventType = "BatchStarted"
+	EventBatchCompleted      EventType = "BatchCompleted"
+	EventPoolUpdated         EventType = "PoolUpdated"
+	EventPaused              EventType = "Paused"
+	EventResumed             EventType = "Resumed"
+	EventCompleted           EventType = "Completed"
+)
+
+// Event : The progress of an upgrade.
+type Event struct {
+	Type EventType
+
+	// The cluster being upgraded, as passed to NewUpgrade.
+	Cluster string
+
+	// The target version, once it is selected.
+	Version string
+
+	// The version of the master before the update, for EventTargetSelected and the master events.
+	From string
+
+	// The worker pool of the batch events and of EventPoolUpdated.
+	Pool string
+
+	// The IDs of the workers updated by the batch. Replaced workers get new IDs.
+	Workers []string
+
+	// The number of workers of the pool that run an older version than the target, before the batch for
+	// EventBatchStarted and after it for EventBatchCompleted.
+	Remaining int
+}
+
+// Options : The options of NewUpgrade.
+type Options struct {
+	// The target version, such as "1.30" or "1.30.2". Defaults to the minor version following the version of the
+	// master, or to the version of the master if it is the newest one. The newest patch version is selected.
+	Version string
+
+	// The maximum number of workers of a worker pool updated at once. Defaults to 1. The workers of a VPC batch are
+	// drained at once, but those of the same zone are replaced one after the other, since the replacement of a worker
+	// can only be told apart from the others by its zone.
+	MaxUnavailable int
+
+	// The worker pools to update, in order. Defaults to every worker pool, in the order listed by GetWorkerPools.
+	Pools []string
+
+	// Called before every worker is updated, to drain it, and once it, or the worker replacing it, is ready. None if
+	// nil.
+	Hooks workerops.Hooks
+
+	// The limits of the hooks and of the update of every worker.
+	Timeouts workerops.TimeoutPolicy
+
+	// Called after every batch, once the workers of the pool are ready. A non-nil error stops the upgrade and is
+	// returned by Run.
+	HealthGate func(ctx context.Context, cluster string, pool string) error
+
+	// Called with the progress of the upgrade.
+	OnProgress func(event Event)
+
+	// How to poll the master and the workers. The timeout applies to every wait.
+	Wait common.WaitOptions
+
+	// The ID of the resource group that the cluster is in.
+	XAuthResourceGroup *string
+}
+
+// Upgrade : The upgrade of a cluster.
+type Upgrade struct {
+	service *kubernetesserviceapiv1.KubernetesServiceApiV1
+	cluster string
+	options Options
+	runner  *workerops.Runner
+	version string
+
+	mutex   sync.Mutex
+	paused  bool
+	resumed chan struct{}
+	aborted bool
+	cancel  context.CancelFunc
+}
+
+// NewUpgrade returns the upgrade of cluster, an ID or a name, with the client service, configured by options, which
+// may be nil.
+func NewUpgrade(service *kubernetesserviceapiv1.KubernetesServiceApiV1, cluster string, options *Options) *Upgrade {
+	upgrade := &Upgrade{service: service, cluster: cluster}
+	if options != nil {
+		upgrade.options = *options
+	}
+	if upgrade.options.MaxUnavailable < 1 {
+		upgrade.options.MaxUnavailable = 1
+	}
+	upgrade.runner = workerops.NewRunner(service, &workerops.Options{
+		Hooks:              upgrade.options.Hooks,
+		Timeouts:           upgrade.options.Timeouts,
+		Wait:               upgrade.options.Wait,
+		XAuthResourceGroup: upgrade.options.XAuthResourceGroup,
+	})
+	return upgrade
+}
+
+// Run runs the upgrade until the master and the workers of the selected pools run the target version. It returns
+// ErrAborted if the upgrade is aborted, and the error of ctx if it is done first.
+func (upgrade *Upgrade) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	upgrade.mutex.Lock()
+	upgrade.cancel = cancel
+	aborted := upgrade.aborted
+	upgrade.mutex.Unlock()
+	if aborted {
+		return ErrAborted
+	}
+
+	err := upgrade.run(ctx)
+	if err != nil && upgrade.isAborted() {
+		return ErrAborted
+	}
+	return err
+}
+
+// Pause pauses the upgrade before its next step. The batch in progress, or the update of the master, completes.
+func (upgrade *Upgrade) Pause() {
+	upgrade.mutex.Lock()
+	defer upgrade.mutex.Unlock()
+	if !upgrade.paused {
+		upgrade.paused = true
+		upgrade.resumed = make(chan struct{})
+	}
+}
+
+// Resume resumes a paused upgrade.
+func (upgrade *Upgrade) Resume() {
+	upgrade.mutex.Lock()
+	defer upgrade.mutex.Unlock()
+	if upgrade.paused {
+		upgrade.paused = false
+		close(upgrade.resumed)
+	}
+}
+
+// Abort stops the upgrade: Run stops waiting and returns ErrAborted. The updates already requested, of the master
+// or of the workers of the batch in progress, are not undone.
+func (upgrade *Upgrade) Abort() {
+	upgrade.mutex.Lock()
+	defer upgrade.mutex.Unlock()
+	upgrade.aborted = true
+	if upgrade.cancel != nil {
+		upgrade.cancel()
+	}
+	if upgrade.paused {
+		upgrade.paused = false
+		close(upgrade.resumed)
+	}
+}
+
+// isAborted reports whether the upgrade is aborted.
+func (upgrade *Upgrade) isAborted() bool {
+	upgrade.mutex.Lock()
+	defer upgrade.mutex.Unlock()
+	return upgrade.aborted
+}
+
+// checkpoint waits while the upgrade is paused, and returns ErrAborted if it is aborted.
+func (upgrade *Upgrade) checkpoint(ctx context.Context) error {
+	upgrade.mutex.Lock()
+	paused, resumed, aborted := upgrade.paused, upgrade.resumed, upgrade.aborted
+	upgrade.mutex.Unlock()
+	if aborted {
+		return ErrAborted
+	}
+	if !paused {
+		return ctx.Err()
+	}
+
+	upgrade.emit(Event{Type: EventPaused})
+	select {
+	case <-resumed:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if upgrade.isAborted() {
+		return ErrAborted
+	}
+	upgrade.emit(Event{Type: EventResumed})
+	return upgrade.checkpoint(ctx)
+}
+
+// emit reports event to the OnProgress callback.
+func (upgrade *Upgrade) emit(event Event) {
+	if upgrade.options.OnProgress == nil {
+		return
+	}
+	event.Cluster = upgrade.cluster
+	event.Version = upgrade.version
+	upgrade.options.OnProgress(event)
+}
+
+// run runs the steps of the upgrade.
+func (upgrade *Upgrade) run(ctx context.Context) error {
+	getClusterOptions := upgrade.service.NewGetClusterOptions(upgrade.cluster)
+	getClusterOptions.XAuthResourceGroup = upgrade.options.XAuthResourceGroup
+	cluster, _, err := upgrade.service.GetClusterWithContext(ctx, getClusterOptions)
+	if err != nil {
+		return fmt.Errorf("upgrade: reading cluster %s: %w", upgrade.cluster, err)
+	}
+	vpc := strings.HasPrefix(core.StringNilMapper(cluster.Provider), "vpc")
+	pools, err := upgrade.pools(ctx)
+	if err != nil {
+		return err
+	}
+
+	master := core.StringNilMapper(cluster.MasterKubeVersion)
+	if err := upgrade.selectTarget(ctx, cluster, pools, vpc); err != nil {
+		return err
+	}
+	upgrade.emit(Event{Type: EventTargetSelected, From: master})
+
+	if err := upgrade.checkpoint(ctx); err != nil {
+		return err
+	}
+	if err := upgrade.updateMaster(ctx, cluster); err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		if err := upgrade.updatePool(ctx, pool, vpc); err != nil {
+			return err
+		}
+	}
+	upgrade.emit(Event{Type: EventCompleted})
+	return nil
+}
+
+// pools returns the worker pools to update.
+func (upgrade *Upgrade) pools(ctx context.Context) ([]string, error) {
+	getWorkerPoolsOptions := upgrade.service.NewGetWorkerPoolsOptions(upgrade.cluster)
+	getWorkerPoolsOptions.XAuthResourceGroup = upgrade.options.XAuthResourceGroup
+	workerPools, _, err := upgrade.service.GetWorkerPoolsWithContext(ctx, getWorkerPoolsOptions)
+	if err != nil {
+		return nil, fmt.Errorf("upgrade: reading the worker pools of cluster %s: %w", upgrade.cluster, err)
+	}
+	var names []string
+	for _, pool := range workerPools {
+		names = append(names, core.StringNilMapper(pool.Name))
+	}
+	if len(upgrade.options.Pools) == 0 {
+		return names, nil
+	}
+
+	for _, pool := range upgrade.options.Pools {
+		found := false
+		for _, name := range names {
+			found = found || name == pool
+		}
+		if !found {
+			return nil, fmt.Errorf("upgrade: cluster %s has no worker pool %s", upgrade.cluster, pool)
+		}
+	}
+	return upgrade.options.Pools, nil
+}
+
+// selectTarget selects the target version of the upgrade of cluster. If Options.Version is not set and the workers
+// of pools run an older minor version than the master, an earlier upgrade is resumed.
+func (upgrade *Upgrade) selectTarget(ctx context.Context, cluster *kubernetesserviceapiv1.GetClusterResponse, pools []string, vpc bool) error {
+	master := core.StringNilMapper(cluster.MasterKubeVersion)
+	if upgrade.options.Version == "" {
+		masterMinor := majorMinor(master)
+		for _, pool := range pools {
+			workers, err := upgrade.listWorkers(ctx, pool, vpc)
+			if err != nil {
+				return err
+			}
+			for _, worker := range workers {
+				if common.CompareVersions(workerVersion(&worker), masterMinor) < 0 {
+					upgrade.version = numeric(master)
+					return nil
+				}
+			}
+		}
+	}
+
+	versions, _, err := upgrade.service.GetVersionsWithContext(ctx, upgrade.service.NewGetVersionsOptions())
+	if err != nil {
+		return fmt.Errorf("upgrade: reading the supported versions: %w", err)
+	}
+	platform := core.StringNilMapper(cluster.Type)
+	if platform == "" {
+		platform = "kubernetes"
+	}
+	upgrade.version, err = SelectVersion(versions[platform], master, upgrade.options.Version)
+	if err != nil {
+		return fmt.Errorf("upgrade: selecting the version of cluster %s: %w", upgrade.cluster, err)
+	}
+	return nil
+}
+
+// updateMaster updates the master of cluster to the target version, unless it already runs it, and waits for the
+// master to be ready.
+func (upgrade *Upgrade) updateMaster(ctx context.Context, cluster *kubernetesserviceapiv1.GetClusterResponse) error {
+	master := core.StringNilMapper(cluster.MasterKubeVersion)
+	if common.CompareVersions(master, upgrade.version) >= 0 {
+		return nil
+	}
+
+	upgrade.emit(Event{Type: EventMasterUpdateStarted, From: master})
+	if target := core.StringNilMapper(cluster.TargetVersion); target == "" || common.CompareVersions(target, upgrade.version) != 0 {
+		version := upgrade.version
+		if strings.HasSuffix(master, "_openshift") {
+			version += "_openshift"
+		}
+		updateClusterOptions := upgrade.service.NewUpdateClusterOptions(upgrade.cluster)
+		updateClusterOptions.SetAction(kubernetesserviceapiv1.UpdateClusterOptions_Action_Update)
+		updateClusterOptions.SetVersion(version)
+		updateClusterOptions.XAuthResourceGroup = upgrade.options.XAuthResourceGroup
+		if _, err := upgrade.service.UpdateClusterWithContext(ctx, updateClusterOptions); err != nil {
+			return fmt.Errorf("upgrade: updating the master of cluster %s to %s: %w", upgrade.cluster, version, err)
+		}
+	}
+
+	predicate := kubernetesserviceapiv1.AllClusterPredicates(kubernetesserviceapiv1.ClusterMasterReady, func(cluster *kubernetesserviceapiv1.GetClusterResponse) (bool, error) {
+		return common.CompareVersions(core.StringNilMapper(cluster.MasterKubeVersion), upgrade.version) >= 0, nil
+	})
+	_, err := upgrade.service.WaitForClusterState(ctx, upgrade.cluster, predicate, &kubernetesserviceapiv1.ClusterWaitOptions{
+		WaitOptions:        upgrade.options.Wait,
+		XAuthResourceGroup: upgrade.options.XAuthResourceGroup,
+	})
+	if err != nil {
+		return fmt.Errorf("upgrade: waiting for the master of cluster %s to run %s: %w", upgrade.cluster, upgrade.version, err)
+	}
+	upgrade.emit(Event{Type: EventMasterUpdated, From: master})
+	return nil
+}
+
+// updatePool updates the workers of pool that run an older version than the target, in batches.
+func (upgrade *Upgrade) updatePool(ctx context.Context, pool string, vpc bool) error {
+	workers, err := upgrade.listWorkers(ctx, pool, vpc)
+	if err != nil {
+		return err
+	}
+	if err := upgrade.waitForPool(ctx, pool, vpc, len(workers)); err != nil {
+		return err
+	}
+
+	for {
+		if err := upgrade.checkpoint(ctx); err != nil {
+			return err
+		}
+		workers, err := upgrade.listWorkers(ctx, pool, vpc)
+		if err != nil {
+			return err
+		}
+		var outdated []string
+		for _, worker := range workers {
+			if common.CompareVersions(workerVersion(&worker), upgrade.version) < 0 {
+				outdated = append(outdated, core.StringNilMapper(worker.ID))
+			}
+		}
+		if len(outdated) == 0 {
+			upgrade.emit(Event{Type: EventPoolUpdated, Pool: pool})
+			return nil
+		}
+
+		batch := outdated
+		if len(batch) > upgrade.options.MaxUnavailable {
+			batch = batch[:upgrade.options.MaxUnavailable]
+		}
+		upgrade.emit(Event{Type: EventBatchStarted, Pool: pool, Workers: batch, Remaining: len(outdated)})
+		if err := upgrade.updateWorkers(ctx, batch, vpc); err != nil {
+			return err
+		}
+		if err := upgrade.waitForPool(ctx, pool, vpc, len(workers)); err != nil {
+			return err
+		}
+		if upgrade.options.HealthGate != nil {
+			if err := upgrade.options.HealthGate(ctx, upgrade.cluster, pool); err != nil {
+				return fmt.Errorf("upgrade: health gate of worker pool %s of cluster %s: %w", pool, upgrade.cluster, err)
+			}
+		}
+		upgrade.emit(Event{Type: EventBatchCompleted, Pool: pool, Workers: batch, Remaining: len(outdated) - len(batch)})
+	}
+}
+
+// updateWorkers updates the workers of a batch at once, replacing them with VpcReplaceWorker if vpc, or updating
+// them in place with UpdateClusterWorker otherwise, and waits for them to be ready. The runner serializes the
+// replacements of the workers of the same zone.
+func (upgrade *Upgrade) updateWorkers(ctx context.Context, batch []string, vpc bool) error {
+	operation := workerops.OperationUpdate
+	if vpc {
+		operation = workerops.OperationReplaceAndUpdate
+	}
+	errs := make([]error, len(batch))
+	var wg sync.WaitGroup
+	for i, worker := range batch {
+		wg.Add(1)
+		go func(i int, worker string) {
+			defer wg.Done()
+			_, errs[i] = upgrade.runner.Run(ctx, upgrade.cluster, worker, operation)
+		}(i, worker)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("upgrade: updating worker %s of cluster %s: %w", batch[i], upgrade.cluster, err)
+		}
+	}
+	return nil
+}
+
+// waitForPool waits until at least minWorkers workers of pool are listed and all of them are ready.
+func (upgrade *Upgrade) waitForPool(ctx context.Context, pool string, vpc bool, minWorkers int) error {
+	if minWorkers == 0 {
+		// The pool had no workers to start with, so an empty list is ready.
+		minWorkers = -1
+	}
+	_, err := upgrade.service.WaitForWorkers(ctx, upgrade.cluster, kubernetesserviceapiv1.WorkerReady, &kubernetesserviceapiv1.WorkersWaitOptions{
+		WaitOptions:        upgrade.options.Wait,
+		VPC:                vpc,
+		XAuthResourceGroup: upgrade.options.XAuthResourceGroup,
+		Pool:               pool,
+		MinWorkers:         minWorkers,
+	})
+	if err != nil {
+		return fmt.Errorf("upgrade: waiting for the workers of worker pool %s of cluster %s: %w", pool, upgrade.cluster, err)
+	}
+	return nil
+}
+
+// listWorkers lists the workers of pool with VpcGetWorkers or GetWorkers1.
+func (upgrade *Upgrade) listWorkers(ctx context.Context, pool string, vpc bool) (workers []kubernetesserviceapiv1.GetWorkerResponse, err error) {
+	if vpc {
+		vpcGetWorkersOptions := upgrade.service.NewVpcGetWorkersOptions(upgrade.cluster)
+		vpcGetWorkersOptions.SetPool(pool)
+		vpcGetWorkersOptions.XAuthResourceGroup = upgrade.options.XAuthResourceGroup
+		workers, _, err = upgrade.service.VpcGetWorkersWithContext(ctx, vpcGetWorkersOptions)
+	} else {
+		getWorkersOptions := upgrade.service.NewGetWorkers1Options(upgrade.cluster)
+		getWorkersOptions.XAuthResourceGroup = upgrade.options.XAuthResourceGroup
+		var all []kubernetesserviceapiv1.GetWorkerResponse
+		all, _, err = upgrade.service.GetWorkers1WithContext(ctx, getWorkersOptions)
+		for _, worker := range all {
+			if core.StringNilMapper(worker.PoolName) == pool || core.StringNilMapper(worker.PoolID) == pool {
+				workers = append(workers, worker)
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("upgrade: reading the workers of worker pool %s of cluster %s: %w", pool, upgrade.cluster, err)
+	}
+	return workers, nil
+}
+
+// SelectVersion returns the version, such as "1.30.2", to upgrade a master running current to: the newest patch
+// version of wanted, such as "1.30", or, if wanted is empty, of the minor version following current, or of current
+// if it is the newest minor version. It returns an error if wanted is not listed in versions or older than current.
+func SelectVersion(versions []kubernetesserviceapiv1.KubeVersion, current string, wanted string) (string, error) {
+	var candidates []string
+	for _, version := range versions {
+		candidate := fmt.Sprintf("%d.%d.%d", int64Value(version.Major), int64Value(version.Minor), int64Value(version.Patch))
+		if common.CompareVersions(candidate, majorMinor(current)) >= 0 {
+			candidates = append(candidates, candidate)
+		}
+	}
+	sort.Slice(candidates, func(i int, j int) bool {
+		return common.CompareVersions(candidates[i], candidates[j]) < 0
+	})
+
+	if wanted == "" {
+		if len(candidates) == 0 {
+			return "", fmt.Errorf("no version from %s is listed", current)
+		}
+		wanted = majorMinor(candidates[0])
+		for _, candidate := range candidates {
+			if common.CompareVersions(candidate, majorMinor(current)) > 0 {
+				wanted = majorMinor(candidate)
+				break
+			}
+		}
+	}
+	selected := ""
+	for _, candidate := range candidates {
+		if common.CompareVersions(candidate, wanted) == 0 {
+			selected = candidate
+		}
+	}
+	if selected == "" {
+		return "", fmt.Errorf("version %s is not listed, or is older than %s", wanted, current)
+	}
+	return selected, nil
+}
+
+// int64Value returns the value of i, or 0 if i is nil.
+func int64Value(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// workerVersion returns the version the worker runs.
+func workerVersion(worker *kubernetesserviceapiv1.GetWorkerResponse) string {
+	if worker.KubeVersion == nil {
+		return ""
+	}
+	return core.StringNilMapper(worker.KubeVersion.Actual)
+}
+
+// majorMinor returns the major and minor components of version, such as "1.29" for "1.29.3_1547".
+func majorMinor(version string) string {
+	components := append(common.VersionComponents(version), 0, 0)
+	return fmt.Sprintf("%d.%d", components[0], components[1])
+}
+
+// numeric returns the numeric components of version, such as "1.29.3" for "1.29.3_1547".
+func numeric(version string) string {
+	components := common.VersionComponents(version)
+	parts := make([]string, len(components))
+	for i, component := range components {
+		parts[i] = fmt.Sprint(component)
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,355 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgrade_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/upgrade"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/workerops"
+)
+
+var _ = Describe(`Upgrade`, func() {
+	var (
+		server  *fake.Server
+		service *kubernetesserviceapiv1.KubernetesServiceApiV1
+		ctx     = context.Background()
+		wait    = common.WaitOptions{Interval: 5 * time.Millisecond}
+
+		mutex  sync.Mutex
+		events []upgrade.Event
+	)
+
+	// record records the events of an upgrade.
+	var record = func(event upgrade.Event) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		events = append(events, event)
+	}
+
+	// eventTypes returns the types of the events recorded.
+	var eventTypes = func() []upgrade.EventType {
+		mutex.Lock()
+		defer mutex.Unlock()
+		types := []upgrade.EventType{}
+		for _, event := range events {
+			types = append(types, event.Type)
+		}
+		return types
+	}
+
+	// createVPCCluster creates a VPC cluster with a worker pool of 3 workers and one of 1, and waits for its master.
+	var createVPCCluster = func() {
+		options := service.NewVpcCreateClusterOptions("rg1")
+		options.Name = core.StringPtr("c1")
+		options.WorkerPool = &kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+			Name:        core.StringPtr("default"),
+			Flavor:      core.StringPtr("bx2.4x16"),
+			WorkerCount: core.Int64Ptr(1),
+			Zones: []kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{
+				{ID: core.StringPtr("us-south-1"), SubnetID: core.StringPtr("subnet-1")},
+				{ID: core.StringPtr("us-south-2"), SubnetID: core.StringPtr("subnet-2")},
+				{ID: core.StringPtr("us-south-3"), SubnetID: core.StringPtr("subnet-3")},
+			},
+		}
+		_, _, err := service.VpcCreateCluster(options)
+		Expect(err).To(BeNil())
+		_, _, err = service.VpcCreateWorkerPool(&kubernetesserviceapiv1.VpcCreateWorkerPoolOptions{
+			Cluster:     core.StringPtr("c1"),
+			Name:        core.StringPtr("edge"),
+			Flavor:      core.StringPtr("cx2.2x4"),
+			WorkerCount: core.Int64Ptr(1),
+			Zones:       []kubernetesserviceapiv1.Zone{{ID: core.StringPtr("us-south-1")}},
+		})
+		Expect(err).To(BeNil())
+		_, err = service.WaitForClusterState(ctx, "c1", kubernetesserviceapiv1.ClusterMasterReady, &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: wait})
+		Expect(err).To(BeNil())
+	}
+
+	// workerVersions returns the versions of the workers of the cluster c1, by worker pool.
+	var workerVersions = func() map[string][]string {
+		workers, _, err := service.GetWorkers1(service.NewGetWorkers1Options("c1"))
+		Expect(err).To(BeNil())
+		versions := map[string][]string{}
+		for _, worker := range workers {
+			versions[*worker.PoolName] = append(versions[*worker.PoolName], *worker.KubeVersion.Actual)
+		}
+		return versions
+	}
+
+	// requestCount returns the number of requests sent to path.
+	var requestCount = func(path string) int {
+		count := 0
+		for _, request := range server.Requests() {
+			if request.Path == path {
+				count++
+			}
+		}
+		return count
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		var err error
+		service, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		events = nil
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Updates the master, then replaces the workers of a VPC cluster in batches`, func() {
+		createVPCCluster()
+
+		var gates []string
+		// The hooks run on the goroutines of the batches, so they record the versions for the assertions after Run.
+		var hookMutex sync.Mutex
+		var drained, uncordoned []string
+		u := upgrade.NewUpgrade(service, "c1", &upgrade.Options{
+			MaxUnavailable: 2,
+			Wait:           wait,
+			OnProgress:     record,
+			Hooks: workerops.HookFuncs{
+				BeforeWorkerDisruptionFunc: func(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error {
+					hookMutex.Lock()
+					defer hookMutex.Unlock()
+					drained = append(drained, *worker.KubeVersion.Actual)
+					return nil
+				},
+				AfterWorkerReadyFunc: func(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error {
+					hookMutex.Lock()
+					defer hookMutex.Unlock()
+					uncordoned = append(uncordoned, *worker.KubeVersion.Actual)
+					return nil
+				},
+			},
+			HealthGate: func(ctx context.Context, cluster string, pool string) error {
+				gates = append(gates, pool)
+				return nil
+			},
+		})
+		Expect(u.Run(ctx)).To(Succeed())
+
+		Expect(eventTypes()).To(Equal([]upgrade.EventType{
+			upgrade.EventTargetSelected,
+			upgrade.EventMasterUpdateStarted,
+			upgrade.EventMasterUpdated,
+			upgrade.EventBatchStarted,
+			upgrade.EventBatchCompleted,
+			upgrade.EventBatchStarted,
+			upgrade.EventBatchCompleted,
+			upgrade.EventPoolUpdated,
+			upgrade.EventBatchStarted,
+			upgrade.EventBatchCompleted,
+			upgrade.EventPoolUpdated,
+			upgrade.EventCompleted,
+		}))
+		Expect(events[0]).To(Equal(upgrade.Event{Type: upgrade.EventTargetSelected, Cluster: "c1", Version: "1.30.2", From: fake.DefaultKubeVersion}))
+		Expect(events[3].Pool).To(Equal("default"))
+		Expect(events[3].Workers).To(HaveLen(2))
+		Expect(events[3].Remaining).To(Equal(3))
+		Expect(events[4].Remaining).To(Equal(1))
+		Expect(events[5].Workers).To(HaveLen(1))
+		Expect(gates).To(Equal([]string{"default", "default", "edge"}))
+		Expect(drained).To(Equal([]string{fake.DefaultKubeVersion, fake.DefaultKubeVersion, fake.DefaultKubeVersion, fake.DefaultKubeVersion}))
+		Expect(uncordoned).To(Equal([]string{"1.30.2", "1.30.2", "1.30.2", "1.30.2"}))
+
+		cluster, _, err := service.GetCluster(service.NewGetClusterOptions("c1"))
+		Expect(err).To(BeNil())
+		Expect(*cluster.MasterKubeVersion).To(Equal("1.30.2"))
+		Expect(workerVersions()).To(Equal(map[string][]string{
+			"default": {"1.30.2", "1.30.2", "1.30.2"},
+			"edge":    {"1.30.2"},
+		}))
+		Expect(requestCount("/v2/vpc/replaceWorker")).To(Equal(4))
+		for _, request := range server.Requests() {
+			if request.Path == "/v2/vpc/replaceWorker" {
+				var body map[string]interface{}
+				Expect(json.Unmarshal(request.Body, &body)).To(Succeed())
+				Expect(body["update"]).To(BeTrue())
+			}
+		}
+
+		events = nil
+		Expect(upgrade.NewUpgrade(service, "c1", &upgrade.Options{Wait: wait, OnProgress: record}).Run(ctx)).To(Succeed())
+		Expect(events[0].Version).To(Equal("1.30.2"))
+		Expect(eventTypes()).To(Equal([]upgrade.EventType{
+			upgrade.EventTargetSelected, upgrade.EventPoolUpdated, upgrade.EventPoolUpdated, upgrade.EventCompleted,
+		}))
+	})
+
+	It(`Replaces batches of workers of the same zone`, func() {
+		options := service.NewVpcCreateClusterOptions("rg1")
+		options.Name = core.StringPtr("c1")
+		options.WorkerPool = &kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+			Flavor:      core.StringPtr("bx2.4x16"),
+			WorkerCount: core.Int64Ptr(4),
+			Zones:       []kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{{ID: core.StringPtr("us-south-1"), SubnetID: core.StringPtr("subnet-1")}},
+		}
+		_, _, err := service.VpcCreateCluster(options)
+		Expect(err).To(BeNil())
+		_, err = service.WaitForClusterState(ctx, "c1", kubernetesserviceapiv1.ClusterMasterReady, &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: wait})
+		Expect(err).To(BeNil())
+
+		// Each batch replaces two workers of us-south-1 at once; the timeouts turn a lost replacement into a failure.
+		timeout, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		u := upgrade.NewUpgrade(service, "c1", &upgrade.Options{
+			MaxUnavailable: 2,
+			Wait:           common.WaitOptions{Interval: wait.Interval, Timeout: 20 * time.Second},
+			OnProgress:     record,
+		})
+		Expect(u.Run(timeout)).To(Succeed())
+		var batches [][]string
+		for _, event := range events {
+			if event.Type == upgrade.EventBatchCompleted {
+				batches = append(batches, event.Workers)
+			}
+		}
+		Expect(batches).To(HaveLen(2))
+		Expect(batches[0]).To(HaveLen(2))
+		Expect(workerVersions()).To(Equal(map[string][]string{"default": {"1.30.2", "1.30.2", "1.30.2", "1.30.2"}}))
+		Expect(requestCount("/v2/vpc/replaceWorker")).To(Equal(4))
+	})
+
+	It(`Updates the workers of a classic cluster in place and stops at a failed health gate`, func() {
+		options := service.NewCreateClusterOptions("rg1")
+		options.Name = core.StringPtr("c1")
+		options.DataCenter = core.StringPtr("dal10")
+		options.MachineType = core.StringPtr("b3c.4x16")
+		options.WorkerNum = core.Int64Ptr(2)
+		_, _, err := service.CreateCluster(options)
+		Expect(err).To(BeNil())
+		_, err = service.WaitForClusterState(ctx, "c1", kubernetesserviceapiv1.ClusterMasterReady, &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: wait})
+		Expect(err).To(BeNil())
+
+		gateErr := errors.New("pods are not ready")
+		u := upgrade.NewUpgrade(service, "c1", &upgrade.Options{
+			Version:    "1.30",
+			Pools:      []string{"default"},
+			Wait:       wait,
+			OnProgress: record,
+			HealthGate: func(ctx context.Context, cluster string, pool string) error {
+				return gateErr
+			},
+		})
+		err = u.Run(ctx)
+		Expect(errors.Is(err, gateErr)).To(BeTrue())
+		Expect(err).To(MatchError("upgrade: health gate of worker pool default of cluster c1: pods are not ready"))
+		Expect(requestCount("/v2/vpc/replaceWorker")).To(Equal(0))
+		Expect(workerVersions()["default"]).To(ConsistOf("1.30.2", fake.DefaultKubeVersion))
+
+		Expect(upgrade.NewUpgrade(service, "c1", &upgrade.Options{Wait: wait}).Run(ctx)).To(Succeed())
+		Expect(workerVersions()["default"]).To(Equal([]string{"1.30.2", "1.30.2"}))
+
+		err = upgrade.NewUpgrade(service, "c1", &upgrade.Options{Pools: []string{"edge"}, Wait: wait}).Run(ctx)
+		Expect(err).To(MatchError("upgrade: cluster c1 has no worker pool edge"))
+	})
+
+	It(`Pauses, resumes and aborts between batches`, func() {
+		createVPCCluster()
+
+		var u *upgrade.Upgrade
+		batches := 0
+		u = upgrade.NewUpgrade(service, "c1", &upgrade.Options{
+			Wait: wait,
+			OnProgress: func(event upgrade.Event) {
+				record(event)
+				switch event.Type {
+				case upgrade.EventBatchStarted:
+					batches++
+					if batches == 1 {
+						u.Pause()
+					}
+					if batches == 3 {
+						u.Abort()
+					}
+				case upgrade.EventPaused:
+					go func() {
+						time.Sleep(20 * time.Millisecond)
+						u.Resume()
+					}()
+				}
+			},
+		})
+		Expect(u.Run(ctx)).To(Equal(upgrade.ErrAborted))
+		Expect(eventTypes()).To(Equal([]upgrade.EventType{
+			upgrade.EventTargetSelected,
+			upgrade.EventMasterUpdateStarted,
+			upgrade.EventMasterUpdated,
+			upgrade.EventBatchStarted,
+			upgrade.EventBatchCompleted,
+			upgrade.EventPaused,
+			upgrade.EventResumed,
+			upgrade.EventBatchStarted,
+			upgrade.EventBatchCompleted,
+			upgrade.EventBatchStarted,
+		}))
+		Expect(u.Run(ctx)).To(Equal(upgrade.ErrAborted))
+
+		events = nil
+		Expect(upgrade.NewUpgrade(service, "c1", &upgrade.Options{Wait: wait, OnProgress: record}).Run(ctx)).To(Succeed())
+		Expect(events[0].Version).To(Equal("1.30.2"))
+		Expect(workerVersions()).To(Equal(map[string][]string{
+			"default": {"1.30.2", "1.30.2", "1.30.2"},
+			"edge":    {"1.30.2"},
+		}))
+	})
+
+	It(`Selects the newest patch version of the wanted or next minor version`, func() {
+		versions := []kubernetesserviceapiv1.KubeVersion{
+			{Major: core.Int64Ptr(1), Minor: core.Int64Ptr(29), Patch: core.Int64Ptr(3)},
+			{Major: core.Int64Ptr(1), Minor: core.Int64Ptr(30), Patch: core.Int64Ptr(1)},
+			{Major: core.Int64Ptr(1), Minor: core.Int64Ptr(30), Patch: core.Int64Ptr(2)},
+			{Major: core.Int64Ptr(1), Minor: core.Int64Ptr(31), Patch: core.Int64Ptr(0)},
+			{Major: core.Int64Ptr(1), Minor: core.Int64Ptr(28), Patch: core.Int64Ptr(11)},
+		}
+		for _, test := range []struct{ current, wanted, selected string }{
+			{"1.29.3_1547", "", "1.30.2"},
+			{"1.30.1_1530", "", "1.31.0"},
+			{"1.31.0_1501", "", "1.31.0"},
+			{"1.29.3_1547", "1.31", "1.31.0"},
+			{"1.29.3_1547", "1.30.1", "1.30.1"},
+			{"1.29.3_1547", "1.29", "1.29.3"},
+		} {
+			selected, err := upgrade.SelectVersion(versions, test.current, test.wanted)
+			Expect(err).To(BeNil())
+			Expect(selected).To(Equal(test.selected), "%+v", test)
+		}
+
+		_, err := upgrade.SelectVersion(versions, "1.29.3_1547", "1.28")
+		Expect(err).To(MatchError("version 1.28 is not listed, or is older than 1.29.3_1547"))
+		_, err = upgrade.SelectVersion(versions, "1.29.3_1547", "1.32")
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,105 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1
+
+import (
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// Constants associated with the UpdateClusterWorkerOptions.Action property.
+// The action to perform on the worker node.
+const (
+	UpdateClusterWorkerOptions_Action_OsReboot = "os_reboot"
+	UpdateClusterWorkerOptions_Action_Reboot   = "reboot"
+	UpdateClusterWorkerOptions_Action_Reload   = "reload"
+	UpdateClusterWorkerOptions_Action_Update   = "update"
+)
+
+// Constants associated with the UpdateClusterOptions.Action property.
+// The action to perform on the master.
+const (
+	UpdateClusterOptions_Action_Refresh = "refresh"
+	UpdateClusterOptions_Action_Update  = "update"
+)
+
+// validateStrict returns the violations of the constraints of options if strict validation is enabled and options
+// has a Validate method.
+func (kubernetesServiceApi *KubernetesServiceApiV1) validateStrict(options interface{}) error {
+	if validator, ok := options.(interface{ Validate() error }); ok && kubernetesServiceApi.strictValidation {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *CreateClusterOptions) Validate() error {
+	validation := common.NewValidation("CreateClusterOptions")
+	validation.CIDR("PodSubnet", options.PodSubnet)
+	validation.CIDR("ServiceSubnet", options.ServiceSubnet)
+	return validation.Err()
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *CreateSatelliteClusterOptions) Validate() error {
+	validation := common.NewValidation("CreateSatelliteClusterOptions")
+	validation.CIDR("PodSubnet", options.PodSubnet)
+	validation.CIDR("ServiceSubnet", options.ServiceSubnet)
+	return validation.Err()
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *CreateSatelliteClusterRemoteOptions) Validate() error {
+	validation := common.NewValidation("CreateSatelliteClusterRemoteOptions")
+	validation.CIDR("PodSubnet", options.PodSubnet)
+	validation.CIDR("ServiceSubnet", options.ServiceSubnet)
+	return validation.Err()
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *CreateSatelliteLocationOptions) Validate() error {
+	validation := common.NewValidation("CreateSatelliteLocationOptions")
+	validation.CIDR("PodSubnet", options.PodSubnet)
+	validation.CIDR("ServiceSubnet", options.ServiceSubnet)
+	return validation.Err()
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *VpcCreateClusterOptions) Validate() error {
+	validation := common.NewValidation("VpcCreateClusterOptions")
+	validation.CIDR("PodSubnet", options.PodSubnet)
+	validation.CIDR("ServiceSubnet", options.ServiceSubnet)
+	return validation.Err()
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *UpdateClusterOptions) Validate() error {
+	validation := common.NewValidation("UpdateClusterOptions")
+	validation.Enum("Action", options.Action, UpdateClusterOptions_Action_Refresh, UpdateClusterOptions_Action_Update)
+	if options.Version != nil && (options.Action == nil || *options.Action != UpdateClusterOptions_Action_Update) {
+		validation.Errorf("Version", "is only used with the '%s' action", UpdateClusterOptions_Action_Update)
+	}
+	return validation.Err()
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *UpdateClusterWorkerOptions) Validate() error {
+	validation := common.NewValidation("UpdateClusterWorkerOptions")
+	validation.Enum("Action", options.Action,
+		UpdateClusterWorkerOptions_Action_OsReboot, UpdateClusterWorkerOptions_Action_Reboot,
+		UpdateClusterWorkerOptions_Action_Reload, UpdateClusterWorkerOptions_Action_Update)
+	return validation.Err()
+}
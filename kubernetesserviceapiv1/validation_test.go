@@ -0,0 +1,98 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+var _ = Describe(`Strict validation`, func() {
+	var testServer *httptest.Server
+	var kubernetesServiceApiService *kubernetesserviceapiv1.KubernetesServiceApiV1
+	var requests int
+
+	BeforeEach(func() {
+		requests = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(201)
+			fmt.Fprintf(res, "%s", `{}`)
+		}))
+		var serviceErr error
+		kubernetesServiceApiService, serviceErr = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:              testServer.URL,
+			Authenticator:    &core.NoAuthAuthenticator{},
+			StrictValidation: true,
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Checks the subnets of the clusters before creating them`, func() {
+		vpcCreateClusterOptionsModel := kubernetesServiceApiService.NewVpcCreateClusterOptions("rg1")
+		vpcCreateClusterOptionsModel.PodSubnet = core.StringPtr("172.30.0.0")
+		vpcCreateClusterOptionsModel.ServiceSubnet = core.StringPtr("not-a-subnet")
+		_, _, operationErr := kubernetesServiceApiService.VpcCreateCluster(vpcCreateClusterOptionsModel)
+		var validationErrs *common.ValidationErrors
+		Expect(errors.As(operationErr, &validationErrs)).To(BeTrue())
+		Expect(validationErrs.Errors).To(HaveLen(2))
+		Expect(operationErr.Error()).To(Equal("VpcCreateClusterOptions is not valid: " +
+			"PodSubnet must be a CIDR block, not '172.30.0.0'; ServiceSubnet must be a CIDR block, not 'not-a-subnet'"))
+		Expect(requests).To(Equal(0))
+
+		vpcCreateClusterOptionsModel.PodSubnet = core.StringPtr("172.30.0.0/16")
+		vpcCreateClusterOptionsModel.ServiceSubnet = core.StringPtr("172.21.0.0/16")
+		_, _, operationErr = kubernetesServiceApiService.VpcCreateCluster(vpcCreateClusterOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(requests).To(Equal(1))
+	})
+
+	It(`Checks the actions on the workers and the master`, func() {
+		updateClusterWorkerOptionsModel := kubernetesServiceApiService.NewUpdateClusterWorkerOptions("c1", "w1")
+		updateClusterWorkerOptionsModel.Action = core.StringPtr("restart")
+		_, operationErr := kubernetesServiceApiService.UpdateClusterWorker(updateClusterWorkerOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(Equal("UpdateClusterWorkerOptions is not valid: " +
+			"Action must be one of 'os_reboot', 'reboot', 'reload', 'update', not 'restart'"))
+
+		updateClusterOptionsModel := kubernetesServiceApiService.NewUpdateClusterOptions("c1")
+		updateClusterOptionsModel.SetAction(kubernetesserviceapiv1.UpdateClusterOptions_Action_Refresh)
+		updateClusterOptionsModel.SetVersion("1.29")
+		_, operationErr = kubernetesServiceApiService.UpdateCluster(updateClusterOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(Equal("UpdateClusterOptions is not valid: Version is only used with the 'update' action"))
+		Expect(requests).To(Equal(0))
+
+		kubernetesServiceApiService.SetStrictValidation(false)
+		_, operationErr = kubernetesServiceApiService.UpdateClusterWorker(updateClusterWorkerOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(requests).To(Equal(1))
+	})
+})
@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// Worker states reported in GetWorkerResponseLifecycle.ActualState and DesiredState.
+const (
+	WorkerStateCritical        = "critical"
+	WorkerStateDeleted         = "deleted"
+	WorkerStateDeleting        = "deleting"
+	WorkerStateDeployFailed    = "deploy_failed"
+	WorkerStateDeployed        = "deployed"
+	WorkerStateDeploying       = "deploying"
+	WorkerStateNormal          = "normal"
+	WorkerStateProvisionFailed = "provision_failed"
+	WorkerStateProvisioning    = "provisioning"
+	WorkerStateReloadFailed    = "reload_failed"
+	WorkerStateReloadPending   = "reload_pending"
+	WorkerStateReloading       = "reloading"
+	WorkerStateUnknown         = "unknown"
+	WorkerStateWarning         = "warning"
+)
+
+// Worker health states reported in GetWorkerResponseHealth.State.
+const (
+	WorkerHealthCritical = "critical"
+	WorkerHealthNormal   = "normal"
+	WorkerHealthPending  = "pending"
+	WorkerHealthWarning  = "warning"
+)
+
+// WorkerPredicate reports whether a worker has reached the awaited state.
+// A non-nil error stops the wait and is returned to the caller.
+type WorkerPredicate func(worker *GetWorkerResponse) (done bool, err error)
+
+// WorkerWaitOptions : The options of the single worker waiters.
+type WorkerWaitOptions struct {
+	common.WaitOptions
+
+	// Poll with VpcGetWorker instead of GetWorker.
+	VPC bool
+
+	// The ID of the resource group that the cluster is in.
+	XAuthResourceGroup *string
+
+	// How long a 404 is tolerated after the wait starts. Defaults to DefaultNotFoundGracePeriod.
+	NotFoundGracePeriod time.Duration
+
+	// Called with the worker after every successful poll.
+	OnProgress func(worker *GetWorkerResponse)
+}
+
+// WorkersWaitOptions : The options of the cluster and worker pool waiters.
+type WorkersWaitOptions struct {
+	common.WaitOptions
+
+	// Poll with VpcGetWorkers instead of GetWorkers1.
+	VPC bool
+
+	// The ID of the resource group that the cluster is in.
+	XAuthResourceGroup *string
+
+	// Only wait for the workers of this worker pool, matched by name or ID. The wait stops with the error of
+	// GetWorkerPool if no worker is listed and the pool cannot be read, for example because it does not exist.
+	Pool string
+
+	// The number of workers that must be listed before the wait can succeed, so that a pool that is still being
+	// populated is not reported as ready. Defaults to 1; negative to accept an empty list.
+	MinWorkers int
+
+	// Called after every successful poll with the workers considered and those not satisfying the predicate yet.
+	OnProgress func(workers []GetWorkerResponse, pending []GetWorkerResponse)
+}
+
+// WorkerReady is satisfied when the worker is deployed, is meant to be, is healthy and has no pending operation.
+func WorkerReady(worker *GetWorkerResponse) (bool, error) {
+	lifecycle := worker.Lifecycle
+	if lifecycle == nil || lifecycle.ActualState == nil || *lifecycle.ActualState != WorkerStateDeployed {
+		return false, nil
+	}
+	if lifecycle.DesiredState != nil && *lifecycle.DesiredState != "" && *lifecycle.DesiredState != WorkerStateDeployed {
+		return false, nil
+	}
+	if lifecycle.PendingOperation != nil && *lifecycle.PendingOperation != "" {
+		return false, nil
+	}
+	return worker.Health != nil && worker.Health.State != nil && *worker.Health.State == WorkerHealthNormal, nil
+}
+
+// WorkerAtDesiredState is satisfied when the actual state of the worker is its desired state, such as "deployed" or
+// "deleted", and it has no pending operation.
+func WorkerAtDesiredState(worker *GetWorkerResponse) (bool, error) {
+	lifecycle := worker.Lifecycle
+	if lifecycle == nil || lifecycle.ActualState == nil || lifecycle.DesiredState == nil || *lifecycle.ActualState != *lifecycle.DesiredState {
+		return false, nil
+	}
+	return lifecycle.PendingOperation == nil || *lifecycle.PendingOperation == "", nil
+}
+
+// WorkerKubeVersion returns a predicate that is satisfied when the worker runs version, which may be a full version
+// such as "1.29.3_1547" or a prefix such as "1.29".
+func WorkerKubeVersion(version string) WorkerPredicate {
+	return func(worker *GetWorkerResponse) (bool, error) {
+		return worker.KubeVersion != nil && worker.KubeVersion.Actual != nil && versionMatches(*worker.KubeVersion.Actual, version), nil
+	}
+}
+
+// AllWorkerPredicates returns a predicate that is satisfied when every one of predicates is satisfied.
+func AllWorkerPredicates(predicates ...WorkerPredicate) WorkerPredicate {
+	return func(worker *GetWorkerResponse) (bool, error) {
+		for _, predicate := range predicates {
+			done, err := predicate(worker)
+			if !done || err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+}
+
+// WaitForWorkerState polls the worker until predicate is satisfied and returns the last worker read.
+// The wait stops early with a *TerminalStateError when the worker reaches a failure state.
+func (kubernetesServiceApi *KubernetesServiceApiV1) WaitForWorkerState(ctx context.Context, cluster string, worker string, predicate WorkerPredicate, options *WorkerWaitOptions) (result *GetWorkerResponse, err error) {
+	if options == nil {
+		options = &WorkerWaitOptions{}
+	}
+	notFoundDeadline := time.Now().Add(notFoundGracePeriod(options.NotFoundGracePeriod))
+
+	err = common.Poll(ctx, &options.WaitOptions, func(ctx context.Context) (bool, error) {
+		workerResult, getErr := kubernetesServiceApi.getWorkerForWait(ctx, cluster, worker, options)
+		if getErr != nil {
+			if isNotFound(getErr) && time.Now().Before(notFoundDeadline) {
+				return false, nil
+			}
+			return false, getErr
+		}
+		result = workerResult
+		if options.OnProgress != nil {
+			options.OnProgress(workerResult)
+		}
+
+		done, predicateErr := predicate(workerResult)
+		if done || predicateErr != nil {
+			return done, predicateErr
+		}
+		return false, workerTerminalStateError(worker, workerResult)
+	})
+	return
+}
+
+// WaitForWorkerReady waits until the worker is deployed, healthy and has no pending operation, for example after a
+// reload or reboot with UpdateClusterWorker. A worker replaced with VpcReplaceWorker gets a new ID, so wait for its
+// pool with WaitForWorkerPoolReady instead.
+func (kubernetesServiceApi *KubernetesServiceApiV1) WaitForWorkerReady(ctx context.Context, cluster string, worker string, options *WorkerWaitOptions) (*GetWorkerResponse, error) {
+	return kubernetesServiceApi.WaitForWorkerState(ctx, cluster, worker, WorkerReady, options)
+}
+
+// WaitForWorkers polls the workers of the cluster, or of options.Pool, until every one of them satisfies predicate,
+// and at least options.MinWorkers are listed, and returns the workers of the last poll. The wait stops early with a
+// *TerminalStateError when a worker reaches a failure state.
+func (kubernetesServiceApi *KubernetesServiceApiV1) WaitForWorkers(ctx context.Context, cluster string, predicate WorkerPredicate, options *WorkersWaitOptions) (result []GetWorkerResponse, err error) {
+	if options == nil {
+		options = &WorkersWaitOptions{}
+	}
+	minWorkers := options.MinWorkers
+	if minWorkers == 0 {
+		minWorkers = 1
+	}
+
+	err = common.Poll(ctx, &options.WaitOptions, func(ctx context.Context) (bool, error) {
+		workers, listErr := kubernetesServiceApi.listWorkersForWait(ctx, cluster, options)
+		if listErr != nil {
+			return false, listErr
+		}
+		result = workers
+		if len(workers) == 0 && options.Pool != "" {
+			if poolErr := kubernetesServiceApi.getWorkerPoolForWait(ctx, cluster, options); poolErr != nil {
+				return false, poolErr
+			}
+		}
+
+		var pending []GetWorkerResponse
+		var terminalErr error
+		for i := range workers {
+			done, predicateErr := predicate(&workers[i])
+			if predicateErr != nil {
+				return false, predicateErr
+			}
+			if !done {
+				pending = append(pending, workers[i])
+				if terminalErr == nil && workers[i].ID != nil {
+					terminalErr = workerTerminalStateError(*workers[i].ID, &workers[i])
+				}
+			}
+		}
+		if options.OnProgress != nil {
+			options.OnProgress(workers, pending)
+		}
+		if terminalErr != nil {
+			return false, terminalErr
+		}
+		return len(pending) == 0 && len(workers) >= minWorkers, nil
+	})
+	return
+}
+
+// WaitForWorkerPoolReady waits until every worker of the pool is ready and, if version is not empty, runs that
+// Kubernetes version.
+func (kubernetesServiceApi *KubernetesServiceApiV1) WaitForWorkerPoolReady(ctx context.Context, cluster string, pool string, version string, options *WorkersWaitOptions) ([]GetWorkerResponse, error) {
+	poolOptions := WorkersWaitOptions{}
+	if options != nil {
+		poolOptions = *options
+	}
+	poolOptions.Pool = pool
+
+	predicate := WorkerReady
+	if version != "" {
+		predicate = AllWorkerPredicates(WorkerReady, WorkerKubeVersion(version))
+	}
+	return kubernetesServiceApi.WaitForWorkers(ctx, cluster, predicate, &poolOptions)
+}
+
+// getWorkerForWait reads the worker with GetWorker or VpcGetWorker, as selected by options.
+func (kubernetesServiceApi *KubernetesServiceApiV1) getWorkerForWait(ctx context.Context, cluster string, worker string, options *WorkerWaitOptions) (result *GetWorkerResponse, err error) {
+	if options.VPC {
+		vpcGetWorkerOptions := kubernetesServiceApi.NewVpcGetWorkerOptions(cluster, worker)
+		vpcGetWorkerOptions.XAuthResourceGroup = options.XAuthResourceGroup
+		result, _, err = kubernetesServiceApi.VpcGetWorkerWithContext(ctx, vpcGetWorkerOptions)
+		return
+	}
+	getWorkerOptions := kubernetesServiceApi.NewGetWorkerOptions(cluster, worker)
+	getWorkerOptions.XAuthResourceGroup = options.XAuthResourceGroup
+	result, _, err = kubernetesServiceApi.GetWorkerWithContext(ctx, getWorkerOptions)
+	return
+}
+
+// getWorkerPoolForWait reads options.Pool with GetWorkerPool, to tell a pool without workers from one that does not
+// exist.
+func (kubernetesServiceApi *KubernetesServiceApiV1) getWorkerPoolForWait(ctx context.Context, cluster string, options *WorkersWaitOptions) error {
+	getWorkerPoolOptions := kubernetesServiceApi.NewGetWorkerPoolOptions(cluster, options.Pool)
+	getWorkerPoolOptions.XAuthResourceGroup = options.XAuthResourceGroup
+	_, _, err := kubernetesServiceApi.GetWorkerPoolWithContext(ctx, getWorkerPoolOptions)
+	return err
+}
+
+// listWorkersForWait lists the workers with GetWorkers1 or VpcGetWorkers, as selected by options, keeping only those
+// of options.Pool if it is set. VpcGetWorkers filters by pool on the server; GetWorkers1 is filtered here.
+func (kubernetesServiceApi *KubernetesServiceApiV1) listWorkersForWait(ctx context.Context, cluster string, options *WorkersWaitOptions) (result []GetWorkerResponse, err error) {
+	if options.VPC {
+		vpcGetWorkersOptions := kubernetesServiceApi.NewVpcGetWorkersOptions(cluster)
+		vpcGetWorkersOptions.XAuthResourceGroup = options.XAuthResourceGroup
+		if options.Pool != "" {
+			vpcGetWorkersOptions.SetPool(options.Pool)
+		}
+		result, _, err = kubernetesServiceApi.VpcGetWorkersWithContext(ctx, vpcGetWorkersOptions)
+		return
+	}
+
+	getWorkersOptions := kubernetesServiceApi.NewGetWorkers1Options(cluster)
+	getWorkersOptions.XAuthResourceGroup = options.XAuthResourceGroup
+	workers, _, err := kubernetesServiceApi.GetWorkers1WithContext(ctx, getWorkersOptions)
+	if err != nil || options.Pool == "" {
+		return workers, err
+	}
+
+	for _, worker := range workers {
+		if (worker.PoolName != nil && *worker.PoolName == options.Pool) || (worker.PoolID != nil && *worker.PoolID == options.Pool) {
+			result = append(result, worker)
+		}
+	}
+	return
+}
+
+// workerTerminalStateError returns a *TerminalStateError if the worker is in a failure state.
+func workerTerminalStateError(worker string, workerResult *GetWorkerResponse) error {
+	if workerResult.Lifecycle != nil && workerResult.Lifecycle.ActualState != nil {
+		switch *workerResult.Lifecycle.ActualState {
+		case WorkerStateDeployFailed, WorkerStateProvisionFailed, WorkerStateReloadFailed:
+			return &TerminalStateError{Resource: "worker", ID: worker, Field: "actualState", State: *workerResult.Lifecycle.ActualState}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kubernetesserviceapiv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+var _ = Describe(`Worker waiters`, func() {
+	var testServer *httptest.Server
+	var kubernetesServiceApiService *kubernetesserviceapiv1.KubernetesServiceApiV1
+	var requests []*http.Request
+	var mutex sync.Mutex
+	var workerPoolStatus int
+
+	// startServer serves the bodies in order, repeating the last one. GetWorkerPool is answered with workerPoolStatus.
+	var startServer = func(bodies ...string) {
+		requests = nil
+		workerPoolStatus = 200
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests = append(requests, req)
+			if req.URL.Path == "/v2/getWorkerPool" {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(workerPoolStatus)
+				fmt.Fprintf(res, `{"id": "p1", "poolName": "default"}`)
+				return
+			}
+			body := bodies[0]
+			if len(bodies) > 1 {
+				bodies = bodies[1:]
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", body)
+		}))
+		var serviceErr error
+		kubernetesServiceApiService, serviceErr = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	var waitOptions = common.WaitOptions{Interval: time.Millisecond, Timeout: 5 * time.Second}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Waits for a reloaded worker to be ready`, func() {
+		startServer(
+			`{"id": "w1", "lifecycle": {"actualState": "reloading", "pendingOperation": "reloading"}, "health": {"state": "pending"}}`,
+			`{"id": "w1", "lifecycle": {"actualState": "deployed", "pendingOperation": ""}, "health": {"state": "normal"}}`,
+		)
+		polls := 0
+		worker, err := kubernetesServiceApiService.WaitForWorkerReady(context.Background(), "c1", "w1", &kubernetesserviceapiv1.WorkerWaitOptions{
+			WaitOptions: waitOptions,
+			OnProgress:  func(worker *kubernetesserviceapiv1.GetWorkerResponse) { polls++ },
+		})
+		Expect(err).To(BeNil())
+		Expect(*worker.ID).To(Equal("w1"))
+		Expect(polls).To(Equal(2))
+		Expect(requests[0].URL.Path).To(Equal("/v2/getWorker"))
+		Expect(requests[0].URL.Query().Get("worker")).To(Equal("w1"))
+	})
+	It(`Stops early when a reload fails`, func() {
+		startServer(`{"id": "w1", "lifecycle": {"actualState": "reload_failed"}, "health": {"state": "critical"}}`)
+
+		_, err := kubernetesServiceApiService.WaitForWorkerReady(context.Background(), "c1", "w1", &kubernetesserviceapiv1.WorkerWaitOptions{
+			WaitOptions: waitOptions,
+			VPC:         true,
+		})
+		var terminalStateError *kubernetesserviceapiv1.TerminalStateError
+		Expect(errors.As(err, &terminalStateError)).To(BeTrue())
+		Expect(terminalStateError.Resource).To(Equal("worker"))
+		Expect(terminalStateError.State).To(Equal("reload_failed"))
+		Expect(requests[0].URL.Path).To(Equal("/v2/vpc/getWorker"))
+	})
+	It(`Waits for a worker pool to be ready at a version`, func() {
+		startServer(
+			`[{"id": "w1", "poolName": "default", "lifecycle": {"actualState": "deployed"}, "health": {"state": "normal"}, "kubeVersion": {"actual": "1.28.7_1550"}},
+			  {"id": "w2", "poolName": "default", "lifecycle": {"actualState": "deployed"}, "health": {"state": "normal"}, "kubeVersion": {"actual": "1.29.3_1547"}},
+			  {"id": "w3", "poolName": "other", "lifecycle": {"actualState": "deploying"}, "health": {"state": "pending"}}]`,
+			`[{"id": "w1", "poolName": "default", "lifecycle": {"actualState": "deployed"}, "health": {"state": "normal"}, "kubeVersion": {"actual": "1.29.3_1547"}},
+			  {"id": "w2", "poolName": "default", "lifecycle": {"actualState": "deployed"}, "health": {"state": "normal"}, "kubeVersion": {"actual": "1.29.3_1547"}},
+			  {"id": "w3", "poolName": "other", "lifecycle": {"actualState": "deploying"}, "health": {"state": "pending"}}]`,
+		)
+		var pendingCounts []int
+		workers, err := kubernetesServiceApiService.WaitForWorkerPoolReady(context.Background(), "c1", "default", "1.29", &kubernetesserviceapiv1.WorkersWaitOptions{
+			WaitOptions: waitOptions,
+			MinWorkers:  2,
+			OnProgress: func(workers []kubernetesserviceapiv1.GetWorkerResponse, pending []kubernetesserviceapiv1.GetWorkerResponse) {
+				pendingCounts = append(pendingCounts, len(pending))
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(workers).To(HaveLen(2))
+		Expect(pendingCounts).To(Equal([]int{1, 0}))
+		Expect(requests[0].URL.Path).To(Equal("/v2/getWorkers"))
+	})
+	It(`Waits until a worker is listed by default`, func() {
+		startServer(
+			`[]`,
+			`[{"id": "w1", "lifecycle": {"actualState": "deployed"}, "health": {"state": "normal"}}]`,
+		)
+		workers, err := kubernetesServiceApiService.WaitForWorkers(context.Background(), "c1", kubernetesserviceapiv1.WorkerReady, &kubernetesserviceapiv1.WorkersWaitOptions{
+			WaitOptions: waitOptions,
+			VPC:         true,
+			Pool:        "default",
+		})
+		Expect(err).To(BeNil())
+		Expect(workers).To(HaveLen(1))
+		Expect(len(requests)).To(BeNumerically(">=", 3))
+		Expect(requests[0].URL.Path).To(Equal("/v2/vpc/getWorkers"))
+		Expect(requests[0].URL.Query().Get("pool")).To(Equal("default"))
+		Expect(requests[1].URL.Path).To(Equal("/v2/getWorkerPool"))
+		Expect(requests[1].URL.Query().Get("workerpool")).To(Equal("default"))
+	})
+	It(`Accepts an empty list when MinWorkers is negative`, func() {
+		startServer(`[]`)
+
+		workers, err := kubernetesServiceApiService.WaitForWorkers(context.Background(), "c1", kubernetesserviceapiv1.WorkerReady, &kubernetesserviceapiv1.WorkersWaitOptions{
+			WaitOptions: waitOptions,
+			MinWorkers:  -1,
+		})
+		Expect(err).To(BeNil())
+		Expect(workers).To(BeEmpty())
+		Expect(requests).To(HaveLen(1))
+	})
+	It(`Stops when the worker pool does not exist`, func() {
+		startServer(`[]`)
+		workerPoolStatus = 404
+
+		_, err := kubernetesServiceApiService.WaitForWorkerPoolReady(context.Background(), "c1", "defualt", "", &kubernetesserviceapiv1.WorkersWaitOptions{
+			WaitOptions: waitOptions,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, common.ErrWaitTimeout)).To(BeFalse())
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].URL.Query().Get("workerpool")).To(Equal("defualt"))
+	})
+	It(`Checks the desired state of the workers`, func() {
+		startServer(
+			`[{"id": "w1", "lifecycle": {"actualState": "deployed", "desiredState": "deleted"}, "health": {"state": "normal"}}]`,
+			`[{"id": "w1", "lifecycle": {"actualState": "deleted", "desiredState": "deleted"}, "health": {"state": "normal"}}]`,
+		)
+		var readyCounts []int
+		workers, err := kubernetesServiceApiService.WaitForWorkers(context.Background(), "c1", kubernetesserviceapiv1.WorkerAtDesiredState, &kubernetesserviceapiv1.WorkersWaitOptions{
+			WaitOptions: waitOptions,
+			OnProgress: func(workers []kubernetesserviceapiv1.GetWorkerResponse, pending []kubernetesserviceapiv1.GetWorkerResponse) {
+				ready, _ := kubernetesserviceapiv1.WorkerReady(&workers[0])
+				if ready {
+					readyCounts = append(readyCounts, 1)
+				} else {
+					readyCounts = append(readyCounts, 0)
+				}
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(workers).To(HaveLen(1))
+		Expect(readyCounts).To(Equal([]int{0, 0}))
+	})
+})
@@ -0,0 +1,437 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package workerops runs the operations that take workers down, such as reloads, reboots and replacements, between
+// hooks that let the caller prepare the worker and check it afterwards.
+//
+// UpdateClusterWorker and VpcReplaceWorker disrupt the pods of a worker without warning. A Runner calls the
+// BeforeWorkerDisruption hook first, typically to cordon and drain the worker with a Kubernetes client, requests the
+// operation, waits for the worker, or the worker replacing it, to be ready, then calls the AfterWorkerReady hook,
+// for example to uncordon it or to check the pod disruption budgets:
+//
+//	runner := workerops.NewRunner(kubernetesServiceApi, &workerops.Options{
+//		Hooks:    drainer,
+//		Timeouts: workerops.TimeoutPolicy{BeforeDisruption: 10 * time.Minute, Ready: 30 * time.Minute},
+//	})
+//	worker, err := runner.Run(ctx, "my-cluster", workerID, workerops.OperationReplaceAndUpdate)
+//
+// VpcReplaceWorker does not tell which worker replaces the worker replaced, so the replacement is the new worker of
+// the same pool and zone. A Runner therefore replaces the workers of the same pool and zone one at a time, although
+// their hooks may run concurrently.
+package workerops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+)
+
+// DefaultReadyTimeout is the limit of the wait for a worker to be ready when neither Options.Timeouts.Ready nor
+// Options.Wait.Timeout is set.
+const DefaultReadyTimeout = time.Hour
+
+// Operation : An operation disrupting a worker.
+type Operation string
+
+// Operations of a Runner.
+const (
+	// Reload the worker of a classic cluster with UpdateClusterWorker.
+	OperationReload Operation = kubernetesserviceapiv1.UpdateClusterWorkerOptions_Action_Reload
+
+	// Reboot the worker of a classic cluster with UpdateClusterWorker.
+	OperationReboot Operation = kubernetesserviceapiv1.UpdateClusterWorkerOptions_Action_Reboot
+
+	// Reboot the operating system of the worker of a classic cluster with UpdateClusterWorker.
+	OperationOsReboot Operation = kubernetesserviceapiv1.UpdateClusterWorkerOptions_Action_OsReboot
+
+	// Update the worker of a classic cluster to the version of the master with UpdateClusterWorker.
+	OperationUpdate Operation = kubernetesserviceapiv1.UpdateClusterWorkerOptions_Action_Update
+
+	// Replace the worker of a VPC cluster with a new worker running the same version, with VpcReplaceWorker.
+	OperationReplace Operation = "replace"
+
+	// Replace the worker of a VPC cluster with a new worker running the version of the master, with
+	// VpcReplaceWorker.
+	OperationReplaceAndUpdate Operation = "replace_and_update"
+)
+
+// Hooks : The callbacks of the operations of a Runner. A non-nil error stops the operation.
+type Hooks interface {
+	// BeforeWorkerDisruption is called before the operation is requested, to cordon and drain the worker.
+	BeforeWorkerDisruption(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error
+
+	// AfterWorkerReady is called once the worker, or the worker replacing it, is ready.
+	AfterWorkerReady(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error
+}
+
+// HookFuncs : Hooks made of functions, either of which may be nil.
+type HookFuncs struct {
+	BeforeWorkerDisruptionFunc func(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error
+	AfterWorkerReadyFunc       func(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error
+}
+
+// BeforeWorkerDisruption calls BeforeWorkerDisruptionFunc, if set.
+func (hooks HookFuncs) BeforeWorkerDisruption(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error {
+	if hooks.BeforeWorkerDisruptionFunc == nil {
+		return nil
+	}
+	return hooks.BeforeWorkerDisruptionFunc(ctx, worker)
+}
+
+// AfterWorkerReady calls AfterWorkerReadyFunc, if set.
+func (hooks HookFuncs) AfterWorkerReady(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error {
+	if hooks.AfterWorkerReadyFunc == nil {
+		return nil
+	}
+	return hooks.AfterWorkerReadyFunc(ctx, worker)
+}
+
+// TimeoutPolicy : How long the steps of an operation may take. Zero means no limit other than the context.
+type TimeoutPolicy struct {
+	// The limit of the BeforeWorkerDisruption hook, passed to it as the deadline of its context.
+	BeforeDisruption time.Duration
+
+	// Whether to request the operation anyway when the BeforeWorkerDisruption hook exceeds BeforeDisruption, like a
+	// forced drain, instead of failing.
+	ProceedAfterTimeout bool
+
+	// The limit of the wait for the worker, or the worker replacing it, to be ready. Defaults to Options.Wait.Timeout,
+	// or to DefaultReadyTimeout if that is not set either.
+	Ready time.Duration
+
+	// The limit of the AfterWorkerReady hook, passed to it as the deadline of its context.
+	AfterReady time.Duration
+}
+
+// HookError : The failure of a hook, which stopped an operation.
+type HookError struct {
+	// The hook that failed, "BeforeWorkerDisruption" or "AfterWorkerReady".
+	Hook string
+
+	// The ID of the worker passed to the hook.
+	Worker string
+
+	// The error returned by the hook.
+	Err error
+}
+
+// Error returns the hook, the worker and the error of the hook.
+func (hookError *HookError) Error() string {
+	return fmt.Sprintf("workerops: %s hook of worker %s failed: %s", hookError.Hook, hookError.Worker, hookError.Err)
+}
+
+// Unwrap returns the error of the hook.
+func (hookError *HookError) Unwrap() error {
+	return hookError.Err
+}
+
+// Options : The options of NewRunner.
+type Options struct {
+	// The hooks of the operations. None if nil.
+	Hooks Hooks
+
+	// The limits of the steps of the operations.
+	Timeouts TimeoutPolicy
+
+	// How to poll the workers. Timeouts.Ready, if set, takes precedence over Wait.Timeout.
+	Wait common.WaitOptions
+
+	// The ID of the resource group that the clusters are in.
+	XAuthResourceGroup *string
+}
+
+// Runner : Runs operations disrupting workers between hooks. A Runner can run several operations at once, but runs
+// the replacements of workers of the same pool and zone one at a time.
+type Runner struct {
+	service *kubernetesserviceapiv1.KubernetesServiceApiV1
+	options Options
+
+	mutex sync.Mutex
+	// The locks of the pools and zones with a replacement in progress or waiting.
+	zones map[zoneKey]*zoneLock
+}
+
+// zoneKey : A zone of a worker pool of a cluster.
+type zoneKey struct {
+	cluster string
+	pool    string
+	zone    string
+}
+
+// zoneLock : The lock of the replacements of a zone, and the number of replacements holding or waiting for it.
+type zoneLock struct {
+	lock chan struct{}
+	refs int
+}
+
+// NewRunner returns a Runner of the operations of the workers of the clusters of service, configured by options,
+// which may be nil.
+func NewRunner(service *kubernetesserviceapiv1.KubernetesServiceApiV1, options *Options) *Runner {
+	runner := &Runner{service: service, zones: map[zoneKey]*zoneLock{}}
+	if options != nil {
+		runner.options = *options
+	}
+	if runner.options.Hooks == nil {
+		runner.options.Hooks = HookFuncs{}
+	}
+	if runner.options.Timeouts.Ready > 0 {
+		runner.options.Wait.Timeout = runner.options.Timeouts.Ready
+	}
+	if runner.options.Wait.Timeout <= 0 {
+		runner.options.Wait.Timeout = DefaultReadyTimeout
+	}
+	return runner
+}
+
+// Run runs operation on the worker of cluster: it calls the BeforeWorkerDisruption hook, requests the operation,
+// waits for the worker, or the worker replacing it, to be ready, and calls the AfterWorkerReady hook. It returns the
+// ready worker, which has a new ID if the worker was replaced. A hook that fails stops the operation with a
+// *HookError. A replacement waits for the other replacements of the same pool and zone to complete before it is
+// requested.
+func (runner *Runner) Run(ctx context.Context, cluster string, worker string, operation Operation) (*kubernetesserviceapiv1.GetWorkerResponse, error) {
+	replace := operation == OperationReplace || operation == OperationReplaceAndUpdate
+	current, err := runner.readWorker(ctx, cluster, worker, replace)
+	if err != nil {
+		return nil, fmt.Errorf("workerops: reading worker %s of cluster %s: %w", worker, cluster, err)
+	}
+
+	hookCtx, cancel := withTimeout(ctx, runner.options.Timeouts.BeforeDisruption)
+	err = runner.options.Hooks.BeforeWorkerDisruption(hookCtx, current)
+	cancel()
+	if err != nil && !(runner.options.Timeouts.ProceedAfterTimeout && errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil) {
+		return nil, &HookError{Hook: "BeforeWorkerDisruption", Worker: worker, Err: err}
+	}
+
+	var ready *kubernetesserviceapiv1.GetWorkerResponse
+	if replace {
+		ready, err = runner.replace(ctx, cluster, current, operation)
+	} else {
+		ready, err = runner.update(ctx, cluster, worker, operation)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hookCtx, cancel = withTimeout(ctx, runner.options.Timeouts.AfterReady)
+	err = runner.options.Hooks.AfterWorkerReady(hookCtx, ready)
+	cancel()
+	if err != nil {
+		return ready, &HookError{Hook: "AfterWorkerReady", Worker: core.StringNilMapper(ready.ID), Err: err}
+	}
+	return ready, nil
+}
+
+// request requests operation on the worker of cluster.
+func (runner *Runner) request(ctx context.Context, cluster string, worker string, operation Operation) (err error) {
+	switch operation {
+	case OperationReplace, OperationReplaceAndUpdate:
+		vpcReplaceWorkerOptions := runner.service.NewVpcReplaceWorkerOptions()
+		vpcReplaceWorkerOptions.SetCluster(cluster)
+		vpcReplaceWorkerOptions.SetWorkerID(worker)
+		vpcReplaceWorkerOptions.SetUpdate(operation == OperationReplaceAndUpdate)
+		vpcReplaceWorkerOptions.XAuthResourceGroup = runner.options.XAuthResourceGroup
+		_, err = runner.service.VpcReplaceWorkerWithContext(ctx, vpcReplaceWorkerOptions)
+	case OperationReload, OperationReboot, OperationOsReboot, OperationUpdate:
+		updateClusterWorkerOptions := runner.service.NewUpdateClusterWorkerOptions(cluster, worker)
+		updateClusterWorkerOptions.SetAction(string(operation))
+		updateClusterWorkerOptions.XAuthResourceGroup = runner.options.XAuthResourceGroup
+		_, err = runner.service.UpdateClusterWorkerWithContext(ctx, updateClusterWorkerOptions)
+	default:
+		err = fmt.Errorf("unknown operation '%s'", operation)
+	}
+	if err != nil {
+		return fmt.Errorf("workerops: requesting the %s of worker %s of cluster %s: %w", operation, worker, cluster, err)
+	}
+	return nil
+}
+
+// update runs operation on the worker of cluster in place, and returns the worker once it is ready.
+func (runner *Runner) update(ctx context.Context, cluster string, worker string, operation Operation) (*kubernetesserviceapiv1.GetWorkerResponse, error) {
+	if err := runner.request(ctx, cluster, worker, operation); err != nil {
+		return nil, err
+	}
+	predicate := kubernetesserviceapiv1.WorkerReady
+	if operation == OperationUpdate {
+		predicate = kubernetesserviceapiv1.AllWorkerPredicates(kubernetesserviceapiv1.WorkerReady, atTargetVersion)
+	}
+	ready, err := runner.service.WaitForWorkerState(ctx, cluster, worker, predicate, &kubernetesserviceapiv1.WorkerWaitOptions{
+		WaitOptions:        runner.options.Wait,
+		XAuthResourceGroup: runner.options.XAuthResourceGroup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workerops: waiting for worker %s of cluster %s to be ready: %w", worker, cluster, err)
+	}
+	return ready, nil
+}
+
+// replace replaces the worker of cluster once the other replacements of its pool and zone are complete, and returns
+// its replacement once it is ready.
+func (runner *Runner) replace(ctx context.Context, cluster string, replaced *kubernetesserviceapiv1.GetWorkerResponse, operation Operation) (*kubernetesserviceapiv1.GetWorkerResponse, error) {
+	key := zoneKey{cluster: cluster, pool: core.StringNilMapper(replaced.PoolID), zone: core.StringNilMapper(replaced.Location)}
+	if err := runner.lockZone(ctx, key); err != nil {
+		return nil, err
+	}
+	defer runner.unlockZone(key)
+
+	workers, err := runner.listPool(ctx, cluster, key.pool)
+	if err != nil {
+		return nil, err
+	}
+	before := map[string]bool{}
+	for _, w := range workers {
+		before[core.StringNilMapper(w.ID)] = true
+	}
+	if err := runner.request(ctx, cluster, core.StringNilMapper(replaced.ID), operation); err != nil {
+		return nil, err
+	}
+	ready, err := runner.waitForReplacement(ctx, cluster, replaced, before, operation == OperationReplaceAndUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("workerops: waiting for worker %s of cluster %s to be ready: %w", core.StringNilMapper(replaced.ID), cluster, err)
+	}
+	return ready, nil
+}
+
+// lockZone waits until no other replacement of the zone is in progress, or ctx is done.
+func (runner *Runner) lockZone(ctx context.Context, key zoneKey) error {
+	runner.mutex.Lock()
+	zone := runner.zones[key]
+	if zone == nil {
+		zone = &zoneLock{lock: make(chan struct{}, 1)}
+		runner.zones[key] = zone
+	}
+	zone.refs++
+	runner.mutex.Unlock()
+
+	select {
+	case zone.lock <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		runner.release(key, zone)
+		return ctx.Err()
+	}
+}
+
+// unlockZone ends the replacement in progress in the zone.
+func (runner *Runner) unlockZone(key zoneKey) {
+	runner.mutex.Lock()
+	zone := runner.zones[key]
+	runner.mutex.Unlock()
+	<-zone.lock
+	runner.release(key, zone)
+}
+
+// release forgets the zone once no replacement holds or waits for its lock.
+func (runner *Runner) release(key zoneKey, zone *zoneLock) {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+	zone.refs--
+	if zone.refs == 0 {
+		delete(runner.zones, key)
+	}
+}
+
+// waitForReplacement waits until the worker replaced is gone and a new worker of its pool and zone, which is not
+// one of the workers before the replacement, is ready, and returns it. The zone must be locked, so that the new
+// worker is the replacement.
+func (runner *Runner) waitForReplacement(ctx context.Context, cluster string, replaced *kubernetesserviceapiv1.GetWorkerResponse, before map[string]bool, update bool) (ready *kubernetesserviceapiv1.GetWorkerResponse, err error) {
+	replacedID := core.StringNilMapper(replaced.ID)
+	err = common.Poll(ctx, &runner.options.Wait, func(ctx context.Context) (bool, error) {
+		workers, err := runner.listPool(ctx, cluster, core.StringNilMapper(replaced.PoolID))
+		if err != nil {
+			return false, err
+		}
+		for _, worker := range workers {
+			if core.StringNilMapper(worker.ID) == replacedID {
+				return false, nil
+			}
+		}
+
+		var terminalErr error
+		for i := range workers {
+			worker := &workers[i]
+			id := core.StringNilMapper(worker.ID)
+			if before[id] || core.StringNilMapper(worker.Location) != core.StringNilMapper(replaced.Location) {
+				continue
+			}
+			done, _ := kubernetesserviceapiv1.WorkerReady(worker)
+			if done && update {
+				done, _ = atTargetVersion(worker)
+			}
+			if done {
+				ready = worker
+				return true, nil
+			}
+			if terminalErr == nil && worker.Lifecycle != nil {
+				switch state := core.StringNilMapper(worker.Lifecycle.ActualState); state {
+				case kubernetesserviceapiv1.WorkerStateDeployFailed, kubernetesserviceapiv1.WorkerStateProvisionFailed:
+					terminalErr = &kubernetesserviceapiv1.TerminalStateError{Resource: "worker", ID: id, Field: "actualState", State: state}
+				}
+			}
+		}
+		return false, terminalErr
+	})
+	return
+}
+
+// readWorker reads the worker of cluster with VpcGetWorker if vpc, GetWorker otherwise.
+func (runner *Runner) readWorker(ctx context.Context, cluster string, worker string, vpc bool) (result *kubernetesserviceapiv1.GetWorkerResponse, err error) {
+	if vpc {
+		vpcGetWorkerOptions := runner.service.NewVpcGetWorkerOptions(cluster, worker)
+		vpcGetWorkerOptions.XAuthResourceGroup = runner.options.XAuthResourceGroup
+		result, _, err = runner.service.VpcGetWorkerWithContext(ctx, vpcGetWorkerOptions)
+		return
+	}
+	getWorkerOptions := runner.service.NewGetWorkerOptions(cluster, worker)
+	getWorkerOptions.XAuthResourceGroup = runner.options.XAuthResourceGroup
+	result, _, err = runner.service.GetWorkerWithContext(ctx, getWorkerOptions)
+	return
+}
+
+// listPool lists the workers of pool with VpcGetWorkers.
+func (runner *Runner) listPool(ctx context.Context, cluster string, pool string) ([]kubernetesserviceapiv1.GetWorkerResponse, error) {
+	vpcGetWorkersOptions := runner.service.NewVpcGetWorkersOptions(cluster)
+	vpcGetWorkersOptions.SetPool(pool)
+	vpcGetWorkersOptions.XAuthResourceGroup = runner.options.XAuthResourceGroup
+	workers, _, err := runner.service.VpcGetWorkersWithContext(ctx, vpcGetWorkersOptions)
+	if err != nil {
+		return nil, fmt.Errorf("workerops: reading the workers of worker pool %s of cluster %s: %w", pool, cluster, err)
+	}
+	return workers, nil
+}
+
+// atTargetVersion is satisfied when the worker runs the version it is being updated to.
+func atTargetVersion(worker *kubernetesserviceapiv1.GetWorkerResponse) (bool, error) {
+	if worker.KubeVersion == nil {
+		return false, nil
+	}
+	actual, target := core.StringNilMapper(worker.KubeVersion.Actual), core.StringNilMapper(worker.KubeVersion.Target)
+	return target == "" || common.CompareVersions(actual, target) >= 0, nil
+}
+
+// withTimeout returns ctx with the deadline timeout, if positive.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,266 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workerops_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/workerops"
+)
+
+// recorder : Hooks recording the workers passed to them, and failing with before and after.
+type recorder struct {
+	mutex  sync.Mutex
+	calls  []string
+	before error
+	after  error
+}
+
+func (r *recorder) record(call string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+func (r *recorder) BeforeWorkerDisruption(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error {
+	r.record("before " + *worker.ID)
+	return r.before
+}
+
+func (r *recorder) AfterWorkerReady(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error {
+	r.record("after " + *worker.ID)
+	return r.after
+}
+
+var _ = Describe(`Runner`, func() {
+	var (
+		server  *fake.Server
+		service *kubernetesserviceapiv1.KubernetesServiceApiV1
+		ctx     = context.Background()
+		wait    = common.WaitOptions{Interval: 5 * time.Millisecond}
+	)
+
+	// workers returns the workers of the cluster c1.
+	var workers = func() []kubernetesserviceapiv1.GetWorkerResponse {
+		workers, _, err := service.GetWorkers1(service.NewGetWorkers1Options("c1"))
+		Expect(err).To(BeNil())
+		return workers
+	}
+
+	// requestCount returns the number of requests sent to path.
+	var requestCount = func(path string) int {
+		count := 0
+		for _, request := range server.Requests() {
+			if request.Path == path {
+				count++
+			}
+		}
+		return count
+	}
+
+	// createCluster creates the VPC cluster c1 with a worker pool of two workers per zone, or the classic cluster
+	// c1 with two workers, and waits for its master and its workers to be ready.
+	var createCluster = func(vpc bool) {
+		if vpc {
+			options := service.NewVpcCreateClusterOptions("rg1")
+			options.Name = core.StringPtr("c1")
+			options.WorkerPool = &kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+				Flavor:      core.StringPtr("bx2.4x16"),
+				WorkerCount: core.Int64Ptr(2),
+				Zones: []kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{
+					{ID: core.StringPtr("us-south-1"), SubnetID: core.StringPtr("subnet-1")},
+				},
+			}
+			_, _, err := service.VpcCreateCluster(options)
+			Expect(err).To(BeNil())
+		} else {
+			options := service.NewCreateClusterOptions("rg1")
+			options.Name = core.StringPtr("c1")
+			options.DataCenter = core.StringPtr("dal10")
+			options.MachineType = core.StringPtr("b3c.4x16")
+			options.WorkerNum = core.Int64Ptr(2)
+			_, _, err := service.CreateCluster(options)
+			Expect(err).To(BeNil())
+		}
+		_, err := service.WaitForClusterState(ctx, "c1", kubernetesserviceapiv1.ClusterMasterReady, &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: wait})
+		Expect(err).To(BeNil())
+		_, err = service.WaitForWorkers(ctx, "c1", kubernetesserviceapiv1.WorkerReady, &kubernetesserviceapiv1.WorkersWaitOptions{WaitOptions: wait})
+		Expect(err).To(BeNil())
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		var err error
+		service, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Replaces workers between the hooks and returns their replacements`, func() {
+		createCluster(true)
+		updateClusterOptions := service.NewUpdateClusterOptions("c1")
+		updateClusterOptions.SetAction(kubernetesserviceapiv1.UpdateClusterOptions_Action_Update)
+		updateClusterOptions.SetVersion("1.30.2")
+		_, err := service.UpdateCluster(updateClusterOptions)
+		Expect(err).To(BeNil())
+		_, err = service.WaitForClusterState(ctx, "c1", kubernetesserviceapiv1.ClusterMasterVersion("1.30.2"), &kubernetesserviceapiv1.ClusterWaitOptions{WaitOptions: wait})
+		Expect(err).To(BeNil())
+
+		hooks := &recorder{}
+		runner := workerops.NewRunner(service, &workerops.Options{Hooks: hooks, Wait: wait})
+		old := workers()
+		Expect(old).To(HaveLen(2))
+
+		var wg sync.WaitGroup
+		replacements := make([]*kubernetesserviceapiv1.GetWorkerResponse, 2)
+		for i := range old {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				var err error
+				operation := workerops.OperationReplace
+				if i == 0 {
+					operation = workerops.OperationReplaceAndUpdate
+				}
+				replacements[i], err = runner.Run(ctx, "c1", *old[i].ID, operation)
+				Expect(err).To(BeNil())
+			}(i)
+		}
+		wg.Wait()
+
+		Expect(*replacements[0].ID).ToNot(Equal(*replacements[1].ID))
+		Expect(*replacements[0].KubeVersion.Actual).To(Equal("1.30.2"))
+		Expect(*replacements[1].KubeVersion.Actual).To(Equal(fake.DefaultKubeVersion))
+		for i, replacement := range replacements {
+			Expect(*replacement.ID).ToNot(Equal(*old[i].ID))
+			Expect(*replacement.Location).To(Equal("us-south-1"))
+			Expect(hooks.calls).To(ContainElement("before " + *old[i].ID))
+			Expect(hooks.calls).To(ContainElement("after " + *replacement.ID))
+		}
+		Expect(workers()).To(HaveLen(2))
+		Expect(requestCount("/v2/vpc/replaceWorker")).To(Equal(2))
+	})
+
+	It(`Reloads a worker in place and stops when a hook fails`, func() {
+		createCluster(false)
+		worker := *workers()[0].ID
+
+		hooks := &recorder{}
+		runner := workerops.NewRunner(service, &workerops.Options{Hooks: hooks, Wait: wait})
+		ready, err := runner.Run(ctx, "c1", worker, workerops.OperationReload)
+		Expect(err).To(BeNil())
+		Expect(*ready.ID).To(Equal(worker))
+		Expect(*ready.Lifecycle.ActualState).To(Equal(kubernetesserviceapiv1.WorkerStateDeployed))
+		Expect(hooks.calls).To(Equal([]string{"before " + worker, "after " + worker}))
+		Expect(requestCount("/v1/clusters/c1/workers/" + worker)).To(Equal(1))
+
+		hooks.before = errors.New("the pod disruption budget of app1 does not allow evictions")
+		_, err = runner.Run(ctx, "c1", worker, workerops.OperationReboot)
+		var hookError *workerops.HookError
+		Expect(errors.As(err, &hookError)).To(BeTrue())
+		Expect(hookError.Hook).To(Equal("BeforeWorkerDisruption"))
+		Expect(hookError.Worker).To(Equal(worker))
+		Expect(errors.Is(err, hooks.before)).To(BeTrue())
+		Expect(requestCount("/v1/clusters/c1/workers/" + worker)).To(Equal(1))
+
+		hooks.before = nil
+		hooks.after = errors.New("the worker is not schedulable")
+		ready, err = runner.Run(ctx, "c1", worker, workerops.OperationReboot)
+		Expect(err).To(MatchError("workerops: AfterWorkerReady hook of worker " + worker + " failed: the worker is not schedulable"))
+		Expect(*ready.ID).To(Equal(worker))
+		Expect(requestCount("/v1/clusters/c1/workers/" + worker)).To(Equal(2))
+	})
+
+	It(`Applies the timeout policy to the hooks`, func() {
+		createCluster(false)
+		worker := *workers()[0].ID
+		drain := workerops.HookFuncs{
+			BeforeWorkerDisruptionFunc: func(ctx context.Context, worker *kubernetesserviceapiv1.GetWorkerResponse) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		}
+
+		runner := workerops.NewRunner(service, &workerops.Options{
+			Hooks:    drain,
+			Timeouts: workerops.TimeoutPolicy{BeforeDisruption: 10 * time.Millisecond},
+			Wait:     wait,
+		})
+		_, err := runner.Run(ctx, "c1", worker, workerops.OperationReload)
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(requestCount("/v1/clusters/c1/workers/" + worker)).To(Equal(0))
+
+		runner = workerops.NewRunner(service, &workerops.Options{
+			Hooks:    drain,
+			Timeouts: workerops.TimeoutPolicy{BeforeDisruption: 10 * time.Millisecond, ProceedAfterTimeout: true},
+			Wait:     wait,
+		})
+		_, err = runner.Run(ctx, "c1", worker, workerops.OperationReload)
+		Expect(err).To(BeNil())
+		Expect(requestCount("/v1/clusters/c1/workers/" + worker)).To(Equal(1))
+
+		server.InjectFault(fake.Fault{Method: "GET", Path: "/v2/getWorker", Latency: 100 * time.Millisecond})
+		runner = workerops.NewRunner(service, &workerops.Options{
+			Timeouts: workerops.TimeoutPolicy{Ready: 30 * time.Millisecond},
+			Wait:     wait,
+		})
+		_, err = runner.Run(ctx, "c1", worker, workerops.OperationReload)
+		Expect(errors.Is(err, common.ErrWaitTimeout)).To(BeTrue())
+	})
+
+	It(`Fails the replacements of a zone whose replacement is not found in time`, func() {
+		createCluster(true)
+		old := workers()
+		server.InjectFault(fake.Fault{Method: "GET", Path: "/v2/vpc/getWorkers", Latency: 50 * time.Millisecond})
+		runner := workerops.NewRunner(service, &workerops.Options{
+			Timeouts: workerops.TimeoutPolicy{Ready: 20 * time.Millisecond},
+			Wait:     wait,
+		})
+
+		var wg sync.WaitGroup
+		errs := make([]error, len(old))
+		for i := range old {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = runner.Run(ctx, "c1", *old[i].ID, workerops.OperationReplace)
+			}(i)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			Expect(errors.Is(err, common.ErrWaitTimeout)).To(BeTrue())
+		}
+		Expect(requestCount("/v2/vpc/replaceWorker")).To(Equal(2))
+	})
+})
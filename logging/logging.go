@@ -0,0 +1,216 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package logging logs the operations of the service clients as structured records with their secrets redacted.
+//
+// Unlike the debug logging of the core library, which dumps the requests and responses as sent, a Logger emits one
+// Record per operation, with the secret headers and JSON fields listed by common.SecretHeaders and
+// common.SecretFields replaced by common.Redacted. Bodies that are not JSON, such as the multipart forms uploading
+// certificates, are logged by size only. A Logger is added to a client as an interceptor:
+//
+//	logger := logging.NewLogger(&logging.Options{Writer: os.Stderr})
+//	kubernetesServiceApi.AddInterceptor(logger.Intercept)
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// DefaultMaxBodyLength is the length the bodies are truncated to when Options.MaxBodyLength is not set.
+const DefaultMaxBodyLength = 2048
+
+// Record : The structured record of an operation.
+type Record struct {
+	// When the request was sent.
+	Time time.Time `json:"time"`
+
+	// The name of the service, such as "kubernetes_service_api".
+	ServiceName string `json:"service,omitempty"`
+
+	// The ID of the operation, such as "GetCluster".
+	OperationID string `json:"operation"`
+
+	// The HTTP method of the request.
+	Method string `json:"method"`
+
+	// The URL of the request.
+	URL string `json:"url"`
+
+	// The headers of the request, redacted.
+	RequestHeader http.Header `json:"request_header,omitempty"`
+
+	// The body of the request, redacted and truncated.
+	RequestBody string `json:"request_body,omitempty"`
+
+	// The status code of the response, or 0 if there was none.
+	StatusCode int `json:"status,omitempty"`
+
+	// The time until the response, in milliseconds.
+	DurationMS float64 `json:"duration_ms"`
+
+	// The ID the service assigned to the request, from the X-Request-ID or X-Correlation-ID response header.
+	RequestID string `json:"request_id,omitempty"`
+
+	// The body of the response, redacted and truncated.
+	ResponseBody string `json:"response_body,omitempty"`
+
+	// The error of the operation, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Options : The options of NewLogger.
+type Options struct {
+	// The function the records are passed to. Defaults to writing them as JSON lines to Writer.
+	Handler func(record Record)
+
+	// The writer of the JSON lines when Handler is not set. Defaults to os.Stderr.
+	Writer io.Writer
+
+	// The length the bodies are truncated to. Defaults to DefaultMaxBodyLength; negative to log no bodies.
+	MaxBodyLength int
+
+	// Headers to redact in addition to common.SecretHeaders.
+	RedactHeaders []string
+
+	// JSON fields to redact in addition to common.SecretFields.
+	RedactFields []string
+}
+
+// Logger : Logs the operations of the clients it is added to.
+type Logger struct {
+	options  Options
+	redactor *common.Redactor
+
+	mutex sync.Mutex
+}
+
+// NewLogger returns a Logger configured by options, which may be nil.
+func NewLogger(options *Options) *Logger {
+	logger := &Logger{}
+	if options != nil {
+		logger.options = *options
+	}
+	if logger.options.Writer == nil {
+		logger.options.Writer = os.Stderr
+	}
+	if logger.options.MaxBodyLength == 0 {
+		logger.options.MaxBodyLength = DefaultMaxBodyLength
+	}
+	logger.redactor = common.NewRedactor(logger.options.RedactHeaders, logger.options.RedactFields)
+	return logger
+}
+
+// Intercept is a common.Interceptor logging the operation operationID once it completes.
+func (logger *Logger) Intercept(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+	operation, _ := common.OperationFromContext(request.Context())
+	record := Record{
+		Time:          time.Now(),
+		ServiceName:   operation.ServiceName,
+		OperationID:   operationID,
+		Method:        request.Method,
+		URL:           request.URL.String(),
+		RequestHeader: logger.redactor.Header(request.Header),
+	}
+	if request.Body != nil && request.GetBody != nil {
+		if body, err := request.GetBody(); err == nil {
+			contents, _ := ioutil.ReadAll(body)
+			body.Close()
+			record.RequestBody = logger.body(contents, request.Header.Get("Content-Type"))
+		}
+	}
+
+	response, err := next(request)
+	record.DurationMS = float64(time.Since(record.Time)) / float64(time.Millisecond)
+	if response != nil {
+		record.StatusCode = response.StatusCode
+		record.RequestID = response.Headers.Get("X-Request-ID")
+		if record.RequestID == "" {
+			record.RequestID = response.Headers.Get("X-Correlation-ID")
+		}
+		record.ResponseBody = logger.responseBody(response)
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	logger.emit(record)
+	return response, err
+}
+
+// emit passes record to the handler.
+func (logger *Logger) emit(record Record) {
+	if logger.options.Handler != nil {
+		logger.options.Handler(record)
+		return
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+	logger.options.Writer.Write(append(line, '\n'))
+}
+
+// responseBody returns the body of response as logged.
+func (logger *Logger) responseBody(response *core.DetailedResponse) string {
+	switch result := response.Result.(type) {
+	case nil:
+		return logger.body(response.RawResult, response.Headers.Get("Content-Type"))
+	case io.Reader:
+		return "(stream)"
+	default:
+		contents, err := json.Marshal(result)
+		if err != nil {
+			return ""
+		}
+		return logger.body(contents, "application/json")
+	}
+}
+
+// body returns a body of contentType as logged: redacted and truncated if it is JSON, its size otherwise.
+func (logger *Logger) body(contents []byte, contentType string) string {
+	if logger.options.MaxBodyLength < 0 || len(contents) == 0 {
+		return ""
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "" {
+		mediaType = "unknown content"
+	}
+	if mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json") {
+		return fmt.Sprintf("(%d bytes of %s)", len(contents), mediaType)
+	}
+	redacted, ok := logger.redactor.JSON(contents)
+	if !ok {
+		return fmt.Sprintf("(%d bytes of invalid JSON)", len(contents))
+	}
+	if len(redacted) > logger.options.MaxBodyLength {
+		return fmt.Sprintf("%s...(%d bytes truncated)", redacted[:logger.options.MaxBodyLength], len(redacted)-logger.options.MaxBodyLength)
+	}
+	return string(redacted)
+}
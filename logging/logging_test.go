@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	kubefake "github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/logging"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	linkfake "github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1/fake"
+)
+
+const testCert = "-----BEGIN CERTIFICATE-----\nMIIB-secret\n-----END CERTIFICATE-----\n"
+
+var _ = Describe(`Logger`, func() {
+	Describe(`With the Kubernetes Service API client`, func() {
+		var server *kubefake.Server
+		var service *kubernetesserviceapiv1.KubernetesServiceApiV1
+
+		BeforeEach(func() {
+			server = kubefake.NewServer(nil)
+			authenticator, err := core.NewBearerTokenAuthenticator("secret-token")
+			Expect(err).To(BeNil())
+			service, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+				URL:           server.URL,
+				Authenticator: authenticator,
+			})
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It(`Writes one redacted JSON line per operation`, func() {
+			var out bytes.Buffer
+			service.AddInterceptor(logging.NewLogger(&logging.Options{Writer: &out}).Intercept)
+
+			service.StoreUserCredentials(service.NewStoreUserCredentialsOptions("us-south", "secret-refresh-token", "user", "secret-api-key"))
+			_, _, err := service.GetCluster(service.NewGetClusterOptions("missing"))
+			Expect(err).ToNot(BeNil())
+
+			Expect(out.String()).ToNot(ContainSubstring("secret"))
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+
+			var record logging.Record
+			Expect(json.Unmarshal([]byte(lines[0]), &record)).To(Succeed())
+			Expect(record.ServiceName).To(Equal("kubernetes_service_api"))
+			Expect(record.OperationID).To(Equal("StoreUserCredentials"))
+			Expect(record.RequestHeader.Get("X-Auth-Refresh-Token")).To(Equal(common.Redacted))
+			Expect(record.RequestHeader["X-Auth-Softlayer-APIKey"]).To(Equal([]string{common.Redacted}))
+			Expect(record.RequestHeader.Get("X-Auth-Softlayer-Username")).To(Equal("user"))
+
+			Expect(json.Unmarshal([]byte(lines[1]), &record)).To(Succeed())
+			Expect(record.OperationID).To(Equal("GetCluster"))
+			Expect(record.Method).To(Equal("GET"))
+			Expect(record.URL).To(HavePrefix(server.URL))
+			Expect(record.StatusCode).To(Equal(404))
+			Expect(record.ResponseBody).To(ContainSubstring(`"code":"E0040"`))
+			Expect(record.Error).To(Equal(err.Error()))
+		})
+
+		It(`Truncates the bodies`, func() {
+			var records []logging.Record
+			service.AddInterceptor(logging.NewLogger(&logging.Options{
+				Handler:       func(record logging.Record) { records = append(records, record) },
+				MaxBodyLength: 10,
+			}).Intercept)
+			_, _, err := service.GetCluster(service.NewGetClusterOptions("missing"))
+			Expect(err).ToNot(BeNil())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].ResponseBody).To(MatchRegexp(`^.{10}\.\.\.\(\d+ bytes truncated\)$`))
+		})
+	})
+
+	Describe(`With the Satellite Link client`, func() {
+		It(`Redacts the certificates and keys of the endpoints`, func() {
+			server := linkfake.NewServer(nil)
+			defer server.Close()
+			service, err := satellitelinkv1.NewSatelliteLinkV1(&satellitelinkv1.SatelliteLinkV1Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+			var records []logging.Record
+			service.AddInterceptor(logging.NewLogger(&logging.Options{Handler: func(record logging.Record) {
+				records = append(records, record)
+			}}).Intercept)
+
+			_, _, err = service.CreateLink(service.NewCreateLinkOptions().SetLocationID("loc1"))
+			Expect(err).To(BeNil())
+			options := service.NewCreateEndpointsOptions("loc1")
+			options.ConnType = core.StringPtr(satellitelinkv1.CreateEndpointsOptions_ConnType_Location)
+			options.DisplayName = core.StringPtr("endpoint1")
+			options.ServerHost = core.StringPtr("example.com")
+			options.ServerPort = core.Int64Ptr(443)
+			options.ClientProtocol = core.StringPtr(satellitelinkv1.CreateEndpointsOptions_ClientProtocol_Tls)
+			options.Certs = &satellitelinkv1.AdditionalNewEndpointRequestCerts{
+				Server: &satellitelinkv1.AdditionalNewEndpointRequestCertsServer{
+					Cert: &satellitelinkv1.AdditionalNewEndpointRequestCertsServerCert{FileContents: core.StringPtr(testCert)},
+				},
+			}
+			endpoint, _, err := service.CreateEndpoints(options)
+			Expect(err).To(BeNil())
+			uploadOptions := service.NewUploadEndpointCertsOptions("loc1", *endpoint.EndpointID)
+			uploadOptions.ClientCert = ioutil.NopCloser(bytes.NewReader([]byte(testCert)))
+			_, _, err = service.UploadEndpointCerts(uploadOptions)
+			Expect(err).To(BeNil())
+
+			Expect(records).To(HaveLen(3))
+			Expect(records[1].RequestBody).To(ContainSubstring(`"file_contents":"REDACTED"`))
+			Expect(records[2].RequestBody).To(HavePrefix("("))
+			for _, record := range records {
+				Expect(record.RequestBody + record.ResponseBody).ToNot(ContainSubstring("MIIB"))
+			}
+		})
+	})
+})
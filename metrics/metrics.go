@@ -0,0 +1,345 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics records metrics of the operations of the service clients and renders them in the Prometheus text
+// exposition format.
+//
+// A Registry counts the requests, errors and retries of every operation and the distribution of their latency,
+// labelled by service and operation ID. It is added to clients as an interceptor, and optionally installed on their
+// core.BaseService to count the retries of the requests. One Registry can collect the metrics of several clients:
+//
+//	registry := metrics.NewRegistry(nil)
+//	kubernetesServiceApi.AddInterceptor(registry.Intercept)
+//	registry.Install(kubernetesServiceApi.Service)
+//	satelliteLink.AddInterceptor(registry.Intercept)
+//	registry.Install(satelliteLink.Service)
+//	http.Handle("/metrics", registry)
+package metrics
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// DefaultNamespace is the prefix of the names of the metrics unless Options.Namespace is set.
+const DefaultNamespace = "ibm_sdk"
+
+// DefaultBuckets are the upper bounds in seconds of the buckets of the latency histograms unless Options.Buckets
+// is set.
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// StatusClassNone is the status class of the errors without a response, such as network errors.
+const StatusClassNone = "none"
+
+// Options : The options of NewRegistry.
+type Options struct {
+	// The prefix of the names of the metrics. Defaults to DefaultNamespace.
+	Namespace string
+
+	// The upper bounds in seconds of the buckets of the latency histograms, in increasing order. Defaults to
+	// DefaultBuckets.
+	Buckets []float64
+}
+
+// Registry : Records the metrics of the operations of the clients it is added to.
+type Registry struct {
+	options Options
+
+	mutex      sync.Mutex
+	operations map[operationKey]*operationMetrics
+}
+
+// operationKey identifies the metrics of an operation.
+type operationKey struct {
+	serviceName string
+	operationID string
+}
+
+// operationMetrics are the metrics of an operation as recorded.
+type operationMetrics struct {
+	requests int64
+	retries  int64
+	errors   map[ErrorLabels]int64
+	buckets  []int64
+	count    int64
+	sum      float64
+}
+
+// ErrorLabels : The labels of the errors of an operation.
+type ErrorLabels struct {
+	// The class of the status code of the response, such as "4xx" or "5xx", or StatusClassNone.
+	StatusClass string
+
+	// The code of the API error, such as "E0040", or empty if the response did not have one.
+	Code string
+}
+
+// OperationMetrics : The metrics of an operation.
+type OperationMetrics struct {
+	// The name of the service, such as "kubernetes_service_api".
+	ServiceName string
+
+	// The ID of the operation, such as "GetCluster".
+	OperationID string
+
+	// The number of requests of the operation.
+	Requests int64
+
+	// The number of attempts to send the requests after the first ones, once the Registry is installed.
+	Retries int64
+
+	// The number of failed requests by labels.
+	Errors map[ErrorLabels]int64
+
+	// The distribution of the latency of the requests.
+	Latency Histogram
+}
+
+// Histogram : A distribution of durations in seconds.
+type Histogram struct {
+	// The upper bounds of the buckets.
+	Bounds []float64
+
+	// The number of durations of at most the bound of each bucket.
+	Counts []int64
+
+	// The number of durations.
+	Count int64
+
+	// The sum of the durations.
+	Sum float64
+}
+
+// NewRegistry returns a Registry configured by options, which may be nil.
+func NewRegistry(options *Options) *Registry {
+	registry := &Registry{operations: map[operationKey]*operationMetrics{}}
+	if options != nil {
+		registry.options = *options
+	}
+	if registry.options.Namespace == "" {
+		registry.options.Namespace = DefaultNamespace
+	}
+	if registry.options.Buckets == nil {
+		registry.options.Buckets = DefaultBuckets
+	}
+	return registry
+}
+
+// Intercept is a common.Interceptor recording the metrics of the operation operationID.
+func (registry *Registry) Intercept(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+	operation, _ := common.OperationFromContext(request.Context())
+	ctx, counter := common.WithAttemptCounter(request.Context())
+
+	start := time.Now()
+	response, err := next(request.WithContext(ctx))
+	elapsed := time.Since(start).Seconds()
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	key := operationKey{serviceName: operation.ServiceName, operationID: operationID}
+	metrics := registry.operations[key]
+	if metrics == nil {
+		metrics = &operationMetrics{errors: map[ErrorLabels]int64{}, buckets: make([]int64, len(registry.options.Buckets))}
+		registry.operations[key] = metrics
+	}
+	metrics.requests++
+	metrics.retries += counter.Retries()
+	if err != nil {
+		metrics.errors[errorLabels(response, err)]++
+	}
+	for i, bound := range registry.options.Buckets {
+		if elapsed <= bound {
+			metrics.buckets[i]++
+		}
+	}
+	metrics.count++
+	metrics.sum += elapsed
+	return response, err
+}
+
+// Install makes the client of service count the attempts to send the requests, for the retries of the operations,
+// as common.CountAttempts does.
+func (registry *Registry) Install(service *core.BaseService) {
+	common.CountAttempts(service)
+}
+
+// Operations returns the metrics of the operations recorded, ordered by service name and operation ID.
+func (registry *Registry) Operations() []OperationMetrics {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	operations := make([]OperationMetrics, 0, len(registry.operations))
+	for key, metrics := range registry.operations {
+		errs := make(map[ErrorLabels]int64, len(metrics.errors))
+		for labels, count := range metrics.errors {
+			errs[labels] = count
+		}
+		operations = append(operations, OperationMetrics{
+			ServiceName: key.serviceName,
+			OperationID: key.operationID,
+			Requests:    metrics.requests,
+			Retries:     metrics.retries,
+			Errors:      errs,
+			Latency: Histogram{
+				Bounds: append([]float64(nil), registry.options.Buckets...),
+				Counts: append([]int64(nil), metrics.buckets...),
+				Count:  metrics.count,
+				Sum:    metrics.sum,
+			},
+		})
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].ServiceName != operations[j].ServiceName {
+			return operations[i].ServiceName < operations[j].ServiceName
+		}
+		return operations[i].OperationID < operations[j].OperationID
+	})
+	return operations
+}
+
+// WriteText writes the metrics in the Prometheus text exposition format to w.
+func (registry *Registry) WriteText(w io.Writer) error {
+	operations := registry.Operations()
+	namespace := registry.options.Namespace
+	var b strings.Builder
+
+	writeHeader(&b, namespace+"_requests_total", "counter", "Number of requests of the operations.")
+	for _, operation := range operations {
+		writeSample(&b, namespace+"_requests_total", operationLabels(operation), float64(operation.Requests))
+	}
+
+	writeHeader(&b, namespace+"_errors_total", "counter", "Number of failed requests of the operations, by status class and API error code.")
+	for _, operation := range operations {
+		labels := make([]ErrorLabels, 0, len(operation.Errors))
+		for label := range operation.Errors {
+			labels = append(labels, label)
+		}
+		sort.Slice(labels, func(i, j int) bool {
+			if labels[i].StatusClass != labels[j].StatusClass {
+				return labels[i].StatusClass < labels[j].StatusClass
+			}
+			return labels[i].Code < labels[j].Code
+		})
+		for _, label := range labels {
+			pairs := append(operationLabels(operation), "status_class", label.StatusClass, "code", label.Code)
+			writeSample(&b, namespace+"_errors_total", pairs, float64(operation.Errors[label]))
+		}
+	}
+
+	writeHeader(&b, namespace+"_retries_total", "counter", "Number of retried attempts to send the requests of the operations.")
+	for _, operation := range operations {
+		writeSample(&b, namespace+"_retries_total", operationLabels(operation), float64(operation.Retries))
+	}
+
+	writeHeader(&b, namespace+"_request_duration_seconds", "histogram", "Latency of the requests of the operations, retries included.")
+	for _, operation := range operations {
+		name := namespace + "_request_duration_seconds"
+		for i, bound := range operation.Latency.Bounds {
+			pairs := append(operationLabels(operation), "le", formatFloat(bound))
+			writeSample(&b, name+"_bucket", pairs, float64(operation.Latency.Counts[i]))
+		}
+		pairs := append(operationLabels(operation), "le", "+Inf")
+		writeSample(&b, name+"_bucket", pairs, float64(operation.Latency.Count))
+		writeSample(&b, name+"_sum", operationLabels(operation), operation.Latency.Sum)
+		writeSample(&b, name+"_count", operationLabels(operation), float64(operation.Latency.Count))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ServeHTTP serves the metrics in the Prometheus text exposition format.
+func (registry *Registry) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	res.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	registry.WriteText(res)
+}
+
+// operationLabels returns the label pairs identifying operation.
+func operationLabels(operation OperationMetrics) []string {
+	return []string{"service", operation.ServiceName, "operation", operation.OperationID}
+}
+
+// writeHeader writes the HELP and TYPE lines of the metric name.
+func writeHeader(b *strings.Builder, name string, kind string, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// writeSample writes a sample of the metric name with the label pairs.
+func writeSample(b *strings.Builder, name string, pairs []string, value float64) {
+	b.WriteString(name)
+	b.WriteByte('{')
+	for i := 0; i < len(pairs); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(b, "%s=\"%s\"", pairs[i], escapeLabel(pairs[i+1]))
+	}
+	b.WriteString("} ")
+	b.WriteString(formatFloat(value))
+	b.WriteByte('\n')
+}
+
+// escapeLabel escapes a label value for the text exposition format.
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// formatFloat formats a sample value for the text exposition format.
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// errorLabels returns the labels of the error err of an operation with response.
+func errorLabels(response *core.DetailedResponse, err error) ErrorLabels {
+	labels := ErrorLabels{StatusClass: StatusClassNone}
+	if response == nil || response.StatusCode == 0 {
+		return labels
+	}
+	labels.StatusClass = fmt.Sprintf("%dxx", response.StatusCode/100)
+
+	var coder interface{ Code() string }
+	if errors.As(err, &coder) {
+		labels.Code = coder.Code()
+		return labels
+	}
+	result, ok := response.Result.(map[string]interface{})
+	if !ok && len(response.RawResult) > 0 {
+		json.Unmarshal(response.RawResult, &result)
+	}
+	if code, ok := result["code"].(string); ok {
+		labels.Code = code
+	} else if errs, ok := result["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			labels.Code, _ = first["code"].(string)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	kubefake "github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/metrics"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	linkfake "github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1/fake"
+)
+
+var _ = Describe(`Registry`, func() {
+	var kubeServer *kubefake.Server
+	var linkServer *linkfake.Server
+	var kubernetesServiceApi *kubernetesserviceapiv1.KubernetesServiceApiV1
+	var satelliteLink *satellitelinkv1.SatelliteLinkV1
+	var registry *metrics.Registry
+
+	BeforeEach(func() {
+		kubeServer = kubefake.NewServer(nil)
+		linkServer = linkfake.NewServer(nil)
+		var err error
+		kubernetesServiceApi, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           kubeServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		satelliteLink, err = satellitelinkv1.NewSatelliteLinkV1(&satellitelinkv1.SatelliteLinkV1Options{
+			URL:           linkServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		registry = metrics.NewRegistry(&metrics.Options{Buckets: []float64{0.5, 60}})
+		kubernetesServiceApi.EnableRetries(2, 10*time.Millisecond)
+		kubernetesServiceApi.AddInterceptor(registry.Intercept)
+		registry.Install(kubernetesServiceApi.Service)
+		satelliteLink.AddInterceptor(registry.Intercept)
+		registry.Install(satelliteLink.Service)
+	})
+	AfterEach(func() {
+		kubeServer.Close()
+		linkServer.Close()
+	})
+
+	It(`Collects the metrics of the operations of both clients`, func() {
+		kubeServer.InjectFault(kubefake.Fault{Path: "/v2/vpc/getClusters", StatusCode: 429, RetryAfter: "0", Count: 1})
+		_, _, err := kubernetesServiceApi.VpcGetClusters(kubernetesServiceApi.NewVpcGetClustersOptions())
+		Expect(err).To(BeNil())
+		_, _, err = kubernetesServiceApi.GetCluster(kubernetesServiceApi.NewGetClusterOptions("missing"))
+		Expect(err).ToNot(BeNil())
+		_, _, err = satelliteLink.GetLink(satelliteLink.NewGetLinkOptions("missing"))
+		Expect(err).ToNot(BeNil())
+
+		operations := registry.Operations()
+		Expect(operations).To(HaveLen(3))
+		Expect(operations[0].ServiceName).To(Equal("kubernetes_service_api"))
+		Expect(operations[0].OperationID).To(Equal("GetCluster"))
+		Expect(operations[0].Errors).To(Equal(map[metrics.ErrorLabels]int64{{StatusClass: "4xx", Code: "E0040"}: 1}))
+
+		Expect(operations[1].OperationID).To(Equal("VpcGetClusters"))
+		Expect(operations[1].Requests).To(Equal(int64(1)))
+		Expect(operations[1].Retries).To(Equal(int64(1)))
+		Expect(operations[1].Errors).To(BeEmpty())
+		Expect(operations[1].Latency.Count).To(Equal(int64(1)))
+		Expect(operations[1].Latency.Counts).To(Equal([]int64{1, 1}))
+
+		Expect(operations[2].ServiceName).To(Equal("satellite_link"))
+		Expect(operations[2].OperationID).To(Equal("GetLink"))
+		Expect(operations[2].Errors).To(Equal(map[metrics.ErrorLabels]int64{{StatusClass: "4xx", Code: "not_found"}: 1}))
+	})
+
+	It(`Renders the metrics in the Prometheus text format`, func() {
+		_, _, err := kubernetesServiceApi.VpcGetClusters(kubernetesServiceApi.NewVpcGetClustersOptions())
+		Expect(err).To(BeNil())
+		_, _, err = satelliteLink.GetLink(satelliteLink.NewGetLinkOptions("missing"))
+		Expect(err).ToNot(BeNil())
+
+		recorder := httptest.NewRecorder()
+		registry.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+		Expect(recorder.Header().Get("Content-Type")).To(HavePrefix("text/plain; version=0.0.4"))
+		lines := strings.Split(recorder.Body.String(), "\n")
+		Expect(lines).To(ContainElements(
+			"# TYPE ibm_sdk_requests_total counter",
+			`ibm_sdk_requests_total{service="kubernetes_service_api",operation="VpcGetClusters"} 1`,
+			`ibm_sdk_requests_total{service="satellite_link",operation="GetLink"} 1`,
+			`ibm_sdk_errors_total{service="satellite_link",operation="GetLink",status_class="4xx",code="not_found"} 1`,
+			`ibm_sdk_retries_total{service="kubernetes_service_api",operation="VpcGetClusters"} 0`,
+			"# TYPE ibm_sdk_request_duration_seconds histogram",
+			`ibm_sdk_request_duration_seconds_bucket{service="kubernetes_service_api",operation="VpcGetClusters",le="60"} 1`,
+			`ibm_sdk_request_duration_seconds_bucket{service="kubernetes_service_api",operation="VpcGetClusters",le="+Inf"} 1`,
+			`ibm_sdk_request_duration_seconds_count{service="kubernetes_service_api",operation="VpcGetClusters"} 1`,
+		))
+	})
+})
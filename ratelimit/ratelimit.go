@@ -0,0 +1,245 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ratelimit limits the rate and concurrency of the operations of the service clients.
+//
+// A Limiter holds a token bucket and a limit of requests in flight for the read operations, those sent with GET,
+// HEAD or OPTIONS, and separate ones for the mutating operations. It is added to a client as an interceptor, which
+// Clone copies, so that the clones of a client share its budgets. Throttled responses pause both budgets until the
+// time given by their Retry-After header; installing the Limiter on the core.BaseService of a client also observes
+// the throttled attempts retried by the client:
+//
+//	limiter := ratelimit.NewLimiter(&ratelimit.Options{
+//		Read:  ratelimit.Budget{Rate: 10, Burst: 20, MaxInFlight: 8},
+//		Write: ratelimit.Budget{Rate: 2, MaxInFlight: 2},
+//	})
+//	kubernetesServiceApi.AddInterceptor(limiter.Intercept)
+//	limiter.Install(kubernetesServiceApi.Service)
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// Budget : The limits of a class of operations.
+type Budget struct {
+	// The number of requests per second. Unlimited if 0.
+	Rate float64
+
+	// The number of requests that can be sent at once after an idle period. Defaults to 1.
+	Burst int
+
+	// The maximum number of requests in flight. Unlimited if 0.
+	MaxInFlight int
+}
+
+// Options : The options of NewLimiter.
+type Options struct {
+	// The budget of the read operations, sent with GET, HEAD or OPTIONS.
+	Read Budget
+
+	// The budget of the mutating operations.
+	Write Budget
+}
+
+// Limiter : Limits the operations of the clients it is added to.
+type Limiter struct {
+	read  *bucket
+	write *bucket
+
+	mutex       sync.Mutex
+	pausedUntil time.Time
+}
+
+// NewLimiter returns a Limiter configured by options, which may be nil for no limits.
+func NewLimiter(options *Options) *Limiter {
+	if options == nil {
+		options = &Options{}
+	}
+	return &Limiter{read: newBucket(options.Read), write: newBucket(options.Write)}
+}
+
+// Intercept is a common.Interceptor waiting for the budget of the operation operationID before sending its request.
+// It returns the error of the request context if it is done before then.
+func (limiter *Limiter) Intercept(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+	bucket := limiter.write
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		bucket = limiter.read
+	}
+
+	ctx := request.Context()
+	if err := bucket.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer bucket.release()
+	if err := bucket.take(ctx, limiter.paused); err != nil {
+		return nil, err
+	}
+
+	response, err := next(request)
+	if response != nil {
+		limiter.observe(response.StatusCode, response.Headers)
+	}
+	return response, err
+}
+
+// Install makes the Limiter observe the throttled attempts to send the requests of the client of service, as
+// common.WrapTransport does, so that the attempts retried by the client pause the budgets too.
+func (limiter *Limiter) Install(service *core.BaseService) {
+	common.WrapTransport(service, func(transport http.RoundTripper) http.RoundTripper {
+		return &observingTransport{limiter: limiter, transport: transport}
+	})
+}
+
+// paused returns the time the budgets are paused until.
+func (limiter *Limiter) paused() time.Time {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	return limiter.pausedUntil
+}
+
+// observe pauses the budgets until the time given by the Retry-After header of a throttled response.
+func (limiter *Limiter) observe(statusCode int, header http.Header) {
+	if statusCode != http.StatusTooManyRequests {
+		return
+	}
+	until, ok := common.RetryAfter(header, time.Now())
+	if !ok {
+		return
+	}
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	if until.After(limiter.pausedUntil) {
+		limiter.pausedUntil = until
+	}
+}
+
+// observingTransport : An http.RoundTripper reporting the throttled responses to a Limiter.
+type observingTransport struct {
+	limiter   *Limiter
+	transport http.RoundTripper
+}
+
+// RoundTrip sends req and reports its response to the Limiter.
+func (transport *observingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := transport.transport.RoundTrip(req)
+	if res != nil {
+		transport.limiter.observe(res.StatusCode, res.Header)
+	}
+	return res, err
+}
+
+// bucket : The token bucket and the requests in flight of a Budget.
+type bucket struct {
+	budget   Budget
+	inFlight chan struct{}
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// newBucket returns a full bucket for budget.
+func newBucket(budget Budget) *bucket {
+	if budget.Burst < 1 {
+		budget.Burst = 1
+	}
+	bucket := &bucket{budget: budget, tokens: float64(budget.Burst)}
+	if budget.MaxInFlight > 0 {
+		bucket.inFlight = make(chan struct{}, budget.MaxInFlight)
+	}
+	return bucket
+}
+
+// acquire waits for a request to be allowed in flight.
+func (bucket *bucket) acquire(ctx context.Context) error {
+	if bucket.inFlight == nil {
+		return nil
+	}
+	select {
+	case bucket.inFlight <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release ends a request in flight.
+func (bucket *bucket) release() {
+	if bucket.inFlight != nil {
+		<-bucket.inFlight
+	}
+}
+
+// take waits for a token and until the time returned by paused.
+func (bucket *bucket) take(ctx context.Context, paused func() time.Time) error {
+	delay := bucket.reserve(time.Now())
+	for {
+		if until := time.Until(paused()); until > delay {
+			delay = until
+		}
+		if delay <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+			delay = 0
+		case <-ctx.Done():
+			timer.Stop()
+			bucket.cancel()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve takes a token at now and returns how long to wait until it is available.
+func (bucket *bucket) reserve(now time.Time) time.Duration {
+	if bucket.budget.Rate <= 0 {
+		return 0
+	}
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	if !bucket.last.IsZero() {
+		elapsed := now.Sub(bucket.last).Seconds()
+		bucket.tokens = math.Min(float64(bucket.budget.Burst), bucket.tokens+elapsed*bucket.budget.Rate)
+	}
+	bucket.last = now
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / bucket.budget.Rate * float64(time.Second))
+}
+
+// cancel returns a token reserved by a request that was not sent.
+func (bucket *bucket) cancel() {
+	if bucket.budget.Rate <= 0 {
+		return
+	}
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	bucket.tokens++
+}
@@ -0,0 +1,141 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/ratelimit"
+)
+
+var _ = Describe(`Limiter`, func() {
+	var server *fake.Server
+	var service *kubernetesserviceapiv1.KubernetesServiceApiV1
+
+	// getClusters lists the clusters and returns how long it took.
+	var getClusters = func(service *kubernetesserviceapiv1.KubernetesServiceApiV1) time.Duration {
+		start := time.Now()
+		_, _, err := service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).To(BeNil())
+		return time.Since(start)
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		var err error
+		service, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Limits the rate of the operations after the burst`, func() {
+		service.AddInterceptor(ratelimit.NewLimiter(&ratelimit.Options{Read: ratelimit.Budget{Rate: 20, Burst: 2}}).Intercept)
+		Expect(getClusters(service)).To(BeNumerically("<", 40*time.Millisecond))
+		Expect(getClusters(service)).To(BeNumerically("<", 40*time.Millisecond))
+		Expect(getClusters(service)).To(BeNumerically(">=", 40*time.Millisecond))
+	})
+
+	It(`Keeps separate budgets for the read and mutating operations`, func() {
+		service.AddInterceptor(ratelimit.NewLimiter(&ratelimit.Options{Read: ratelimit.Budget{Rate: 0.1}}).Intercept)
+		getClusters(service)
+
+		start := time.Now()
+		_, err := service.RemoveCluster(service.NewRemoveClusterOptions("missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, _, err = service.VpcGetClustersWithContext(ctx, service.NewVpcGetClustersOptions())
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+
+	It(`Limits the requests in flight across clones`, func() {
+		service.AddInterceptor(ratelimit.NewLimiter(&ratelimit.Options{Read: ratelimit.Budget{MaxInFlight: 2}}).Intercept)
+		var inFlight, maxInFlight int64
+		service.AddInterceptor(func(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+			current := atomic.AddInt64(&inFlight, 1)
+			defer atomic.AddInt64(&inFlight, -1)
+			for {
+				observed := atomic.LoadInt64(&maxInFlight)
+				if current <= observed || atomic.CompareAndSwapInt64(&maxInFlight, observed, current) {
+					break
+				}
+			}
+			return next(request)
+		})
+		server.InjectFault(fake.Fault{Latency: 20 * time.Millisecond})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 6; i++ {
+			wg.Add(1)
+			go func(clone *kubernetesserviceapiv1.KubernetesServiceApiV1) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				getClusters(clone)
+			}(service.Clone())
+		}
+		wg.Wait()
+		Expect(maxInFlight).To(Equal(int64(2)))
+	})
+
+	It(`Pauses the operations until the Retry-After time of a throttled response`, func() {
+		service.AddInterceptor(ratelimit.NewLimiter(nil).Intercept)
+		server.InjectFault(fake.Fault{StatusCode: 429, RetryAfter: "1", Count: 1})
+
+		_, _, err := service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).ToNot(BeNil())
+		start := time.Now()
+		_, err = service.RemoveCluster(service.NewRemoveClusterOptions("missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(time.Since(start)).To(BeNumerically(">=", 500*time.Millisecond))
+	})
+
+	It(`Pauses the other operations while a throttled attempt is retried once installed`, func() {
+		service.EnableRetries(1, 10*time.Millisecond)
+		limiter := ratelimit.NewLimiter(nil)
+		service.AddInterceptor(limiter.Intercept)
+		limiter.Install(service.Service)
+		server.InjectFault(fake.Fault{StatusCode: 429, RetryAfter: "1", Count: 1})
+
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			getClusters(service)
+		}()
+		Eventually(server.Requests).Should(HaveLen(1))
+		Expect(getClusters(service.Clone())).To(BeNumerically(">=", 500*time.Millisecond))
+		<-done
+	})
+})
@@ -0,0 +1,264 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package retry retries the failed operations of the service clients according to whether they are safe to retry.
+//
+// Unlike EnableRetries, which retries every request on the same status codes, a Policy classifies the operations as
+// Safe or Unsafe. Safe operations, by default those sent with GET, HEAD, OPTIONS, PUT or DELETE, are retried on
+// network errors, 429 and 5xx responses. Unsafe operations, such as CreateCluster or CreateEndpoints, are retried
+// only on 429 responses, which the service sends before processing the request, since retrying them after a
+// timeout could create duplicates. The class of an operation can be overridden by its ID, and the retryability of
+// an error by its API error code or type.
+//
+// A Policy is added to a client as an interceptor, after the interceptors that should observe the operation as a
+// whole, such as tracing, and used instead of EnableRetries:
+//
+//	policy := retry.NewPolicy(&retry.Options{
+//		Operations: map[string]retry.Class{"RemoveCluster": retry.Unsafe},
+//		Codes:      map[string]bool{"Conflict": false},
+//		Budget:     retry.NewBudget(0.1, 10),
+//	})
+//	kubernetesServiceApi.AddInterceptor(policy.Intercept)
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries of an operation when Options.MaxRetries is not set.
+	DefaultMaxRetries = 3
+
+	// DefaultMinInterval is the base of the backoff when Options.MinInterval is not set.
+	DefaultMinInterval = time.Second
+
+	// DefaultMaxInterval caps the backoff when Options.MaxInterval is not set.
+	DefaultMaxInterval = 30 * time.Second
+)
+
+// Class : Whether an operation is safe to retry.
+type Class int
+
+const (
+	// Safe operations can be sent several times with the same effect, and are retried on any transient failure.
+	Safe Class = iota + 1
+
+	// Unsafe operations may have an effect on every request, and are retried only on throttled responses.
+	Unsafe
+)
+
+// Options : The options of NewPolicy.
+type Options struct {
+	// The maximum number of retries of an operation. Defaults to DefaultMaxRetries; negative for none.
+	MaxRetries int
+
+	// The base of the exponential backoff between two attempts. Defaults to DefaultMinInterval.
+	MinInterval time.Duration
+
+	// The upper bound of the backoff between two attempts. Defaults to DefaultMaxInterval.
+	MaxInterval time.Duration
+
+	// The classes of the operations by operation ID, overriding the class given by their HTTP method.
+	Operations map[string]Class
+
+	// Whether to retry the errors by API error code or type, such as "E0040" or "Conflict", regardless of their
+	// status code and of the class of the operation. The code takes precedence over the type.
+	Codes map[string]bool
+
+	// The budget capping the retries of the operations. Unlimited if nil.
+	Budget *Budget
+}
+
+// Policy : Retries the operations of the clients it is added to.
+type Policy struct {
+	options Options
+}
+
+// NewPolicy returns a Policy configured by options, which may be nil.
+func NewPolicy(options *Options) *Policy {
+	policy := &Policy{}
+	if options != nil {
+		policy.options = *options
+	}
+	if policy.options.MaxRetries == 0 {
+		policy.options.MaxRetries = DefaultMaxRetries
+	}
+	if policy.options.MinInterval <= 0 {
+		policy.options.MinInterval = DefaultMinInterval
+	}
+	if policy.options.MaxInterval <= 0 {
+		policy.options.MaxInterval = DefaultMaxInterval
+	}
+	return policy
+}
+
+// Class returns the class of the operation operationID sent with method.
+func (policy *Policy) Class(operationID string, method string) Class {
+	if class, ok := policy.options.Operations[operationID]; ok {
+		return class
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return Safe
+	}
+	return Unsafe
+}
+
+// Intercept is a common.Interceptor sending the request of the operation operationID until it succeeds, fails with
+// an error that should not be retried, or the retries are exhausted. The backoff between two attempts is jittered,
+// and at least the delay given by the Retry-After header of the response.
+func (policy *Policy) Intercept(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+	class := policy.Class(operationID, request.Method)
+	if policy.options.Budget != nil {
+		policy.options.Budget.deposit()
+	}
+
+	for retries := 0; ; retries++ {
+		attempt := request
+		if retries > 0 {
+			attempt = request.Clone(request.Context())
+			if request.GetBody != nil {
+				body, err := request.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attempt.Body = body
+			}
+		}
+
+		response, err := next(attempt)
+		if err == nil || retries >= policy.options.MaxRetries || !policy.retryable(class, response, err) {
+			return response, err
+		}
+		if request.Body != nil && request.GetBody == nil {
+			return response, err
+		}
+		if policy.options.Budget != nil && !policy.options.Budget.withdraw() {
+			return response, err
+		}
+
+		timer := time.NewTimer(policy.backoff(retries, response))
+		select {
+		case <-timer.C:
+		case <-request.Context().Done():
+			timer.Stop()
+			return response, err
+		}
+	}
+}
+
+// retryable reports whether the error err of an operation of class with response should be retried.
+func (policy *Policy) retryable(class Class, response *core.DetailedResponse, err error) bool {
+	var coded interface {
+		Code() string
+		Type() string
+	}
+	if errors.As(err, &coded) {
+		if retry, ok := policy.options.Codes[coded.Code()]; ok {
+			return retry
+		}
+		if retry, ok := policy.options.Codes[coded.Type()]; ok {
+			return retry
+		}
+	}
+
+	if response == nil || response.StatusCode == 0 {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		// A request that could not be sent was not processed, but one that timed out may have been.
+		return class == Safe || isDialError(err)
+	}
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return class == Safe
+	}
+	return false
+}
+
+// isDialError reports whether err happened while connecting to the service.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// backoff returns the time to wait before the attempt following retries retries, given the response of the failed
+// attempt.
+func (policy *Policy) backoff(retries int, response *core.DetailedResponse) time.Duration {
+	ceiling := policy.options.MaxInterval
+	if retries < 32 {
+		if exponential := policy.options.MinInterval << uint(retries); exponential > 0 && exponential < ceiling {
+			ceiling = exponential
+		}
+	}
+	delay := time.Duration(rand.Int63n(int64(ceiling) + 1))
+	if response != nil {
+		if until, ok := common.RetryAfter(response.Headers, time.Now()); ok {
+			if wait := time.Until(until); wait > delay {
+				delay = wait
+			}
+		}
+	}
+	return delay
+}
+
+// Budget : Caps the retries of the operations of the policies sharing it to a ratio of their requests.
+type Budget struct {
+	ratio   float64
+	reserve float64
+
+	mutex   sync.Mutex
+	balance float64
+}
+
+// NewBudget returns a Budget crediting ratio retries per operation, up to a balance of reserve retries, which it
+// starts with.
+func NewBudget(ratio float64, reserve int) *Budget {
+	return &Budget{ratio: ratio, reserve: float64(reserve), balance: float64(reserve)}
+}
+
+// deposit credits the budget for an operation.
+func (budget *Budget) deposit() {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+	budget.balance += budget.ratio
+	if budget.balance > budget.reserve {
+		budget.balance = budget.reserve
+	}
+}
+
+// withdraw debits the budget for a retry and reports whether it was allowed.
+func (budget *Budget) withdraw() bool {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+	if budget.balance < 1 {
+		return false
+	}
+	budget.balance--
+	return true
+}
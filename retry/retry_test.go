@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/retry"
+)
+
+var _ = Describe(`Policy`, func() {
+	var server *fake.Server
+	var service *kubernetesserviceapiv1.KubernetesServiceApiV1
+
+	// usePolicy adds a policy with short backoffs configured by options to the client.
+	var usePolicy = func(options retry.Options) {
+		options.MinInterval = time.Millisecond
+		options.MaxInterval = 5 * time.Millisecond
+		service.AddInterceptor(retry.NewPolicy(&options).Intercept)
+	}
+
+	// createCluster creates a cluster.
+	var createCluster = func() error {
+		options := service.NewVpcCreateClusterOptions("rg1")
+		options.Name = core.StringPtr("c1")
+		options.WorkerPool = &kubernetesserviceapiv1.VPCCreateClusterWorkerPool{
+			Flavor:      core.StringPtr("bx2.4x16"),
+			WorkerCount: core.Int64Ptr(1),
+			Zones: []kubernetesserviceapiv1.VPCCreateClusterWorkerPoolZone{
+				{ID: core.StringPtr("us-south-1"), SubnetID: core.StringPtr("subnet-1")},
+			},
+		}
+		_, _, err := service.VpcCreateCluster(options)
+		return err
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		var err error
+		service, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Classifies the operations by method unless overridden`, func() {
+		policy := retry.NewPolicy(&retry.Options{Operations: map[string]retry.Class{"GetCluster": retry.Unsafe}})
+		Expect(policy.Class("VpcGetClusters", http.MethodGet)).To(Equal(retry.Safe))
+		Expect(policy.Class("RemoveCluster", http.MethodDelete)).To(Equal(retry.Safe))
+		Expect(policy.Class("VpcCreateCluster", http.MethodPost)).To(Equal(retry.Unsafe))
+		Expect(policy.Class("GetCluster", http.MethodGet)).To(Equal(retry.Unsafe))
+	})
+
+	It(`Retries the safe operations on server errors`, func() {
+		usePolicy(retry.Options{})
+		server.InjectFault(fake.Fault{StatusCode: 503, Count: 2})
+		_, _, err := service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).To(BeNil())
+		Expect(server.Requests()).To(HaveLen(3))
+	})
+
+	It(`Gives up after the maximum number of retries`, func() {
+		usePolicy(retry.Options{MaxRetries: 1})
+		server.InjectFault(fake.Fault{StatusCode: 502})
+		_, response, err := service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(502))
+		Expect(server.Requests()).To(HaveLen(2))
+	})
+
+	It(`Retries the unsafe operations on throttled responses only`, func() {
+		usePolicy(retry.Options{})
+		server.InjectFault(fake.Fault{StatusCode: 503, Count: 1})
+		Expect(createCluster()).ToNot(BeNil())
+		Expect(server.Requests()).To(HaveLen(1))
+
+		server.InjectFault(fake.Fault{StatusCode: 429, Count: 1})
+		Expect(createCluster()).To(BeNil())
+		requests := server.Requests()
+		Expect(requests).To(HaveLen(3))
+		Expect(requests[2].Body).To(Equal(requests[1].Body))
+	})
+
+	It(`Retries an operation overridden as safe`, func() {
+		usePolicy(retry.Options{Operations: map[string]retry.Class{"VpcCreateCluster": retry.Safe}})
+		server.InjectFault(fake.Fault{StatusCode: 500, Count: 1})
+		Expect(createCluster()).To(BeNil())
+		Expect(server.Requests()).To(HaveLen(2))
+	})
+
+	It(`Decides by the API error code and type`, func() {
+		usePolicy(retry.Options{Codes: map[string]bool{"E0001": false, "Not Found": true}})
+		server.InjectFault(fake.Fault{StatusCode: 503, Count: 1})
+		_, _, err := service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(server.Requests()).To(HaveLen(1))
+
+		_, _, err = service.GetCluster(service.NewGetClusterOptions("missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(server.Requests()).To(HaveLen(1 + 1 + retry.DefaultMaxRetries))
+	})
+
+	It(`Stops retrying once the budget is exhausted`, func() {
+		usePolicy(retry.Options{Budget: retry.NewBudget(0, 1)})
+		server.InjectFault(fake.Fault{StatusCode: 503})
+		_, _, err := service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(server.Requests()).To(HaveLen(2))
+
+		_, _, err = service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(server.Requests()).To(HaveLen(3))
+	})
+})
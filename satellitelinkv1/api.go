@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+package satellitelinkv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/apigen -type SatelliteLinkV1
+
+// API : The operations of SatelliteLinkV1, for substituting test doubles such as the one of the mock package.
+type API interface {
+	// CreateLink : create link [Administrator]
+	CreateLink(createLinkOptions *CreateLinkOptions) (*Location, *core.DetailedResponse, error)
+
+	// CreateLinkWithContext is an alternate form of the CreateLink method which supports a Context parameter
+	CreateLinkWithContext(ctx context.Context, createLinkOptions *CreateLinkOptions) (*Location, *core.DetailedResponse, error)
+
+	// GetLink : read link [Administrator, Editor, Operator, Viewer, satellite-link-administrator]
+	GetLink(getLinkOptions *GetLinkOptions) (*Location, *core.DetailedResponse, error)
+
+	// GetLinkWithContext is an alternate form of the GetLink method which supports a Context parameter
+	GetLinkWithContext(ctx context.Context, getLinkOptions *GetLinkOptions) (*Location, *core.DetailedResponse, error)
+
+	// UpdateLink : update link [Administrator, Editor, Operator, satellite-link-administrator]
+	UpdateLink(updateLinkOptions *UpdateLinkOptions) (*Location, *core.DetailedResponse, error)
+
+	// UpdateLinkWithContext is an alternate form of the UpdateLink method which supports a Context parameter
+	UpdateLinkWithContext(ctx context.Context, updateLinkOptions *UpdateLinkOptions) (*Location, *core.DetailedResponse, error)
+
+	// DeleteLink : delete link [Administrator, Operator]
+	DeleteLink(deleteLinkOptions *DeleteLinkOptions) (*ExecutionResult, *core.DetailedResponse, error)
+
+	// DeleteLinkWithContext is an alternate form of the DeleteLink method which supports a Context parameter
+	DeleteLinkWithContext(ctx context.Context, deleteLinkOptions *DeleteLinkOptions) (*ExecutionResult, *core.DetailedResponse, error)
+
+	// ListEndpoints : list endpoints [Administrator, Editor, Viewer, Operator, satellite-link-administrator]
+	ListEndpoints(listEndpointsOptions *ListEndpointsOptions) (*Endpoints, *core.DetailedResponse, error)
+
+	// ListEndpointsWithContext is an alternate form of the ListEndpoints method which supports a Context parameter
+	ListEndpointsWithContext(ctx context.Context, listEndpointsOptions *ListEndpointsOptions) (*Endpoints, *core.DetailedResponse, error)
+
+	// CreateEndpoints : create endpoint [Administrator, Editor, Operator, satellite-link-administrator]
+	CreateEndpoints(createEndpointsOptions *CreateEndpointsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// CreateEndpointsWithContext is an alternate form of the CreateEndpoints method which supports a Context parameter
+	CreateEndpointsWithContext(ctx context.Context, createEndpointsOptions *CreateEndpointsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// ImportEndpoints : import an endpoint [Administrator, Editor, Operator, satellite-link-administrator]
+	ImportEndpoints(importEndpointsOptions *ImportEndpointsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// ImportEndpointsWithContext is an alternate form of the ImportEndpoints method which supports a Context parameter
+	ImportEndpointsWithContext(ctx context.Context, importEndpointsOptions *ImportEndpointsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// ExportEndpoints : export an endpoint [Administrator, Editor, Operator, satellite-link-administrator]
+	ExportEndpoints(exportEndpointsOptions *ExportEndpointsOptions) (*ExportEndpointsResponse, *core.DetailedResponse, error)
+
+	// ExportEndpointsWithContext is an alternate form of the ExportEndpoints method which supports a Context parameter
+	ExportEndpointsWithContext(ctx context.Context, exportEndpointsOptions *ExportEndpointsOptions) (*ExportEndpointsResponse, *core.DetailedResponse, error)
+
+	// GetEndpoints : read endpoint [Administrator, Editor, Viewer, Operator, satellite-link-administrator]
+	GetEndpoints(getEndpointsOptions *GetEndpointsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// GetEndpointsWithContext is an alternate form of the GetEndpoints method which supports a Context parameter
+	GetEndpointsWithContext(ctx context.Context, getEndpointsOptions *GetEndpointsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// UpdateEndpoints : update endpoint [Administrator, Editor, Operator, satellite-link-administrator]
+	UpdateEndpoints(updateEndpointsOptions *UpdateEndpointsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// UpdateEndpointsWithContext is an alternate form of the UpdateEndpoints method which supports a Context parameter
+	UpdateEndpointsWithContext(ctx context.Context, updateEndpointsOptions *UpdateEndpointsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// DeleteEndpoints : delete endpoint [Administrator, Editor, Operator, satellite-link-administrator]
+	DeleteEndpoints(deleteEndpointsOptions *DeleteEndpointsOptions) (*ExecutionResult, *core.DetailedResponse, error)
+
+	// DeleteEndpointsWithContext is an alternate form of the DeleteEndpoints method which supports a Context parameter
+	DeleteEndpointsWithContext(ctx context.Context, deleteEndpointsOptions *DeleteEndpointsOptions) (*ExecutionResult, *core.DetailedResponse, error)
+
+	// GetEndpointCerts : read endpoint certs [Administrator, Editor, Operator, satellite-link-administrator]
+	GetEndpointCerts(getEndpointCertsOptions *GetEndpointCertsOptions) (*DownloadedCerts, *core.DetailedResponse, error)
+
+	// GetEndpointCertsWithContext is an alternate form of the GetEndpointCerts method which supports a Context parameter
+	GetEndpointCertsWithContext(ctx context.Context, getEndpointCertsOptions *GetEndpointCertsOptions) (*DownloadedCerts, *core.DetailedResponse, error)
+
+	// UploadEndpointCerts : upload endpoint certs [Administrator, Editor, Operator, satellite-link-administrator]
+	UploadEndpointCerts(uploadEndpointCertsOptions *UploadEndpointCertsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// UploadEndpointCertsWithContext is an alternate form of the UploadEndpointCerts method which supports a Context
+	// parameter
+	UploadEndpointCertsWithContext(ctx context.Context, uploadEndpointCertsOptions *UploadEndpointCertsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// DeleteEndpointCerts : delete endpoint certs [Administrator, Editor, Operator, satellite-link-administrator]
+	DeleteEndpointCerts(deleteEndpointCertsOptions *DeleteEndpointCertsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// DeleteEndpointCertsWithContext is an alternate form of the DeleteEndpointCerts method which supports a Context
+	// parameter
+	DeleteEndpointCertsWithContext(ctx context.Context, deleteEndpointCertsOptions *DeleteEndpointCertsOptions) (*Endpoint, *core.DetailedResponse, error)
+
+	// ListEndpointSources : list endpoint sources [Administrator, Editor, Viewer, Operator, satellite-link-administrator]
+	ListEndpointSources(listEndpointSourcesOptions *ListEndpointSourcesOptions) (*SourceStatus, *core.DetailedResponse, error)
+
+	// ListEndpointSourcesWithContext is an alternate form of the ListEndpointSources method which supports a Context
+	// parameter
+	ListEndpointSourcesWithContext(ctx context.Context, listEndpointSourcesOptions *ListEndpointSourcesOptions) (*SourceStatus, *core.DetailedResponse, error)
+
+	// UpdateEndpointSources : update endpoint sources [Administrator, Editor, Operator,
+	// satellite-link-source-access-controller]
+	UpdateEndpointSources(updateEndpointSourcesOptions *UpdateEndpointSourcesOptions) (*SourceStatus, *core.DetailedResponse, error)
+
+	// UpdateEndpointSourcesWithContext is an alternate form of the UpdateEndpointSources method which supports a Context
+	// parameter
+	UpdateEndpointSourcesWithContext(ctx context.Context, updateEndpointSourcesOptions *UpdateEndpointSourcesOptions) (*SourceStatus, *core.DetailedResponse, error)
+
+	// ListSources : list sources [Administrator, Editor, Viewer, Operator, satellite-link-administrator]
+	ListSources(listSourcesOptions *ListSourcesOptions) (*Sources, *core.DetailedResponse, error)
+
+	// ListSourcesWithContext is an alternate form of the ListSources method which supports a Context parameter
+	ListSourcesWithContext(ctx context.Context, listSourcesOptions *ListSourcesOptions) (*Sources, *core.DetailedResponse, error)
+
+	// CreateSources : create source [Administrator, Editor, Operator, satellite-link-administrator]
+	CreateSources(createSourcesOptions *CreateSourcesOptions) (*Source, *core.DetailedResponse, error)
+
+	// CreateSourcesWithContext is an alternate form of the CreateSources method which supports a Context parameter
+	CreateSourcesWithContext(ctx context.Context, createSourcesOptions *CreateSourcesOptions) (*Source, *core.DetailedResponse, error)
+
+	// UpdateSources : update source [Administrator, Editor, Operator, satellite-link-administrator]
+	UpdateSources(updateSourcesOptions *UpdateSourcesOptions) (*Source, *core.DetailedResponse, error)
+
+	// UpdateSourcesWithContext is an alternate form of the UpdateSources method which supports a Context parameter
+	UpdateSourcesWithContext(ctx context.Context, updateSourcesOptions *UpdateSourcesOptions) (*Source, *core.DetailedResponse, error)
+
+	// DeleteSources : delete source [Administrator, Editor, Operator, satellite-link-administrator]
+	DeleteSources(deleteSourcesOptions *DeleteSourcesOptions) (*ExecutionResult, *core.DetailedResponse, error)
+
+	// DeleteSourcesWithContext is an alternate form of the DeleteSources method which supports a Context parameter
+	DeleteSourcesWithContext(ctx context.Context, deleteSourcesOptions *DeleteSourcesOptions) (*ExecutionResult, *core.DetailedResponse, error)
+
+	// ListSourceEndpoints : list source status for all endpoints [Administrator, Editor, Viewer, Operator,
+	// satellite-link-administrator]
+	ListSourceEndpoints(listSourceEndpointsOptions *ListSourceEndpointsOptions) (*EndpointSourceStatus, *core.DetailedResponse, error)
+
+	// ListSourceEndpointsWithContext is an alternate form of the ListSourceEndpoints method which supports a Context
+	// parameter
+	ListSourceEndpointsWithContext(ctx context.Context, listSourceEndpointsOptions *ListSourceEndpointsOptions) (*EndpointSourceStatus, *core.DetailedResponse, error)
+
+	// UpdateSourceEndpoints : update source status for listed endpoints [Administrator, Editor, Operator,
+	// satellite-link-source-access-controller]
+	UpdateSourceEndpoints(updateSourceEndpointsOptions *UpdateSourceEndpointsOptions) (*ExecutionResult, *core.DetailedResponse, error)
+
+	// UpdateSourceEndpointsWithContext is an alternate form of the UpdateSourceEndpoints method which supports a Context
+	// parameter
+	UpdateSourceEndpointsWithContext(ctx context.Context, updateSourceEndpointsOptions *UpdateSourceEndpointsOptions) (*ExecutionResult, *core.DetailedResponse, error)
+
+	// WaitForEndpointStatus polls the endpoint with GetEndpoints until its status is status (Endpoint_Status_Enabled or
+	// Endpoint_Status_Disabled) and returns the last endpoint read.
+	WaitForEndpointStatus(ctx context.Context, locationID string, endpointID string, status string, options *EndpointWaitOptions) (*Endpoint, error)
+
+	// WaitForEndpointReady polls the endpoint with GetEndpoints until it is enabled and none of its sources is pending,
+	// and returns the last endpoint read.
+	WaitForEndpointReady(ctx context.Context, locationID string, endpointID string, options *EndpointWaitOptions) (*Endpoint, error)
+
+	// WaitForLinkReady polls the location with GetLink until its Satellite Link is enabled and returns the last location
+	// read.
+	WaitForLinkReady(ctx context.Context, locationID string, options *LinkWaitOptions) (*Location, error)
+
+	// WaitForSourcesApplied polls the sources of the endpoint with ListEndpointSources until none of them is pending, for
+	// example after UpdateEndpointSources, and returns the last sources read.
+	WaitForSourcesApplied(ctx context.Context, locationID string, endpointID string, options *SourcesWaitOptions) (*SourceStatus, error)
+}
+
+var _ API = (*SatelliteLinkV1)(nil)
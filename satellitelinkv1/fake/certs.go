@@ -0,0 +1,290 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+)
+
+// The kinds of endpoint certificates, which are also the fields of the UploadEndpointCerts form.
+const (
+	certClient       = "client_cert"
+	certServer       = "server_cert"
+	certConnector    = "connector_cert"
+	certConnectorKey = "connector_key"
+)
+
+// cert : A certificate or key of an endpoint.
+type cert struct {
+	filename string
+	contents string
+}
+
+// addCertRoutes adds the endpoint certificate routes.
+func (server *Server) addCertRoutes() {
+	server.handle("GET", "/v1/locations/{location_id}/endpoints/{endpoint_id}/cert", server.getCerts)
+	server.handle("POST", "/v1/locations/{location_id}/endpoints/{endpoint_id}/cert", server.uploadCerts)
+	server.handle("DELETE", "/v1/locations/{location_id}/endpoints/{endpoint_id}/cert", server.deleteCerts)
+}
+
+// isPEM reports whether contents looks like a PEM encoded certificate or key.
+func isPEM(contents string) bool {
+	begin := strings.Index(contents, "-----BEGIN ")
+	return begin >= 0 && strings.Contains(contents[begin:], "-----END ")
+}
+
+// validateCertsSpec returns the reason the certificates of an endpoint request are rejected, if any.
+func validateCertsSpec(certs *satellitelinkv1.AdditionalNewEndpointRequestCerts) string {
+	files := map[string]*string{}
+	if certs.Client != nil && certs.Client.Cert != nil {
+		files[certClient] = certs.Client.Cert.FileContents
+	}
+	if certs.Server != nil && certs.Server.Cert != nil {
+		files[certServer] = certs.Server.Cert.FileContents
+	}
+	if certs.Connector != nil {
+		if (certs.Connector.Cert == nil) != (certs.Connector.Key == nil) {
+			return "The connector cert and key must be provided together."
+		}
+		if certs.Connector.Cert != nil {
+			files[certConnector] = certs.Connector.Cert.FileContents
+			files[certConnectorKey] = certs.Connector.Key.FileContents
+		}
+	}
+	for kind, contents := range files {
+		if contents == nil || !isPEM(*contents) {
+			return "The file_contents of the " + kind + " must be in PEM format."
+		}
+	}
+	return ""
+}
+
+// checkConnectorPair returns the reason certs are rejected if they hold only one of the connector cert and key.
+func checkConnectorPair(certs map[string]cert) string {
+	_, hasCert := certs[certConnector]
+	_, hasKey := certs[certConnectorKey]
+	if hasCert != hasKey {
+		return "The connector cert and key must be provided together."
+	}
+	return ""
+}
+
+// certsFromSpec returns the certificates of an endpoint request, which validateCertsSpec has accepted.
+func certsFromSpec(certs *satellitelinkv1.AdditionalNewEndpointRequestCerts) map[string]cert {
+	result := make(map[string]cert)
+	if certs == nil {
+		return result
+	}
+	add := func(kind string, filename *string, contents *string) {
+		result[kind] = cert{filename: valueOr(filename, kind+".pem"), contents: *contents}
+	}
+	if certs.Client != nil && certs.Client.Cert != nil {
+		add(certClient, certs.Client.Cert.Filename, certs.Client.Cert.FileContents)
+	}
+	if certs.Server != nil && certs.Server.Cert != nil {
+		add(certServer, certs.Server.Cert.Filename, certs.Server.Cert.FileContents)
+	}
+	if certs.Connector != nil && certs.Connector.Cert != nil {
+		add(certConnector, certs.Connector.Cert.Filename, certs.Connector.Cert.FileContents)
+		add(certConnectorKey, certs.Connector.Key.Filename, certs.Connector.Key.FileContents)
+	}
+	return result
+}
+
+// certsSpec returns the certificates of the endpoint as sent in an endpoint request, or nil if it has none.
+func (endpoint *endpoint) certsSpec() *satellitelinkv1.AdditionalNewEndpointRequestCerts {
+	if len(endpoint.certs) == 0 {
+		return nil
+	}
+	certs := &satellitelinkv1.AdditionalNewEndpointRequestCerts{}
+	if cert, ok := endpoint.certs[certClient]; ok {
+		certs.Client = &satellitelinkv1.AdditionalNewEndpointRequestCertsClient{
+			Cert: &satellitelinkv1.AdditionalNewEndpointRequestCertsClientCert{Filename: stringPtr(cert.filename), FileContents: stringPtr(cert.contents)},
+		}
+	}
+	if cert, ok := endpoint.certs[certServer]; ok {
+		certs.Server = &satellitelinkv1.AdditionalNewEndpointRequestCertsServer{
+			Cert: &satellitelinkv1.AdditionalNewEndpointRequestCertsServerCert{Filename: stringPtr(cert.filename), FileContents: stringPtr(cert.contents)},
+		}
+	}
+	if cert, ok := endpoint.certs[certConnector]; ok {
+		key := endpoint.certs[certConnectorKey]
+		certs.Connector = &satellitelinkv1.AdditionalNewEndpointRequestCertsConnector{
+			Cert: &satellitelinkv1.AdditionalNewEndpointRequestCertsConnectorCert{Filename: stringPtr(cert.filename), FileContents: stringPtr(cert.contents)},
+			Key:  &satellitelinkv1.AdditionalNewEndpointRequestCertsConnectorKey{Filename: stringPtr(key.filename), FileContents: stringPtr(key.contents)},
+		}
+	}
+	return certs
+}
+
+// endpointCerts returns the file names of the certificates of the endpoint, or nil if it has none.
+func (endpoint *endpoint) endpointCerts() *satellitelinkv1.EndpointCerts {
+	if len(endpoint.certs) == 0 {
+		return nil
+	}
+	certs := &satellitelinkv1.EndpointCerts{}
+	if cert, ok := endpoint.certs[certClient]; ok {
+		certs.Client = &satellitelinkv1.EndpointCertsClient{Cert: &satellitelinkv1.EndpointCertsClientCert{Filename: stringPtr(cert.filename)}}
+	}
+	if cert, ok := endpoint.certs[certServer]; ok {
+		certs.Server = &satellitelinkv1.EndpointCertsServer{Cert: &satellitelinkv1.EndpointCertsServerCert{Filename: stringPtr(cert.filename)}}
+	}
+	if cert, ok := endpoint.certs[certConnector]; ok {
+		certs.Connector = &satellitelinkv1.EndpointCertsConnector{
+			Cert: &satellitelinkv1.EndpointCertsConnectorCert{Filename: stringPtr(cert.filename)},
+			Key:  &satellitelinkv1.EndpointCertsConnectorKey{Filename: stringPtr(endpoint.certs[certConnectorKey].filename)},
+		}
+	}
+	return certs
+}
+
+// getCerts serves GetEndpointCerts. The certificates are returned as a DownloadedCerts if no_zip is true, and as a
+// zip archive otherwise.
+func (server *Server) getCerts(c *call) {
+	_, endpoint := server.requireEndpoint(c)
+	if endpoint == nil {
+		return
+	}
+	var kinds []string
+	for kind := range endpoint.certs {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	if c.query("no_zip") == "true" {
+		downloaded := satellitelinkv1.DownloadedCerts{Certs: []satellitelinkv1.DownloadedCertsCertsItem{}}
+		for _, kind := range kinds {
+			cert := endpoint.certs[kind]
+			downloaded.Certs = append(downloaded.Certs, satellitelinkv1.DownloadedCertsCertsItem{
+				Name:    stringPtr(cert.filename),
+				Content: stringPtr(cert.contents),
+			})
+		}
+		c.ok(downloaded)
+		return
+	}
+
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	for _, kind := range kinds {
+		cert := endpoint.certs[kind]
+		file, _ := writer.Create(cert.filename)
+		file.Write([]byte(cert.contents))
+	}
+	writer.Close()
+	c.res.Header().Set("Content-Type", "application/zip")
+	c.res.Header().Set("Content-Disposition", "attachment; filename="+endpoint.id+"_certs.zip")
+	c.res.WriteHeader(http.StatusOK)
+	c.res.Write(archive.Bytes())
+}
+
+// uploadCerts serves UploadEndpointCerts, replacing the certificates in the multipart form.
+func (server *Server) uploadCerts(c *call) {
+	link, endpoint := server.requireEndpoint(c)
+	if endpoint == nil {
+		return
+	}
+	files, ok := c.multipartFiles()
+	if !ok {
+		return
+	}
+	certs := make(map[string]cert)
+	for kind, cert := range endpoint.certs {
+		certs[kind] = cert
+	}
+	uploaded := 0
+	for _, kind := range []string{certClient, certServer, certConnector, certConnectorKey} {
+		contents, found := files[kind]
+		if !found {
+			continue
+		}
+		if !isPEM(string(contents)) {
+			c.badRequest("The " + kind + " must be in PEM format.")
+			return
+		}
+		certs[kind] = cert{filename: kind + ".pem", contents: string(contents)}
+		uploaded++
+	}
+	if uploaded == 0 {
+		c.badRequest("At least one of client_cert, server_cert, connector_cert and connector_key is required.")
+		return
+	}
+	if reason := checkConnectorPair(certs); reason != "" {
+		c.badRequest(reason)
+		return
+	}
+	endpoint.certs = certs
+	endpoint.lastChange = now()
+	c.ok(server.endpointResponse(link, endpoint))
+}
+
+// deleteCerts serves DeleteEndpointCerts.
+func (server *Server) deleteCerts(c *call) {
+	link, endpoint := server.requireEndpoint(c)
+	if endpoint == nil {
+		return
+	}
+	endpoint.certs = make(map[string]cert)
+	endpoint.lastChange = now()
+	c.ok(server.endpointResponse(link, endpoint))
+}
+
+// multipartFiles returns the parts of the multipart form body by field name, or reports a 400 if the body is not a
+// multipart form.
+func (c *call) multipartFiles() (map[string][]byte, bool) {
+	mediaType, params, err := mime.ParseMediaType(c.req.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		c.badRequest("The request body must be a multipart form.")
+		return nil, false
+	}
+	files := make(map[string][]byte)
+	reader := multipart.NewReader(bytes.NewReader(c.body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		var contents []byte
+		if err == nil {
+			contents, err = ioutil.ReadAll(part)
+		}
+		if err != nil {
+			c.badRequest("The multipart form could not be read: " + err.Error())
+			return nil, false
+		}
+		files[part.FormName()] = contents
+	}
+	return files, true
+}
+
+// valueOr returns the value of pointer, or fallback if it is nil or empty.
+func valueOr(pointer *string, fallback string) string {
+	if pointer == nil || *pointer == "" {
+		return fallback
+	}
+	return *pointer
+}
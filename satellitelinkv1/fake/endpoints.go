@@ -0,0 +1,480 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+)
+
+// namePattern matches the valid endpoint and source names.
+var namePattern = regexp.MustCompile(`^[a-zA-Z]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// serverProtocols maps each client protocol to the server protocols it can be combined with, the default first.
+var serverProtocols = map[string][]string{
+	satellitelinkv1.Endpoint_ClientProtocol_Udp:        {satellitelinkv1.Endpoint_ServerProtocol_Udp},
+	satellitelinkv1.Endpoint_ClientProtocol_Tcp:        {satellitelinkv1.Endpoint_ServerProtocol_Tcp, satellitelinkv1.Endpoint_ServerProtocol_Tls},
+	satellitelinkv1.Endpoint_ClientProtocol_Http:       {satellitelinkv1.Endpoint_ServerProtocol_Tcp, satellitelinkv1.Endpoint_ServerProtocol_Tls},
+	satellitelinkv1.Endpoint_ClientProtocol_Tls:        {satellitelinkv1.Endpoint_ServerProtocol_Tls, satellitelinkv1.Endpoint_ServerProtocol_Tcp},
+	satellitelinkv1.Endpoint_ClientProtocol_Https:      {satellitelinkv1.Endpoint_ServerProtocol_Tls, satellitelinkv1.Endpoint_ServerProtocol_Tcp},
+	satellitelinkv1.Endpoint_ClientProtocol_HttpTunnel: {satellitelinkv1.Endpoint_ServerProtocol_Tcp},
+}
+
+// MaxEndpointTimeout is the longest inactivity timeout of an endpoint, in seconds.
+const MaxEndpointTimeout = 180
+
+// endpoint : An endpoint of a link.
+type endpoint struct {
+	id         string
+	spec       endpointSpec
+	enabled    bool
+	status     *script
+	clientPort int64
+	certs      map[string]cert
+	sources    map[string]*binding
+	createdAt  string
+	lastChange string
+}
+
+// endpointSpec : The writable fields of an endpoint, as sent by CreateEndpoints, UpdateEndpoints and
+// ImportEndpoints.
+type endpointSpec struct {
+	ConnType         *string                                            `json:"conn_type,omitempty"`
+	DisplayName      *string                                            `json:"display_name,omitempty"`
+	ServerHost       *string                                            `json:"server_host,omitempty"`
+	ServerPort       *int64                                             `json:"server_port,omitempty"`
+	Sni              *string                                            `json:"sni,omitempty"`
+	ClientProtocol   *string                                            `json:"client_protocol,omitempty"`
+	ClientMutualAuth *bool                                              `json:"client_mutual_auth,omitempty"`
+	ServerProtocol   *string                                            `json:"server_protocol,omitempty"`
+	ServerMutualAuth *bool                                              `json:"server_mutual_auth,omitempty"`
+	RejectUnauth     *bool                                              `json:"reject_unauth,omitempty"`
+	Timeout          *int64                                             `json:"timeout,omitempty"`
+	CreatedBy        *string                                            `json:"created_by,omitempty"`
+	Certs            *satellitelinkv1.AdditionalNewEndpointRequestCerts `json:"certs,omitempty"`
+	Enabled          *bool                                              `json:"enabled,omitempty"`
+}
+
+// merge returns spec with the fields set in update replaced.
+func (spec endpointSpec) merge(update endpointSpec) endpointSpec {
+	if update.DisplayName != nil {
+		spec.DisplayName = update.DisplayName
+	}
+	if update.ServerHost != nil {
+		spec.ServerHost = update.ServerHost
+	}
+	if update.ServerPort != nil {
+		spec.ServerPort = update.ServerPort
+	}
+	if update.Sni != nil {
+		spec.Sni = update.Sni
+	}
+	if update.ClientProtocol != nil {
+		spec.ClientProtocol = update.ClientProtocol
+		if update.ServerProtocol == nil {
+			spec.ServerProtocol = nil
+		}
+	}
+	if update.ClientMutualAuth != nil {
+		spec.ClientMutualAuth = update.ClientMutualAuth
+	}
+	if update.ServerProtocol != nil {
+		spec.ServerProtocol = update.ServerProtocol
+	}
+	if update.ServerMutualAuth != nil {
+		spec.ServerMutualAuth = update.ServerMutualAuth
+	}
+	if update.RejectUnauth != nil {
+		spec.RejectUnauth = update.RejectUnauth
+	}
+	if update.Timeout != nil {
+		spec.Timeout = update.Timeout
+	}
+	if update.CreatedBy != nil {
+		spec.CreatedBy = update.CreatedBy
+	}
+	return spec
+}
+
+// validate checks spec against the rules of the service, filling in the defaults, and returns the reason it is
+// rejected, if any.
+func (spec *endpointSpec) validate() string {
+	if spec.ConnType == nil || (*spec.ConnType != satellitelinkv1.Endpoint_ConnType_Cloud && *spec.ConnType != satellitelinkv1.Endpoint_ConnType_Location) {
+		return "The conn_type must be 'cloud' or 'location'."
+	}
+	if spec.DisplayName == nil || !namePattern.MatchString(*spec.DisplayName) {
+		return "The display_name must start with a letter, end with an alphanumeric character, contain only letters, numbers and hyphens, and be 63 characters or fewer."
+	}
+	if spec.ClientProtocol == nil || serverProtocols[*spec.ClientProtocol] == nil {
+		return "The client_protocol must be one of 'udp', 'tcp', 'tls', 'http', 'https' and 'http-tunnel'."
+	}
+	clientProtocol := *spec.ClientProtocol
+	if spec.ServerProtocol == nil {
+		spec.ServerProtocol = stringPtr(serverProtocols[clientProtocol][0])
+	}
+	if !contains(serverProtocols[clientProtocol], *spec.ServerProtocol) {
+		return fmt.Sprintf("The server_protocol '%s' cannot be used with the client_protocol '%s'.", *spec.ServerProtocol, clientProtocol)
+	}
+	serverProtocol := *spec.ServerProtocol
+
+	if spec.ServerHost == nil || *spec.ServerHost == "" {
+		return "The server_host is required."
+	}
+	if strings.Contains(*spec.ServerHost, "*") && (clientProtocol != satellitelinkv1.Endpoint_ClientProtocol_HttpTunnel || !strings.HasPrefix(*spec.ServerHost, "*.") || strings.Count(*spec.ServerHost, "*") > 1) {
+		return "The server_host can only start with '*.' when the client_protocol is 'http-tunnel'."
+	}
+	if spec.ServerPort == nil || *spec.ServerPort < 0 || *spec.ServerPort > 65535 {
+		return "The server_port must be between 0 and 65535."
+	}
+	if *spec.ServerPort == 0 && clientProtocol != satellitelinkv1.Endpoint_ClientProtocol_HttpTunnel {
+		return "The server_port can only be 0 when the client_protocol is 'http-tunnel'."
+	}
+	if spec.ClientMutualAuth != nil && *spec.ClientMutualAuth && clientProtocol != satellitelinkv1.Endpoint_ClientProtocol_Tls && clientProtocol != satellitelinkv1.Endpoint_ClientProtocol_Https {
+		return "The client_mutual_auth can only be enabled when the client_protocol is 'tls' or 'https'."
+	}
+	if spec.ServerMutualAuth != nil && *spec.ServerMutualAuth && serverProtocol != satellitelinkv1.Endpoint_ServerProtocol_Tls {
+		return "The server_mutual_auth can only be enabled when the server_protocol is 'tls'."
+	}
+	if spec.Sni != nil && *spec.Sni != "" && serverProtocol != satellitelinkv1.Endpoint_ServerProtocol_Tls {
+		return "The sni can only be set when the server_protocol is 'tls'."
+	}
+	if spec.Timeout != nil && (*spec.Timeout < 1 || *spec.Timeout > MaxEndpointTimeout) {
+		return fmt.Sprintf("The timeout must be between 1 and %d seconds.", MaxEndpointTimeout)
+	}
+	if spec.CreatedBy != nil && len(*spec.CreatedBy) > 1000 {
+		return "The created_by must be 1000 characters or fewer."
+	}
+	if spec.Certs != nil {
+		if reason := validateCertsSpec(spec.Certs); reason != "" {
+			return reason
+		}
+	}
+
+	if spec.ClientMutualAuth == nil {
+		spec.ClientMutualAuth = boolPtr(false)
+	}
+	if spec.ServerMutualAuth == nil {
+		spec.ServerMutualAuth = boolPtr(false)
+	}
+	if spec.RejectUnauth == nil {
+		spec.RejectUnauth = boolPtr(false)
+	}
+	if spec.Timeout == nil {
+		spec.Timeout = int64Ptr(60)
+	}
+	return ""
+}
+
+// addEndpointRoutes adds the endpoint routes.
+func (server *Server) addEndpointRoutes() {
+	server.handle("GET", "/v1/locations/{location_id}/endpoints", server.listEndpoints)
+	server.handle("POST", "/v1/locations/{location_id}/endpoints", server.createEndpoint)
+	server.handle("POST", "/v1/locations/{location_id}/endpoints/import", server.importEndpoint)
+	server.handle("GET", "/v1/locations/{location_id}/endpoints/{endpoint_id}/export", server.exportEndpoint)
+	server.handle("GET", "/v1/locations/{location_id}/endpoints/{endpoint_id}", server.getEndpoint)
+	server.handle("PATCH", "/v1/locations/{location_id}/endpoints/{endpoint_id}", server.updateEndpoint)
+	server.handle("DELETE", "/v1/locations/{location_id}/endpoints/{endpoint_id}", server.deleteEndpoint)
+}
+
+// SetEndpointStatuses scripts the statuses the endpoint goes through from now on.
+func (server *Server) SetEndpointStatuses(locationID string, endpointID string, statuses ...string) error {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	link := server.findLink(locationID)
+	if link == nil {
+		return fmt.Errorf("link '%s' not found", locationID)
+	}
+	endpoint := link.findEndpoint(endpointID)
+	if endpoint == nil {
+		return fmt.Errorf("endpoint '%s' not found", endpointID)
+	}
+	endpoint.status = newScript(statuses...)
+	return nil
+}
+
+// findEndpoint returns the endpoint with the ID id.
+func (link *link) findEndpoint(id string) *endpoint {
+	for _, endpoint := range link.endpoints {
+		if endpoint.id == id {
+			return endpoint
+		}
+	}
+	return nil
+}
+
+// requireEndpoint returns the link and endpoint of the location_id and endpoint_id path parameters, or reports a
+// 404.
+func (server *Server) requireEndpoint(c *call) (*link, *endpoint) {
+	link := server.requireLink(c)
+	if link == nil {
+		return nil, nil
+	}
+	endpoint := link.findEndpoint(c.params["endpoint_id"])
+	if endpoint == nil {
+		c.notFound("endpoint", c.params["endpoint_id"])
+	}
+	return link, endpoint
+}
+
+// checkDisplayName reports a 409 if another endpoint of the link is called name.
+func (link *link) checkDisplayName(c *call, self *endpoint, name string) bool {
+	for _, endpoint := range link.endpoints {
+		if endpoint != self && *endpoint.spec.DisplayName == name {
+			c.conflict(fmt.Sprintf("An endpoint with the display_name '%s' already exists.", name))
+			return false
+		}
+	}
+	return true
+}
+
+// addEndpoint validates spec and adds it to the link as a new endpoint, or reports a 400 or 409.
+func (server *Server) addEndpoint(c *call, link *link, spec endpointSpec) *endpoint {
+	if reason := spec.validate(); reason != "" {
+		c.badRequest(reason)
+		return nil
+	}
+	if !link.checkDisplayName(c, nil, *spec.DisplayName) {
+		return nil
+	}
+	endpoint := &endpoint{
+		id:         server.newID(link.locationID, "_"),
+		enabled:    spec.Enabled == nil || *spec.Enabled,
+		clientPort: int64(30000 + server.nextID),
+		certs:      certsFromSpec(spec.Certs),
+		sources:    make(map[string]*binding),
+		createdAt:  now(),
+	}
+	endpoint.lastChange = endpoint.createdAt
+	endpoint.status = newScript(satellitelinkv1.Endpoint_Status_Disabled)
+	if endpoint.enabled {
+		endpoint.status = newScript(satellitelinkv1.Endpoint_Status_Disabled, satellitelinkv1.Endpoint_Status_Enabled)
+	}
+	spec.Certs, spec.Enabled = nil, nil
+	endpoint.spec = spec
+	link.endpoints = append(link.endpoints, endpoint)
+	return endpoint
+}
+
+// endpointResponse reads the endpoint as an Endpoint.
+func (server *Server) endpointResponse(link *link, endpoint *endpoint) satellitelinkv1.Endpoint {
+	spec := endpoint.spec
+	response := satellitelinkv1.Endpoint{
+		ConnType:         spec.ConnType,
+		DisplayName:      spec.DisplayName,
+		ServerHost:       spec.ServerHost,
+		ServerPort:       spec.ServerPort,
+		Sni:              spec.Sni,
+		ClientProtocol:   spec.ClientProtocol,
+		ClientMutualAuth: spec.ClientMutualAuth,
+		ServerProtocol:   spec.ServerProtocol,
+		ServerMutualAuth: spec.ServerMutualAuth,
+		RejectUnauth:     spec.RejectUnauth,
+		Timeout:          spec.Timeout,
+		CreatedBy:        spec.CreatedBy,
+		Sources:          server.sourceStatuses(link, endpoint),
+		EndpointID:       stringPtr(endpoint.id),
+		LocationID:       stringPtr(link.locationID),
+		ClientHost:       stringPtr(link.host()),
+		ClientPort:       int64Ptr(endpoint.clientPort),
+		Certs:            endpoint.endpointCerts(),
+		Status:           stringPtr(endpoint.status.read(server.options.ReadsPerTransition)),
+		CreatedAt:        stringPtr(endpoint.createdAt),
+		LastChange:       stringPtr(endpoint.lastChange),
+	}
+	if link.crn != "" {
+		response.Crn = stringPtr(fmt.Sprintf("%s:endpoint:%s", link.crn, endpoint.id))
+	}
+	return response
+}
+
+// listEndpoints serves ListEndpoints, filtering the endpoints by the type query parameter.
+func (server *Server) listEndpoints(c *call) {
+	link := server.requireLink(c)
+	if link == nil {
+		return
+	}
+	filter := c.query("type")
+	if filter != "" && filter != satellitelinkv1.ListEndpointsOptions_Type_Enabled && filter != satellitelinkv1.ListEndpointsOptions_Type_Disabled {
+		c.badRequest(fmt.Sprintf("The type '%s' must be 'enabled' or 'disabled'.", filter))
+		return
+	}
+	endpoints := []satellitelinkv1.Endpoint{}
+	for _, endpoint := range link.endpoints {
+		if filter != "" && endpoint.enabled != (filter == satellitelinkv1.ListEndpointsOptions_Type_Enabled) {
+			continue
+		}
+		endpoints = append(endpoints, server.endpointResponse(link, endpoint))
+	}
+	c.ok(satellitelinkv1.Endpoints{Endpoints: endpoints})
+}
+
+// createEndpoint serves CreateEndpoints.
+func (server *Server) createEndpoint(c *call) {
+	link := server.requireLink(c)
+	if link == nil {
+		return
+	}
+	var spec endpointSpec
+	if !c.decode(&spec) {
+		return
+	}
+	spec.Enabled = nil
+	endpoint := server.addEndpoint(c, link, spec)
+	if endpoint == nil {
+		return
+	}
+	c.ok(server.endpointResponse(link, endpoint))
+}
+
+// getEndpoint serves GetEndpoints.
+func (server *Server) getEndpoint(c *call) {
+	link, endpoint := server.requireEndpoint(c)
+	if endpoint == nil {
+		return
+	}
+	c.ok(server.endpointResponse(link, endpoint))
+}
+
+// updateEndpoint serves UpdateEndpoints. Enabling or disabling the endpoint takes one transition.
+func (server *Server) updateEndpoint(c *call) {
+	link, endpoint := server.requireEndpoint(c)
+	if endpoint == nil {
+		return
+	}
+	var update endpointSpec
+	if !c.decode(&update) {
+		return
+	}
+	if update.ConnType != nil && *update.ConnType != *endpoint.spec.ConnType {
+		c.badRequest("The conn_type of an endpoint cannot be changed.")
+		return
+	}
+	spec := endpoint.spec.merge(update)
+	spec.Certs = update.Certs
+	if reason := spec.validate(); reason != "" {
+		c.badRequest(reason)
+		return
+	}
+	if !link.checkDisplayName(c, endpoint, *spec.DisplayName) {
+		return
+	}
+	certs := make(map[string]cert)
+	for kind, cert := range endpoint.certs {
+		certs[kind] = cert
+	}
+	for kind, cert := range certsFromSpec(spec.Certs) {
+		certs[kind] = cert
+	}
+	if reason := checkConnectorPair(certs); reason != "" {
+		c.badRequest(reason)
+		return
+	}
+	endpoint.certs = certs
+	if update.Enabled != nil && *update.Enabled != endpoint.enabled {
+		from, to := satellitelinkv1.Endpoint_Status_Enabled, satellitelinkv1.Endpoint_Status_Disabled
+		if *update.Enabled {
+			from, to = to, from
+		}
+		endpoint.enabled = *update.Enabled
+		endpoint.status = newScript(from, to)
+	}
+	spec.Certs = nil
+	endpoint.spec = spec
+	endpoint.lastChange = now()
+	c.ok(server.endpointResponse(link, endpoint))
+}
+
+// deleteEndpoint serves DeleteEndpoints.
+func (server *Server) deleteEndpoint(c *call) {
+	link, endpoint := server.requireEndpoint(c)
+	if endpoint == nil {
+		return
+	}
+	for i := range link.endpoints {
+		if link.endpoints[i] == endpoint {
+			link.endpoints = append(link.endpoints[:i], link.endpoints[i+1:]...)
+			break
+		}
+	}
+	c.message("The endpoint '%s' was deleted.", endpoint.id)
+}
+
+// exportEndpoint serves ExportEndpoints, returning the endpoint with its certificates as an ExportEndpointsResponse
+// that ImportEndpoints accepts.
+func (server *Server) exportEndpoint(c *call) {
+	_, endpoint := server.requireEndpoint(c)
+	if endpoint == nil {
+		return
+	}
+	spec := endpoint.spec
+	c.ok(satellitelinkv1.ExportEndpointsResponse{
+		ConnType:         spec.ConnType,
+		DisplayName:      spec.DisplayName,
+		ServerHost:       spec.ServerHost,
+		ServerPort:       spec.ServerPort,
+		Sni:              spec.Sni,
+		ClientProtocol:   spec.ClientProtocol,
+		ClientMutualAuth: spec.ClientMutualAuth,
+		ServerProtocol:   spec.ServerProtocol,
+		ServerMutualAuth: spec.ServerMutualAuth,
+		RejectUnauth:     spec.RejectUnauth,
+		Timeout:          spec.Timeout,
+		Certs:            endpoint.certsSpec(),
+	})
+}
+
+// importEndpoint serves ImportEndpoints, creating an endpoint from the exported state in the multipart form.
+func (server *Server) importEndpoint(c *call) {
+	link := server.requireLink(c)
+	if link == nil {
+		return
+	}
+	files, ok := c.multipartFiles()
+	if !ok {
+		return
+	}
+	state, found := files["state"]
+	if !found {
+		c.badRequest("The state file is required.")
+		return
+	}
+	var spec endpointSpec
+	if err := json.Unmarshal(state, &spec); err != nil {
+		c.badRequest("The state file is not a valid endpoint export: " + err.Error())
+		return
+	}
+	spec.Enabled = nil
+	endpoint := server.addEndpoint(c, link, spec)
+	if endpoint == nil {
+		return
+	}
+	c.ok(server.endpointResponse(link, endpoint))
+}
+
+// contains reports whether values contains value.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
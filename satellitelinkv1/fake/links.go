@@ -0,0 +1,191 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+)
+
+// link : The Satellite Link of a location, and its endpoints and sources.
+type link struct {
+	locationID string
+	crn        string
+	wsEndpoint string
+	createdAt  string
+	lastChange string
+	status     *script
+	endpoints  []*endpoint
+	sources    []*source
+}
+
+// addLinkRoutes adds the link routes.
+func (server *Server) addLinkRoutes() {
+	server.handle("POST", "/v1/locations", server.createLink)
+	server.handle("GET", "/v1/locations/{location_id}", server.getLink)
+	server.handle("PATCH", "/v1/locations/{location_id}", server.updateLink)
+	server.handle("DELETE", "/v1/locations/{location_id}", server.deleteLink)
+}
+
+// SetLinkStatuses scripts the statuses the link of the location goes through from now on.
+func (server *Server) SetLinkStatuses(locationID string, statuses ...string) error {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	link := server.findLink(locationID)
+	if link == nil {
+		return fmt.Errorf("link '%s' not found", locationID)
+	}
+	link.status = newScript(statuses...)
+	return nil
+}
+
+// findLink returns the link of the location.
+func (server *Server) findLink(locationID string) *link {
+	for _, link := range server.links {
+		if link.locationID == locationID {
+			return link
+		}
+	}
+	return nil
+}
+
+// requireLink returns the link of the location_id path parameter, or reports a 404.
+func (server *Server) requireLink(c *call) *link {
+	link := server.findLink(c.params["location_id"])
+	if link == nil {
+		c.notFound("location", c.params["location_id"])
+	}
+	return link
+}
+
+// location reads the link as a Location.
+func (server *Server) location(link *link) satellitelinkv1.Location {
+	return satellitelinkv1.Location{
+		WsEndpoint:        stringPtr(link.wsEndpoint),
+		LocationID:        stringPtr(link.locationID),
+		Crn:               stringPtr(link.crn),
+		SatelliteLinkHost: stringPtr(link.host()),
+		Status:            stringPtr(link.status.read(server.options.ReadsPerTransition)),
+		CreatedAt:         stringPtr(link.createdAt),
+		LastChange:        stringPtr(link.lastChange),
+	}
+}
+
+// host returns the host name the endpoints of the link are reached at from IBM Cloud.
+func (link *link) host() string {
+	return fmt.Sprintf("c-01.%s.link.satellite.test", strings.ToLower(link.locationID))
+}
+
+// createLink serves CreateLink. The link is enabled once the tunnel server connects, after one transition.
+func (server *Server) createLink(c *call) {
+	var body struct {
+		Crn        string `json:"crn"`
+		LocationID string `json:"location_id"`
+	}
+	if !c.decode(&body) {
+		return
+	}
+	if body.LocationID == "" {
+		c.badRequest("The location_id is required.")
+		return
+	}
+	if body.Crn != "" && !strings.HasSuffix(body.Crn, ":location:"+body.LocationID) {
+		c.badRequest(fmt.Sprintf("The crn '%s' is not the CRN of location '%s'.", body.Crn, body.LocationID))
+		return
+	}
+	if server.findLink(body.LocationID) != nil {
+		c.conflict(fmt.Sprintf("The location '%s' already has a link.", body.LocationID))
+		return
+	}
+	link := &link{
+		locationID: body.LocationID,
+		crn:        body.Crn,
+		wsEndpoint: fmt.Sprintf("wss://%s.link.satellite.test:443/ws", strings.ToLower(body.LocationID)),
+		createdAt:  now(),
+		status:     newScript(satellitelinkv1.Location_Status_Disabled, satellitelinkv1.Location_Status_Enabled),
+	}
+	link.lastChange = link.createdAt
+	server.links = append(server.links, link)
+	c.ok(server.location(link))
+}
+
+// getLink serves GetLink.
+func (server *Server) getLink(c *call) {
+	link := server.requireLink(c)
+	if link == nil {
+		return
+	}
+	c.ok(server.location(link))
+}
+
+// updateLink serves UpdateLink.
+func (server *Server) updateLink(c *call) {
+	link := server.requireLink(c)
+	if link == nil {
+		return
+	}
+	var body struct {
+		WsEndpoint *string `json:"ws_endpoint"`
+	}
+	if !c.decode(&body) {
+		return
+	}
+	if body.WsEndpoint != nil {
+		if *body.WsEndpoint == "" {
+			c.badRequest("The ws_endpoint cannot be empty.")
+			return
+		}
+		link.wsEndpoint = *body.WsEndpoint
+		link.lastChange = now()
+	}
+	c.ok(server.location(link))
+}
+
+// deleteLink serves DeleteLink, deleting the endpoints and sources of the link with it.
+func (server *Server) deleteLink(c *call) {
+	link := server.requireLink(c)
+	if link == nil {
+		return
+	}
+	for i := range server.links {
+		if server.links[i] == link {
+			server.links = append(server.links[:i], server.links[i+1:]...)
+			break
+		}
+	}
+	c.message("The link of location '%s' was deleted.", link.locationID)
+}
+
+// stringPtr returns a pointer to value, or nil if it is empty.
+func stringPtr(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// int64Ptr returns a pointer to value.
+func int64Ptr(value int64) *int64 {
+	return &value
+}
+
+// boolPtr returns a pointer to value.
+func boolPtr(value bool) *bool {
+	return &value
+}
@@ -0,0 +1,331 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fake provides an in-memory Satellite Link server for testing code that uses the satellitelinkv1 package
+// without access to IBM Cloud.
+//
+// The server keeps the links of Satellite locations, their endpoints, sources and certificates in memory and serves
+// all of the satellitelinkv1 routes for them, rejecting the requests the real service rejects. Links and endpoints
+// that are created or updated go through scripted status transitions, one status every Options.ReadsPerTransition
+// reads, and faults can be injected to test error handling:
+//
+//	server := fake.NewServer(nil)
+//	defer server.Close()
+//	satelliteLink.SetServiceURL(server.URL)
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options : The options of NewServer.
+type Options struct {
+	// How many times a resource is read before moving to the next status of its script. Defaults to 1.
+	ReadsPerTransition int
+}
+
+// Fault : A failure injected into the responses of the server.
+type Fault struct {
+	// The HTTP method of the requests to fail. All methods if empty.
+	Method string
+
+	// The path of the requests to fail, for example "/v1/locations/{location_id}". All paths if empty.
+	Path string
+
+	// The status code to respond with. The request is served normally after Latency if 0.
+	StatusCode int
+
+	// The value of the Retry-After header of the response, if not empty.
+	RetryAfter string
+
+	// How long to wait before responding.
+	Latency time.Duration
+
+	// How many requests to fail. All of them if 0.
+	Count int
+}
+
+// Request : A request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Query  map[string][]string
+	Header http.Header
+	Body   []byte
+}
+
+// Server : An in-memory Satellite Link server.
+type Server struct {
+	// The URL of the server, to pass to SetServiceURL.
+	URL string
+
+	httpServer *httptest.Server
+	options    Options
+	routes     []route
+
+	mutex    sync.Mutex
+	nextID   int
+	faults   []*Fault
+	requests []Request
+	links    []*link
+}
+
+// NewServer starts a server. Close it when done.
+func NewServer(options *Options) *Server {
+	server := &Server{}
+	if options != nil {
+		server.options = *options
+	}
+	if server.options.ReadsPerTransition <= 0 {
+		server.options.ReadsPerTransition = 1
+	}
+	server.addLinkRoutes()
+	server.addEndpointRoutes()
+	server.addCertRoutes()
+	server.addSourceRoutes()
+
+	server.httpServer = httptest.NewServer(server)
+	server.URL = server.httpServer.URL
+	return server
+}
+
+// Close shuts the server down.
+func (server *Server) Close() {
+	server.httpServer.Close()
+}
+
+// InjectFault makes the server fail the requests matching fault.
+func (server *Server) InjectFault(fault Fault) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = append(server.faults, &fault)
+}
+
+// ClearFaults removes the injected faults.
+func (server *Server) ClearFaults() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = nil
+}
+
+// Requests returns the requests received so far.
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]Request(nil), server.requests...)
+}
+
+// ServeHTTP serves a request, applying the injected faults first.
+func (server *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	body, _ := readBody(req)
+	server.mutex.Lock()
+	server.requests = append(server.requests, Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	})
+	fault := server.takeFault(req)
+	server.mutex.Unlock()
+
+	if fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-req.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			if fault.RetryAfter != "" {
+				res.Header().Set("Retry-After", fault.RetryAfter)
+			}
+			writeError(res, fault.StatusCode, "injected_fault", "Injected fault.")
+			return
+		}
+	}
+
+	for _, route := range server.routes {
+		params, ok := route.match(req.Method, req.URL.Path)
+		if !ok {
+			continue
+		}
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		route.handler(&call{res: res, req: req, params: params, body: body})
+		return
+	}
+	writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("The route %s %s is not implemented by the fake server.", req.Method, req.URL.Path))
+}
+
+// takeFault returns the first fault matching req and consumes one of its requests.
+func (server *Server) takeFault(req *http.Request) *Fault {
+	for i, fault := range server.faults {
+		if (fault.Method != "" && fault.Method != req.Method) || (fault.Path != "" && fault.Path != req.URL.Path) {
+			continue
+		}
+		if fault.Count > 0 {
+			fault.Count--
+			if fault.Count == 0 {
+				server.faults = append(server.faults[:i:i], server.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+// newID returns a new resource ID of the location with the separator sep, in the format of the service.
+func (server *Server) newID(locationID string, sep string) string {
+	server.nextID++
+	return fmt.Sprintf("%s%s%05d", locationID, sep, server.nextID)
+}
+
+// script : The statuses a resource goes through, the last one being kept.
+type script struct {
+	statuses []string
+	reads    int
+}
+
+// newScript returns a script going through statuses.
+func newScript(statuses ...string) *script {
+	return &script{statuses: statuses}
+}
+
+// read returns the current status and counts a read.
+func (s *script) read(readsPerTransition int) string {
+	status := s.statuses[0]
+	s.reads++
+	if s.reads >= readsPerTransition && len(s.statuses) > 1 {
+		s.statuses = s.statuses[1:]
+		s.reads = 0
+	}
+	return status
+}
+
+// route : A handler for a method and a path pattern, where segments in braces are parameters.
+type route struct {
+	method   string
+	segments []string
+	handler  func(c *call)
+}
+
+// handle adds a route to the server.
+func (server *Server) handle(method string, pattern string, handler func(c *call)) {
+	server.routes = append(server.routes, route{method: method, segments: strings.Split(strings.Trim(pattern, "/"), "/"), handler: handler})
+}
+
+// match returns the path parameters if the route serves method and path.
+func (route route) match(method string, path string) (map[string]string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if method != route.method || len(segments) != len(route.segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, segment := range route.segments {
+		if strings.HasPrefix(segment, "{") {
+			params[strings.Trim(segment, "{}")] = segments[i]
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// call : A request being served.
+type call struct {
+	res    http.ResponseWriter
+	req    *http.Request
+	params map[string]string
+	body   []byte
+}
+
+// query returns the query parameter name.
+func (c *call) query(name string) string {
+	return c.req.URL.Query().Get(name)
+}
+
+// decode decodes the JSON body into value and reports a 400 if it fails.
+func (c *call) decode(value interface{}) bool {
+	if len(c.body) == 0 {
+		return true
+	}
+	if err := json.Unmarshal(c.body, value); err != nil {
+		c.badRequest("The request body is not valid JSON: " + err.Error())
+		return false
+	}
+	return true
+}
+
+// ok writes value with a 200.
+func (c *call) ok(value interface{}) {
+	c.res.Header().Set("Content-Type", "application/json")
+	c.res.WriteHeader(http.StatusOK)
+	json.NewEncoder(c.res).Encode(value)
+}
+
+// message writes an ExecutionResult with a 200.
+func (c *call) message(format string, args ...interface{}) {
+	c.ok(map[string]string{"message": fmt.Sprintf(format, args...)})
+}
+
+// notFound writes a 404 for the resource kind called name.
+func (c *call) notFound(kind string, name string) {
+	writeError(c.res, http.StatusNotFound, "not_found", fmt.Sprintf("The %s '%s' was not found.", kind, name))
+}
+
+// badRequest writes a 400 with message.
+func (c *call) badRequest(message string) {
+	writeError(c.res, http.StatusBadRequest, "bad_request", message)
+}
+
+// conflict writes a 409 with message.
+func (c *call) conflict(message string) {
+	writeError(c.res, http.StatusConflict, "conflict", message)
+}
+
+// writeError writes an error response in the IBM Cloud API error format.
+func writeError(res http.ResponseWriter, status int, code string, message string) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	json.NewEncoder(res).Encode(map[string]interface{}{
+		"errors":      []map[string]string{{"code": code, "message": message}},
+		"status_code": status,
+		"trace":       fmt.Sprintf("fake-%d", time.Now().UnixNano()),
+	})
+}
+
+// readBody reads the body of req.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return ioutil.ReadAll(req.Body)
+}
+
+// now returns the current time in the format of the API dates.
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,280 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1/fake"
+)
+
+const testCert = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+var _ = Describe(`Fake server`, func() {
+	var server *fake.Server
+	var satelliteLinkService *satellitelinkv1.SatelliteLinkV1
+
+	var waitOptions = common.WaitOptions{Interval: time.Millisecond, Timeout: 5 * time.Second}
+
+	// createLink creates the link of location loc1.
+	var createLink = func() {
+		createLinkOptions := satelliteLinkService.NewCreateLinkOptions()
+		createLinkOptions.LocationID = core.StringPtr("loc1")
+		createLinkOptions.Crn = core.StringPtr("crn:v1:bluemix:public:satellite:us-south:a/acct::location:loc1")
+		_, _, err := satelliteLinkService.CreateLink(createLinkOptions)
+		Expect(err).To(BeNil())
+	}
+
+	// endpointOptions returns the options of a valid https endpoint of loc1.
+	var endpointOptions = func(name string) *satellitelinkv1.CreateEndpointsOptions {
+		options := satelliteLinkService.NewCreateEndpointsOptions("loc1")
+		options.ConnType = core.StringPtr(satellitelinkv1.CreateEndpointsOptions_ConnType_Cloud)
+		options.DisplayName = core.StringPtr(name)
+		options.ServerHost = core.StringPtr("example.com")
+		options.ServerPort = core.Int64Ptr(443)
+		options.ClientProtocol = core.StringPtr(satellitelinkv1.CreateEndpointsOptions_ClientProtocol_Https)
+		return options
+	}
+
+	var createEndpoint = func(name string) *satellitelinkv1.Endpoint {
+		endpoint, _, err := satelliteLinkService.CreateEndpoints(endpointOptions(name))
+		Expect(err).To(BeNil())
+		return endpoint
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		var serviceErr error
+		satelliteLinkService, serviceErr = satellitelinkv1.NewSatelliteLinkV1(&satellitelinkv1.SatelliteLinkV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Creates, enables and deletes links`, func() {
+		_, response, err := satelliteLinkService.CreateLink(satelliteLinkService.NewCreateLinkOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("location_id is required"))
+		Expect(response.StatusCode).To(Equal(400))
+
+		createLink()
+		_, response, _ = satelliteLinkService.CreateLink(&satellitelinkv1.CreateLinkOptions{LocationID: core.StringPtr("loc1")})
+		Expect(response.StatusCode).To(Equal(409))
+
+		location, err := satelliteLinkService.WaitForLinkReady(context.Background(), "loc1", &satellitelinkv1.LinkWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+		Expect(*location.Status).To(Equal(satellitelinkv1.Location_Status_Enabled))
+
+		_, _, err = satelliteLinkService.DeleteLink(satelliteLinkService.NewDeleteLinkOptions("loc1"))
+		Expect(err).To(BeNil())
+		_, response, _ = satelliteLinkService.GetLink(satelliteLinkService.NewGetLinkOptions("loc1"))
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Validates endpoints`, func() {
+		createLink()
+
+		endpoint := createEndpoint("my-endpoint")
+		Expect(*endpoint.ServerProtocol).To(Equal(satellitelinkv1.Endpoint_ServerProtocol_Tls))
+		Expect(*endpoint.LocationID).To(Equal("loc1"))
+
+		invalid := map[string]func(options *satellitelinkv1.CreateEndpointsOptions){
+			"display_name": func(options *satellitelinkv1.CreateEndpointsOptions) {
+				options.DisplayName = core.StringPtr("My endpoint")
+			},
+			"conn_type": func(options *satellitelinkv1.CreateEndpointsOptions) { options.ConnType = nil },
+			"server_protocol 'udp' cannot be used": func(options *satellitelinkv1.CreateEndpointsOptions) {
+				options.ServerProtocol = core.StringPtr(satellitelinkv1.CreateEndpointsOptions_ServerProtocol_Udp)
+			},
+			"server_port can only be 0": func(options *satellitelinkv1.CreateEndpointsOptions) { options.ServerPort = core.Int64Ptr(0) },
+			"client_mutual_auth": func(options *satellitelinkv1.CreateEndpointsOptions) {
+				options.ClientProtocol = core.StringPtr(satellitelinkv1.CreateEndpointsOptions_ClientProtocol_Tcp)
+				options.ClientMutualAuth = core.BoolPtr(true)
+			},
+			"sni": func(options *satellitelinkv1.CreateEndpointsOptions) {
+				options.ServerProtocol = core.StringPtr(satellitelinkv1.CreateEndpointsOptions_ServerProtocol_Tcp)
+				options.Sni = core.StringPtr("example.com")
+			},
+			"timeout": func(options *satellitelinkv1.CreateEndpointsOptions) { options.Timeout = core.Int64Ptr(600) },
+			"connector cert and key": func(options *satellitelinkv1.CreateEndpointsOptions) {
+				options.Certs = &satellitelinkv1.AdditionalNewEndpointRequestCerts{
+					Connector: &satellitelinkv1.AdditionalNewEndpointRequestCertsConnector{
+						Cert: &satellitelinkv1.AdditionalNewEndpointRequestCertsConnectorCert{FileContents: core.StringPtr(testCert)},
+					},
+				}
+			},
+		}
+		for reason, mutate := range invalid {
+			options := endpointOptions("other-endpoint")
+			mutate(options)
+			_, response, err := satelliteLinkService.CreateEndpoints(options)
+			Expect(err).ToNot(BeNil(), reason)
+			Expect(err.Error()).To(ContainSubstring(reason))
+			Expect(response.StatusCode).To(Equal(400))
+		}
+
+		_, response, _ := satelliteLinkService.CreateEndpoints(endpointOptions("my-endpoint"))
+		Expect(response.StatusCode).To(Equal(409))
+		_, response, _ = satelliteLinkService.CreateEndpoints(endpointOptions("my-endpoint").SetLocationID("loc2"))
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Enables and disables endpoints`, func() {
+		createLink()
+		endpoint := createEndpoint("my-endpoint")
+
+		endpoint, err := satelliteLinkService.WaitForEndpointReady(context.Background(), "loc1", *endpoint.EndpointID, &satellitelinkv1.EndpointWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+
+		updateOptions := satelliteLinkService.NewUpdateEndpointsOptions("loc1", *endpoint.EndpointID)
+		updateOptions.Enabled = core.BoolPtr(false)
+		updateOptions.ClientProtocol = core.StringPtr(satellitelinkv1.UpdateEndpointsOptions_ClientProtocol_Tcp)
+		_, _, err = satelliteLinkService.UpdateEndpoints(updateOptions)
+		Expect(err).To(BeNil())
+
+		endpoint, err = satelliteLinkService.WaitForEndpointStatus(context.Background(), "loc1", *endpoint.EndpointID, satellitelinkv1.Endpoint_Status_Disabled, &satellitelinkv1.EndpointWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+		Expect(*endpoint.ServerProtocol).To(Equal(satellitelinkv1.Endpoint_ServerProtocol_Tcp))
+
+		endpoints, _, err := satelliteLinkService.ListEndpoints(satelliteLinkService.NewListEndpointsOptions("loc1").SetType(satellitelinkv1.ListEndpointsOptions_Type_Enabled))
+		Expect(err).To(BeNil())
+		Expect(endpoints.Endpoints).To(BeEmpty())
+
+		_, _, err = satelliteLinkService.DeleteEndpoints(satelliteLinkService.NewDeleteEndpointsOptions("loc1", *endpoint.EndpointID))
+		Expect(err).To(BeNil())
+		_, response, _ := satelliteLinkService.GetEndpoints(satelliteLinkService.NewGetEndpointsOptions("loc1", *endpoint.EndpointID))
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Uploads, downloads and deletes certificates`, func() {
+		createLink()
+		endpoint := createEndpoint("my-endpoint")
+
+		uploadOptions := satelliteLinkService.NewUploadEndpointCertsOptions("loc1", *endpoint.EndpointID)
+		uploadOptions.ClientCert = ioutil.NopCloser(bytes.NewReader([]byte("not a certificate")))
+		_, response, _ := satelliteLinkService.UploadEndpointCerts(uploadOptions)
+		Expect(response.StatusCode).To(Equal(400))
+
+		uploadOptions = satelliteLinkService.NewUploadEndpointCertsOptions("loc1", *endpoint.EndpointID)
+		uploadOptions.ConnectorCert = ioutil.NopCloser(bytes.NewReader([]byte(testCert)))
+		_, response, _ = satelliteLinkService.UploadEndpointCerts(uploadOptions)
+		Expect(response.StatusCode).To(Equal(400))
+
+		uploadOptions = satelliteLinkService.NewUploadEndpointCertsOptions("loc1", *endpoint.EndpointID)
+		uploadOptions.ClientCert = ioutil.NopCloser(bytes.NewReader([]byte(testCert)))
+		endpoint, _, err := satelliteLinkService.UploadEndpointCerts(uploadOptions)
+		Expect(err).To(BeNil())
+		Expect(*endpoint.Certs.Client.Cert.Filename).To(Equal("client_cert.pem"))
+
+		certs, _, err := satelliteLinkService.GetEndpointCerts(satelliteLinkService.NewGetEndpointCertsOptions("loc1", *endpoint.EndpointID).SetNoZip(true))
+		Expect(err).To(BeNil())
+		Expect(certs.Certs).To(HaveLen(1))
+		Expect(*certs.Certs[0].Content).To(Equal(testCert))
+
+		endpoint, _, err = satelliteLinkService.DeleteEndpointCerts(satelliteLinkService.NewDeleteEndpointCertsOptions("loc1", *endpoint.EndpointID))
+		Expect(err).To(BeNil())
+		Expect(endpoint.Certs).To(BeNil())
+	})
+	It(`Imports exported endpoints`, func() {
+		createLink()
+		options := endpointOptions("my-endpoint")
+		options.Certs = &satellitelinkv1.AdditionalNewEndpointRequestCerts{
+			Server: &satellitelinkv1.AdditionalNewEndpointRequestCertsServer{
+				Cert: &satellitelinkv1.AdditionalNewEndpointRequestCertsServerCert{Filename: core.StringPtr("server.pem"), FileContents: core.StringPtr(testCert)},
+			},
+		}
+		endpoint, _, err := satelliteLinkService.CreateEndpoints(options)
+		Expect(err).To(BeNil())
+
+		export, _, err := satelliteLinkService.ExportEndpoints(satelliteLinkService.NewExportEndpointsOptions("loc1", *endpoint.EndpointID))
+		Expect(err).To(BeNil())
+		Expect(*export.Certs.Server.Cert.FileContents).To(Equal(testCert))
+		state, err := json.Marshal(export)
+		Expect(err).To(BeNil())
+
+		_, _, err = satelliteLinkService.DeleteEndpoints(satelliteLinkService.NewDeleteEndpointsOptions("loc1", *endpoint.EndpointID))
+		Expect(err).To(BeNil())
+
+		imported, _, err := satelliteLinkService.ImportEndpoints(satelliteLinkService.NewImportEndpointsOptions("loc1", ioutil.NopCloser(bytes.NewReader(state))))
+		Expect(err).To(BeNil())
+		Expect(*imported.DisplayName).To(Equal("my-endpoint"))
+		Expect(*imported.Certs.Server.Cert.Filename).To(Equal("server.pem"))
+		Expect(*imported.EndpointID).ToNot(Equal(*endpoint.EndpointID))
+
+		_, response, _ := satelliteLinkService.ImportEndpoints(satelliteLinkService.NewImportEndpointsOptions("loc1", ioutil.NopCloser(bytes.NewReader(state))))
+		Expect(response.StatusCode).To(Equal(409))
+	})
+	It(`Binds sources to endpoints`, func() {
+		createLink()
+		endpoint := createEndpoint("my-endpoint")
+
+		sourceOptions := satelliteLinkService.NewCreateSourcesOptions("loc1")
+		sourceOptions.Type = core.StringPtr(satellitelinkv1.CreateSourcesOptions_Type_User)
+		sourceOptions.SourceName = core.StringPtr("db2")
+		sourceOptions.Addresses = []string{"not-an-address"}
+		_, response, _ := satelliteLinkService.CreateSources(sourceOptions)
+		Expect(response.StatusCode).To(Equal(400))
+
+		sourceOptions.Addresses = []string{"192.168.20.1/24", "10.0.0.1"}
+		source, _, err := satelliteLinkService.CreateSources(sourceOptions)
+		Expect(err).To(BeNil())
+
+		updateOptions := satelliteLinkService.NewUpdateEndpointSourcesOptions("loc1", *endpoint.EndpointID)
+		updateOptions.Sources = []satellitelinkv1.SourceStatusRequestObject{{SourceID: source.SourceID, Enabled: core.BoolPtr(true)}}
+		sourceStatus, _, err := satelliteLinkService.UpdateEndpointSources(updateOptions)
+		Expect(err).To(BeNil())
+		Expect(*sourceStatus.Sources[0].Pending).To(BeTrue())
+
+		sourceStatus, err = satelliteLinkService.WaitForSourcesApplied(context.Background(), "loc1", *endpoint.EndpointID, &satellitelinkv1.SourcesWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+		Expect(*sourceStatus.Sources[0].Enabled).To(BeTrue())
+
+		sourceEndpoints, _, err := satelliteLinkService.ListSourceEndpoints(satelliteLinkService.NewListSourceEndpointsOptions("loc1", *source.SourceID))
+		Expect(err).To(BeNil())
+		Expect(*sourceEndpoints.Endpoints[0].Enabled).To(BeTrue())
+
+		_, _, err = satelliteLinkService.DeleteSources(satelliteLinkService.NewDeleteSourcesOptions("loc1", *source.SourceID))
+		Expect(err).To(BeNil())
+		sourceStatus, _, err = satelliteLinkService.ListEndpointSources(satelliteLinkService.NewListEndpointSourcesOptions("loc1", *endpoint.EndpointID))
+		Expect(err).To(BeNil())
+		Expect(sourceStatus.Sources).To(BeEmpty())
+	})
+	It(`Injects faults`, func() {
+		createLink()
+		server.InjectFault(fake.Fault{Method: "GET", Path: "/v1/locations/loc1", StatusCode: 503, RetryAfter: "1", Count: 1})
+
+		_, response, err := satelliteLinkService.GetLink(satelliteLinkService.NewGetLinkOptions("loc1"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(503))
+		Expect(response.Headers.Get("Retry-After")).To(Equal("1"))
+
+		_, _, err = satelliteLinkService.GetLink(satelliteLinkService.NewGetLinkOptions("loc1"))
+		Expect(err).To(BeNil())
+		Expect(server.Requests()).To(HaveLen(3))
+	})
+})
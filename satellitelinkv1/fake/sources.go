@@ -0,0 +1,339 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+)
+
+// The states of a change to the sources of an endpoint.
+const (
+	bindingPending = "pending"
+	bindingApplied = "applied"
+)
+
+// source : A source of a link, allowed or denied access to its endpoints.
+type source struct {
+	id         string
+	sourceType string
+	name       string
+	addresses  []string
+	createdAt  string
+	lastChange string
+}
+
+// binding : Whether a source is allowed to access an endpoint. A change is pending for one transition.
+type binding struct {
+	enabled    bool
+	state      *script
+	lastChange string
+}
+
+// addSourceRoutes adds the source routes and the routes binding sources to endpoints.
+func (server *Server) addSourceRoutes() {
+	server.handle("GET", "/v1/locations/{location_id}/sources", server.listSources)
+	server.handle("POST", "/v1/locations/{location_id}/sources", server.createSource)
+	server.handle("PATCH", "/v1/locations/{location_id}/sources/{source_id}", server.updateSource)
+	server.handle("DELETE", "/v1/locations/{location_id}/sources/{source_id}", server.deleteSource)
+	server.handle("GET", "/v1/locations/{location_id}/sources/{source_id}/endpoints", server.listSourceEndpoints)
+	server.handle("PATCH", "/v1/locations/{location_id}/sources/{source_id}/endpoints", server.updateSourceEndpoints)
+
+	server.handle("GET", "/v1/locations/{location_id}/endpoints/{endpoint_id}/sources", server.listEndpointSources)
+	server.handle("PATCH", "/v1/locations/{location_id}/endpoints/{endpoint_id}/sources", server.updateEndpointSources)
+}
+
+// findSource returns the source with the ID id.
+func (link *link) findSource(id string) *source {
+	for _, source := range link.sources {
+		if source.id == id {
+			return source
+		}
+	}
+	return nil
+}
+
+// requireSource returns the link and source of the location_id and source_id path parameters, or reports a 404.
+func (server *Server) requireSource(c *call) (*link, *source) {
+	link := server.requireLink(c)
+	if link == nil {
+		return nil, nil
+	}
+	source := link.findSource(c.params["source_id"])
+	if source == nil {
+		c.notFound("source", c.params["source_id"])
+	}
+	return link, source
+}
+
+// checkSource reports a 400 or 409 if the name or addresses of a source are not valid.
+func (link *link) checkSource(c *call, self *source, name string, addresses []string) bool {
+	if !namePattern.MatchString(name) {
+		c.badRequest("The source_name must start with a letter, end with an alphanumeric character, contain only letters, numbers and hyphens, and be 63 characters or fewer.")
+		return false
+	}
+	for _, address := range addresses {
+		if _, _, err := net.ParseCIDR(address); err != nil && net.ParseIP(address) == nil {
+			c.badRequest(fmt.Sprintf("The address '%s' is not an IP address or CIDR block.", address))
+			return false
+		}
+	}
+	for _, source := range link.sources {
+		if source != self && source.name == name {
+			c.conflict(fmt.Sprintf("A source with the source_name '%s' already exists.", name))
+			return false
+		}
+	}
+	return true
+}
+
+// sourceResponse returns the source as a Source.
+func (link *link) sourceResponse(source *source) satellitelinkv1.Source {
+	return satellitelinkv1.Source{
+		Type:       stringPtr(source.sourceType),
+		SourceName: stringPtr(source.name),
+		Addresses:  source.addresses,
+		SourceID:   stringPtr(source.id),
+		LocationID: stringPtr(link.locationID),
+		CreatedAt:  stringPtr(source.createdAt),
+		LastChange: stringPtr(source.lastChange),
+	}
+}
+
+// sourceStatuses reads the sources bound to the endpoint.
+func (server *Server) sourceStatuses(link *link, endpoint *endpoint) []satellitelinkv1.SourceStatusObject {
+	statuses := []satellitelinkv1.SourceStatusObject{}
+	for _, source := range link.sources {
+		binding, ok := endpoint.sources[source.id]
+		if !ok {
+			continue
+		}
+		statuses = append(statuses, satellitelinkv1.SourceStatusObject{
+			SourceID:   stringPtr(source.id),
+			Enabled:    boolPtr(binding.enabled),
+			LastChange: stringPtr(binding.lastChange),
+			Pending:    boolPtr(binding.state.read(server.options.ReadsPerTransition) == bindingPending),
+		})
+	}
+	return statuses
+}
+
+// bind allows or denies the source access to the endpoint.
+func (endpoint *endpoint) bind(source *source, enabled bool) {
+	endpoint.sources[source.id] = &binding{
+		enabled:    enabled,
+		state:      newScript(bindingPending, bindingApplied),
+		lastChange: now(),
+	}
+}
+
+// listSources serves ListSources, filtering the sources by the type query parameter.
+func (server *Server) listSources(c *call) {
+	link := server.requireLink(c)
+	if link == nil {
+		return
+	}
+	sources := []satellitelinkv1.Source{}
+	for _, source := range link.sources {
+		if filter := c.query("type"); filter == "" || source.sourceType == filter {
+			sources = append(sources, link.sourceResponse(source))
+		}
+	}
+	c.ok(satellitelinkv1.Sources{Sources: sources})
+}
+
+// createSource serves CreateSources.
+func (server *Server) createSource(c *call) {
+	link := server.requireLink(c)
+	if link == nil {
+		return
+	}
+	var body struct {
+		Type       string   `json:"type"`
+		SourceName string   `json:"source_name"`
+		Addresses  []string `json:"addresses"`
+	}
+	if !c.decode(&body) {
+		return
+	}
+	if body.Type != satellitelinkv1.Source_Type_User && body.Type != satellitelinkv1.Source_Type_Service {
+		c.badRequest("The type must be 'user' or 'service'.")
+		return
+	}
+	if body.Type == satellitelinkv1.Source_Type_User && len(body.Addresses) == 0 {
+		c.badRequest("The addresses are required for a source of type 'user'.")
+		return
+	}
+	if !link.checkSource(c, nil, body.SourceName, body.Addresses) {
+		return
+	}
+	source := &source{
+		id:         server.newID(link.locationID, "-Source-"),
+		sourceType: body.Type,
+		name:       body.SourceName,
+		addresses:  body.Addresses,
+		createdAt:  now(),
+	}
+	source.lastChange = source.createdAt
+	link.sources = append(link.sources, source)
+	c.ok(link.sourceResponse(source))
+}
+
+// updateSource serves UpdateSources.
+func (server *Server) updateSource(c *call) {
+	link, source := server.requireSource(c)
+	if source == nil {
+		return
+	}
+	var body struct {
+		SourceName *string  `json:"source_name"`
+		Addresses  []string `json:"addresses"`
+	}
+	if !c.decode(&body) {
+		return
+	}
+	name, addresses := source.name, source.addresses
+	if body.SourceName != nil {
+		name = *body.SourceName
+	}
+	if body.Addresses != nil {
+		addresses = body.Addresses
+	}
+	if source.sourceType == satellitelinkv1.Source_Type_User && len(addresses) == 0 {
+		c.badRequest("The addresses are required for a source of type 'user'.")
+		return
+	}
+	if !link.checkSource(c, source, name, addresses) {
+		return
+	}
+	source.name, source.addresses, source.lastChange = name, addresses, now()
+	c.ok(link.sourceResponse(source))
+}
+
+// deleteSource serves DeleteSources, removing the source from the endpoints it is bound to.
+func (server *Server) deleteSource(c *call) {
+	link, source := server.requireSource(c)
+	if source == nil {
+		return
+	}
+	for i := range link.sources {
+		if link.sources[i] == source {
+			link.sources = append(link.sources[:i], link.sources[i+1:]...)
+			break
+		}
+	}
+	for _, endpoint := range link.endpoints {
+		delete(endpoint.sources, source.id)
+	}
+	c.message("The source '%s' was deleted.", source.id)
+}
+
+// listSourceEndpoints serves ListSourceEndpoints, listing whether the source can access each endpoint of the link.
+func (server *Server) listSourceEndpoints(c *call) {
+	link, source := server.requireSource(c)
+	if source == nil {
+		return
+	}
+	status := satellitelinkv1.EndpointSourceStatus{Endpoints: []satellitelinkv1.EndpointSourceStatusEndpointsItem{}}
+	for _, endpoint := range link.endpoints {
+		enabled := false
+		if binding, ok := endpoint.sources[source.id]; ok {
+			enabled = binding.enabled
+		}
+		status.Endpoints = append(status.Endpoints, satellitelinkv1.EndpointSourceStatusEndpointsItem{
+			EndpointID: stringPtr(endpoint.id),
+			Enabled:    boolPtr(enabled),
+		})
+	}
+	c.ok(status)
+}
+
+// updateSourceEndpoints serves UpdateSourceEndpoints.
+func (server *Server) updateSourceEndpoints(c *call) {
+	link, source := server.requireSource(c)
+	if source == nil {
+		return
+	}
+	var body struct {
+		Endpoints []struct {
+			EndpointID string `json:"endpoint_id"`
+			Enabled    *bool  `json:"enabled"`
+		} `json:"endpoints"`
+	}
+	if !c.decode(&body) {
+		return
+	}
+	endpoints := make([]*endpoint, len(body.Endpoints))
+	for i, item := range body.Endpoints {
+		if endpoints[i] = link.findEndpoint(item.EndpointID); endpoints[i] == nil {
+			c.notFound("endpoint", item.EndpointID)
+			return
+		}
+		if item.Enabled == nil {
+			c.badRequest(fmt.Sprintf("The enabled field is required for endpoint '%s'.", item.EndpointID))
+			return
+		}
+	}
+	for i, item := range body.Endpoints {
+		endpoints[i].bind(source, *item.Enabled)
+	}
+	c.message("The endpoints of source '%s' were updated.", source.id)
+}
+
+// listEndpointSources serves ListEndpointSources.
+func (server *Server) listEndpointSources(c *call) {
+	link, endpoint := server.requireEndpoint(c)
+	if endpoint == nil {
+		return
+	}
+	c.ok(satellitelinkv1.SourceStatus{Sources: server.sourceStatuses(link, endpoint)})
+}
+
+// updateEndpointSources serves UpdateEndpointSources.
+func (server *Server) updateEndpointSources(c *call) {
+	link, endpoint := server.requireEndpoint(c)
+	if endpoint == nil {
+		return
+	}
+	var body struct {
+		Sources []struct {
+			SourceID string `json:"source_id"`
+			Enabled  *bool  `json:"enabled"`
+		} `json:"sources"`
+	}
+	if !c.decode(&body) {
+		return
+	}
+	sources := make([]*source, len(body.Sources))
+	for i, item := range body.Sources {
+		if sources[i] = link.findSource(item.SourceID); sources[i] == nil {
+			c.notFound("source", item.SourceID)
+			return
+		}
+		if item.Enabled == nil {
+			c.badRequest(fmt.Sprintf("The enabled field is required for source '%s'.", item.SourceID))
+			return
+		}
+	}
+	for i, item := range body.Sources {
+		endpoint.bind(sources[i], *item.Enabled)
+	}
+	endpoint.lastChange = now()
+	c.ok(satellitelinkv1.SourceStatus{Sources: server.sourceStatuses(link, endpoint)})
+}
@@ -0,0 +1,72 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package satellitelinkv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+)
+
+var _ = Describe(`Interceptors`, func() {
+	var testServer *httptest.Server
+	var satelliteLinkService *satellitelinkv1.SatelliteLinkV1
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"location_id": "loc1", "status": "%s"}`, req.Header.Get("X-Status"))
+		}))
+		var serviceErr error
+		satelliteLinkService, serviceErr = satellitelinkv1.NewSatelliteLinkV1(&satellitelinkv1.SatelliteLinkV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Receive the operation ID and modify the request and response`, func() {
+		var operations []string
+		satelliteLinkService.AddInterceptor(func(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+			operations = append(operations, operationID)
+			request.Header.Set("X-Status", "enabled")
+			response, err := next(request)
+			response.Headers.Set("X-Intercepted", "true")
+			return response, err
+		})
+
+		location, response, err := satelliteLinkService.GetLink(satelliteLinkService.NewGetLinkOptions("loc1"))
+		Expect(err).To(BeNil())
+		Expect(*location.Status).To(Equal("enabled"))
+		Expect(response.Headers.Get("X-Intercepted")).To(Equal("true"))
+
+		_, _, err = satelliteLinkService.ListEndpoints(satelliteLinkService.NewListEndpointsOptions("loc1"))
+		Expect(err).To(BeNil())
+		Expect(operations).To(Equal([]string{"GetLink", "ListEndpoints"}))
+	})
+})
@@ -0,0 +1,727 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+// Package mock provides a mock implementation of the satellitelinkv1.API interface.
+//
+// Each method of API calls the function field of the same name with a Func suffix. A method without a
+// context falls back to the function of its WithContext form, called with context.Background(). Methods whose
+// function is not set return zero values and an error wrapping ErrNotStubbed.
+package mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrNotStubbed is wrapped by the errors of the methods whose function is not set.
+var ErrNotStubbed = errors.New("mock: method not stubbed")
+
+// Call : A call of a method of the mock.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// API : A mock implementation of satellitelinkv1.API.
+type API struct {
+	CreateLinkFunc                       func(createLinkOptions *satellitelinkv1.CreateLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error)
+	CreateLinkWithContextFunc            func(ctx context.Context, createLinkOptions *satellitelinkv1.CreateLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error)
+	GetLinkFunc                          func(getLinkOptions *satellitelinkv1.GetLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error)
+	GetLinkWithContextFunc               func(ctx context.Context, getLinkOptions *satellitelinkv1.GetLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error)
+	UpdateLinkFunc                       func(updateLinkOptions *satellitelinkv1.UpdateLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error)
+	UpdateLinkWithContextFunc            func(ctx context.Context, updateLinkOptions *satellitelinkv1.UpdateLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error)
+	DeleteLinkFunc                       func(deleteLinkOptions *satellitelinkv1.DeleteLinkOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error)
+	DeleteLinkWithContextFunc            func(ctx context.Context, deleteLinkOptions *satellitelinkv1.DeleteLinkOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error)
+	ListEndpointsFunc                    func(listEndpointsOptions *satellitelinkv1.ListEndpointsOptions) (*satellitelinkv1.Endpoints, *core.DetailedResponse, error)
+	ListEndpointsWithContextFunc         func(ctx context.Context, listEndpointsOptions *satellitelinkv1.ListEndpointsOptions) (*satellitelinkv1.Endpoints, *core.DetailedResponse, error)
+	CreateEndpointsFunc                  func(createEndpointsOptions *satellitelinkv1.CreateEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	CreateEndpointsWithContextFunc       func(ctx context.Context, createEndpointsOptions *satellitelinkv1.CreateEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	ImportEndpointsFunc                  func(importEndpointsOptions *satellitelinkv1.ImportEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	ImportEndpointsWithContextFunc       func(ctx context.Context, importEndpointsOptions *satellitelinkv1.ImportEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	ExportEndpointsFunc                  func(exportEndpointsOptions *satellitelinkv1.ExportEndpointsOptions) (*satellitelinkv1.ExportEndpointsResponse, *core.DetailedResponse, error)
+	ExportEndpointsWithContextFunc       func(ctx context.Context, exportEndpointsOptions *satellitelinkv1.ExportEndpointsOptions) (*satellitelinkv1.ExportEndpointsResponse, *core.DetailedResponse, error)
+	GetEndpointsFunc                     func(getEndpointsOptions *satellitelinkv1.GetEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	GetEndpointsWithContextFunc          func(ctx context.Context, getEndpointsOptions *satellitelinkv1.GetEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	UpdateEndpointsFunc                  func(updateEndpointsOptions *satellitelinkv1.UpdateEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	UpdateEndpointsWithContextFunc       func(ctx context.Context, updateEndpointsOptions *satellitelinkv1.UpdateEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	DeleteEndpointsFunc                  func(deleteEndpointsOptions *satellitelinkv1.DeleteEndpointsOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error)
+	DeleteEndpointsWithContextFunc       func(ctx context.Context, deleteEndpointsOptions *satellitelinkv1.DeleteEndpointsOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error)
+	GetEndpointCertsFunc                 func(getEndpointCertsOptions *satellitelinkv1.GetEndpointCertsOptions) (*satellitelinkv1.DownloadedCerts, *core.DetailedResponse, error)
+	GetEndpointCertsWithContextFunc      func(ctx context.Context, getEndpointCertsOptions *satellitelinkv1.GetEndpointCertsOptions) (*satellitelinkv1.DownloadedCerts, *core.DetailedResponse, error)
+	UploadEndpointCertsFunc              func(uploadEndpointCertsOptions *satellitelinkv1.UploadEndpointCertsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	UploadEndpointCertsWithContextFunc   func(ctx context.Context, uploadEndpointCertsOptions *satellitelinkv1.UploadEndpointCertsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	DeleteEndpointCertsFunc              func(deleteEndpointCertsOptions *satellitelinkv1.DeleteEndpointCertsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	DeleteEndpointCertsWithContextFunc   func(ctx context.Context, deleteEndpointCertsOptions *satellitelinkv1.DeleteEndpointCertsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error)
+	ListEndpointSourcesFunc              func(listEndpointSourcesOptions *satellitelinkv1.ListEndpointSourcesOptions) (*satellitelinkv1.SourceStatus, *core.DetailedResponse, error)
+	ListEndpointSourcesWithContextFunc   func(ctx context.Context, listEndpointSourcesOptions *satellitelinkv1.ListEndpointSourcesOptions) (*satellitelinkv1.SourceStatus, *core.DetailedResponse, error)
+	UpdateEndpointSourcesFunc            func(updateEndpointSourcesOptions *satellitelinkv1.UpdateEndpointSourcesOptions) (*satellitelinkv1.SourceStatus, *core.DetailedResponse, error)
+	UpdateEndpointSourcesWithContextFunc func(ctx context.Context, updateEndpointSourcesOptions *satellitelinkv1.UpdateEndpointSourcesOptions) (*satellitelinkv1.SourceStatus, *core.DetailedResponse, error)
+	ListSourcesFunc                      func(listSourcesOptions *satellitelinkv1.ListSourcesOptions) (*satellitelinkv1.Sources, *core.DetailedResponse, error)
+	ListSourcesWithContextFunc           func(ctx context.Context, listSourcesOptions *satellitelinkv1.ListSourcesOptions) (*satellitelinkv1.Sources, *core.DetailedResponse, error)
+	CreateSourcesFunc                    func(createSourcesOptions *satellitelinkv1.CreateSourcesOptions) (*satellitelinkv1.Source, *core.DetailedResponse, error)
+	CreateSourcesWithContextFunc         func(ctx context.Context, createSourcesOptions *satellitelinkv1.CreateSourcesOptions) (*satellitelinkv1.Source, *core.DetailedResponse, error)
+	UpdateSourcesFunc                    func(updateSourcesOptions *satellitelinkv1.UpdateSourcesOptions) (*satellitelinkv1.Source, *core.DetailedResponse, error)
+	UpdateSourcesWithContextFunc         func(ctx context.Context, updateSourcesOptions *satellitelinkv1.UpdateSourcesOptions) (*satellitelinkv1.Source, *core.DetailedResponse, error)
+	DeleteSourcesFunc                    func(deleteSourcesOptions *satellitelinkv1.DeleteSourcesOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error)
+	DeleteSourcesWithContextFunc         func(ctx context.Context, deleteSourcesOptions *satellitelinkv1.DeleteSourcesOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error)
+	ListSourceEndpointsFunc              func(listSourceEndpointsOptions *satellitelinkv1.ListSourceEndpointsOptions) (*satellitelinkv1.EndpointSourceStatus, *core.DetailedResponse, error)
+	ListSourceEndpointsWithContextFunc   func(ctx context.Context, listSourceEndpointsOptions *satellitelinkv1.ListSourceEndpointsOptions) (*satellitelinkv1.EndpointSourceStatus, *core.DetailedResponse, error)
+	UpdateSourceEndpointsFunc            func(updateSourceEndpointsOptions *satellitelinkv1.UpdateSourceEndpointsOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error)
+	UpdateSourceEndpointsWithContextFunc func(ctx context.Context, updateSourceEndpointsOptions *satellitelinkv1.UpdateSourceEndpointsOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error)
+	WaitForEndpointStatusFunc            func(ctx context.Context, locationID string, endpointID string, status string, options *satellitelinkv1.EndpointWaitOptions) (*satellitelinkv1.Endpoint, error)
+	WaitForEndpointReadyFunc             func(ctx context.Context, locationID string, endpointID string, options *satellitelinkv1.EndpointWaitOptions) (*satellitelinkv1.Endpoint, error)
+	WaitForLinkReadyFunc                 func(ctx context.Context, locationID string, options *satellitelinkv1.LinkWaitOptions) (*satellitelinkv1.Location, error)
+	WaitForSourcesAppliedFunc            func(ctx context.Context, locationID string, endpointID string, options *satellitelinkv1.SourcesWaitOptions) (*satellitelinkv1.SourceStatus, error)
+
+	mutex sync.Mutex
+	calls []Call
+}
+
+var _ satellitelinkv1.API = (*API)(nil)
+
+// Calls returns the calls of the methods of the mock, in order.
+func (m *API) Calls() []Call {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the calls of the method called name, in order.
+func (m *API) CallsTo(name string) []Call {
+	var calls []Call
+	for _, call := range m.Calls() {
+		if call.Method == name {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls recorded so far.
+func (m *API) Reset() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.calls = nil
+}
+
+// record records a call of the method called name.
+func (m *API) record(name string, args ...interface{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.calls = append(m.calls, Call{Method: name, Args: args})
+}
+
+// notStubbed returns the error of the method called name when its function is not set.
+func notStubbed(name string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, name)
+}
+
+// CreateLink calls CreateLinkFunc.
+func (m *API) CreateLink(createLinkOptions *satellitelinkv1.CreateLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error) {
+	m.record("CreateLink", createLinkOptions)
+	if m.CreateLinkFunc != nil {
+		return m.CreateLinkFunc(createLinkOptions)
+	}
+	if m.CreateLinkWithContextFunc != nil {
+		return m.CreateLinkWithContextFunc(context.Background(), createLinkOptions)
+	}
+	var r0 *satellitelinkv1.Location
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateLink")
+}
+
+// CreateLinkWithContext calls CreateLinkWithContextFunc.
+func (m *API) CreateLinkWithContext(ctx context.Context, createLinkOptions *satellitelinkv1.CreateLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error) {
+	m.record("CreateLinkWithContext", ctx, createLinkOptions)
+	if m.CreateLinkWithContextFunc != nil {
+		return m.CreateLinkWithContextFunc(ctx, createLinkOptions)
+	}
+	var r0 *satellitelinkv1.Location
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateLinkWithContext")
+}
+
+// GetLink calls GetLinkFunc.
+func (m *API) GetLink(getLinkOptions *satellitelinkv1.GetLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error) {
+	m.record("GetLink", getLinkOptions)
+	if m.GetLinkFunc != nil {
+		return m.GetLinkFunc(getLinkOptions)
+	}
+	if m.GetLinkWithContextFunc != nil {
+		return m.GetLinkWithContextFunc(context.Background(), getLinkOptions)
+	}
+	var r0 *satellitelinkv1.Location
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetLink")
+}
+
+// GetLinkWithContext calls GetLinkWithContextFunc.
+func (m *API) GetLinkWithContext(ctx context.Context, getLinkOptions *satellitelinkv1.GetLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error) {
+	m.record("GetLinkWithContext", ctx, getLinkOptions)
+	if m.GetLinkWithContextFunc != nil {
+		return m.GetLinkWithContextFunc(ctx, getLinkOptions)
+	}
+	var r0 *satellitelinkv1.Location
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetLinkWithContext")
+}
+
+// UpdateLink calls UpdateLinkFunc.
+func (m *API) UpdateLink(updateLinkOptions *satellitelinkv1.UpdateLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error) {
+	m.record("UpdateLink", updateLinkOptions)
+	if m.UpdateLinkFunc != nil {
+		return m.UpdateLinkFunc(updateLinkOptions)
+	}
+	if m.UpdateLinkWithContextFunc != nil {
+		return m.UpdateLinkWithContextFunc(context.Background(), updateLinkOptions)
+	}
+	var r0 *satellitelinkv1.Location
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateLink")
+}
+
+// UpdateLinkWithContext calls UpdateLinkWithContextFunc.
+func (m *API) UpdateLinkWithContext(ctx context.Context, updateLinkOptions *satellitelinkv1.UpdateLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error) {
+	m.record("UpdateLinkWithContext", ctx, updateLinkOptions)
+	if m.UpdateLinkWithContextFunc != nil {
+		return m.UpdateLinkWithContextFunc(ctx, updateLinkOptions)
+	}
+	var r0 *satellitelinkv1.Location
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateLinkWithContext")
+}
+
+// DeleteLink calls DeleteLinkFunc.
+func (m *API) DeleteLink(deleteLinkOptions *satellitelinkv1.DeleteLinkOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error) {
+	m.record("DeleteLink", deleteLinkOptions)
+	if m.DeleteLinkFunc != nil {
+		return m.DeleteLinkFunc(deleteLinkOptions)
+	}
+	if m.DeleteLinkWithContextFunc != nil {
+		return m.DeleteLinkWithContextFunc(context.Background(), deleteLinkOptions)
+	}
+	var r0 *satellitelinkv1.ExecutionResult
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DeleteLink")
+}
+
+// DeleteLinkWithContext calls DeleteLinkWithContextFunc.
+func (m *API) DeleteLinkWithContext(ctx context.Context, deleteLinkOptions *satellitelinkv1.DeleteLinkOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error) {
+	m.record("DeleteLinkWithContext", ctx, deleteLinkOptions)
+	if m.DeleteLinkWithContextFunc != nil {
+		return m.DeleteLinkWithContextFunc(ctx, deleteLinkOptions)
+	}
+	var r0 *satellitelinkv1.ExecutionResult
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DeleteLinkWithContext")
+}
+
+// ListEndpoints calls ListEndpointsFunc.
+func (m *API) ListEndpoints(listEndpointsOptions *satellitelinkv1.ListEndpointsOptions) (*satellitelinkv1.Endpoints, *core.DetailedResponse, error) {
+	m.record("ListEndpoints", listEndpointsOptions)
+	if m.ListEndpointsFunc != nil {
+		return m.ListEndpointsFunc(listEndpointsOptions)
+	}
+	if m.ListEndpointsWithContextFunc != nil {
+		return m.ListEndpointsWithContextFunc(context.Background(), listEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoints
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListEndpoints")
+}
+
+// ListEndpointsWithContext calls ListEndpointsWithContextFunc.
+func (m *API) ListEndpointsWithContext(ctx context.Context, listEndpointsOptions *satellitelinkv1.ListEndpointsOptions) (*satellitelinkv1.Endpoints, *core.DetailedResponse, error) {
+	m.record("ListEndpointsWithContext", ctx, listEndpointsOptions)
+	if m.ListEndpointsWithContextFunc != nil {
+		return m.ListEndpointsWithContextFunc(ctx, listEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoints
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListEndpointsWithContext")
+}
+
+// CreateEndpoints calls CreateEndpointsFunc.
+func (m *API) CreateEndpoints(createEndpointsOptions *satellitelinkv1.CreateEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("CreateEndpoints", createEndpointsOptions)
+	if m.CreateEndpointsFunc != nil {
+		return m.CreateEndpointsFunc(createEndpointsOptions)
+	}
+	if m.CreateEndpointsWithContextFunc != nil {
+		return m.CreateEndpointsWithContextFunc(context.Background(), createEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateEndpoints")
+}
+
+// CreateEndpointsWithContext calls CreateEndpointsWithContextFunc.
+func (m *API) CreateEndpointsWithContext(ctx context.Context, createEndpointsOptions *satellitelinkv1.CreateEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("CreateEndpointsWithContext", ctx, createEndpointsOptions)
+	if m.CreateEndpointsWithContextFunc != nil {
+		return m.CreateEndpointsWithContextFunc(ctx, createEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateEndpointsWithContext")
+}
+
+// ImportEndpoints calls ImportEndpointsFunc.
+func (m *API) ImportEndpoints(importEndpointsOptions *satellitelinkv1.ImportEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("ImportEndpoints", importEndpointsOptions)
+	if m.ImportEndpointsFunc != nil {
+		return m.ImportEndpointsFunc(importEndpointsOptions)
+	}
+	if m.ImportEndpointsWithContextFunc != nil {
+		return m.ImportEndpointsWithContextFunc(context.Background(), importEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ImportEndpoints")
+}
+
+// ImportEndpointsWithContext calls ImportEndpointsWithContextFunc.
+func (m *API) ImportEndpointsWithContext(ctx context.Context, importEndpointsOptions *satellitelinkv1.ImportEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("ImportEndpointsWithContext", ctx, importEndpointsOptions)
+	if m.ImportEndpointsWithContextFunc != nil {
+		return m.ImportEndpointsWithContextFunc(ctx, importEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ImportEndpointsWithContext")
+}
+
+// ExportEndpoints calls ExportEndpointsFunc.
+func (m *API) ExportEndpoints(exportEndpointsOptions *satellitelinkv1.ExportEndpointsOptions) (*satellitelinkv1.ExportEndpointsResponse, *core.DetailedResponse, error) {
+	m.record("ExportEndpoints", exportEndpointsOptions)
+	if m.ExportEndpointsFunc != nil {
+		return m.ExportEndpointsFunc(exportEndpointsOptions)
+	}
+	if m.ExportEndpointsWithContextFunc != nil {
+		return m.ExportEndpointsWithContextFunc(context.Background(), exportEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.ExportEndpointsResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ExportEndpoints")
+}
+
+// ExportEndpointsWithContext calls ExportEndpointsWithContextFunc.
+func (m *API) ExportEndpointsWithContext(ctx context.Context, exportEndpointsOptions *satellitelinkv1.ExportEndpointsOptions) (*satellitelinkv1.ExportEndpointsResponse, *core.DetailedResponse, error) {
+	m.record("ExportEndpointsWithContext", ctx, exportEndpointsOptions)
+	if m.ExportEndpointsWithContextFunc != nil {
+		return m.ExportEndpointsWithContextFunc(ctx, exportEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.ExportEndpointsResponse
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ExportEndpointsWithContext")
+}
+
+// GetEndpoints calls GetEndpointsFunc.
+func (m *API) GetEndpoints(getEndpointsOptions *satellitelinkv1.GetEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("GetEndpoints", getEndpointsOptions)
+	if m.GetEndpointsFunc != nil {
+		return m.GetEndpointsFunc(getEndpointsOptions)
+	}
+	if m.GetEndpointsWithContextFunc != nil {
+		return m.GetEndpointsWithContextFunc(context.Background(), getEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetEndpoints")
+}
+
+// GetEndpointsWithContext calls GetEndpointsWithContextFunc.
+func (m *API) GetEndpointsWithContext(ctx context.Context, getEndpointsOptions *satellitelinkv1.GetEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("GetEndpointsWithContext", ctx, getEndpointsOptions)
+	if m.GetEndpointsWithContextFunc != nil {
+		return m.GetEndpointsWithContextFunc(ctx, getEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetEndpointsWithContext")
+}
+
+// UpdateEndpoints calls UpdateEndpointsFunc.
+func (m *API) UpdateEndpoints(updateEndpointsOptions *satellitelinkv1.UpdateEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("UpdateEndpoints", updateEndpointsOptions)
+	if m.UpdateEndpointsFunc != nil {
+		return m.UpdateEndpointsFunc(updateEndpointsOptions)
+	}
+	if m.UpdateEndpointsWithContextFunc != nil {
+		return m.UpdateEndpointsWithContextFunc(context.Background(), updateEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateEndpoints")
+}
+
+// UpdateEndpointsWithContext calls UpdateEndpointsWithContextFunc.
+func (m *API) UpdateEndpointsWithContext(ctx context.Context, updateEndpointsOptions *satellitelinkv1.UpdateEndpointsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("UpdateEndpointsWithContext", ctx, updateEndpointsOptions)
+	if m.UpdateEndpointsWithContextFunc != nil {
+		return m.UpdateEndpointsWithContextFunc(ctx, updateEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateEndpointsWithContext")
+}
+
+// DeleteEndpoints calls DeleteEndpointsFunc.
+func (m *API) DeleteEndpoints(deleteEndpointsOptions *satellitelinkv1.DeleteEndpointsOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error) {
+	m.record("DeleteEndpoints", deleteEndpointsOptions)
+	if m.DeleteEndpointsFunc != nil {
+		return m.DeleteEndpointsFunc(deleteEndpointsOptions)
+	}
+	if m.DeleteEndpointsWithContextFunc != nil {
+		return m.DeleteEndpointsWithContextFunc(context.Background(), deleteEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.ExecutionResult
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DeleteEndpoints")
+}
+
+// DeleteEndpointsWithContext calls DeleteEndpointsWithContextFunc.
+func (m *API) DeleteEndpointsWithContext(ctx context.Context, deleteEndpointsOptions *satellitelinkv1.DeleteEndpointsOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error) {
+	m.record("DeleteEndpointsWithContext", ctx, deleteEndpointsOptions)
+	if m.DeleteEndpointsWithContextFunc != nil {
+		return m.DeleteEndpointsWithContextFunc(ctx, deleteEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.ExecutionResult
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DeleteEndpointsWithContext")
+}
+
+// GetEndpointCerts calls GetEndpointCertsFunc.
+func (m *API) GetEndpointCerts(getEndpointCertsOptions *satellitelinkv1.GetEndpointCertsOptions) (*satellitelinkv1.DownloadedCerts, *core.DetailedResponse, error) {
+	m.record("GetEndpointCerts", getEndpointCertsOptions)
+	if m.GetEndpointCertsFunc != nil {
+		return m.GetEndpointCertsFunc(getEndpointCertsOptions)
+	}
+	if m.GetEndpointCertsWithContextFunc != nil {
+		return m.GetEndpointCertsWithContextFunc(context.Background(), getEndpointCertsOptions)
+	}
+	var r0 *satellitelinkv1.DownloadedCerts
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetEndpointCerts")
+}
+
+// GetEndpointCertsWithContext calls GetEndpointCertsWithContextFunc.
+func (m *API) GetEndpointCertsWithContext(ctx context.Context, getEndpointCertsOptions *satellitelinkv1.GetEndpointCertsOptions) (*satellitelinkv1.DownloadedCerts, *core.DetailedResponse, error) {
+	m.record("GetEndpointCertsWithContext", ctx, getEndpointCertsOptions)
+	if m.GetEndpointCertsWithContextFunc != nil {
+		return m.GetEndpointCertsWithContextFunc(ctx, getEndpointCertsOptions)
+	}
+	var r0 *satellitelinkv1.DownloadedCerts
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("GetEndpointCertsWithContext")
+}
+
+// UploadEndpointCerts calls UploadEndpointCertsFunc.
+func (m *API) UploadEndpointCerts(uploadEndpointCertsOptions *satellitelinkv1.UploadEndpointCertsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("UploadEndpointCerts", uploadEndpointCertsOptions)
+	if m.UploadEndpointCertsFunc != nil {
+		return m.UploadEndpointCertsFunc(uploadEndpointCertsOptions)
+	}
+	if m.UploadEndpointCertsWithContextFunc != nil {
+		return m.UploadEndpointCertsWithContextFunc(context.Background(), uploadEndpointCertsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UploadEndpointCerts")
+}
+
+// UploadEndpointCertsWithContext calls UploadEndpointCertsWithContextFunc.
+func (m *API) UploadEndpointCertsWithContext(ctx context.Context, uploadEndpointCertsOptions *satellitelinkv1.UploadEndpointCertsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("UploadEndpointCertsWithContext", ctx, uploadEndpointCertsOptions)
+	if m.UploadEndpointCertsWithContextFunc != nil {
+		return m.UploadEndpointCertsWithContextFunc(ctx, uploadEndpointCertsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UploadEndpointCertsWithContext")
+}
+
+// DeleteEndpointCerts calls DeleteEndpointCertsFunc.
+func (m *API) DeleteEndpointCerts(deleteEndpointCertsOptions *satellitelinkv1.DeleteEndpointCertsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("DeleteEndpointCerts", deleteEndpointCertsOptions)
+	if m.DeleteEndpointCertsFunc != nil {
+		return m.DeleteEndpointCertsFunc(deleteEndpointCertsOptions)
+	}
+	if m.DeleteEndpointCertsWithContextFunc != nil {
+		return m.DeleteEndpointCertsWithContextFunc(context.Background(), deleteEndpointCertsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DeleteEndpointCerts")
+}
+
+// DeleteEndpointCertsWithContext calls DeleteEndpointCertsWithContextFunc.
+func (m *API) DeleteEndpointCertsWithContext(ctx context.Context, deleteEndpointCertsOptions *satellitelinkv1.DeleteEndpointCertsOptions) (*satellitelinkv1.Endpoint, *core.DetailedResponse, error) {
+	m.record("DeleteEndpointCertsWithContext", ctx, deleteEndpointCertsOptions)
+	if m.DeleteEndpointCertsWithContextFunc != nil {
+		return m.DeleteEndpointCertsWithContextFunc(ctx, deleteEndpointCertsOptions)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DeleteEndpointCertsWithContext")
+}
+
+// ListEndpointSources calls ListEndpointSourcesFunc.
+func (m *API) ListEndpointSources(listEndpointSourcesOptions *satellitelinkv1.ListEndpointSourcesOptions) (*satellitelinkv1.SourceStatus, *core.DetailedResponse, error) {
+	m.record("ListEndpointSources", listEndpointSourcesOptions)
+	if m.ListEndpointSourcesFunc != nil {
+		return m.ListEndpointSourcesFunc(listEndpointSourcesOptions)
+	}
+	if m.ListEndpointSourcesWithContextFunc != nil {
+		return m.ListEndpointSourcesWithContextFunc(context.Background(), listEndpointSourcesOptions)
+	}
+	var r0 *satellitelinkv1.SourceStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListEndpointSources")
+}
+
+// ListEndpointSourcesWithContext calls ListEndpointSourcesWithContextFunc.
+func (m *API) ListEndpointSourcesWithContext(ctx context.Context, listEndpointSourcesOptions *satellitelinkv1.ListEndpointSourcesOptions) (*satellitelinkv1.SourceStatus, *core.DetailedResponse, error) {
+	m.record("ListEndpointSourcesWithContext", ctx, listEndpointSourcesOptions)
+	if m.ListEndpointSourcesWithContextFunc != nil {
+		return m.ListEndpointSourcesWithContextFunc(ctx, listEndpointSourcesOptions)
+	}
+	var r0 *satellitelinkv1.SourceStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListEndpointSourcesWithContext")
+}
+
+// UpdateEndpointSources calls UpdateEndpointSourcesFunc.
+func (m *API) UpdateEndpointSources(updateEndpointSourcesOptions *satellitelinkv1.UpdateEndpointSourcesOptions) (*satellitelinkv1.SourceStatus, *core.DetailedResponse, error) {
+	m.record("UpdateEndpointSources", updateEndpointSourcesOptions)
+	if m.UpdateEndpointSourcesFunc != nil {
+		return m.UpdateEndpointSourcesFunc(updateEndpointSourcesOptions)
+	}
+	if m.UpdateEndpointSourcesWithContextFunc != nil {
+		return m.UpdateEndpointSourcesWithContextFunc(context.Background(), updateEndpointSourcesOptions)
+	}
+	var r0 *satellitelinkv1.SourceStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateEndpointSources")
+}
+
+// UpdateEndpointSourcesWithContext calls UpdateEndpointSourcesWithContextFunc.
+func (m *API) UpdateEndpointSourcesWithContext(ctx context.Context, updateEndpointSourcesOptions *satellitelinkv1.UpdateEndpointSourcesOptions) (*satellitelinkv1.SourceStatus, *core.DetailedResponse, error) {
+	m.record("UpdateEndpointSourcesWithContext", ctx, updateEndpointSourcesOptions)
+	if m.UpdateEndpointSourcesWithContextFunc != nil {
+		return m.UpdateEndpointSourcesWithContextFunc(ctx, updateEndpointSourcesOptions)
+	}
+	var r0 *satellitelinkv1.SourceStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateEndpointSourcesWithContext")
+}
+
+// ListSources calls ListSourcesFunc.
+func (m *API) ListSources(listSourcesOptions *satellitelinkv1.ListSourcesOptions) (*satellitelinkv1.Sources, *core.DetailedResponse, error) {
+	m.record("ListSources", listSourcesOptions)
+	if m.ListSourcesFunc != nil {
+		return m.ListSourcesFunc(listSourcesOptions)
+	}
+	if m.ListSourcesWithContextFunc != nil {
+		return m.ListSourcesWithContextFunc(context.Background(), listSourcesOptions)
+	}
+	var r0 *satellitelinkv1.Sources
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListSources")
+}
+
+// ListSourcesWithContext calls ListSourcesWithContextFunc.
+func (m *API) ListSourcesWithContext(ctx context.Context, listSourcesOptions *satellitelinkv1.ListSourcesOptions) (*satellitelinkv1.Sources, *core.DetailedResponse, error) {
+	m.record("ListSourcesWithContext", ctx, listSourcesOptions)
+	if m.ListSourcesWithContextFunc != nil {
+		return m.ListSourcesWithContextFunc(ctx, listSourcesOptions)
+	}
+	var r0 *satellitelinkv1.Sources
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListSourcesWithContext")
+}
+
+// CreateSources calls CreateSourcesFunc.
+func (m *API) CreateSources(createSourcesOptions *satellitelinkv1.CreateSourcesOptions) (*satellitelinkv1.Source, *core.DetailedResponse, error) {
+	m.record("CreateSources", createSourcesOptions)
+	if m.CreateSourcesFunc != nil {
+		return m.CreateSourcesFunc(createSourcesOptions)
+	}
+	if m.CreateSourcesWithContextFunc != nil {
+		return m.CreateSourcesWithContextFunc(context.Background(), createSourcesOptions)
+	}
+	var r0 *satellitelinkv1.Source
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSources")
+}
+
+// CreateSourcesWithContext calls CreateSourcesWithContextFunc.
+func (m *API) CreateSourcesWithContext(ctx context.Context, createSourcesOptions *satellitelinkv1.CreateSourcesOptions) (*satellitelinkv1.Source, *core.DetailedResponse, error) {
+	m.record("CreateSourcesWithContext", ctx, createSourcesOptions)
+	if m.CreateSourcesWithContextFunc != nil {
+		return m.CreateSourcesWithContextFunc(ctx, createSourcesOptions)
+	}
+	var r0 *satellitelinkv1.Source
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("CreateSourcesWithContext")
+}
+
+// UpdateSources calls UpdateSourcesFunc.
+func (m *API) UpdateSources(updateSourcesOptions *satellitelinkv1.UpdateSourcesOptions) (*satellitelinkv1.Source, *core.DetailedResponse, error) {
+	m.record("UpdateSources", updateSourcesOptions)
+	if m.UpdateSourcesFunc != nil {
+		return m.UpdateSourcesFunc(updateSourcesOptions)
+	}
+	if m.UpdateSourcesWithContextFunc != nil {
+		return m.UpdateSourcesWithContextFunc(context.Background(), updateSourcesOptions)
+	}
+	var r0 *satellitelinkv1.Source
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateSources")
+}
+
+// UpdateSourcesWithContext calls UpdateSourcesWithContextFunc.
+func (m *API) UpdateSourcesWithContext(ctx context.Context, updateSourcesOptions *satellitelinkv1.UpdateSourcesOptions) (*satellitelinkv1.Source, *core.DetailedResponse, error) {
+	m.record("UpdateSourcesWithContext", ctx, updateSourcesOptions)
+	if m.UpdateSourcesWithContextFunc != nil {
+		return m.UpdateSourcesWithContextFunc(ctx, updateSourcesOptions)
+	}
+	var r0 *satellitelinkv1.Source
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateSourcesWithContext")
+}
+
+// DeleteSources calls DeleteSourcesFunc.
+func (m *API) DeleteSources(deleteSourcesOptions *satellitelinkv1.DeleteSourcesOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error) {
+	m.record("DeleteSources", deleteSourcesOptions)
+	if m.DeleteSourcesFunc != nil {
+		return m.DeleteSourcesFunc(deleteSourcesOptions)
+	}
+	if m.DeleteSourcesWithContextFunc != nil {
+		return m.DeleteSourcesWithContextFunc(context.Background(), deleteSourcesOptions)
+	}
+	var r0 *satellitelinkv1.ExecutionResult
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DeleteSources")
+}
+
+// DeleteSourcesWithContext calls DeleteSourcesWithContextFunc.
+func (m *API) DeleteSourcesWithContext(ctx context.Context, deleteSourcesOptions *satellitelinkv1.DeleteSourcesOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error) {
+	m.record("DeleteSourcesWithContext", ctx, deleteSourcesOptions)
+	if m.DeleteSourcesWithContextFunc != nil {
+		return m.DeleteSourcesWithContextFunc(ctx, deleteSourcesOptions)
+	}
+	var r0 *satellitelinkv1.ExecutionResult
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("DeleteSourcesWithContext")
+}
+
+// ListSourceEndpoints calls ListSourceEndpointsFunc.
+func (m *API) ListSourceEndpoints(listSourceEndpointsOptions *satellitelinkv1.ListSourceEndpointsOptions) (*satellitelinkv1.EndpointSourceStatus, *core.DetailedResponse, error) {
+	m.record("ListSourceEndpoints", listSourceEndpointsOptions)
+	if m.ListSourceEndpointsFunc != nil {
+		return m.ListSourceEndpointsFunc(listSourceEndpointsOptions)
+	}
+	if m.ListSourceEndpointsWithContextFunc != nil {
+		return m.ListSourceEndpointsWithContextFunc(context.Background(), listSourceEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.EndpointSourceStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListSourceEndpoints")
+}
+
+// ListSourceEndpointsWithContext calls ListSourceEndpointsWithContextFunc.
+func (m *API) ListSourceEndpointsWithContext(ctx context.Context, listSourceEndpointsOptions *satellitelinkv1.ListSourceEndpointsOptions) (*satellitelinkv1.EndpointSourceStatus, *core.DetailedResponse, error) {
+	m.record("ListSourceEndpointsWithContext", ctx, listSourceEndpointsOptions)
+	if m.ListSourceEndpointsWithContextFunc != nil {
+		return m.ListSourceEndpointsWithContextFunc(ctx, listSourceEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.EndpointSourceStatus
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("ListSourceEndpointsWithContext")
+}
+
+// UpdateSourceEndpoints calls UpdateSourceEndpointsFunc.
+func (m *API) UpdateSourceEndpoints(updateSourceEndpointsOptions *satellitelinkv1.UpdateSourceEndpointsOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error) {
+	m.record("UpdateSourceEndpoints", updateSourceEndpointsOptions)
+	if m.UpdateSourceEndpointsFunc != nil {
+		return m.UpdateSourceEndpointsFunc(updateSourceEndpointsOptions)
+	}
+	if m.UpdateSourceEndpointsWithContextFunc != nil {
+		return m.UpdateSourceEndpointsWithContextFunc(context.Background(), updateSourceEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.ExecutionResult
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateSourceEndpoints")
+}
+
+// UpdateSourceEndpointsWithContext calls UpdateSourceEndpointsWithContextFunc.
+func (m *API) UpdateSourceEndpointsWithContext(ctx context.Context, updateSourceEndpointsOptions *satellitelinkv1.UpdateSourceEndpointsOptions) (*satellitelinkv1.ExecutionResult, *core.DetailedResponse, error) {
+	m.record("UpdateSourceEndpointsWithContext", ctx, updateSourceEndpointsOptions)
+	if m.UpdateSourceEndpointsWithContextFunc != nil {
+		return m.UpdateSourceEndpointsWithContextFunc(ctx, updateSourceEndpointsOptions)
+	}
+	var r0 *satellitelinkv1.ExecutionResult
+	var r1 *core.DetailedResponse
+	return r0, r1, notStubbed("UpdateSourceEndpointsWithContext")
+}
+
+// WaitForEndpointStatus calls WaitForEndpointStatusFunc.
+func (m *API) WaitForEndpointStatus(ctx context.Context, locationID string, endpointID string, status string, options *satellitelinkv1.EndpointWaitOptions) (*satellitelinkv1.Endpoint, error) {
+	m.record("WaitForEndpointStatus", ctx, locationID, endpointID, status, options)
+	if m.WaitForEndpointStatusFunc != nil {
+		return m.WaitForEndpointStatusFunc(ctx, locationID, endpointID, status, options)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	return r0, notStubbed("WaitForEndpointStatus")
+}
+
+// WaitForEndpointReady calls WaitForEndpointReadyFunc.
+func (m *API) WaitForEndpointReady(ctx context.Context, locationID string, endpointID string, options *satellitelinkv1.EndpointWaitOptions) (*satellitelinkv1.Endpoint, error) {
+	m.record("WaitForEndpointReady", ctx, locationID, endpointID, options)
+	if m.WaitForEndpointReadyFunc != nil {
+		return m.WaitForEndpointReadyFunc(ctx, locationID, endpointID, options)
+	}
+	var r0 *satellitelinkv1.Endpoint
+	return r0, notStubbed("WaitForEndpointReady")
+}
+
+// WaitForLinkReady calls WaitForLinkReadyFunc.
+func (m *API) WaitForLinkReady(ctx context.Context, locationID string, options *satellitelinkv1.LinkWaitOptions) (*satellitelinkv1.Location, error) {
+	m.record("WaitForLinkReady", ctx, locationID, options)
+	if m.WaitForLinkReadyFunc != nil {
+		return m.WaitForLinkReadyFunc(ctx, locationID, options)
+	}
+	var r0 *satellitelinkv1.Location
+	return r0, notStubbed("WaitForLinkReady")
+}
+
+// WaitForSourcesApplied calls WaitForSourcesAppliedFunc.
+func (m *API) WaitForSourcesApplied(ctx context.Context, locationID string, endpointID string, options *satellitelinkv1.SourcesWaitOptions) (*satellitelinkv1.SourceStatus, error) {
+	m.record("WaitForSourcesApplied", ctx, locationID, endpointID, options)
+	if m.WaitForSourcesAppliedFunc != nil {
+		return m.WaitForSourcesAppliedFunc(ctx, locationID, endpointID, options)
+	}
+	var r0 *satellitelinkv1.SourceStatus
+	return r0, notStubbed("WaitForSourcesApplied")
+}
@@ -0,0 +1,65 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1/mock"
+)
+
+var _ = Describe(`Mock API`, func() {
+	var api *mock.API
+
+	BeforeEach(func() {
+		api = &mock.API{}
+	})
+
+	It(`Serves the API interface with the functions of the methods`, func() {
+		api.GetLinkWithContextFunc = func(ctx context.Context, options *satellitelinkv1.GetLinkOptions) (*satellitelinkv1.Location, *core.DetailedResponse, error) {
+			return &satellitelinkv1.Location{LocationID: options.LocationID, Status: core.StringPtr(satellitelinkv1.Location_Status_Enabled)}, nil, nil
+		}
+		api.WaitForLinkReadyFunc = func(ctx context.Context, locationID string, options *satellitelinkv1.LinkWaitOptions) (*satellitelinkv1.Location, error) {
+			return &satellitelinkv1.Location{LocationID: core.StringPtr(locationID)}, nil
+		}
+
+		var client satellitelinkv1.API = api
+		location, _, err := client.GetLink(&satellitelinkv1.GetLinkOptions{LocationID: core.StringPtr("loc1")})
+		Expect(err).To(BeNil())
+		Expect(*location.Status).To(Equal(satellitelinkv1.Location_Status_Enabled))
+		location, err = client.WaitForLinkReady(context.Background(), "loc2", nil)
+		Expect(err).To(BeNil())
+		Expect(*location.LocationID).To(Equal("loc2"))
+
+		Expect(api.Calls()).To(HaveLen(2))
+		Expect(api.CallsTo("GetLink")[0].Args[0].(*satellitelinkv1.GetLinkOptions).LocationID).To(Equal(core.StringPtr("loc1")))
+	})
+
+	It(`Returns ErrNotStubbed for the methods without a function`, func() {
+		result, response, err := api.DeleteEndpoints(&satellitelinkv1.DeleteEndpointsOptions{})
+		Expect(result).To(BeNil())
+		Expect(response).To(BeNil())
+		Expect(errors.Is(err, mock.ErrNotStubbed)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("DeleteEndpoints"))
+	})
+})
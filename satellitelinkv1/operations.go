@@ -0,0 +1,49 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+package satellitelinkv1
+
+import (
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// operations are the operations of the service by ID, passed to the interceptors in the request context.
+var operations = map[string]common.Operation{
+	"CreateLink":            {ServiceName: "satellite_link", ID: "CreateLink", Method: "POST", PathTemplate: "/v1/locations"},
+	"GetLink":               {ServiceName: "satellite_link", ID: "GetLink", Method: "GET", PathTemplate: "/v1/locations/{location_id}"},
+	"UpdateLink":            {ServiceName: "satellite_link", ID: "UpdateLink", Method: "PATCH", PathTemplate: "/v1/locations/{location_id}"},
+	"DeleteLink":            {ServiceName: "satellite_link", ID: "DeleteLink", Method: "DELETE", PathTemplate: "/v1/locations/{location_id}"},
+	"ListEndpoints":         {ServiceName: "satellite_link", ID: "ListEndpoints", Method: "GET", PathTemplate: "/v1/locations/{location_id}/endpoints"},
+	"CreateEndpoints":       {ServiceName: "satellite_link", ID: "CreateEndpoints", Method: "POST", PathTemplate: "/v1/locations/{location_id}/endpoints"},
+	"ImportEndpoints":       {ServiceName: "satellite_link", ID: "ImportEndpoints", Method: "POST", PathTemplate: "/v1/locations/{location_id}/endpoints/import"},
+	"ExportEndpoints":       {ServiceName: "satellite_link", ID: "ExportEndpoints", Method: "GET", PathTemplate: "/v1/locations/{location_id}/endpoints/{endpoint_id}/export"},
+	"GetEndpoints":          {ServiceName: "satellite_link", ID: "GetEndpoints", Method: "GET", PathTemplate: "/v1/locations/{location_id}/endpoints/{endpoint_id}"},
+	"UpdateEndpoints":       {ServiceName: "satellite_link", ID: "UpdateEndpoints", Method: "PATCH", PathTemplate: "/v1/locations/{location_id}/endpoints/{endpoint_id}"},
+	"DeleteEndpoints":       {ServiceName: "satellite_link", ID: "DeleteEndpoints", Method: "DELETE", PathTemplate: "/v1/locations/{location_id}/endpoints/{endpoint_id}"},
+	"GetEndpointCerts":      {ServiceName: "satellite_link", ID: "GetEndpointCerts", Method: "GET", PathTemplate: "/v1/locations/{location_id}/endpoints/{endpoint_id}/cert"},
+	"UploadEndpointCerts":   {ServiceName: "satellite_link", ID: "UploadEndpointCerts", Method: "POST", PathTemplate: "/v1/locations/{location_id}/endpoints/{endpoint_id}/cert"},
+	"DeleteEndpointCerts":   {ServiceName: "satellite_link", ID: "DeleteEndpointCerts", Method: "DELETE", PathTemplate: "/v1/locations/{location_id}/endpoints/{endpoint_id}/cert"},
+	"ListEndpointSources":   {ServiceName: "satellite_link", ID: "ListEndpointSources", Method: "GET", PathTemplate: "/v1/locations/{location_id}/endpoints/{endpoint_id}/sources"},
+	"UpdateEndpointSources": {ServiceName: "satellite_link", ID: "UpdateEndpointSources", Method: "PATCH", PathTemplate: "/v1/locations/{location_id}/endpoints/{endpoint_id}/sources"},
+	"ListSources":           {ServiceName: "satellite_link", ID: "ListSources", Method: "GET", PathTemplate: "/v1/locations/{location_id}/sources"},
+	"CreateSources":         {ServiceName: "satellite_link", ID: "CreateSources", Method: "POST", PathTemplate: "/v1/locations/{location_id}/sources"},
+	"UpdateSources":         {ServiceName: "satellite_link", ID: "UpdateSources", Method: "PATCH", PathTemplate: "/v1/locations/{location_id}/sources/{source_id}"},
+	"DeleteSources":         {ServiceName: "satellite_link", ID: "DeleteSources", Method: "DELETE", PathTemplate: "/v1/locations/{location_id}/sources/{source_id}"},
+	"ListSourceEndpoints":   {ServiceName: "satellite_link", ID: "ListSourceEndpoints", Method: "GET", PathTemplate: "/v1/locations/{location_id}/sources/{source_id}/endpoints"},
+	"UpdateSourceEndpoints": {ServiceName: "satellite_link", ID: "UpdateSourceEndpoints", Method: "PATCH", PathTemplate: "/v1/locations/{location_id}/sources/{source_id}/endpoints"},
+}
@@ -40,6 +40,13 @@ import (
 // Version: 1.0.0
 type SatelliteLinkV1 struct {
 	Service *core.BaseService
+
+	// strictValidation makes the operations check the constraints of their options that core.ValidateStruct does not
+	// check, as the Validate methods of the options do, before sending them.
+	strictValidation bool
+
+	// interceptors wrap the sending of the request of every operation, in order.
+	interceptors []common.Interceptor
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -53,6 +60,11 @@ type SatelliteLinkV1Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// StrictValidation makes the operations whose options have a Validate method call it before sending them, and
+	// return the violations of the constraints of their options, such as enums, ranges and CIDR blocks, that
+	// core.ValidateStruct does not check.
+	StrictValidation bool
 }
 
 // NewSatelliteLinkV1UsingExternalConfig : constructs an instance of SatelliteLinkV1 with passed in options and external configuration.
@@ -104,7 +116,8 @@ func NewSatelliteLinkV1(options *SatelliteLinkV1Options) (service *SatelliteLink
 	}
 
 	service = &SatelliteLinkV1{
-		Service: baseService,
+		Service:          baseService,
+		strictValidation: options.StrictValidation,
 	}
 
 	return
@@ -122,6 +135,7 @@ func (satelliteLink *SatelliteLinkV1) Clone() *SatelliteLinkV1 {
 	}
 	clone := *satelliteLink
 	clone.Service = satelliteLink.Service.Clone()
+	clone.interceptors = append([]common.Interceptor(nil), satelliteLink.interceptors...)
 	return &clone
 }
 
@@ -135,6 +149,22 @@ func (satelliteLink *SatelliteLinkV1) GetServiceURL() string {
 	return satelliteLink.Service.GetServiceURL()
 }
 
+// SetStrictValidation sets whether the operations validate their options with their Validate method
+func (satelliteLink *SatelliteLinkV1) SetStrictValidation(strictValidation bool) {
+	satelliteLink.strictValidation = strictValidation
+}
+
+// GetStrictValidation returns whether the operations validate their options with their Validate method
+func (satelliteLink *SatelliteLinkV1) GetStrictValidation() bool {
+	return satelliteLink.strictValidation
+}
+
+// AddInterceptor adds an interceptor wrapping the sending of the request of every operation, after the ones
+// already added
+func (satelliteLink *SatelliteLinkV1) AddInterceptor(interceptor common.Interceptor) {
+	satelliteLink.interceptors = append(satelliteLink.interceptors, interceptor)
+}
+
 // SetDefaultHeaders sets HTTP headers to be sent in every request
 func (satelliteLink *SatelliteLinkV1) SetDefaultHeaders(headers http.Header) {
 	satelliteLink.Service.SetDefaultHeaders(headers)
@@ -161,6 +191,14 @@ func (satelliteLink *SatelliteLinkV1) DisableRetries() {
 	satelliteLink.Service.DisableRetries()
 }
 
+// invoke sends the request for operationID through the interceptors, with the operation in its context.
+func (satelliteLink *SatelliteLinkV1) invoke(operationID string, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+	request = request.WithContext(common.WithOperation(request.Context(), operations[operationID]))
+	return common.Intercept(satelliteLink.interceptors, operationID, request, func(request *http.Request) (*core.DetailedResponse, error) {
+		return satelliteLink.Service.Request(request, result)
+	})
+}
+
 // CreateLink : create link [Administrator]
 // Create Link for a Location.
 func (satelliteLink *SatelliteLinkV1) CreateLink(createLinkOptions *CreateLinkOptions) (result *Location, response *core.DetailedResponse, err error) {
@@ -215,7 +253,7 @@ func (satelliteLink *SatelliteLinkV1) CreateLinkWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("CreateLink", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -273,7 +311,7 @@ func (satelliteLink *SatelliteLinkV1) GetLinkWithContext(ctx context.Context, ge
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("GetLink", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -341,7 +379,7 @@ func (satelliteLink *SatelliteLinkV1) UpdateLinkWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("UpdateLink", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -399,7 +437,7 @@ func (satelliteLink *SatelliteLinkV1) DeleteLinkWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("DeleteLink", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -461,7 +499,7 @@ func (satelliteLink *SatelliteLinkV1) ListEndpointsWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("ListEndpoints", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -490,6 +528,10 @@ func (satelliteLink *SatelliteLinkV1) CreateEndpointsWithContext(ctx context.Con
 	if err != nil {
 		return
 	}
+	err = satelliteLink.validateStrict(createEndpointsOptions)
+	if err != nil {
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"location_id": *createEndpointsOptions.LocationID,
@@ -565,7 +607,7 @@ func (satelliteLink *SatelliteLinkV1) CreateEndpointsWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("CreateEndpoints", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -626,7 +668,7 @@ func (satelliteLink *SatelliteLinkV1) ImportEndpointsWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("ImportEndpoints", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -685,7 +727,7 @@ func (satelliteLink *SatelliteLinkV1) ExportEndpointsWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("ExportEndpoints", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -744,7 +786,7 @@ func (satelliteLink *SatelliteLinkV1) GetEndpointsWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("GetEndpoints", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -773,6 +815,10 @@ func (satelliteLink *SatelliteLinkV1) UpdateEndpointsWithContext(ctx context.Con
 	if err != nil {
 		return
 	}
+	err = satelliteLink.validateStrict(updateEndpointsOptions)
+	if err != nil {
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"location_id": *updateEndpointsOptions.LocationID,
@@ -849,7 +895,7 @@ func (satelliteLink *SatelliteLinkV1) UpdateEndpointsWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("UpdateEndpoints", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -908,7 +954,7 @@ func (satelliteLink *SatelliteLinkV1) DeleteEndpointsWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("DeleteEndpoints", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -971,7 +1017,7 @@ func (satelliteLink *SatelliteLinkV1) GetEndpointCertsWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("GetEndpointCerts", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1051,7 +1097,7 @@ func (satelliteLink *SatelliteLinkV1) UploadEndpointCertsWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("UploadEndpointCerts", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1110,7 +1156,7 @@ func (satelliteLink *SatelliteLinkV1) DeleteEndpointCertsWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("DeleteEndpointCerts", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1169,7 +1215,7 @@ func (satelliteLink *SatelliteLinkV1) ListEndpointSourcesWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("ListEndpointSources", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1241,7 +1287,7 @@ func (satelliteLink *SatelliteLinkV1) UpdateEndpointSourcesWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("UpdateEndpointSources", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1303,7 +1349,7 @@ func (satelliteLink *SatelliteLinkV1) ListSourcesWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("ListSources", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1332,6 +1378,10 @@ func (satelliteLink *SatelliteLinkV1) CreateSourcesWithContext(ctx context.Conte
 	if err != nil {
 		return
 	}
+	err = satelliteLink.validateStrict(createSourcesOptions)
+	if err != nil {
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"location_id": *createSourcesOptions.LocationID,
@@ -1377,7 +1427,7 @@ func (satelliteLink *SatelliteLinkV1) CreateSourcesWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("CreateSources", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1406,6 +1456,10 @@ func (satelliteLink *SatelliteLinkV1) UpdateSourcesWithContext(ctx context.Conte
 	if err != nil {
 		return
 	}
+	err = satelliteLink.validateStrict(updateSourcesOptions)
+	if err != nil {
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"location_id": *updateSourcesOptions.LocationID,
@@ -1449,7 +1503,7 @@ func (satelliteLink *SatelliteLinkV1) UpdateSourcesWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("UpdateSources", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1508,7 +1562,7 @@ func (satelliteLink *SatelliteLinkV1) DeleteSourcesWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("DeleteSources", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1568,7 +1622,7 @@ func (satelliteLink *SatelliteLinkV1) ListSourceEndpointsWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("ListSourceEndpoints", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1638,7 +1692,7 @@ func (satelliteLink *SatelliteLinkV1) UpdateSourceEndpointsWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = satelliteLink.Service.Request(request, &rawResponse)
+	response, err = satelliteLink.invoke("UpdateSourceEndpoints", request, &rawResponse)
 	if err != nil {
 		return
 	}
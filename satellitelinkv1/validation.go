@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package satellitelinkv1
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// namePattern matches the names of the endpoints and sources: starting with a letter, ending with an alphanumeric
+// character, made of letters, numbers and hyphens, and 63 characters or fewer.
+var namePattern = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+
+// serverProtocols are the server protocols allowed with each client protocol.
+var serverProtocols = map[string][]string{
+	Endpoint_ClientProtocol_Http:       {Endpoint_ServerProtocol_Tcp, Endpoint_ServerProtocol_Tls},
+	Endpoint_ClientProtocol_HttpTunnel: {Endpoint_ServerProtocol_Tcp},
+	Endpoint_ClientProtocol_Https:      {Endpoint_ServerProtocol_Tcp, Endpoint_ServerProtocol_Tls},
+	Endpoint_ClientProtocol_Tcp:        {Endpoint_ServerProtocol_Tcp, Endpoint_ServerProtocol_Tls},
+	Endpoint_ClientProtocol_Tls:        {Endpoint_ServerProtocol_Tcp, Endpoint_ServerProtocol_Tls},
+	Endpoint_ClientProtocol_Udp:        {Endpoint_ServerProtocol_Udp},
+}
+
+// validateStrict returns the violations of the constraints of options if strict validation is enabled and options
+// has a Validate method.
+func (satelliteLink *SatelliteLinkV1) validateStrict(options interface{}) error {
+	if validator, ok := options.(interface{ Validate() error }); ok && satelliteLink.strictValidation {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *CreateEndpointsOptions) Validate() error {
+	validation := common.NewValidation("CreateEndpointsOptions")
+	validation.Enum("ConnType", options.ConnType, CreateEndpointsOptions_ConnType_Cloud, CreateEndpointsOptions_ConnType_Location)
+	validateEndpoint(validation, options.DisplayName, options.ServerPort, options.Sni, options.ClientProtocol, options.ServerProtocol)
+	validation.MaxLength("CreatedBy", options.CreatedBy, 1000)
+	return validation.Err()
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *UpdateEndpointsOptions) Validate() error {
+	validation := common.NewValidation("UpdateEndpointsOptions")
+	validateEndpoint(validation, options.DisplayName, options.ServerPort, options.Sni, options.ClientProtocol, options.ServerProtocol)
+	validation.MaxLength("CreatedBy", options.CreatedBy, 1000)
+	return validation.Err()
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *CreateSourcesOptions) Validate() error {
+	validation := common.NewValidation("CreateSourcesOptions")
+	validation.Enum("Type", options.Type, CreateSourcesOptions_Type_Service, CreateSourcesOptions_Type_User)
+	validateSource(validation, options.SourceName, options.Addresses)
+	return validation.Err()
+}
+
+// Validate returns the violations of the constraints of the options as a *common.ValidationErrors, or nil.
+func (options *UpdateSourcesOptions) Validate() error {
+	validation := common.NewValidation("UpdateSourcesOptions")
+	validateSource(validation, options.SourceName, options.Addresses)
+	return validation.Err()
+}
+
+// validateEndpoint checks the constraints shared by the options creating and updating endpoints. The server protocol
+// is checked against the client protocol, and the port and SNI against the protocols, when they are set.
+func validateEndpoint(validation *common.Validation, displayName *string, serverPort *int64, sni *string, clientProtocol *string, serverProtocol *string) {
+	validateName(validation, "DisplayName", displayName)
+	validation.Enum("ClientProtocol", clientProtocol,
+		Endpoint_ClientProtocol_Http, Endpoint_ClientProtocol_HttpTunnel, Endpoint_ClientProtocol_Https,
+		Endpoint_ClientProtocol_Tcp, Endpoint_ClientProtocol_Tls, Endpoint_ClientProtocol_Udp)
+	validation.Enum("ServerProtocol", serverProtocol,
+		Endpoint_ServerProtocol_Tcp, Endpoint_ServerProtocol_Tls, Endpoint_ServerProtocol_Udp)
+
+	if clientProtocol != nil && serverProtocol != nil {
+		if allowed, ok := serverProtocols[*clientProtocol]; ok && !contains(allowed, *serverProtocol) {
+			validation.Errorf("ServerProtocol", "cannot be '%s' with the '%s' client protocol", *serverProtocol, *clientProtocol)
+		}
+	}
+
+	validation.Range("ServerPort", serverPort, 0, 65535)
+	if serverPort != nil && *serverPort == 0 && clientProtocol != nil && *clientProtocol != Endpoint_ClientProtocol_HttpTunnel {
+		validation.Errorf("ServerPort", "can only be 0 with the '%s' client protocol", Endpoint_ClientProtocol_HttpTunnel)
+	}
+
+	// The server protocol defaults to 'tls' for the 'tls' and 'https' client protocols, and to 'tcp' or 'udp' otherwise.
+	effectiveServerProtocol := ""
+	if serverProtocol != nil {
+		effectiveServerProtocol = *serverProtocol
+	} else if clientProtocol != nil {
+		effectiveServerProtocol = Endpoint_ServerProtocol_Tcp
+		switch *clientProtocol {
+		case Endpoint_ClientProtocol_Tls, Endpoint_ClientProtocol_Https:
+			effectiveServerProtocol = Endpoint_ServerProtocol_Tls
+		case Endpoint_ClientProtocol_Udp:
+			effectiveServerProtocol = Endpoint_ServerProtocol_Udp
+		}
+	}
+	if sni != nil && effectiveServerProtocol != "" && effectiveServerProtocol != Endpoint_ServerProtocol_Tls {
+		validation.Errorf("Sni", "is only used with the '%s' server protocol, not '%s'", Endpoint_ServerProtocol_Tls, effectiveServerProtocol)
+	}
+}
+
+// validateSource checks the constraints shared by the options creating and updating sources. The addresses are IP
+// addresses or CIDR blocks.
+func validateSource(validation *common.Validation, sourceName *string, addresses []string) {
+	validateName(validation, "SourceName", sourceName)
+	for i, address := range addresses {
+		if _, _, err := net.ParseCIDR(address); err != nil && net.ParseIP(address) == nil {
+			validation.Errorf(fmt.Sprintf("Addresses[%d]", i), "must be an IP address or a CIDR block, not '%s'", address)
+		}
+	}
+}
+
+// validateName checks the name of an endpoint or source.
+func validateName(validation *common.Validation, field string, name *string) {
+	if name != nil && !namePattern.MatchString(*name) {
+		validation.Errorf(field, "must start with a letter, end with a letter or number, contain only letters, numbers and hyphens, and be 63 characters or fewer, not '%s'", *name)
+	}
+}
+
+// contains reports whether values contains value.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
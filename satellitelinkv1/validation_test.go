@@ -0,0 +1,111 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package satellitelinkv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Strict validation`, func() {
+	var testServer *httptest.Server
+	var satelliteLinkService *satellitelinkv1.SatelliteLinkV1
+	var requests int
+
+	BeforeEach(func() {
+		requests = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(201)
+			fmt.Fprintf(res, "%s", `{}`)
+		}))
+		var serviceErr error
+		satelliteLinkService, serviceErr = satellitelinkv1.NewSatelliteLinkV1(&satellitelinkv1.SatelliteLinkV1Options{
+			URL:              testServer.URL,
+			Authenticator:    &core.NoAuthAuthenticator{},
+			StrictValidation: true,
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Lists every violation of the endpoint options before sending them`, func() {
+		createEndpointsOptionsModel := satelliteLinkService.NewCreateEndpointsOptions("testString")
+		createEndpointsOptionsModel.SetConnType("internet")
+		createEndpointsOptionsModel.SetDisplayName("1-endpoint")
+		createEndpointsOptionsModel.SetServerPort(70000)
+		createEndpointsOptionsModel.SetClientProtocol(satellitelinkv1.CreateEndpointsOptions_ClientProtocol_Udp)
+		createEndpointsOptionsModel.SetServerProtocol(satellitelinkv1.CreateEndpointsOptions_ServerProtocol_Tcp)
+		createEndpointsOptionsModel.SetSni("api.example.com")
+
+		_, _, operationErr := satelliteLinkService.CreateEndpoints(createEndpointsOptionsModel)
+		var validationErrs *common.ValidationErrors
+		Expect(errors.As(operationErr, &validationErrs)).To(BeTrue())
+		Expect(validationErrs.Options).To(Equal("CreateEndpointsOptions"))
+		fields := []string{}
+		for _, validationErr := range validationErrs.Errors {
+			fields = append(fields, validationErr.Field)
+		}
+		Expect(fields).To(Equal([]string{"ConnType", "DisplayName", "ServerProtocol", "ServerPort", "Sni"}))
+		Expect(requests).To(Equal(0))
+
+		createEndpointsOptionsModel.SetConnType(satellitelinkv1.CreateEndpointsOptions_ConnType_Cloud)
+		createEndpointsOptionsModel.SetDisplayName("endpoint-1")
+		createEndpointsOptionsModel.SetServerPort(443)
+		createEndpointsOptionsModel.SetClientProtocol(satellitelinkv1.CreateEndpointsOptions_ClientProtocol_Https)
+		createEndpointsOptionsModel.ServerProtocol = nil
+		Expect(createEndpointsOptionsModel.Validate()).To(BeNil())
+		_, _, operationErr = satelliteLinkService.CreateEndpoints(createEndpointsOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(requests).To(Equal(1))
+
+		updateEndpointsOptionsModel := satelliteLinkService.NewUpdateEndpointsOptions("testString", "testString")
+		updateEndpointsOptionsModel.SetClientProtocol(satellitelinkv1.UpdateEndpointsOptions_ClientProtocol_Tcp)
+		updateEndpointsOptionsModel.SetServerPort(0)
+		_, _, operationErr = satelliteLinkService.UpdateEndpoints(updateEndpointsOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(Equal("UpdateEndpointsOptions is not valid: ServerPort can only be 0 with the 'http-tunnel' client protocol"))
+	})
+
+	It(`Checks the source addresses`, func() {
+		createSourcesOptionsModel := satelliteLinkService.NewCreateSourcesOptions("testString")
+		createSourcesOptionsModel.SetType(satellitelinkv1.CreateSourcesOptions_Type_User)
+		createSourcesOptionsModel.SetSourceName("source-1")
+		createSourcesOptionsModel.SetAddresses([]string{"10.0.0.1", "10.0.0.0/8", "10.0.0.0/33"})
+		_, _, operationErr := satelliteLinkService.CreateSources(createSourcesOptionsModel)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(Equal("CreateSourcesOptions is not valid: Addresses[2] must be an IP address or a CIDR block, not '10.0.0.0/33'"))
+		Expect(requests).To(Equal(0))
+
+		satelliteLinkService.SetStrictValidation(false)
+		Expect(satelliteLinkService.GetStrictValidation()).To(BeFalse())
+		_, _, operationErr = satelliteLinkService.CreateSources(createSourcesOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(requests).To(Equal(1))
+	})
+})
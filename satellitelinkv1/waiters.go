@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package satellitelinkv1
+
+import (
+	"context"
+
+	common "github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// EndpointWaitOptions : The options of the endpoint waiters.
+type EndpointWaitOptions struct {
+	common.WaitOptions
+
+	// Called with the endpoint after every successful poll.
+	OnProgress func(endpoint *Endpoint)
+}
+
+// LinkWaitOptions : The options of WaitForLinkReady.
+type LinkWaitOptions struct {
+	common.WaitOptions
+
+	// Called with the location after every successful poll.
+	OnProgress func(location *Location)
+}
+
+// SourcesWaitOptions : The options of WaitForSourcesApplied.
+type SourcesWaitOptions struct {
+	common.WaitOptions
+
+	// Called with the sources of the endpoint after every successful poll.
+	OnProgress func(sources []SourceStatusObject)
+}
+
+// WaitForEndpointStatus polls the endpoint with GetEndpoints until its status is status (Endpoint_Status_Enabled or
+// Endpoint_Status_Disabled) and returns the last endpoint read.
+func (satelliteLink *SatelliteLinkV1) WaitForEndpointStatus(ctx context.Context, locationID string, endpointID string, status string, options *EndpointWaitOptions) (*Endpoint, error) {
+	return satelliteLink.waitForEndpoint(ctx, locationID, endpointID, options, func(endpoint *Endpoint) bool {
+		return endpoint.Status != nil && *endpoint.Status == status
+	})
+}
+
+// WaitForEndpointReady polls the endpoint with GetEndpoints until it is enabled and none of its sources is pending,
+// and returns the last endpoint read.
+func (satelliteLink *SatelliteLinkV1) WaitForEndpointReady(ctx context.Context, locationID string, endpointID string, options *EndpointWaitOptions) (*Endpoint, error) {
+	return satelliteLink.waitForEndpoint(ctx, locationID, endpointID, options, func(endpoint *Endpoint) bool {
+		return endpoint.Status != nil && *endpoint.Status == Endpoint_Status_Enabled && !hasPendingSources(endpoint.Sources)
+	})
+}
+
+// WaitForLinkReady polls the location with GetLink until its Satellite Link is enabled and returns the last location
+// read.
+func (satelliteLink *SatelliteLinkV1) WaitForLinkReady(ctx context.Context, locationID string, options *LinkWaitOptions) (result *Location, err error) {
+	if options == nil {
+		options = &LinkWaitOptions{}
+	}
+	err = common.Poll(ctx, &options.WaitOptions, func(ctx context.Context) (bool, error) {
+		location, _, getErr := satelliteLink.GetLinkWithContext(ctx, satelliteLink.NewGetLinkOptions(locationID))
+		if getErr != nil {
+			return false, getErr
+		}
+		result = location
+		if options.OnProgress != nil {
+			options.OnProgress(location)
+		}
+		return location.Status != nil && *location.Status == Location_Status_Enabled, nil
+	})
+	return
+}
+
+// WaitForSourcesApplied polls the sources of the endpoint with ListEndpointSources until none of them is pending, for
+// example after UpdateEndpointSources, and returns the last sources read.
+func (satelliteLink *SatelliteLinkV1) WaitForSourcesApplied(ctx context.Context, locationID string, endpointID string, options *SourcesWaitOptions) (result *SourceStatus, err error) {
+	if options == nil {
+		options = &SourcesWaitOptions{}
+	}
+	err = common.Poll(ctx, &options.WaitOptions, func(ctx context.Context) (bool, error) {
+		sourceStatus, _, listErr := satelliteLink.ListEndpointSourcesWithContext(ctx, satelliteLink.NewListEndpointSourcesOptions(locationID, endpointID))
+		if listErr != nil {
+			return false, listErr
+		}
+		result = sourceStatus
+		if options.OnProgress != nil {
+			options.OnProgress(sourceStatus.Sources)
+		}
+		return !hasPendingSources(sourceStatus.Sources), nil
+	})
+	return
+}
+
+// waitForEndpoint polls the endpoint with GetEndpoints until done reports true.
+func (satelliteLink *SatelliteLinkV1) waitForEndpoint(ctx context.Context, locationID string, endpointID string, options *EndpointWaitOptions, done func(endpoint *Endpoint) bool) (result *Endpoint, err error) {
+	if options == nil {
+		options = &EndpointWaitOptions{}
+	}
+	err = common.Poll(ctx, &options.WaitOptions, func(ctx context.Context) (bool, error) {
+		endpoint, _, getErr := satelliteLink.GetEndpointsWithContext(ctx, satelliteLink.NewGetEndpointsOptions(locationID, endpointID))
+		if getErr != nil {
+			return false, getErr
+		}
+		result = endpoint
+		if options.OnProgress != nil {
+			options.OnProgress(endpoint)
+		}
+		return done(endpoint), nil
+	})
+	return
+}
+
+// hasPendingSources reports whether any of sources is still being applied.
+func hasPendingSources(sources []SourceStatusObject) bool {
+	for _, source := range sources {
+		if source.Pending != nil && *source.Pending {
+			return true
+		}
+	}
+	return false
+}
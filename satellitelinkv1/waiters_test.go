@@ -0,0 +1,133 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package satellitelinkv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SatelliteLinkV1 waiters`, func() {
+	var testServer *httptest.Server
+	var satelliteLinkService *satellitelinkv1.SatelliteLinkV1
+	var requestedPaths []string
+	var mutex sync.Mutex
+
+	// startServer serves the bodies in order, repeating the last one.
+	var startServer = func(bodies ...string) {
+		requestedPaths = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			requestedPaths = append(requestedPaths, req.URL.Path)
+			body := bodies[0]
+			if len(bodies) > 1 {
+				bodies = bodies[1:]
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", body)
+		}))
+		var serviceErr error
+		satelliteLinkService, serviceErr = satellitelinkv1.NewSatelliteLinkV1(&satellitelinkv1.SatelliteLinkV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	var waitOptions = common.WaitOptions{Interval: time.Millisecond, Timeout: 5 * time.Second}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`WaitForEndpointStatus waits for the endpoint status`, func() {
+		startServer(`{"endpoint_id": "e1", "status": "disabled"}`, `{"endpoint_id": "e1", "status": "enabled"}`)
+
+		polls := 0
+		endpoint, err := satelliteLinkService.WaitForEndpointStatus(context.Background(), "l1", "e1", satellitelinkv1.Endpoint_Status_Enabled, &satellitelinkv1.EndpointWaitOptions{
+			WaitOptions: waitOptions,
+			OnProgress:  func(endpoint *satellitelinkv1.Endpoint) { polls++ },
+		})
+		Expect(err).To(BeNil())
+		Expect(*endpoint.Status).To(Equal("enabled"))
+		Expect(polls).To(Equal(2))
+		Expect(requestedPaths[0]).To(Equal("/v1/locations/l1/endpoints/e1"))
+	})
+	It(`WaitForEndpointReady waits for pending sources`, func() {
+		startServer(
+			`{"endpoint_id": "e1", "status": "enabled", "sources": [{"source_id": "s1", "pending": true}]}`,
+			`{"endpoint_id": "e1", "status": "enabled", "sources": [{"source_id": "s1", "pending": false}]}`,
+		)
+
+		endpoint, err := satelliteLinkService.WaitForEndpointReady(context.Background(), "l1", "e1", &satellitelinkv1.EndpointWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+		Expect(*endpoint.Sources[0].Pending).To(BeFalse())
+		Expect(requestedPaths).To(HaveLen(2))
+	})
+	It(`WaitForLinkReady waits for the location to be enabled`, func() {
+		startServer(`{"location_id": "l1", "status": "disabled"}`, `{"location_id": "l1", "status": "enabled"}`)
+
+		location, err := satelliteLinkService.WaitForLinkReady(context.Background(), "l1", &satellitelinkv1.LinkWaitOptions{WaitOptions: waitOptions})
+		Expect(err).To(BeNil())
+		Expect(*location.Status).To(Equal("enabled"))
+		Expect(requestedPaths[0]).To(Equal("/v1/locations/l1"))
+	})
+	It(`WaitForSourcesApplied waits until no source is pending`, func() {
+		startServer(
+			`{"sources": [{"source_id": "s1", "pending": true}, {"source_id": "s2", "pending": false}]}`,
+			`{"sources": [{"source_id": "s1", "pending": false}, {"source_id": "s2", "pending": false}]}`,
+		)
+
+		var pending []int
+		sourceStatus, err := satelliteLinkService.WaitForSourcesApplied(context.Background(), "l1", "e1", &satellitelinkv1.SourcesWaitOptions{
+			WaitOptions: waitOptions,
+			OnProgress: func(sources []satellitelinkv1.SourceStatusObject) {
+				count := 0
+				for _, source := range sources {
+					if *source.Pending {
+						count++
+					}
+				}
+				pending = append(pending, count)
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(sourceStatus.Sources).To(HaveLen(2))
+		Expect(pending).To(Equal([]int{1, 0}))
+		Expect(requestedPaths[0]).To(Equal("/v1/locations/l1/endpoints/e1/sources"))
+	})
+	It(`Returns the context error when cancelled`, func() {
+		startServer(`{"location_id": "l1", "status": "disabled"}`)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := satelliteLinkService.WaitForLinkReady(ctx, "l1", &satellitelinkv1.LinkWaitOptions{WaitOptions: common.WaitOptions{Interval: time.Millisecond}})
+		Expect(err).ToNot(BeNil())
+	})
+})
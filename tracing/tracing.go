@@ -0,0 +1,160 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tracing provides OpenTelemetry tracing of the operations of the service clients.
+//
+// A Tracer opens a client span for every operation, named after the service and the operation, as in
+// "kubernetes_service_api.GetCluster", and injects the W3C traceparent header into the request. It is added to a
+// client as an interceptor, and optionally installed on its core.BaseService to count the retries of the requests:
+//
+//	tracer := tracing.NewTracer(nil)
+//	kubernetesServiceApi.AddInterceptor(tracer.Intercept)
+//	tracer.Install(kubernetesServiceApi.Service)
+//
+// The spans are created with the global TracerProvider unless Options.TracerProvider is set, so tracing is a no-op
+// until a TracerProvider is configured.
+package tracing
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/common"
+)
+
+// InstrumentationName is the name of the tracer the spans are created with.
+const InstrumentationName = "github.com/IBM-Cloud/container-services-go-sdk/tracing"
+
+// The attributes of the spans.
+const (
+	AttributeServiceName = attribute.Key("ibm.service_name")
+	AttributeOperationID = attribute.Key("ibm.operation_id")
+	AttributeHTTPMethod  = attribute.Key("http.method")
+	AttributeURLTemplate = attribute.Key("url.template")
+	AttributeStatusCode  = attribute.Key("http.status_code")
+	AttributeIncidentID  = attribute.Key("ibm.incident_id")
+	AttributeRetryCount  = attribute.Key("http.retry_count")
+)
+
+// Options : The options of NewTracer.
+type Options struct {
+	// The provider of the tracer the spans are created with. Defaults to the global TracerProvider.
+	TracerProvider trace.TracerProvider
+
+	// The propagator injecting the span context into the requests. Defaults to propagation.TraceContext, which
+	// injects the W3C traceparent and tracestate headers.
+	Propagator propagation.TextMapPropagator
+}
+
+// Tracer : Opens a span for every operation of the clients it is added to.
+type Tracer struct {
+	options Options
+}
+
+// NewTracer returns a Tracer configured by options, which may be nil.
+func NewTracer(options *Options) *Tracer {
+	tracer := &Tracer{}
+	if options != nil {
+		tracer.options = *options
+	}
+	if tracer.options.Propagator == nil {
+		tracer.options.Propagator = propagation.TraceContext{}
+	}
+	return tracer
+}
+
+// tracer returns the tracer the spans are created with.
+func (tracer *Tracer) tracer() trace.Tracer {
+	provider := tracer.options.TracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(InstrumentationName, trace.WithInstrumentationVersion(common.Version))
+}
+
+// Intercept is a common.Interceptor opening a span for the operation operationID, a child of the span of the request
+// context if any.
+func (tracer *Tracer) Intercept(operationID string, request *http.Request, next common.Invoker) (*core.DetailedResponse, error) {
+	operation, ok := common.OperationFromContext(request.Context())
+	if !ok {
+		operation = common.Operation{ID: operationID, Method: request.Method}
+	}
+	name := operationID
+	if operation.ServiceName != "" {
+		name = operation.ServiceName + "." + operationID
+	}
+
+	ctx, span := tracer.tracer().Start(request.Context(), name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			AttributeServiceName.String(operation.ServiceName),
+			AttributeOperationID.String(operationID),
+			AttributeHTTPMethod.String(request.Method),
+			AttributeURLTemplate.String(operation.PathTemplate),
+		))
+	defer span.End()
+	if !span.IsRecording() {
+		return next(request)
+	}
+
+	ctx, counter := common.WithAttemptCounter(ctx)
+	request = request.WithContext(ctx)
+	tracer.options.Propagator.Inject(ctx, propagation.HeaderCarrier(request.Header))
+
+	response, err := next(request)
+	if response != nil {
+		span.SetAttributes(AttributeStatusCode.Int(response.StatusCode))
+	}
+	if counter.Attempts() > 0 {
+		span.SetAttributes(AttributeRetryCount.Int64(counter.Retries()))
+	}
+	if err != nil {
+		if incidentID := incidentID(response); incidentID != "" {
+			span.SetAttributes(AttributeIncidentID.String(incidentID))
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return response, err
+}
+
+// Install makes the client of service count the attempts to send the requests of the spans, for their retry count
+// attribute, as common.CountAttempts does.
+func (tracer *Tracer) Install(service *core.BaseService) {
+	common.CountAttempts(service)
+}
+
+// incidentID returns the IBM Cloud incident ID of an error response, if any.
+func incidentID(response *core.DetailedResponse) string {
+	if response == nil {
+		return ""
+	}
+	result, ok := response.Result.(map[string]interface{})
+	if !ok && len(response.RawResult) > 0 {
+		json.Unmarshal(response.RawResult, &result)
+	}
+	if id, ok := result["incidentID"].(string); ok {
+		return id
+	}
+	return ""
+}
@@ -0,0 +1,123 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1/fake"
+	"github.com/IBM-Cloud/container-services-go-sdk/tracing"
+)
+
+var _ = Describe(`Tracer`, func() {
+	var server *fake.Server
+	var service *kubernetesserviceapiv1.KubernetesServiceApiV1
+	var exporter *tracetest.InMemoryExporter
+	var provider *sdktrace.TracerProvider
+
+	// attributes returns the attributes of span by key.
+	var attributes = func(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+		values := map[attribute.Key]attribute.Value{}
+		for _, kv := range span.Attributes {
+			values[kv.Key] = kv.Value
+		}
+		return values
+	}
+
+	BeforeEach(func() {
+		server = fake.NewServer(nil)
+		var err error
+		service, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(&kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		exporter = tracetest.NewInMemoryExporter()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	})
+	AfterEach(func() {
+		server.Close()
+		provider.Shutdown(context.Background())
+	})
+
+	It(`Opens a span per operation and propagates its context`, func() {
+		service.AddInterceptor(tracing.NewTracer(&tracing.Options{TracerProvider: provider}).Intercept)
+		_, _, err := service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).To(BeNil())
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name).To(Equal("kubernetes_service_api.VpcGetClusters"))
+		Expect(attributes(spans[0])).To(Equal(map[attribute.Key]attribute.Value{
+			tracing.AttributeServiceName: attribute.StringValue("kubernetes_service_api"),
+			tracing.AttributeOperationID: attribute.StringValue("VpcGetClusters"),
+			tracing.AttributeHTTPMethod:  attribute.StringValue("GET"),
+			tracing.AttributeURLTemplate: attribute.StringValue("/v2/vpc/getClusters"),
+			tracing.AttributeStatusCode:  attribute.IntValue(200),
+		}))
+		Expect(spans[0].Status.Code).To(Equal(codes.Unset))
+
+		traceparent := server.Requests()[0].Header.Get("traceparent")
+		Expect(traceparent).To(ContainSubstring(spans[0].SpanContext.TraceID().String()))
+		Expect(traceparent).To(ContainSubstring(spans[0].SpanContext.SpanID().String()))
+	})
+
+	It(`Records the errors with their incident ID`, func() {
+		service.AddInterceptor(tracing.NewTracer(&tracing.Options{TracerProvider: provider}).Intercept)
+		_, _, err := service.GetCluster(service.NewGetClusterOptions("missing"))
+		Expect(err).ToNot(BeNil())
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status.Code).To(Equal(codes.Error))
+		Expect(attributes(spans[0])[tracing.AttributeStatusCode]).To(Equal(attribute.IntValue(404)))
+		Expect(attributes(spans[0])[tracing.AttributeIncidentID].AsString()).To(HavePrefix("fake-"))
+		Expect(spans[0].Events).To(HaveLen(1))
+	})
+
+	It(`Counts the retries once installed`, func() {
+		service.EnableRetries(2, 10*time.Millisecond)
+		tracer := tracing.NewTracer(&tracing.Options{TracerProvider: provider})
+		service.AddInterceptor(tracer.Intercept)
+		tracer.Install(service.Service)
+		server.InjectFault(fake.Fault{Path: "/v2/vpc/getClusters", StatusCode: 503, RetryAfter: "0", Count: 2})
+
+		_, _, err := service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).To(BeNil())
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(attributes(spans[0])[tracing.AttributeRetryCount]).To(Equal(attribute.Int64Value(2)))
+	})
+
+	It(`Is a no-op without a TracerProvider`, func() {
+		service.AddInterceptor(tracing.NewTracer(nil).Intercept)
+		_, _, err := service.VpcGetClusters(service.NewVpcGetClustersOptions())
+		Expect(err).To(BeNil())
+		Expect(server.Requests()[0].Header.Get("traceparent")).To(BeEmpty())
+		Expect(exporter.GetSpans()).To(BeEmpty())
+	})
+})